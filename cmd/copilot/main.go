@@ -20,6 +20,10 @@ type actionRecommender interface {
 	RecommendActions() string
 }
 
+type exitCoder interface {
+	ExitCode() int
+}
+
 func init() {
 	color.DisableColorBasedOnEnvVar()
 	cobra.EnableCommandSorting = false // Maintain the order in which we add commands.
@@ -33,6 +37,10 @@ func main() {
 			log.Infoln(ac.RecommendActions())
 		}
 		log.Errorln(err.Error())
+		var ec exitCoder
+		if errors.As(err, &ec) && ec.ExitCode() > 0 {
+			os.Exit(ec.ExitCode())
+		}
 		os.Exit(1)
 	}
 }
@@ -73,6 +73,39 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "bucket.yml",
 		},
+		"redis": {
+			addonMarshaler: addon.NewRedisTemplate(&addon.RedisProps{
+				StorageProps: &addon.StorageProps{
+					Name: "cache",
+				},
+				NodeType:      addon.DefaultRedisNodeType,
+				Replicas:      1,
+				EngineVersion: addon.DefaultRedisEngineVersion,
+			}),
+			outFileName: "redis.yml",
+		},
+		"opensearch": {
+			addonMarshaler: addon.NewOpenSearchTemplate(&addon.OpenSearchProps{
+				StorageProps: &addon.StorageProps{
+					Name: "search",
+				},
+				InstanceType:  addon.DefaultOpenSearchInstanceType,
+				InstanceCount: 2,
+				VolumeSize:    addon.DefaultOpenSearchVolumeSize,
+				EngineVersion: addon.DefaultOpenSearchEngineVersion,
+			}),
+			outFileName: "opensearch.yml",
+		},
+		"sqs": {
+			addonMarshaler: addon.NewSQSTemplate(&addon.SQSProps{
+				StorageProps: &addon.StorageProps{
+					Name: "work-queue",
+				},
+				FIFO:            true,
+				MaxReceiveCount: addon.DefaultSQSMaxReceiveCount,
+			}),
+			outFileName: "queue.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -18,6 +18,8 @@ import (
 const (
 	// StackName is the name of the addons nested stack resource.
 	StackName = "AddonsStack"
+
+	envAddonsLabel = "environments" // Used in place of a workload name for addons shared by an environment.
 )
 
 var (
@@ -30,6 +32,9 @@ var (
 		}
 		return fnames
 	}()
+
+	wkldReservedParameters = []string{"App", "Env", "Name"}
+	envReservedParameters  = []string{"App", "Env"}
 )
 
 type workspaceReader interface {
@@ -37,9 +42,30 @@ type workspaceReader interface {
 	ReadAddon(svcName, fileName string) ([]byte, error)
 }
 
-// Addons represents additional resources for a workload.
+type envWorkspaceReader interface {
+	ReadEnvAddonsDir() ([]string, error)
+	ReadEnvAddon(fileName string) ([]byte, error)
+}
+
+// envAddonsReader adapts the environment addons directory to a workspaceReader.
+type envAddonsReader struct {
+	ws envWorkspaceReader
+}
+
+// ReadAddonsDir returns the file names under the environment addons directory.
+func (r envAddonsReader) ReadAddonsDir(_ string) ([]string, error) {
+	return r.ws.ReadEnvAddonsDir()
+}
+
+// ReadAddon returns the contents of a file under the environment addons directory.
+func (r envAddonsReader) ReadAddon(_, fileName string) ([]byte, error) {
+	return r.ws.ReadEnvAddon(fileName)
+}
+
+// Addons represents additional resources for a workload or an environment.
 type Addons struct {
-	wlName string
+	wlName         string
+	reservedParams []string // Parameters passed by the parent stack that can't be declared in the parameters file.
 
 	parser template.Parser
 	ws     workspaceReader
@@ -52,9 +78,25 @@ func New(wlName string) (*Addons, error) {
 		return nil, fmt.Errorf("workspace cannot be created: %w", err)
 	}
 	return &Addons{
-		wlName: wlName,
-		parser: template.New(),
-		ws:     ws,
+		wlName:         wlName,
+		reservedParams: wkldReservedParameters,
+		parser:         template.New(),
+		ws:             ws,
+	}, nil
+}
+
+// NewEnv creates an Addons object for the resources under "environments/addons/" that are
+// deployed as a nested stack of the environment stack and shared by all its workloads.
+func NewEnv() (*Addons, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("workspace cannot be created: %w", err)
+	}
+	return &Addons{
+		wlName:         envAddonsLabel,
+		reservedParams: envReservedParameters,
+		parser:         template.New(),
+		ws:             envAddonsReader{ws: ws},
 	}, nil
 }
 
@@ -149,18 +191,18 @@ func (a *Addons) Parameters() (string, error) {
 }
 
 func (a *Addons) validateReservedParameters(params yaml.Node, fname string) error {
-	content := struct {
-		App  yaml.Node `yaml:"App"`
-		Env  yaml.Node `yaml:"Env"`
-		Name yaml.Node `yaml:"Name"`
-	}{}
+	content := make(map[string]yaml.Node)
 	if err := params.Decode(&content); err != nil {
 		return fmt.Errorf("decode content of parameters file %s under %s addons/", fname, a.wlName)
 	}
 
-	for _, field := range []yaml.Node{content.App, content.Env, content.Name} {
-		if !field.IsZero() {
-			return fmt.Errorf("reserved parameters 'App', 'Env', and 'Name' cannot be declared in %s under %s addons/", fname, a.wlName)
+	for _, param := range a.reservedParams {
+		if _, ok := content[param]; ok {
+			quoted := make([]string, len(a.reservedParams))
+			for i, name := range a.reservedParams {
+				quoted[i] = fmt.Sprintf("'%s'", name)
+			}
+			return fmt.Errorf("reserved parameters %s cannot be declared in %s under %s addons/", english.OxfordWordSeries(quoted, "and"), fname, a.wlName)
 		}
 	}
 	return nil
@@ -344,12 +344,50 @@ Parameters:
   DiscoveryServiceArn: !GetAtt DiscoveryService.Arn
 `), nil)
 				return &Addons{
-					wlName: "api",
-					ws:     ws,
+					wlName:         "api",
+					reservedParams: wkldReservedParameters,
+					ws:             ws,
 				}
 			},
 			wantedErr: "reserved parameters 'App', 'Env', and 'Name' cannot be declared in addons.parameters.yml under api addons/",
 		},
+		"returns an error if reserved environment parameter fields are redefined in an environment parameters file": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockenvWorkspaceReader(ctrl)
+				ws.EXPECT().ReadEnvAddonsDir().
+					Return([]string{"addons.parameters.yml", "template.yaml"}, nil)
+				ws.EXPECT().ReadEnvAddon("addons.parameters.yml").Return([]byte(`
+Parameters:
+  Env: !Ref EnvironmentName
+`), nil)
+				return &Addons{
+					wlName:         envAddonsLabel,
+					reservedParams: envReservedParameters,
+					ws:             envAddonsReader{ws: ws},
+				}
+			},
+			wantedErr: "reserved parameters 'App' and 'Env' cannot be declared in addons.parameters.yml under environments addons/",
+		},
+		"allows a Name parameter in an environment parameters file": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockenvWorkspaceReader(ctrl)
+				ws.EXPECT().ReadEnvAddonsDir().
+					Return([]string{"addons.parameters.yml", "template.yaml"}, nil)
+				ws.EXPECT().ReadEnvAddon("addons.parameters.yml").Return([]byte(`
+Parameters:
+  Name: shared
+  VpcId: !Ref VPC
+`), nil)
+				return &Addons{
+					wlName:         envAddonsLabel,
+					reservedParams: envReservedParameters,
+					ws:             envAddonsReader{ws: ws},
+				}
+			},
+			wantedParams: `Name: shared
+VpcId: !Ref VPC
+`,
+		},
 		"returns the content of Parameters on success": {
 			mockAddons: func(ctrl *gomock.Controller) *Addons {
 				ws := mocks.NewMockworkspaceReader(ctrl)
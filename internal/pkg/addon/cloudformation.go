@@ -301,6 +301,7 @@ func mappingContents(mappingNode *yaml.Node) []mappingContent {
 //
 // We ignore the style (ex: single quote vs. double) in which the nodes are defined, the comments associated with
 // the nodes, and the indentation and position of the nodes as they're only visual properties and don't matter.
+// Similarly, an intrinsic function in its short form (ex: !Ref) is equal to its full form (ex: Ref:).
 func isEqual(first *yaml.Node, second *yaml.Node) bool {
 	if first == nil {
 		return second == nil
@@ -308,6 +309,7 @@ func isEqual(first *yaml.Node, second *yaml.Node) bool {
 	if second == nil {
 		return false
 	}
+	first, second = toFullFormIntrinsic(first), toFullFormIntrinsic(second)
 	if len(first.Content) != len(second.Content) {
 		return false
 	}
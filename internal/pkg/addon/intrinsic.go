@@ -0,0 +1,78 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fullFormIntrinsicKeys maps the YAML tag of an intrinsic function in its short form to the key of its full form.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/intrinsic-function-reference.html
+var fullFormIntrinsicKeys = map[string]string{
+	"!Ref":         "Ref",
+	"!Condition":   "Condition",
+	"!Base64":      "Fn::Base64",
+	"!Cidr":        "Fn::Cidr",
+	"!FindInMap":   "Fn::FindInMap",
+	"!GetAtt":      "Fn::GetAtt",
+	"!GetAZs":      "Fn::GetAZs",
+	"!ImportValue": "Fn::ImportValue",
+	"!Join":        "Fn::Join",
+	"!Select":      "Fn::Select",
+	"!Split":       "Fn::Split",
+	"!Sub":         "Fn::Sub",
+	"!Transform":   "Fn::Transform",
+	"!And":         "Fn::And",
+	"!Equals":      "Fn::Equals",
+	"!If":          "Fn::If",
+	"!Not":         "Fn::Not",
+	"!Or":          "Fn::Or",
+}
+
+// defaultTags are the tags that YAML resolves untagged nodes to.
+var defaultTags = map[yaml.Kind]string{
+	yaml.ScalarNode:   "!!str",
+	yaml.SequenceNode: "!!seq",
+	yaml.MappingNode:  "!!map",
+}
+
+// toFullFormIntrinsic returns the full form of a node that holds an intrinsic function in its short form.
+// For example, "!GetAtt Bucket.Arn" is returned as "Fn::GetAtt: [Bucket, Arn]".
+// If the node isn't a short form intrinsic function, it's returned as is.
+func toFullFormIntrinsic(node *yaml.Node) *yaml.Node {
+	key, ok := fullFormIntrinsicKeys[node.Tag]
+	if !ok {
+		return node
+	}
+	value := *node
+	value.Tag = defaultTags[node.Kind]
+	if node.Tag == "!GetAtt" && node.Kind == yaml.ScalarNode {
+		// The short form of Fn::GetAtt also accepts "logicalID.attribute" instead of a list.
+		value = yaml.Node{
+			Kind: yaml.SequenceNode,
+			Tag:  defaultTags[yaml.SequenceNode],
+		}
+		for _, part := range strings.SplitN(node.Value, ".", 2) {
+			value.Content = append(value.Content, &yaml.Node{
+				Kind:  yaml.ScalarNode,
+				Tag:   defaultTags[yaml.ScalarNode],
+				Value: part,
+			})
+		}
+	}
+	return &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  defaultTags[yaml.MappingNode],
+		Content: []*yaml.Node{
+			{
+				Kind:  yaml.ScalarNode,
+				Tag:   defaultTags[yaml.ScalarNode],
+				Value: key,
+			},
+			&value,
+		},
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/dustin/go-humanize/english"
+	"gopkg.in/yaml.v3"
+)
+
+// Display settings for the merge report.
+const (
+	minCellWidth           = 20
+	tabWidth               = 4
+	cellPaddingWidth       = 2
+	paddingChar            = ' '
+	noAdditionalFormatting = 0
+
+	sideBySideSeparator = " | "
+)
+
+// Sections of a CloudFormation template whose logical IDs are reported, in the order they're merged.
+var reportedSections = []struct {
+	name     string
+	twoLevel bool // True if the logical IDs are merged one level deeper, like Mappings.
+	field    func(tpl *cfnTemplate) *yaml.Node
+}{
+	{name: "Metadata", field: func(tpl *cfnTemplate) *yaml.Node { return &tpl.Metadata }},
+	{name: "Parameters", field: func(tpl *cfnTemplate) *yaml.Node { return &tpl.Parameters }},
+	{name: "Mappings", twoLevel: true, field: func(tpl *cfnTemplate) *yaml.Node { return &tpl.Mappings }},
+	{name: "Conditions", field: func(tpl *cfnTemplate) *yaml.Node { return &tpl.Conditions }},
+	{name: "Resources", field: func(tpl *cfnTemplate) *yaml.Node { return &tpl.Resources }},
+	{name: "Outputs", field: func(tpl *cfnTemplate) *yaml.Node { return &tpl.Outputs }},
+}
+
+// MergeReport describes how the templates under an addons directory are merged into a single template.
+type MergeReport struct {
+	WlName    string           `json:"name"`
+	Entries   []*MergeEntry    `json:"entries"`
+	Conflicts []*MergeConflict `json:"conflicts,omitempty"`
+}
+
+// MergeEntry is a logical ID of the merged template along with the files that define it.
+type MergeEntry struct {
+	Section   string   `json:"section"`
+	LogicalID string   `json:"logicalID"` // For Mappings, the mapping and its key separated by a dot.
+	Files     []string `json:"files"`     // Files that define the logical ID with the same body, in merge order.
+}
+
+// MergeConflict is a logical ID that is defined with different bodies in two files.
+type MergeConflict struct {
+	Section   string     `json:"section"`
+	LogicalID string     `json:"logicalID"`
+	First     Definition `json:"first"`
+	Second    Definition `json:"second"`
+}
+
+// Definition is the body of a logical ID in a template file.
+type Definition struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	Body   string `json:"body"`
+}
+
+// MergeReport reads every template under the addons directory and reports the file each logical ID comes from
+// along with all the logical IDs that are defined differently across files.
+//
+// If the addons directory doesn't exist or has no templates, it returns ErrAddonsNotFound.
+func (a *Addons) MergeReport() (*MergeReport, error) {
+	fnames, err := a.ws.ReadAddonsDir(a.wlName)
+	if err != nil {
+		return nil, &ErrAddonsNotFound{
+			WlName:    a.wlName,
+			ParentErr: err,
+		}
+	}
+	templateFiles := filterFiles(fnames, yamlMatcher, nonParamsMatcher)
+	if len(templateFiles) == 0 {
+		return nil, &ErrAddonsNotFound{
+			WlName: a.wlName,
+		}
+	}
+
+	report := &MergeReport{
+		WlName: a.wlName,
+	}
+	firstDefinition := make(map[string]*reportedNode) // Keyed by section and logical ID.
+	entryFor := make(map[string]*MergeEntry)
+	for _, fname := range templateFiles {
+		out, err := a.ws.ReadAddon(a.wlName, fname)
+		if err != nil {
+			return nil, fmt.Errorf("read addon %s under %s: %w", fname, a.wlName, err)
+		}
+		tpl := newCFNTemplate(fname)
+		if err := yaml.Unmarshal(out, tpl); err != nil {
+			return nil, fmt.Errorf("unmarshal addon %s under %s: %w", fname, a.wlName, err)
+		}
+		for _, section := range reportedSections {
+			for _, node := range logicalIDNodes(section.field(tpl), section.twoLevel) {
+				id := section.name + "." + node.logicalID
+				first, ok := firstDefinition[id]
+				if !ok {
+					node.file = fname
+					firstDefinition[id] = node
+					entry := &MergeEntry{
+						Section:   section.name,
+						LogicalID: node.logicalID,
+						Files:     []string{fname},
+					}
+					entryFor[id] = entry
+					report.Entries = append(report.Entries, entry)
+					continue
+				}
+				if isEqual(first.value, node.value) {
+					entryFor[id].Files = append(entryFor[id].Files, fname)
+					continue
+				}
+				node.file = fname
+				report.Conflicts = append(report.Conflicts, &MergeConflict{
+					Section:   section.name,
+					LogicalID: node.logicalID,
+					First:     first.definition(),
+					Second:    node.definition(),
+				})
+			}
+		}
+	}
+	return report, nil
+}
+
+// HasConflicts returns true if at least one logical ID is defined differently across files.
+func (r *MergeReport) HasConflicts() bool {
+	return len(r.Conflicts) > 0
+}
+
+// JSONString returns the stringified MergeReport struct with json format.
+func (r *MergeReport) JSONString() (string, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("marshal addons merge report: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified MergeReport struct with human readable format.
+func (r *MergeReport) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	var section string
+	for _, entry := range r.Entries {
+		if entry.Section != section {
+			if section != "" {
+				fmt.Fprint(writer, "\n")
+			}
+			section = entry.Section
+			fmt.Fprint(writer, color.Bold.Sprintf("%s\n\n", section))
+			fmt.Fprintf(writer, "  %s\t%s\n", "Logical ID", "Files")
+			fmt.Fprintf(writer, "  %s\t%s\n", strings.Repeat("-", len("Logical ID")), strings.Repeat("-", len("Files")))
+		}
+		fmt.Fprintf(writer, "  %s\t%s\n", entry.LogicalID, strings.Join(entry.Files, ", "))
+	}
+	writer.Flush()
+	if !r.HasConflicts() {
+		return b.String()
+	}
+	fmt.Fprint(&b, color.Bold.Sprintf("\nConflicts\n"))
+	for _, conflict := range r.Conflicts {
+		fmt.Fprintf(&b, "\n  %s %s is defined differently in %s and %s:\n\n", singularSection(conflict.Section), conflict.LogicalID,
+			conflict.First.File, conflict.Second.File)
+		writeSideBySide(&b, conflict.First, conflict.Second)
+	}
+	fmt.Fprintf(&b, "\n  Found %s. Make both definitions identical or rename one of the logical IDs so that the templates can be merged.\n",
+		english.Plural(len(r.Conflicts), "conflict", "conflicts"))
+	return b.String()
+}
+
+// writeSideBySide writes the two definitions next to each other, one line of each body per row.
+func writeSideBySide(b *bytes.Buffer, first, second Definition) {
+	left := append([]string{first.title()}, strings.Split(strings.TrimSuffix(first.Body, "\n"), "\n")...)
+	right := append([]string{second.title()}, strings.Split(strings.TrimSuffix(second.Body, "\n"), "\n")...)
+	width := 0
+	for _, line := range left {
+		if len(line) > width {
+			width = len(line)
+		}
+	}
+	rows := len(left)
+	if len(right) > rows {
+		rows = len(right)
+	}
+	for i := 0; i < rows; i++ {
+		var l, r string
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+		fmt.Fprintf(b, "    %-*s%s%s\n", width, l, sideBySideSeparator, r)
+	}
+}
+
+func (d Definition) title() string {
+	return fmt.Sprintf("%s:%d:%d", d.File, d.Line, d.Column)
+}
+
+func singularSection(section string) string {
+	switch section {
+	case "Metadata":
+		return "Metadata key"
+	case "Parameters":
+		return "Parameter"
+	case "Mappings":
+		return "Mapping"
+	case "Conditions":
+		return "Condition"
+	case "Resources":
+		return "Resource"
+	case "Outputs":
+		return "Output"
+	}
+	return section
+}
+
+// reportedNode is a logical ID and its body in a template.
+type reportedNode struct {
+	logicalID string
+	key       *yaml.Node
+	value     *yaml.Node
+	file      string
+}
+
+func (n *reportedNode) definition() Definition {
+	return Definition{
+		File:   n.file,
+		Line:   n.key.Line,
+		Column: n.key.Column,
+		Body:   marshalNode(n.value),
+	}
+}
+
+// logicalIDNodes returns the logical IDs under a section of a template.
+// If twoLevel is true, the keys under each top-level key are returned instead, for example "Mapping.Key".
+func logicalIDNodes(section *yaml.Node, twoLevel bool) []*reportedNode {
+	if section.IsZero() || section.Kind != yaml.MappingNode {
+		return nil
+	}
+	var nodes []*reportedNode
+	for _, content := range mappingContents(section) {
+		if !twoLevel || content.valueNode.Kind != yaml.MappingNode {
+			nodes = append(nodes, &reportedNode{
+				logicalID: content.keyNode.Value,
+				key:       content.keyNode,
+				value:     content.valueNode,
+			})
+			continue
+		}
+		for _, nested := range mappingContents(content.valueNode) {
+			nodes = append(nodes, &reportedNode{
+				logicalID: content.keyNode.Value + "." + nested.keyNode.Value,
+				key:       nested.keyNode,
+				value:     nested.valueNode,
+			})
+		}
+	}
+	return nodes
+}
+
+// marshalNode returns the YAML body of a node. Intrinsic functions keep the form they're written in.
+func marshalNode(node *yaml.Node) string {
+	var b strings.Builder
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return node.Value
+	}
+	encoder.Close()
+	return b.String()
+}
@@ -0,0 +1,218 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddons_MergeReport(t *testing.T) {
+	const testSvcName = "mysvc"
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		order []string          // Files under the addons directory.
+		files map[string]string // Content of each template.
+
+		wantedReport *MergeReport
+		wantedErr    error
+	}{
+		"return ErrAddonsNotFound if the addons directory doesn't have templates": {
+			order: []string{"addons.parameters.yml"},
+			wantedErr: &ErrAddonsNotFound{
+				WlName: testSvcName,
+			},
+		},
+		"report the files that define each logical ID": {
+			order: []string{"bucket.yml", "table.yml"},
+			files: map[string]string{
+				"bucket.yml": `Parameters:
+  App:
+    Type: String
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+Outputs:
+  BucketName:
+    Value: !Ref Bucket
+`,
+				"table.yml": `Parameters:
+  App:
+    Type: String
+Mappings:
+  TableSettings:
+    test:
+      BillingMode: PAY_PER_REQUEST
+Resources:
+  Table:
+    Type: AWS::DynamoDB::Table
+`,
+			},
+			wantedReport: &MergeReport{
+				WlName: testSvcName,
+				Entries: []*MergeEntry{
+					{Section: "Parameters", LogicalID: "App", Files: []string{"bucket.yml", "table.yml"}},
+					{Section: "Resources", LogicalID: "Bucket", Files: []string{"bucket.yml"}},
+					{Section: "Outputs", LogicalID: "BucketName", Files: []string{"bucket.yml"}},
+					{Section: "Mappings", LogicalID: "TableSettings.test", Files: []string{"table.yml"}},
+					{Section: "Resources", LogicalID: "Table", Files: []string{"table.yml"}},
+				},
+			},
+		},
+		"short and full form intrinsic functions are the same definition": {
+			order: []string{"a.yml", "b.yml"},
+			files: map[string]string{
+				"a.yml": `Outputs:
+  BucketArn:
+    Value: !GetAtt Bucket.Arn
+  BucketName:
+    Value: !Sub '${Bucket}'
+`,
+				"b.yml": `Outputs:
+  BucketArn:
+    Value:
+      Fn::GetAtt: [Bucket, Arn]
+  BucketName:
+    Value:
+      Fn::Sub: '${Bucket}'
+`,
+			},
+			wantedReport: &MergeReport{
+				WlName: testSvcName,
+				Entries: []*MergeEntry{
+					{Section: "Outputs", LogicalID: "BucketArn", Files: []string{"a.yml", "b.yml"}},
+					{Section: "Outputs", LogicalID: "BucketName", Files: []string{"a.yml", "b.yml"}},
+				},
+			},
+		},
+		"report conflicting definitions side by side": {
+			order: []string{"a.yml", "b.yml"},
+			files: map[string]string{
+				"a.yml": `Outputs:
+  BucketName:
+    Value: !Ref Bucket
+`,
+				"b.yml": `Outputs:
+  BucketName:
+    Value: !Ref OtherBucket
+`,
+			},
+			wantedReport: &MergeReport{
+				WlName: testSvcName,
+				Entries: []*MergeEntry{
+					{Section: "Outputs", LogicalID: "BucketName", Files: []string{"a.yml"}},
+				},
+				Conflicts: []*MergeConflict{
+					{
+						Section:   "Outputs",
+						LogicalID: "BucketName",
+						First: Definition{
+							File:   "a.yml",
+							Line:   2,
+							Column: 3,
+							Body:   "Value: !Ref Bucket\n",
+						},
+						Second: Definition{
+							File:   "b.yml",
+							Line:   2,
+							Column: 3,
+							Body:   "Value: !Ref OtherBucket\n",
+						},
+					},
+				},
+			},
+		},
+		"wrap error on invalid template": {
+			order: []string{"a.yml"},
+			files: map[string]string{
+				"a.yml": "Resources: [",
+			},
+			wantedErr: errors.New("unmarshal addon a.yml under mysvc: yaml: line 1: did not find expected node content"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockworkspaceReader(ctrl)
+			ws.EXPECT().ReadAddonsDir(testSvcName).Return(tc.order, nil)
+			for fname, content := range tc.files {
+				ws.EXPECT().ReadAddon(testSvcName, fname).Return([]byte(content), nil)
+			}
+			addons := &Addons{
+				wlName: testSvcName,
+				ws:     ws,
+			}
+
+			// WHEN
+			report, err := addons.MergeReport()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedReport, report)
+		})
+	}
+
+	t.Run("wrap error on failing to read the addons directory", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ws := mocks.NewMockworkspaceReader(ctrl)
+		ws.EXPECT().ReadAddonsDir(testSvcName).Return(nil, testErr)
+
+		_, err := (&Addons{wlName: testSvcName, ws: ws}).MergeReport()
+
+		require.EqualError(t, err, "read addons directory for mysvc: some error")
+	})
+}
+
+func TestMergeReport_HumanString(t *testing.T) {
+	report := &MergeReport{
+		WlName: "mysvc",
+		Entries: []*MergeEntry{
+			{Section: "Resources", LogicalID: "Bucket", Files: []string{"a.yml"}},
+			{Section: "Outputs", LogicalID: "BucketName", Files: []string{"a.yml", "b.yml"}},
+		},
+		Conflicts: []*MergeConflict{
+			{
+				Section:   "Outputs",
+				LogicalID: "BucketArn",
+				First:     Definition{File: "a.yml", Line: 2, Column: 3, Body: "Value: !GetAtt Bucket.Arn\n"},
+				Second:    Definition{File: "b.yml", Line: 5, Column: 3, Body: "Value:\n  Fn::GetAtt: [Other, Arn]\n"},
+			},
+		},
+	}
+
+	require.Equal(t, `Resources
+
+  Logical ID        Files
+  ----------        -----
+  Bucket            a.yml
+
+Outputs
+
+  Logical ID        Files
+  ----------        -----
+  BucketName        a.yml, b.yml
+
+Conflicts
+
+  Output BucketArn is defined differently in a.yml and b.yml:
+
+    a.yml:2:3                 | b.yml:5:3
+    Value: !GetAtt Bucket.Arn | Value:
+                              |   Fn::GetAtt: [Other, Arn]
+
+  Found 1 conflict. Make both definitions identical or rename one of the logical IDs so that the templates can be merged.
+`, report.HumanString())
+}
@@ -15,11 +15,14 @@ import (
 )
 
 const (
-	dynamoDbTemplatePath = "addons/ddb/cf.yml"
-	s3TemplatePath       = "addons/s3/cf.yml"
-	rdsTemplatePath      = "addons/aurora/cf.yml"
-	rdsRDWSTemplatePath  = "addons/aurora/rdws/cf.yml"
-	rdsRDWSParamsPath    = "addons/aurora/rdws/addons.parameters.yml"
+	dynamoDbTemplatePath   = "addons/ddb/cf.yml"
+	s3TemplatePath         = "addons/s3/cf.yml"
+	rdsTemplatePath        = "addons/aurora/cf.yml"
+	rdsRDWSTemplatePath    = "addons/aurora/rdws/cf.yml"
+	rdsRDWSParamsPath      = "addons/aurora/rdws/addons.parameters.yml"
+	redisTemplatePath      = "addons/redis/cf.yml"
+	openSearchTemplatePath = "addons/opensearch/cf.yml"
+	sqsTemplatePath        = "addons/sqs/cf.yml"
 )
 
 const (
@@ -35,6 +38,7 @@ var storageTemplateFunctions = map[string]interface{}{
 	"envVarName":    template.EnvVarNameFunc,
 	"envVarSecret":  template.EnvVarSecretFunc,
 	"toSnakeCase":   template.ToSnakeCaseFunc,
+	"inc":           template.IncFunc,
 }
 
 // DynamoDBTemplate contains configuration options which fully describe a DynamoDB table.
@@ -106,6 +110,57 @@ func (r *RDSParams) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
+// RedisTemplate contains configuration options which fully describe an ElastiCache Redis replication group.
+// Implements the encoding.BinaryMarshaler interface.
+type RedisTemplate struct {
+	RedisProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (r *RedisTemplate) MarshalBinary() ([]byte, error) {
+	content, err := r.parser.Parse(redisTemplatePath, *r, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// OpenSearchTemplate contains configuration options which fully describe an OpenSearch domain.
+// Implements the encoding.BinaryMarshaler interface.
+type OpenSearchTemplate struct {
+	OpenSearchProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (o *OpenSearchTemplate) MarshalBinary() ([]byte, error) {
+	content, err := o.parser.Parse(openSearchTemplatePath, *o, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// SQSTemplate contains configuration options which fully describe an SQS queue and its dead-letter queue.
+// Implements the encoding.BinaryMarshaler interface.
+type SQSTemplate struct {
+	SQSProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (s *SQSTemplate) MarshalBinary() ([]byte, error) {
+	content, err := s.parser.Parse(sqsTemplatePath, *s, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
 // StorageProps holds basic input properties for addon.NewDDBTemplate() or addon.NewS3Template().
 type StorageProps struct {
 	Name string
@@ -171,6 +226,67 @@ func NewRDSParams() *RDSParams {
 	}
 }
 
+// Default values for the Redis, OpenSearch and SQS addons.
+const (
+	DefaultRedisNodeType           = "cache.t4g.micro"
+	DefaultRedisEngineVersion      = "7.0"
+	DefaultOpenSearchInstanceType  = "t3.small.search"
+	DefaultOpenSearchVolumeSize    = 10
+	DefaultOpenSearchEngineVersion = "OpenSearch_2.5"
+	DefaultSQSMaxReceiveCount      = 10
+)
+
+// RedisProps holds ElastiCache Redis-specific properties for addon.NewRedisTemplate().
+type RedisProps struct {
+	*StorageProps
+	NodeType      string // The node type of the replication group, for example "cache.t4g.micro".
+	Replicas      int    // The number of read replicas of the primary node.
+	EngineVersion string // The version of the Redis engine.
+}
+
+// NewRedisTemplate creates a new Redis marshaler which can be used to write an ElastiCache CloudFormation template.
+func NewRedisTemplate(input *RedisProps) *RedisTemplate {
+	return &RedisTemplate{
+		RedisProps: *input,
+
+		parser: template.New(),
+	}
+}
+
+// OpenSearchProps holds OpenSearch-specific properties for addon.NewOpenSearchTemplate().
+type OpenSearchProps struct {
+	*StorageProps
+	InstanceType  string // The instance type of the data nodes, for example "t3.small.search".
+	InstanceCount int    // The number of data nodes, the nodes are spread across two Availability Zones if there is more than one.
+	VolumeSize    int    // The size in GiB of the EBS volume of each data node.
+	EngineVersion string // The version of the engine, for example "OpenSearch_2.5".
+}
+
+// NewOpenSearchTemplate creates a new OpenSearch marshaler which can be used to write an OpenSearch CloudFormation template.
+func NewOpenSearchTemplate(input *OpenSearchProps) *OpenSearchTemplate {
+	return &OpenSearchTemplate{
+		OpenSearchProps: *input,
+
+		parser: template.New(),
+	}
+}
+
+// SQSProps holds SQS-specific properties for addon.NewSQSTemplate().
+type SQSProps struct {
+	*StorageProps
+	FIFO            bool // True if the queues preserve the order of messages and deliver them exactly once.
+	MaxReceiveCount int  // The number of times a message is received before it's moved to the dead-letter queue.
+}
+
+// NewSQSTemplate creates a new SQS marshaler which can be used to write an SQS CloudFormation template.
+func NewSQSTemplate(input *SQSProps) *SQSTemplate {
+	return &SQSTemplate{
+		SQSProps: *input,
+
+		parser: template.New(),
+	}
+}
+
 // BuildPartitionKey generates the properties required to specify the partition key
 // based on customer inputs.
 func (p *DynamoDBProps) BuildPartitionKey(partitionKey string) error {
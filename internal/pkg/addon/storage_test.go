@@ -108,6 +108,144 @@ func TestS3Template_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestRedisTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, redis *RedisTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, redis *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				redis.parser = m
+				m.EXPECT().Parse(redisTemplatePath, *redis, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, redis *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				redis.parser = m
+				m.EXPECT().Parse(redisTemplatePath, *redis, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &RedisTemplate{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestOpenSearchTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, search *OpenSearchTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, search *OpenSearchTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				search.parser = m
+				m.EXPECT().Parse(openSearchTemplatePath, *search, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, search *OpenSearchTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				search.parser = m
+				m.EXPECT().Parse(openSearchTemplatePath, *search, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &OpenSearchTemplate{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestSQSTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, sqs *SQSTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, sqs *SQSTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				sqs.parser = m
+				m.EXPECT().Parse(sqsTemplatePath, *sqs, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, sqs *SQSTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				sqs.parser = m
+				m.EXPECT().Parse(sqsTemplatePath, *sqs, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &SQSTemplate{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
 func TestRDSTemplate_MarshalBinary(t *testing.T) {
 	testCases := map[string]struct {
 		workloadType     string
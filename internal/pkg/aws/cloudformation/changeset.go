@@ -251,6 +251,31 @@ func (cs *changeSet) createAndExecute(conf *stackConfig) error {
 	return cs.execute()
 }
 
+// preview creates the change set, describes the changes, and then deletes the change set without executing it.
+// If the change set is empty, returns a description without changes.
+func (cs *changeSet) preview(conf *stackConfig) (*ChangeSetDescription, error) {
+	if err := cs.create(conf); err != nil {
+		descr, descrErr := cs.describe()
+		if descrErr != nil {
+			return nil, fmt.Errorf("check if changeset is empty: %v: %w", err, descrErr)
+		}
+		// Clean up failed change sets as there's a limit on the number of change sets a stack can have.
+		_ = cs.delete()
+		if len(descr.Changes) == 0 && strings.Contains(descr.StatusReason, "didn't contain changes") {
+			return descr, nil
+		}
+		return nil, fmt.Errorf("%w: %s", err, descr.StatusReason)
+	}
+	descr, err := cs.describe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cs.delete(); err != nil {
+		return nil, err
+	}
+	return descr, nil
+}
+
 // delete removes the change set.
 func (cs *changeSet) delete() error {
 	_, err := cs.client.DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
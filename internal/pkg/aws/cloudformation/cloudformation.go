@@ -119,6 +119,16 @@ func (c *CloudFormation) Update(stack *Stack) (changeSetID string, err error) {
 	return c.update(stack)
 }
 
+// PreviewUpdate returns the changes that updating an existing stack with the new configuration would make
+// without applying them. The change set created to compute the changes is deleted afterwards.
+func (c *CloudFormation) PreviewUpdate(stack *Stack) (*ChangeSetDescription, error) {
+	cs, err := newUpdateChangeSet(c.client, stack.Name)
+	if err != nil {
+		return nil, err
+	}
+	return cs.preview(stack.stackConfig)
+}
+
 // UpdateAndWait calls Update and then blocks until the stack is updated or until the max attempt window expires.
 func (c *CloudFormation) UpdateAndWait(stack *Stack) error {
 	if _, err := c.Update(stack); err != nil {
@@ -659,6 +659,103 @@ func TestCloudFormation_UpdateAndWait(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_PreviewUpdate(t *testing.T) {
+	const mockStackName = "id"
+	mockChange := &cloudformation.Change{
+		ResourceChange: &cloudformation.ResourceChange{
+			Action:            aws.String(cloudformation.ChangeActionModify),
+			LogicalResourceId: aws.String("HTTPListenerRule"),
+			Replacement:       aws.String(cloudformation.ReplacementTrue),
+		},
+	}
+	testCases := map[string]struct {
+		createMock  func(ctrl *gomock.Controller) client
+		wantedDescr *ChangeSetDescription
+		wantedErr   error
+	}{
+		"error and clean up if the change set cannot be created": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					StatusReason: aws.String("some reason"),
+				}, nil)
+				m.EXPECT().DeleteChangeSet(gomock.Any()).Return(nil, nil)
+				return m
+			},
+			wantedErr: errors.New("create change set copilot-31323334-3536-4738-b930-313233333435 for stack id: some error: some reason"),
+		},
+		"return a description without changes if the change set is empty": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusUnavailable),
+					StatusReason:    aws.String("The submitted information didn't contain changes. Submit different information to create a change set."),
+				}, nil)
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStackName),
+				}).Return(nil, nil)
+				return m
+			},
+			wantedDescr: &ChangeSetDescription{
+				ExecutionStatus: cloudformation.ExecutionStatusUnavailable,
+				StatusReason:    "The submitted information didn't contain changes. Submit different information to create a change set.",
+			},
+		},
+		"describe and delete the change set without executing it": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{
+					Id: aws.String(mockChangeSetID),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+					Changes:         []*cloudformation.Change{mockChange},
+				}, nil)
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetID),
+					StackName:     aws.String(mockStackName),
+				}).Return(nil, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+			wantedDescr: &ChangeSetDescription{
+				ExecutionStatus: cloudformation.ExecutionStatusAvailable,
+				Changes:         []*cloudformation.Change{mockChange},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			descr, err := c.PreviewUpdate(mockStack)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDescr, descr)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_Delete(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
@@ -89,6 +89,21 @@ func WithRoleARN(roleARN string) StackOption {
 	}
 }
 
+// WithPreviousParameterValues reuses the values from the last deployment of the stack for the given parameter keys.
+func WithPreviousParameterValues(keys ...string) StackOption {
+	return func(s *Stack) {
+		for _, param := range s.Parameters {
+			for _, key := range keys {
+				if aws.StringValue(param.ParameterKey) != key {
+					continue
+				}
+				param.ParameterValue = nil
+				param.UsePreviousValue = aws.Bool(true)
+			}
+		}
+	}
+}
+
 // WithDisableRollback disables CloudFormation's automatic stack rollback upon failure for the stack.
 func WithDisableRollback() StackOption {
 	return func(s *Stack) {
@@ -68,3 +68,20 @@ func TestNewStackWithURL(t *testing.T) {
 	}, s.Tags)
 	require.Equal(t, aws.String("arn"), s.RoleARN)
 }
+
+func TestWithPreviousParameterValues(t *testing.T) {
+	// WHEN
+	s := NewStack("hello", "world",
+		WithParameters(map[string]string{
+			"ContainerImage": "",
+		}),
+		WithPreviousParameterValues("ContainerImage", "EnvFileARN"))
+
+	// THEN
+	require.Equal(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:     aws.String("ContainerImage"),
+			UsePreviousValue: aws.Bool(true),
+		},
+	}, s.Parameters)
+}
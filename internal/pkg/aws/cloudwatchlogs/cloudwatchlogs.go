@@ -18,6 +18,13 @@ import (
 const (
 	// SleepDuration is the sleep time for making the next request for log events.
 	SleepDuration = 1 * time.Second
+
+	// See https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_FilterLogEvents.html#CWL-FilterLogEvents-request-logStreamNames
+	maxFilterLogStreams = 100
+
+	// filterResumeOverlap is how far back, in milliseconds, filtered events are retrieved again when resuming
+	// so that events ingested late are not missed. Events already returned are skipped by their IDs.
+	filterResumeOverlap = int64(5000)
 )
 
 var (
@@ -28,11 +35,15 @@ var (
 type api interface {
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
+	FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error)
+	StartQuery(input *cloudwatchlogs.StartQueryInput) (*cloudwatchlogs.StartQueryOutput, error)
+	GetQueryResults(input *cloudwatchlogs.GetQueryResultsInput) (*cloudwatchlogs.GetQueryResultsOutput, error)
 }
 
 // CloudWatchLogs wraps an AWS Cloudwatch Logs client.
 type CloudWatchLogs struct {
 	client api
+	sleep  func()
 }
 
 // LogEventsOutput contains the output for LogEvents
@@ -41,6 +52,8 @@ type LogEventsOutput struct {
 	Events []*Event
 	// Timestamp for the last event
 	StreamLastEventTime map[string]int64
+	// IDs of the filtered events returned within the resume overlap, keyed by log stream and mapped to their timestamps.
+	StreamSeenEventIDs map[string]map[string]int64
 }
 
 // LogEventsOpts wraps the parameters to call LogEvents.
@@ -51,12 +64,28 @@ type LogEventsOpts struct {
 	StartTime           *int64
 	EndTime             *int64
 	StreamLastEventTime map[string]int64
+	StreamSeenEventIDs  map[string]map[string]int64
+	// If set, only the events matching the pattern are retrieved.
+	// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/FilterAndPatternSyntax.html
+	FilterPattern string
+}
+
+// QueryOpts wraps the parameters to call Query.
+type QueryOpts struct {
+	LogGroups   []string
+	QueryString string
+	StartTime   int64 // Unix timestamp in milliseconds.
+	EndTime     int64 // Unix timestamp in milliseconds.
+	Limit       *int64
 }
 
 // New returns a CloudWatchLogs configured against the input session.
 func New(s *session.Session) *CloudWatchLogs {
 	return &CloudWatchLogs{
 		client: cloudwatchlogs.New(s),
+		sleep: func() {
+			time.Sleep(SleepDuration)
+		},
 	}
 }
 
@@ -89,6 +118,9 @@ func (c *CloudWatchLogs) logStreams(logGroup string, logStreams ...string) ([]st
 
 // LogEvents returns an array of Cloudwatch Logs events.
 func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error) {
+	if opts.FilterPattern != "" {
+		return c.filteredLogEvents(opts)
+	}
 	var events []*Event
 	in := initGetLogEventsInput(opts)
 	logStreams, err := c.logStreams(opts.LogGroup, opts.LogStreams...)
@@ -140,6 +172,224 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 	}, nil
 }
 
+// filteredLogEvents returns the events matching the filter pattern of opts.
+// The events are filtered by CloudWatch Logs instead of being retrieved stream by stream.
+func (c *CloudWatchLogs) filteredLogEvents(opts LogEventsOpts) (*LogEventsOutput, error) {
+	var logStreams []string
+	if len(opts.LogStreams) != 0 {
+		var err error
+		if logStreams, err = c.logStreams(opts.LogGroup, opts.LogStreams...); err != nil {
+			return nil, err
+		}
+		if len(logStreams) == 0 {
+			return &LogEventsOutput{
+				StreamLastEventTime: opts.StreamLastEventTime,
+				StreamSeenEventIDs:  opts.StreamSeenEventIDs,
+			}, nil
+		}
+	}
+	streamLastEventTime := make(map[string]int64)
+	var latest int64
+	for k, v := range opts.StreamLastEventTime {
+		streamLastEventTime[k] = v
+		if v > latest {
+			latest = v
+		}
+	}
+	seen := make(map[string]map[string]int64)
+	for stream, ids := range opts.StreamSeenEventIDs {
+		seen[stream] = make(map[string]int64, len(ids))
+		for id, timestamp := range ids {
+			seen[stream][id] = timestamp
+		}
+	}
+	startTime := opts.StartTime
+	if latest != 0 {
+		// Resume shortly before the latest event across all streams, so that an idle stream doesn't hold the window back.
+		if resume := latest - filterResumeOverlap; startTime == nil || resume > *startTime {
+			startTime = aws.Int64(resume)
+		}
+	}
+
+	var events []*Event
+	for _, batch := range batchLogStreams(logStreams) {
+		in := &cloudwatchlogs.FilterLogEventsInput{
+			LogGroupName:  aws.String(opts.LogGroup),
+			FilterPattern: aws.String(opts.FilterPattern),
+			StartTime:     startTime,
+			EndTime:       opts.EndTime,
+		}
+		if len(batch) != 0 {
+			in.LogStreamNames = aws.StringSlice(batch)
+		}
+		for {
+			resp, err := c.client.FilterLogEvents(in)
+			if err != nil {
+				return nil, fmt.Errorf("filter log events of log group %s: %w", opts.LogGroup, err)
+			}
+			for _, event := range resp.Events {
+				logStream := aws.StringValue(event.LogStreamName)
+				timestamp := aws.Int64Value(event.Timestamp)
+				id := aws.StringValue(event.EventId)
+				if _, ok := seen[logStream][id]; ok {
+					continue
+				}
+				if seen[logStream] == nil {
+					seen[logStream] = make(map[string]int64)
+				}
+				seen[logStream][id] = timestamp
+				if timestamp > latest {
+					latest = timestamp
+				}
+				events = append(events, &Event{
+					LogStreamName: logStream,
+					IngestionTime: aws.Int64Value(event.IngestionTime),
+					Message:       aws.StringValue(event.Message),
+					Timestamp:     timestamp,
+				})
+				if timestamp > streamLastEventTime[logStream] {
+					streamLastEventTime[logStream] = timestamp
+				}
+			}
+			if resp.NextToken == nil {
+				break
+			}
+			in.NextToken = resp.NextToken
+		}
+	}
+	// Only the events within the overlap of the next call can be retrieved again.
+	for stream, ids := range seen {
+		for id, timestamp := range ids {
+			if timestamp < latest-filterResumeOverlap {
+				delete(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			delete(seen, stream)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+	if limit := int(aws.Int64Value(opts.Limit)); limit != 0 {
+		events = truncateEvents(limit, events)
+	}
+	return &LogEventsOutput{
+		Events:              events,
+		StreamLastEventTime: streamLastEventTime,
+		StreamSeenEventIDs:  seen,
+	}, nil
+}
+
+// batchLogStreams splits the log streams in batches that can be filtered in a single request.
+// If there are no log streams, it returns a single empty batch to filter the entire log group.
+func batchLogStreams(logStreams []string) [][]string {
+	if len(logStreams) == 0 {
+		return [][]string{nil}
+	}
+	var batches [][]string
+	for len(logStreams) > maxFilterLogStreams {
+		batches = append(batches, logStreams[:maxFilterLogStreams])
+		logStreams = logStreams[maxFilterLogStreams:]
+	}
+	return append(batches, logStreams)
+}
+
+// LogStreams returns the names of all the log streams in a log group that start with one of the prefixes.
+// If there are no prefixes, it returns all the log streams of the log group.
+func (c *CloudWatchLogs) LogStreams(logGroup string, prefixes ...string) ([]string, error) {
+	in := &cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupName: aws.String(logGroup),
+	}
+	var names []string
+	for {
+		resp, err := c.client.DescribeLogStreams(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe log streams of log group %s: %w", logGroup, err)
+		}
+		for _, logStream := range resp.LogStreams {
+			if name := aws.StringValue(logStream.LogStreamName); name != "" {
+				names = append(names, name)
+			}
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	if len(prefixes) != 0 {
+		names = filterStringSliceByPrefix(names, prefixes)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// LogStreamEvents pages through the events of a log stream from the oldest to the newest,
+// and calls fn with each page of events.
+func (c *CloudWatchLogs) LogStreamEvents(logGroup, logStream string, startTime, endTime *int64, fn func(events []*Event) error) error {
+	in := &cloudwatchlogs.GetLogEventsInput{
+		LogGroupName:  aws.String(logGroup),
+		LogStreamName: aws.String(logStream),
+		StartTime:     startTime,
+		EndTime:       endTime,
+		StartFromHead: aws.Bool(true),
+	}
+	for {
+		resp, err := c.client.GetLogEvents(in)
+		if err != nil {
+			return fmt.Errorf("get log events of %s/%s: %w", logGroup, logStream, err)
+		}
+		if len(resp.Events) != 0 {
+			events := make([]*Event, len(resp.Events))
+			for i, event := range resp.Events {
+				events[i] = &Event{
+					LogStreamName: logStream,
+					IngestionTime: aws.Int64Value(event.IngestionTime),
+					Message:       aws.StringValue(event.Message),
+					Timestamp:     aws.Int64Value(event.Timestamp),
+				}
+			}
+			if err := fn(events); err != nil {
+				return err
+			}
+		}
+		// The same token is returned once the end of the stream is reached.
+		if resp.NextForwardToken == nil || aws.StringValue(resp.NextForwardToken) == aws.StringValue(in.NextToken) {
+			return nil
+		}
+		in.NextToken = resp.NextForwardToken
+	}
+}
+
+// Query runs a CloudWatch Logs Insights query over the log groups and waits for its results.
+func (c *CloudWatchLogs) Query(opts QueryOpts) (*QueryResults, error) {
+	start, err := c.client.StartQuery(&cloudwatchlogs.StartQueryInput{
+		LogGroupNames: aws.StringSlice(opts.LogGroups),
+		QueryString:   aws.String(opts.QueryString),
+		StartTime:     aws.Int64(opts.StartTime / 1000), // The API expects the number of seconds since the epoch.
+		EndTime:       aws.Int64(opts.EndTime / 1000),
+		Limit:         opts.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("start query on log groups %s: %w", strings.Join(opts.LogGroups, ", "), err)
+	}
+	queryID := aws.StringValue(start.QueryId)
+	for {
+		resp, err := c.client.GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+			QueryId: start.QueryId,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get results of query %s: %w", queryID, err)
+		}
+		switch status := aws.StringValue(resp.Status); status {
+		case cloudwatchlogs.QueryStatusComplete:
+			return newQueryResults(resp.Results), nil
+		case cloudwatchlogs.QueryStatusScheduled, cloudwatchlogs.QueryStatusRunning:
+			c.sleep()
+		default:
+			return nil, fmt.Errorf("query %s ended with status %s", queryID, status)
+		}
+	}
+}
+
 func truncateEvents(limit int, events []*Event) []*Event {
 	if len(events) <= limit {
 		return events
@@ -269,3 +269,421 @@ func TestLogEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestLogEvents_FilterPattern(t *testing.T) {
+	testCases := map[string]struct {
+		logStreams    []string
+		startTime     *int64
+		limit         *int64
+		lastEventTime map[string]int64
+		seenEventIDs  map[string]map[string]int64
+		setupMocks    func(m *mocks.Mockapi)
+
+		wantLogEvents     []*Event
+		wantLastEventTime map[string]int64
+		wantSeenEventIDs  map[string]map[string]int64
+		wantErr           error
+	}{
+		"should filter the entire log group across pages": {
+			startTime: aws.Int64(100),
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Times(0)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					FilterPattern: aws.String("ERROR"),
+					StartTime:     aws.Int64(100),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							LogStreamName: aws.String("copilot/api/1"),
+							Message:       aws.String("ERROR first"),
+							Timestamp:     aws.Int64(101),
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:  aws.String("mockLogGroup"),
+					FilterPattern: aws.String("ERROR"),
+					StartTime:     aws.Int64(100),
+					NextToken:     aws.String("token"),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							LogStreamName: aws.String("copilot/nginx/1"),
+							Message:       aws.String("ERROR second"),
+							Timestamp:     aws.Int64(102),
+						},
+					},
+				}, nil)
+			},
+			wantLogEvents: []*Event{
+				{
+					LogStreamName: "copilot/api/1",
+					Message:       "ERROR first",
+					Timestamp:     101,
+				},
+				{
+					LogStreamName: "copilot/nginx/1",
+					Message:       "ERROR second",
+					Timestamp:     102,
+				},
+			},
+			wantLastEventTime: map[string]int64{
+				"copilot/api/1":   101,
+				"copilot/nginx/1": 102,
+			},
+		},
+		"should resume shortly before the latest event and skip the events already seen": {
+			logStreams: []string{"copilot/api"},
+			lastEventTime: map[string]int64{
+				"copilot/api/1": 10000,
+				"copilot/api/2": 1000,
+			},
+			seenEventIDs: map[string]map[string]int64{
+				"copilot/api/1": {
+					"old":  4000,
+					"seen": 10000,
+				},
+			},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("copilot/api/1"),
+						},
+						{
+							LogStreamName: aws.String("copilot/api/2"),
+						},
+					},
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:   aws.String("mockLogGroup"),
+					LogStreamNames: aws.StringSlice([]string{"copilot/api/1", "copilot/api/2"}),
+					FilterPattern:  aws.String("ERROR"),
+					StartTime:      aws.Int64(5000),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							EventId:       aws.String("late"),
+							LogStreamName: aws.String("copilot/api/2"),
+							Message:       aws.String("ERROR late"),
+							Timestamp:     aws.Int64(9000),
+						},
+						{
+							EventId:       aws.String("seen"),
+							LogStreamName: aws.String("copilot/api/1"),
+							Message:       aws.String("ERROR seen"),
+							Timestamp:     aws.Int64(10000),
+						},
+						{
+							EventId:       aws.String("same-time"),
+							LogStreamName: aws.String("copilot/api/1"),
+							Message:       aws.String("ERROR same time"),
+							Timestamp:     aws.Int64(10000),
+						},
+						{
+							EventId:       aws.String("new"),
+							LogStreamName: aws.String("copilot/api/1"),
+							Message:       aws.String("ERROR new"),
+							Timestamp:     aws.Int64(15000),
+						},
+					},
+				}, nil)
+			},
+			wantLogEvents: []*Event{
+				{
+					LogStreamName: "copilot/api/2",
+					Message:       "ERROR late",
+					Timestamp:     9000,
+				},
+				{
+					LogStreamName: "copilot/api/1",
+					Message:       "ERROR same time",
+					Timestamp:     10000,
+				},
+				{
+					LogStreamName: "copilot/api/1",
+					Message:       "ERROR new",
+					Timestamp:     15000,
+				},
+			},
+			wantLastEventTime: map[string]int64{
+				"copilot/api/1": 15000,
+				"copilot/api/2": 9000,
+			},
+			wantSeenEventIDs: map[string]map[string]int64{
+				"copilot/api/1": {
+					"seen":      10000,
+					"same-time": 10000,
+					"new":       15000,
+				},
+			},
+		},
+		"should wrap the error if the events can't be filtered": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("filter log events of log group mockLogGroup: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			service := CloudWatchLogs{
+				client: m,
+			}
+
+			// WHEN
+			got, err := service.LogEvents(LogEventsOpts{
+				LogGroup:            "mockLogGroup",
+				LogStreams:          tc.logStreams,
+				StartTime:           tc.startTime,
+				Limit:               tc.limit,
+				StreamLastEventTime: tc.lastEventTime,
+				StreamSeenEventIDs:  tc.seenEventIDs,
+				FilterPattern:       "ERROR",
+			})
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantLogEvents, got.Events)
+			require.Equal(t, tc.wantLastEventTime, got.StreamLastEventTime)
+			if tc.wantSeenEventIDs != nil {
+				require.Equal(t, tc.wantSeenEventIDs, got.StreamSeenEventIDs)
+			}
+		})
+	}
+}
+
+func TestCloudWatchLogs_LogStreams(t *testing.T) {
+	testCases := map[string]struct {
+		prefixes   []string
+		setupMocks func(m *mocks.Mockapi)
+
+		wantStreams []string
+		wantErr     error
+	}{
+		"should wrap the error if the log streams can't be described": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe log streams of log group mockLogGroup: some error"),
+		},
+		"should return the log streams of all pages starting with a prefix": {
+			prefixes: []string{"copilot/api/"},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsInput{
+					LogGroupName: aws.String("mockLogGroup"),
+				}).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{LogStreamName: aws.String("copilot/api/2")},
+						{LogStreamName: aws.String("copilot/nginx/1")},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsInput{
+					LogGroupName: aws.String("mockLogGroup"),
+					NextToken:    aws.String("token"),
+				}).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{LogStreamName: aws.String("copilot/api/1")},
+					},
+				}, nil)
+			},
+			wantStreams: []string{"copilot/api/1", "copilot/api/2"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			service := CloudWatchLogs{
+				client: m,
+			}
+
+			streams, err := service.LogStreams("mockLogGroup", tc.prefixes...)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantStreams, streams)
+		})
+	}
+}
+
+func TestCloudWatchLogs_LogStreamEvents(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantPages [][]*Event
+		wantErr   error
+	}{
+		"should wrap the error if the events can't be retrieved": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetLogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("get log events of mockLogGroup/copilot/api/1: some error"),
+		},
+		"should page through the events until the same token is returned": {
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetLogEvents(&cloudwatchlogs.GetLogEventsInput{
+						LogGroupName:  aws.String("mockLogGroup"),
+						LogStreamName: aws.String("copilot/api/1"),
+						StartTime:     aws.Int64(100),
+						StartFromHead: aws.Bool(true),
+					}).Return(&cloudwatchlogs.GetLogEventsOutput{
+						Events: []*cloudwatchlogs.OutputLogEvent{
+							{Message: aws.String("first"), Timestamp: aws.Int64(101)},
+						},
+						NextForwardToken: aws.String("f/1"),
+					}, nil),
+					m.EXPECT().GetLogEvents(&cloudwatchlogs.GetLogEventsInput{
+						LogGroupName:  aws.String("mockLogGroup"),
+						LogStreamName: aws.String("copilot/api/1"),
+						StartTime:     aws.Int64(100),
+						StartFromHead: aws.Bool(true),
+						NextToken:     aws.String("f/1"),
+					}).Return(&cloudwatchlogs.GetLogEventsOutput{
+						Events: []*cloudwatchlogs.OutputLogEvent{
+							{Message: aws.String("second"), Timestamp: aws.Int64(102)},
+						},
+						NextForwardToken: aws.String("f/2"),
+					}, nil),
+					m.EXPECT().GetLogEvents(gomock.Any()).Return(&cloudwatchlogs.GetLogEventsOutput{
+						NextForwardToken: aws.String("f/2"),
+					}, nil),
+				)
+			},
+			wantPages: [][]*Event{
+				{{LogStreamName: "copilot/api/1", Message: "first", Timestamp: 101}},
+				{{LogStreamName: "copilot/api/1", Message: "second", Timestamp: 102}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			service := CloudWatchLogs{
+				client: m,
+			}
+
+			var pages [][]*Event
+			err := service.LogStreamEvents("mockLogGroup", "copilot/api/1", aws.Int64(100), nil, func(events []*Event) error {
+				pages = append(pages, events)
+				return nil
+			})
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantPages, pages)
+		})
+	}
+}
+
+func TestCloudWatchLogs_Query(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantResults *QueryResults
+		wantErr     error
+	}{
+		"should wrap the error if the query can't be started": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("start query on log groups /copilot/a, /copilot/b: some error"),
+		},
+		"should error if the query fails": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(gomock.Any()).Return(&cloudwatchlogs.StartQueryOutput{QueryId: aws.String("1234")}, nil)
+				m.EXPECT().GetQueryResults(gomock.Any()).Return(&cloudwatchlogs.GetQueryResultsOutput{
+					Status: aws.String(cloudwatchlogs.QueryStatusFailed),
+				}, nil)
+			},
+			wantErr: errors.New("query 1234 ended with status Failed"),
+		},
+		"should poll until the query completes": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartQuery(&cloudwatchlogs.StartQueryInput{
+					LogGroupNames: aws.StringSlice([]string{"/copilot/a", "/copilot/b"}),
+					QueryString:   aws.String("stats count(*) by @logStream"),
+					StartTime:     aws.Int64(1600000000),
+					EndTime:       aws.Int64(1600003600),
+				}).Return(&cloudwatchlogs.StartQueryOutput{QueryId: aws.String("1234")}, nil)
+				gomock.InOrder(
+					m.EXPECT().GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+						QueryId: aws.String("1234"),
+					}).Return(&cloudwatchlogs.GetQueryResultsOutput{
+						Status: aws.String(cloudwatchlogs.QueryStatusRunning),
+					}, nil),
+					m.EXPECT().GetQueryResults(gomock.Any()).Return(&cloudwatchlogs.GetQueryResultsOutput{
+						Status: aws.String(cloudwatchlogs.QueryStatusComplete),
+						Results: [][]*cloudwatchlogs.ResultField{
+							{
+								{Field: aws.String("@logStream"), Value: aws.String("copilot/api/1")},
+								{Field: aws.String("count(*)"), Value: aws.String("42")},
+								{Field: aws.String("@ptr"), Value: aws.String("abc")},
+							},
+						},
+					}, nil),
+				)
+			},
+			wantResults: &QueryResults{
+				Fields: []string{"@logStream", "count(*)"},
+				Rows: []map[string]string{
+					{"@logStream": "copilot/api/1", "count(*)": "42"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			service := CloudWatchLogs{
+				client: m,
+				sleep:  func() {},
+			}
+
+			results, err := service.Query(QueryOpts{
+				LogGroups:   []string{"/copilot/a", "/copilot/b"},
+				QueryString: "stats count(*) by @logStream",
+				StartTime:   1600000000000,
+				EndTime:     1600003600000,
+			})
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantResults, results)
+		})
+	}
+}
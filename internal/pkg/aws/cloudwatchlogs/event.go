@@ -17,6 +17,8 @@ const (
 	shortLogStreamNameLength = 25
 )
 
+var highlightColor = c.New(c.ReverseVideo)
+
 // Event represents a log event.
 type Event struct {
 	LogStreamName string `json:"logStreamName"`
@@ -45,6 +47,16 @@ func (l *Event) HumanString() string {
 	return fmt.Sprintf("%s %s\n", color.Grey.Sprint(l.shortLogStreamName()), l.Message)
 }
 
+// Highlight colors every match of the regular expression in the message of the event.
+func (l *Event) Highlight(re *regexp.Regexp) {
+	if c.NoColor {
+		return
+	}
+	l.Message = re.ReplaceAllStringFunc(l.Message, func(match string) string {
+		return highlightColor.Sprint(match)
+	})
+}
+
 func (l *Event) shortLogStreamName() string {
 	if len(l.LogStreamName) < shortLogStreamNameLength {
 		return l.LogStreamName
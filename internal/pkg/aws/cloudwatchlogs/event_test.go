@@ -5,6 +5,7 @@ package cloudwatchlogs
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -59,3 +60,36 @@ func TestColorCodeMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestEvent_Highlight(t *testing.T) {
+	testCases := map[string]struct {
+		noColor     bool
+		wantMessage func() string
+	}{
+		"should highlight every match": {
+			wantMessage: func() string {
+				return fmt.Sprintf("GET /orders/%s took %sms", highlightColor.Sprint("42"), highlightColor.Sprint("130"))
+			},
+		},
+		"should not highlight if color is disabled": {
+			noColor: true,
+			wantMessage: func() string {
+				return "GET /orders/42 took 130ms"
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			noColor := c.NoColor
+			c.NoColor = tc.noColor
+			defer func() { c.NoColor = noColor }()
+			event := &Event{
+				Message: "GET /orders/42 took 130ms",
+			}
+
+			event.Highlight(regexp.MustCompile(`\d+`))
+
+			require.Equal(t, tc.wantMessage(), event.Message)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+// Field returned in every row of query results to retrieve the complete log event, not meant to be displayed.
+const queryPointerField = "@ptr"
+
+// QueryResults are the results of a CloudWatch Logs Insights query.
+type QueryResults struct {
+	Fields []string // Names of the fields in the order they appear in the results.
+	Rows   []map[string]string
+}
+
+func newQueryResults(rows [][]*cloudwatchlogs.ResultField) *QueryResults {
+	results := &QueryResults{
+		Rows: make([]map[string]string, len(rows)),
+	}
+	seen := make(map[string]bool)
+	for i, row := range rows {
+		results.Rows[i] = make(map[string]string)
+		for _, field := range row {
+			name := aws.StringValue(field.Field)
+			if name == queryPointerField {
+				continue
+			}
+			if !seen[name] {
+				seen[name] = true
+				results.Fields = append(results.Fields, name)
+			}
+			results.Rows[i][name] = aws.StringValue(field.Value)
+		}
+	}
+	return results
+}
+
+// JSONString returns each row of the results as a line of JSON.
+func (r *QueryResults) JSONString() (string, error) {
+	var b strings.Builder
+	for _, row := range r.Rows {
+		data, err := json.Marshal(row)
+		if err != nil {
+			return "", fmt.Errorf("marshal query results: %w", err)
+		}
+		fmt.Fprintf(&b, "%s\n", data)
+	}
+	return b.String(), nil
+}
+
+// HumanString returns the results as a table.
+func (r *QueryResults) HumanString() string {
+	if len(r.Rows) == 0 {
+		return "No results.\n"
+	}
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(r.Fields, "\t"))
+	for _, row := range r.Rows {
+		cells := make([]string, len(r.Fields))
+		for i, field := range r.Fields {
+			// Keep each row on a single line.
+			cells[i] = strings.NewReplacer("\n", " ", "\t", " ").Replace(strings.TrimSpace(row[field]))
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+	w.Flush()
+	return b.String()
+}
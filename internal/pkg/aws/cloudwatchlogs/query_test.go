@@ -0,0 +1,57 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatchlogs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryResults_HumanString(t *testing.T) {
+	testCases := map[string]struct {
+		results *QueryResults
+		wanted  string
+	}{
+		"no results": {
+			results: &QueryResults{},
+			wanted:  "No results.\n",
+		},
+		"results as a table": {
+			results: &QueryResults{
+				Fields: []string{"@timestamp", "@message"},
+				Rows: []map[string]string{
+					{"@timestamp": "2022-03-13 19:50:30.000", "@message": "GET /\n"},
+					{"@timestamp": "2022-03-13 19:50:31.000", "@message": "ERROR\tinternal"},
+				},
+			},
+			wanted: `@timestamp               @message
+2022-03-13 19:50:30.000  GET /
+2022-03-13 19:50:31.000  ERROR internal
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.results.HumanString())
+		})
+	}
+}
+
+func TestQueryResults_JSONString(t *testing.T) {
+	results := &QueryResults{
+		Fields: []string{"@logStream", "count(*)"},
+		Rows: []map[string]string{
+			{"@logStream": "copilot/api/1", "count(*)": "42"},
+			{"@logStream": "copilot/api/2", "count(*)": "7"},
+		},
+	}
+
+	got, err := results.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"@logStream":"copilot/api/1","count(*)":"42"}
+{"@logStream":"copilot/api/2","count(*)":"7"}
+`, got)
+}
@@ -7,6 +7,7 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -40,6 +41,7 @@ type api interface {
 
 type ssmSessionStarter interface {
 	StartSession(ssmSession *ecs.Session) error
+	StartSessionWithOutput(ssmSession *ecs.Session, w io.Writer) error
 }
 
 // ECS wraps an AWS ECS client.
@@ -388,6 +390,30 @@ func (e *ECS) DescribeTasks(cluster string, taskARNs []string) ([]*Task, error)
 
 // ExecuteCommand executes commands in a running container, and then terminate the session.
 func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (err error) {
+	sess, err := e.executeCommand(in)
+	if err != nil {
+		return err
+	}
+	if err = e.newSessStarter().StartSession(sess); err != nil {
+		err = fmt.Errorf("start session %s using ssm plugin: %w", aws.StringValue(sess.SessionId), err)
+	}
+	return err
+}
+
+// ExecuteCommandWithOutput executes commands in a running container without attaching the terminal,
+// and writes the output of the session to w.
+func (e *ECS) ExecuteCommandWithOutput(in ExecuteCommandInput, w io.Writer) error {
+	sess, err := e.executeCommand(in)
+	if err != nil {
+		return err
+	}
+	if err = e.newSessStarter().StartSessionWithOutput(sess, w); err != nil {
+		return fmt.Errorf("start session %s using ssm plugin: %w", aws.StringValue(sess.SessionId), err)
+	}
+	return nil
+}
+
+func (e *ECS) executeCommand(in ExecuteCommandInput) (*ecs.Session, error) {
 	execCmdresp, err := e.client.ExecuteCommand(&ecs.ExecuteCommandInput{
 		Cluster:     aws.String(in.Cluster),
 		Command:     aws.String(in.Command),
@@ -396,13 +422,9 @@ func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (err error) {
 		Task:        aws.String(in.Task),
 	})
 	if err != nil {
-		return &ErrExecuteCommand{err: err}
-	}
-	sessID := aws.StringValue(execCmdresp.Session.SessionId)
-	if err = e.newSessStarter().StartSession(execCmdresp.Session); err != nil {
-		err = fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
+		return nil, &ErrExecuteCommand{err: err}
 	}
-	return err
+	return execCmdresp.Session, nil
 }
 
 // NetworkConfiguration returns the network configuration of a service.
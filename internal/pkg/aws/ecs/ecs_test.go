@@ -4,6 +4,7 @@
 package ecs
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
@@ -1236,6 +1237,77 @@ func TestECS_ExecuteCommand(t *testing.T) {
 	}
 }
 
+func TestECS_ExecuteCommandWithOutput(t *testing.T) {
+	mockSess := &ecs.Session{
+		SessionId: aws.String("mockSessID"),
+	}
+	testCases := map[string]struct {
+		mockAPI         func(m *mocks.Mockapi)
+		mockSessStarter func(m *mocks.MockssmSessionStarter)
+		wantedError     error
+	}{
+		"return error if fail to call ExecuteCommand": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {},
+			wantedError:     &ErrExecuteCommand{err: errors.New("some error")},
+		},
+		"return error if fail to start the session": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSessionWithOutput(mockSess, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("start session mockSessID using ssm plugin: some error"),
+		},
+		"success": {
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSessionWithOutput(mockSess, gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			mockSessStarter := mocks.NewMockssmSessionStarter(ctrl)
+			tc.mockAPI(mockAPI)
+			tc.mockSessStarter(mockSessStarter)
+
+			ecs := ECS{
+				client: mockAPI,
+				newSessStarter: func() ssmSessionStarter {
+					return mockSessStarter
+				},
+			}
+
+			err := ecs.ExecuteCommandWithOutput(ExecuteCommandInput{
+				Cluster:   "mockCluster",
+				Command:   "mockCommand",
+				Container: "mockContainer",
+				Task:      "mockTask",
+			}, &bytes.Buffer{})
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestECS_NetworkConfiguration(t *testing.T) {
 	testCases := map[string]struct {
 		mockAPI func(m *mocks.Mockapi)
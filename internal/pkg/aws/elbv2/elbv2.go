@@ -6,6 +6,9 @@ package elbv2
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -19,6 +22,7 @@ const (
 
 type api interface {
 	DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
+	DescribeRules(input *elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error)
 }
 
 // ELBV2 wraps an AWS ELBV2 client.
@@ -53,6 +57,155 @@ func (e *ELBV2) TargetsHealth(targetGroupARN string) ([]*TargetHealth, error) {
 	return ret, nil
 }
 
+// TargetGroupWeight is the weight of a target group that a listener rule forwards requests to.
+type TargetGroupWeight struct {
+	TargetGroupARN string
+	Weight         int
+}
+
+// ListenerRuleWeights returns the target groups that a listener rule forwards requests to along with their weights.
+func (e *ELBV2) ListenerRuleWeights(ruleARN string) ([]TargetGroupWeight, error) {
+	out, err := e.client.DescribeRules(&elbv2.DescribeRulesInput{
+		RuleArns: aws.StringSlice([]string{ruleARN}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe listener rule %s: %w", ruleARN, err)
+	}
+	if len(out.Rules) == 0 {
+		return nil, fmt.Errorf("listener rule %s not found", ruleARN)
+	}
+	var weights []TargetGroupWeight
+	for _, action := range out.Rules[0].Actions {
+		if aws.StringValue(action.Type) != elbv2.ActionTypeEnumForward {
+			continue
+		}
+		if action.ForwardConfig == nil {
+			// A rule that forwards to a single target group sends all the traffic to it.
+			weights = append(weights, TargetGroupWeight{
+				TargetGroupARN: aws.StringValue(action.TargetGroupArn),
+				Weight:         1,
+			})
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			weights = append(weights, TargetGroupWeight{
+				TargetGroupARN: aws.StringValue(tg.TargetGroupArn),
+				Weight:         int(aws.Int64Value(tg.Weight)),
+			})
+		}
+	}
+	return weights, nil
+}
+
+// ListenerRule is a rule of a load balancer listener.
+type ListenerRule struct {
+	ARN        string
+	Priority   string   // Either a number or "default" for the listener's default rule.
+	Conditions []string // Human readable conditions, such as "path-pattern: /api/*".
+	Action     string   // Human readable action, such as "forward" or "fixed-response 404".
+	// ConditionValues holds the values of each condition keyed by field, such as "path-pattern" or "http-header X-Env".
+	ConditionValues map[string][]string
+}
+
+// IsDefault returns true if the rule is the listener's default rule.
+func (r ListenerRule) IsDefault() bool {
+	return r.Priority == "default"
+}
+
+// ListenerRules returns all the rules of a listener in the order of their priority, with the default rule last.
+func (e *ELBV2) ListenerRules(listenerARN string) ([]ListenerRule, error) {
+	var rules []ListenerRule
+	in := &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(listenerARN),
+	}
+	for {
+		out, err := e.client.DescribeRules(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe rules for listener %s: %w", listenerARN, err)
+		}
+		for _, rule := range out.Rules {
+			values := ruleConditionValues(rule.Conditions)
+			rules = append(rules, ListenerRule{
+				ARN:             aws.StringValue(rule.RuleArn),
+				Priority:        aws.StringValue(rule.Priority),
+				Conditions:      ruleConditions(values),
+				Action:          ruleAction(rule.Actions),
+				ConditionValues: values,
+			})
+		}
+		if out.NextMarker == nil {
+			break
+		}
+		in.Marker = out.NextMarker
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].IsDefault() || rules[j].IsDefault() {
+			return !rules[i].IsDefault()
+		}
+		pi, _ := strconv.Atoi(rules[i].Priority)
+		pj, _ := strconv.Atoi(rules[j].Priority)
+		return pi < pj
+	})
+	return rules, nil
+}
+
+func ruleConditionValues(conditions []*elbv2.RuleCondition) map[string][]string {
+	if len(conditions) == 0 {
+		return nil
+	}
+	out := make(map[string][]string)
+	for _, cond := range conditions {
+		field := aws.StringValue(cond.Field)
+		var values []string
+		switch {
+		case cond.HostHeaderConfig != nil:
+			values = aws.StringValueSlice(cond.HostHeaderConfig.Values)
+		case cond.PathPatternConfig != nil:
+			values = aws.StringValueSlice(cond.PathPatternConfig.Values)
+		case cond.SourceIpConfig != nil:
+			values = aws.StringValueSlice(cond.SourceIpConfig.Values)
+		case cond.HttpRequestMethodConfig != nil:
+			values = aws.StringValueSlice(cond.HttpRequestMethodConfig.Values)
+		case cond.HttpHeaderConfig != nil:
+			field = fmt.Sprintf("%s %s", field, aws.StringValue(cond.HttpHeaderConfig.HttpHeaderName))
+			values = aws.StringValueSlice(cond.HttpHeaderConfig.Values)
+		case cond.QueryStringConfig != nil:
+			for _, kv := range cond.QueryStringConfig.Values {
+				values = append(values, fmt.Sprintf("%s=%s", aws.StringValue(kv.Key), aws.StringValue(kv.Value)))
+			}
+		default:
+			// Conditions created with the legacy format only set "Values".
+			values = aws.StringValueSlice(cond.Values)
+		}
+		out[field] = append(out[field], values...)
+	}
+	return out
+}
+
+func ruleConditions(values map[string][]string) []string {
+	var out []string
+	for field, vals := range values {
+		out = append(out, fmt.Sprintf("%s: %s", field, strings.Join(vals, ", ")))
+	}
+	sort.Strings(out)
+	return out
+}
+
+func ruleAction(actions []*elbv2.Action) string {
+	// The last action of a rule is the one that routes the request, previous ones authenticate it.
+	if len(actions) == 0 {
+		return ""
+	}
+	action := actions[len(actions)-1]
+	switch aws.StringValue(action.Type) {
+	case elbv2.ActionTypeEnumFixedResponse:
+		return fmt.Sprintf("%s %s", elbv2.ActionTypeEnumFixedResponse, aws.StringValue(action.FixedResponseConfig.StatusCode))
+	case elbv2.ActionTypeEnumRedirect:
+		return fmt.Sprintf("%s %s", elbv2.ActionTypeEnumRedirect, aws.StringValue(action.RedirectConfig.StatusCode))
+	}
+	return aws.StringValue(action.Type)
+}
+
 // TargetID returns the target's ID, which is either an instance or an IP address.
 func (t *TargetHealth) TargetID() string {
 	return t.targetID()
@@ -189,3 +189,251 @@ func TestTargetHealth_HealthStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestELBV2_ListenerRuleWeights(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wanted      []TargetGroupWeight
+		wantedError error
+	}{
+		"error if fail to describe the rule": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe listener rule rule-1: some error"),
+		},
+		"error if the rule does not exist": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{}, nil)
+			},
+			wantedError: errors.New("listener rule rule-1 not found"),
+		},
+		"success with a single target group": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					RuleArns: aws.StringSlice([]string{"rule-1"}),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Actions: []*elbv2.Action{
+								{
+									Type:           aws.String(elbv2.ActionTypeEnumForward),
+									TargetGroupArn: aws.String("group-1"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []TargetGroupWeight{
+				{TargetGroupARN: "group-1", Weight: 1},
+			},
+		},
+		"success with weighted target groups": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Actions: []*elbv2.Action{
+								{
+									Type: aws.String(elbv2.ActionTypeEnumForward),
+									ForwardConfig: &elbv2.ForwardActionConfig{
+										TargetGroups: []*elbv2.TargetGroupTuple{
+											{
+												TargetGroupArn: aws.String("group-1"),
+												Weight:         aws.Int64(90),
+											},
+											{
+												TargetGroupArn: aws.String("group-2"),
+												Weight:         aws.Int64(10),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []TargetGroupWeight{
+				{TargetGroupARN: "group-1", Weight: 90},
+				{TargetGroupARN: "group-2", Weight: 10},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.ListenerRuleWeights("rule-1")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestELBV2_ListenerRules(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wanted      []ListenerRule
+		wantedError error
+	}{
+		"error if fail to describe the rules": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe rules for listener listener-1: some error"),
+		},
+		"success across pages": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("listener-1"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							RuleArn:  aws.String("rule-default"),
+							Priority: aws.String("default"),
+							Actions: []*elbv2.Action{
+								{
+									Type: aws.String(elbv2.ActionTypeEnumFixedResponse),
+									FixedResponseConfig: &elbv2.FixedResponseActionConfig{
+										StatusCode: aws.String("403"),
+									},
+								},
+							},
+						},
+						{
+							RuleArn:  aws.String("rule-10"),
+							Priority: aws.String("10"),
+							Conditions: []*elbv2.RuleCondition{
+								{
+									Field: aws.String("path-pattern"),
+									PathPatternConfig: &elbv2.PathPatternConditionConfig{
+										Values: aws.StringSlice([]string{"/api", "/api/*"}),
+									},
+								},
+								{
+									Field: aws.String("http-header"),
+									HttpHeaderConfig: &elbv2.HttpHeaderConditionConfig{
+										HttpHeaderName: aws.String("X-Env"),
+										Values:         aws.StringSlice([]string{"beta"}),
+									},
+								},
+							},
+							Actions: []*elbv2.Action{
+								{
+									Type:           aws.String(elbv2.ActionTypeEnumForward),
+									TargetGroupArn: aws.String("group-1"),
+								},
+							},
+						},
+					},
+					NextMarker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("listener-1"),
+					Marker:      aws.String("next"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							RuleArn:  aws.String("rule-2"),
+							Priority: aws.String("2"),
+							Conditions: []*elbv2.RuleCondition{
+								{
+									Field: aws.String("query-string"),
+									QueryStringConfig: &elbv2.QueryStringConditionConfig{
+										Values: []*elbv2.QueryStringKeyValuePair{
+											{
+												Key:   aws.String("version"),
+												Value: aws.String("2"),
+											},
+										},
+									},
+								},
+							},
+							Actions: []*elbv2.Action{
+								{
+									Type: aws.String(elbv2.ActionTypeEnumRedirect),
+									RedirectConfig: &elbv2.RedirectActionConfig{
+										StatusCode: aws.String("HTTP_301"),
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []ListenerRule{
+				{
+					ARN:        "rule-2",
+					Priority:   "2",
+					Conditions: []string{"query-string: version=2"},
+					Action:     "redirect HTTP_301",
+					ConditionValues: map[string][]string{
+						"query-string": {"version=2"},
+					},
+				},
+				{
+					ARN:        "rule-10",
+					Priority:   "10",
+					Conditions: []string{"http-header X-Env: beta", "path-pattern: /api, /api/*"},
+					Action:     "forward",
+					ConditionValues: map[string][]string{
+						"http-header X-Env": {"beta"},
+						"path-pattern":      {"/api", "/api/*"},
+					},
+				},
+				{
+					ARN:      "rule-default",
+					Priority: "default",
+					Action:   "fixed-response 403",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			got, err := elbv2Client.ListenerRules("listener-1")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
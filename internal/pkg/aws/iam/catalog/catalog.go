@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package catalog provides a bundled list of IAM actions to check policies without calling any AWS API.
+// The list is generated from the AWS Service Authorization Reference with "make gen-iam-action-catalog".
+package catalog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+//go:embed actions.json
+var actionsJSON []byte
+
+// ErrUnknownService is returned when the service prefix of an action is not in the catalog.
+type ErrUnknownService struct {
+	Prefix string
+}
+
+func (e *ErrUnknownService) Error() string {
+	return fmt.Sprintf("service prefix %q is not in the IAM action catalog", e.Prefix)
+}
+
+// ErrUnverifiedService is returned when the service prefix of an action is in the catalog without its actions,
+// so the action can't be verified.
+type ErrUnverifiedService struct {
+	Action string
+}
+
+func (e *ErrUnverifiedService) Error() string {
+	return fmt.Sprintf("action %q can't be verified since the actions of its service are not in the IAM action catalog", e.Action)
+}
+
+// ErrUnknownAction is returned when an action doesn't match any action of its service in the catalog.
+type ErrUnknownAction struct {
+	Action string
+}
+
+func (e *ErrUnknownAction) Error() string {
+	return fmt.Sprintf("action %q does not match any IAM action in the catalog", e.Action)
+}
+
+// Catalog holds the IAM actions of AWS services.
+type Catalog struct {
+	// Lowercase service prefixes to their lowercase actions.
+	// A service with no actions is known, but its actions can't be verified.
+	actions map[string][]string
+}
+
+// New returns the catalog of IAM actions bundled with the binary.
+func New() (*Catalog, error) {
+	var services map[string][]string
+	if err := json.Unmarshal(actionsJSON, &services); err != nil {
+		return nil, fmt.Errorf("unmarshal IAM action catalog: %w", err)
+	}
+	actions := make(map[string][]string, len(services))
+	for prefix, names := range services {
+		lowered := make([]string, len(names))
+		for i, name := range names {
+			lowered[i] = strings.ToLower(name)
+		}
+		actions[strings.ToLower(prefix)] = lowered
+	}
+	return &Catalog{
+		actions: actions,
+	}, nil
+}
+
+// Check returns nil if the action, such as "s3:GetObject" or "s3:Get*", matches at least one action in the catalog.
+// Like in IAM policies, service prefixes and action names are case-insensitive.
+// If the actions of the service are not in the catalog, it returns an *ErrUnverifiedService unless the action is "<service>:*".
+func (c *Catalog) Check(action string) error {
+	if action == "*" {
+		return nil
+	}
+	parts := strings.SplitN(strings.ToLower(action), ":", 2)
+	if len(parts) != 2 {
+		return &ErrUnknownAction{Action: action}
+	}
+	prefix, name := parts[0], parts[1]
+	known, ok := c.actions[prefix]
+	if !ok {
+		return &ErrUnknownService{Prefix: prefix}
+	}
+	if len(known) == 0 {
+		if name == "*" {
+			return nil
+		}
+		return &ErrUnverifiedService{Action: action}
+	}
+	pattern, err := regexp.Compile(wildcardToRegexp(name))
+	if err != nil {
+		return &ErrUnknownAction{Action: action}
+	}
+	for _, k := range known {
+		if pattern.MatchString(k) {
+			return nil
+		}
+	}
+	return &ErrUnknownAction{Action: action}
+}
+
+// wildcardToRegexp converts an IAM action name with the "*" and "?" wildcards to a regular expression.
+func wildcardToRegexp(name string) string {
+	quoted := regexp.QuoteMeta(name)
+	quoted = strings.ReplaceAll(quoted, `\*`, ".*")
+	quoted = strings.ReplaceAll(quoted, `\?`, ".")
+	return "^" + quoted + "$"
+}
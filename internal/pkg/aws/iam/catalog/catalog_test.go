@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalog_Check(t *testing.T) {
+	testCases := map[string]struct {
+		action string
+
+		wantedErr error
+	}{
+		"any action": {
+			action: "*",
+		},
+		"exact action": {
+			action: "s3:GetObject",
+		},
+		"case-insensitive action": {
+			action: "DynamoDB:getitem",
+		},
+		"wildcard action": {
+			action: "sqs:Receive*",
+		},
+		"single character wildcard": {
+			action: "kms:?ncrypt",
+		},
+		"any action of a service": {
+			action: "sns:*",
+		},
+		"any action of a service without bundled actions": {
+			action: "ecs:*",
+		},
+		"service without bundled actions": {
+			action:    "ecs:RunTask",
+			wantedErr: &ErrUnverifiedService{Action: "ecs:RunTask"},
+		},
+		"missing service prefix": {
+			action:    "GetObject",
+			wantedErr: &ErrUnknownAction{Action: "GetObject"},
+		},
+		"unknown service": {
+			action:    "s4:GetObject",
+			wantedErr: &ErrUnknownService{Prefix: "s4"},
+		},
+		"unknown action": {
+			action:    "sqs:SendMessageBatch",
+			wantedErr: &ErrUnknownAction{Action: "sqs:SendMessageBatch"},
+		},
+		"wildcard that matches no action": {
+			action:    "s3:Fetch*",
+			wantedErr: &ErrUnknownAction{Action: "s3:Fetch*"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := New()
+			require.NoError(t, err)
+
+			err = c.Check(tc.action)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Command gen writes the IAM action catalog from the AWS Service Authorization Reference.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const serviceReferenceURL = "https://servicereference.us-east-1.amazonaws.com/"
+
+// service is an entry of the index of the Service Authorization Reference.
+type service struct {
+	Prefix string `json:"service"`
+	URL    string `json:"url"`
+}
+
+// serviceReference is the reference of a service's actions, resources and condition keys.
+type serviceReference struct {
+	Actions []struct {
+		Name string `json:"Name"`
+	} `json:"Actions"`
+}
+
+func main() {
+	out := flag.String("out", filepath.Join("internal", "pkg", "aws", "iam", "catalog", "actions.json"), "File to write the catalog to.")
+	flag.Parse()
+	if err := run(*out); err != nil {
+		fmt.Fprintf(os.Stderr, "generate IAM action catalog: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(out string) error {
+	client := &http.Client{Timeout: 30 * time.Second}
+	var services []service
+	if err := getJSON(client, serviceReferenceURL, &services); err != nil {
+		return fmt.Errorf("get the services of the Service Authorization Reference: %w", err)
+	}
+	catalog := make(map[string][]string, len(services))
+	for _, svc := range services {
+		var ref serviceReference
+		if err := getJSON(client, svc.URL, &ref); err != nil {
+			return fmt.Errorf("get the actions of service %s: %w", svc.Prefix, err)
+		}
+		actions := make([]string, len(ref.Actions))
+		for i, action := range ref.Actions {
+			actions[i] = action.Name
+		}
+		sort.Strings(actions)
+		catalog[svc.Prefix] = actions
+	}
+	// Maps are encoded with their keys sorted, so the catalog only changes if the reference does.
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(catalog); err != nil {
+		return fmt.Errorf("encode catalog: %w", err)
+	}
+	if err := ioutil.WriteFile(out, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("write file %s: %w", out, err)
+	}
+	return nil
+}
+
+func getJSON(client *http.Client, url string, v interface{}) error {
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s: unexpected status %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
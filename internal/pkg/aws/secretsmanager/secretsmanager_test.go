@@ -105,3 +105,55 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_GetSecretValue(t *testing.T) {
+	mockSecretName := "github-token-backend-badgoose"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		expectedValue string
+		expectedError error
+	}{
+		"should wrap error returned by GetSecretValue": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockSecretName),
+				}).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("get value of secret %s from secrets manager: %w", mockSecretName, mockError),
+		},
+		"should return the secret string if successful": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockSecretName),
+				}).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String("H0NKH0NKH0NK"),
+				}, nil)
+			},
+			expectedValue: "H0NKH0NKH0NK",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			got, err := sm.GetSecretValue(mockSecretName)
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expectedValue, got)
+		})
+	}
+}
@@ -8,28 +8,48 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+const (
+	portForwardingDocument             = "AWS-StartPortForwardingSession"
+	portForwardingToRemoteHostDocument = "AWS-StartPortForwardingSessionToRemoteHost"
 )
 
 type api interface {
 	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+	GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
 	AddTagsToResource(input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+	DescribeParameters(input *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error)
+	DeleteParameter(input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+	StartSession(input *ssm.StartSessionInput) (*ssm.StartSessionOutput, error)
+}
+
+type ssmSessionStarter interface {
+	StartSSMSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error
 }
 
 // SSM wraps an AWS SSM client.
 type SSM struct {
-	client api
+	client         api
+	newSessStarter func() ssmSessionStarter
 }
 
 // New returns a SSM service configured against the input session.
 func New(s *session.Session) *SSM {
 	return &SSM{
 		client: ssm.New(s),
+		newSessStarter: func() ssmSessionStarter {
+			return exec.NewSSMPluginCommand(s)
+		},
 	}
 }
 
@@ -61,6 +81,102 @@ func (s *SSM) PutSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	return nil, err
 }
 
+// GetSecretValue returns the decrypted value of the parameter with the given name or ARN.
+func (s *SSM) GetSecretValue(name string) (string, error) {
+	out, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
+
+// SecretMetadata holds the metadata of a secret, without its value.
+type SecretMetadata struct {
+	Name             string
+	Version          int64
+	LastModifiedDate time.Time
+	LastModifiedUser string
+}
+
+// ListSecrets returns the metadata of the secrets directly under the path, sorted by name.
+func (s *SSM) ListSecrets(path string) ([]SecretMetadata, error) {
+	secrets, err := s.describeParameters(&ssm.ParameterStringFilter{
+		Key:    aws.String("Path"),
+		Option: aws.String("OneLevel"),
+		Values: aws.StringSlice([]string{path}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list parameters under path %s: %w", path, err)
+	}
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, nil
+}
+
+// DescribeSecret returns the metadata of the secret with the given name.
+// ErrParameterNotFound is returned if the secret doesn't exist.
+func (s *SSM) DescribeSecret(name string) (*SecretMetadata, error) {
+	secrets, err := s.describeParameters(&ssm.ParameterStringFilter{
+		Key:    aws.String("Name"),
+		Option: aws.String("Equals"),
+		Values: aws.StringSlice([]string{name}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe parameter %s: %w", name, err)
+	}
+	if len(secrets) == 0 {
+		return nil, &ErrParameterNotFound{name}
+	}
+	return &secrets[0], nil
+}
+
+// DeleteSecret deletes the secret with the given name, along with all of its versions.
+// ErrParameterNotFound is returned if the secret doesn't exist.
+func (s *SSM) DeleteSecret(name string) error {
+	_, err := s.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	if err == nil {
+		return nil
+	}
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == ssm.ErrCodeParameterNotFound {
+			return &ErrParameterNotFound{name}
+		}
+	}
+	return fmt.Errorf("delete parameter %s: %w", name, err)
+}
+
+func (s *SSM) describeParameters(filter *ssm.ParameterStringFilter) ([]SecretMetadata, error) {
+	var secrets []SecretMetadata
+	var nextToken *string
+	for {
+		out, err := s.client.DescribeParameters(&ssm.DescribeParametersInput{
+			ParameterFilters: []*ssm.ParameterStringFilter{filter},
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, param := range out.Parameters {
+			secrets = append(secrets, SecretMetadata{
+				Name:             aws.StringValue(param.Name),
+				Version:          aws.Int64Value(param.Version),
+				LastModifiedDate: aws.TimeValue(param.LastModifiedDate),
+				LastModifiedUser: aws.StringValue(param.LastModifiedUser),
+			})
+		}
+		if out.NextToken == nil {
+			return secrets, nil
+		}
+		nextToken = out.NextToken
+	}
+}
+
 func (s *SSM) createSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	// Create a secret while adding the tags in a single call instead of separate calls to `PutParameter` and
 	// `AddTagsToResource` so that there won't be a case where the parameter is created while the tags are not added.
@@ -115,6 +231,43 @@ func (s *SSM) overwriteSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	return (*PutSecretOutput)(output), nil
 }
 
+// PortForwardingSessionInput holds the fields needed to forward a local port to a port of a running ECS container,
+// or to a port of a remote host reachable from the container.
+type PortForwardingSessionInput struct {
+	Cluster    string
+	TaskID     string
+	RuntimeID  string // Runtime ID of the container in the task.
+	LocalPort  int
+	RemotePort int
+	RemoteHost string // Optional. If empty, the port of the container itself is forwarded.
+}
+
+// StartPortForwardingSession starts a port forwarding session to the container and blocks until the session ends.
+func (s *SSM) StartPortForwardingSession(in PortForwardingSessionInput) error {
+	params := map[string][]*string{
+		"portNumber":      aws.StringSlice([]string{strconv.Itoa(in.RemotePort)}),
+		"localPortNumber": aws.StringSlice([]string{strconv.Itoa(in.LocalPort)}),
+	}
+	document := portForwardingDocument
+	if in.RemoteHost != "" {
+		document = portForwardingToRemoteHostDocument
+		params["host"] = aws.StringSlice([]string{in.RemoteHost})
+	}
+	startSessIn := &ssm.StartSessionInput{
+		DocumentName: aws.String(document),
+		Parameters:   params,
+		Target:       aws.String(fmt.Sprintf("ecs:%s_%s_%s", in.Cluster, in.TaskID, in.RuntimeID)),
+	}
+	out, err := s.client.StartSession(startSessIn)
+	if err != nil {
+		return &ErrStartSession{err: err}
+	}
+	if err := s.newSessStarter().StartSSMSession(out, startSessIn); err != nil {
+		return fmt.Errorf("start session %s using ssm plugin: %w", aws.StringValue(out.SessionId), err)
+	}
+	return nil
+}
+
 func convertTags(inTags map[string]string) []*ssm.Tag {
 	// Sort the map so that the unit test won't be flaky.
 	keys := make([]string, 0, len(inTags))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -361,3 +362,358 @@ func TestSSM_PutSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_GetSecretValue(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedValue string
+		wantedError error
+	}{
+		"should wrap the error if the parameter cannot be retrieved": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get parameter /copilot/myapp/myenv/secrets/db-password: some error"),
+		},
+		"should return the decrypted value of the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String("/copilot/myapp/myenv/secrets/db-password"),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("super secure password"),
+					},
+				}, nil)
+			},
+			wantedValue: "super secure password",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.GetSecretValue("/copilot/myapp/myenv/secrets/db-password")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedValue, got)
+			}
+		})
+	}
+}
+
+func TestSSM_ListSecrets(t *testing.T) {
+	const mockPath = "/copilot/myapp/myenv/secrets/"
+	mockTime := time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedSecrets []SecretMetadata
+		wantedError   error
+	}{
+		"wrap error from DescribeParameters": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list parameters under path /copilot/myapp/myenv/secrets/: some error"),
+		},
+		"list secrets across pages sorted by name": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+					ParameterFilters: []*ssm.ParameterStringFilter{
+						{
+							Key:    aws.String("Path"),
+							Option: aws.String("OneLevel"),
+							Values: aws.StringSlice([]string{mockPath}),
+						},
+					},
+				}).Return(&ssm.DescribeParametersOutput{
+					Parameters: []*ssm.ParameterMetadata{
+						{
+							Name:             aws.String(mockPath + "token"),
+							Version:          aws.Int64(2),
+							LastModifiedDate: aws.Time(mockTime),
+							LastModifiedUser: aws.String("arn:aws:iam::123456789012:user/alice"),
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+					ParameterFilters: []*ssm.ParameterStringFilter{
+						{
+							Key:    aws.String("Path"),
+							Option: aws.String("OneLevel"),
+							Values: aws.StringSlice([]string{mockPath}),
+						},
+					},
+					NextToken: aws.String("next"),
+				}).Return(&ssm.DescribeParametersOutput{
+					Parameters: []*ssm.ParameterMetadata{
+						{
+							Name:             aws.String(mockPath + "db-password"),
+							Version:          aws.Int64(1),
+							LastModifiedDate: aws.Time(mockTime),
+							LastModifiedUser: aws.String("arn:aws:iam::123456789012:user/bob"),
+						},
+					},
+				}, nil)
+			},
+			wantedSecrets: []SecretMetadata{
+				{
+					Name:             mockPath + "db-password",
+					Version:          1,
+					LastModifiedDate: mockTime,
+					LastModifiedUser: "arn:aws:iam::123456789012:user/bob",
+				},
+				{
+					Name:             mockPath + "token",
+					Version:          2,
+					LastModifiedDate: mockTime,
+					LastModifiedUser: "arn:aws:iam::123456789012:user/alice",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.ListSecrets(mockPath)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSecrets, got)
+			}
+		})
+	}
+}
+
+func TestSSM_DescribeSecret(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedSecret *SecretMetadata
+		wantedError  error
+	}{
+		"return ErrParameterNotFound if the secret doesn't exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(gomock.Any()).Return(&ssm.DescribeParametersOutput{}, nil)
+			},
+			wantedError: &ErrParameterNotFound{mockName},
+		},
+		"describe the secret": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+					ParameterFilters: []*ssm.ParameterStringFilter{
+						{
+							Key:    aws.String("Name"),
+							Option: aws.String("Equals"),
+							Values: aws.StringSlice([]string{mockName}),
+						},
+					},
+				}).Return(&ssm.DescribeParametersOutput{
+					Parameters: []*ssm.ParameterMetadata{
+						{
+							Name:    aws.String(mockName),
+							Version: aws.Int64(4),
+						},
+					},
+				}, nil)
+			},
+			wantedSecret: &SecretMetadata{
+				Name:    mockName,
+				Version: 4,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.DescribeSecret(mockName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSecret, got)
+			}
+		})
+	}
+}
+
+func TestSSM_DeleteSecret(t *testing.T) {
+	const mockName = "/copilot/myapp/myenv/secrets/db-password"
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedError error
+	}{
+		"return ErrParameterNotFound if the secret doesn't exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wantedError: &ErrParameterNotFound{mockName},
+		},
+		"wrap other errors": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("delete parameter /copilot/myapp/myenv/secrets/db-password: some error"),
+		},
+		"delete the secret": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String(mockName),
+				}).Return(&ssm.DeleteParameterOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			err := client.DeleteSecret(mockName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSSM_StartPortForwardingSession(t *testing.T) {
+	mockSess := &ssm.StartSessionOutput{
+		SessionId: aws.String("mockSessID"),
+	}
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		inRemoteHost    string
+		wantedIn        *ssm.StartSessionInput
+		mockSessStarter func(m *mocks.MockssmSessionStarter, in *ssm.StartSessionInput)
+		startSessionErr error
+
+		wantedError error
+	}{
+		"return error if fail to call StartSession": {
+			wantedIn: &ssm.StartSessionInput{
+				DocumentName: aws.String("AWS-StartPortForwardingSession"),
+				Parameters: map[string][]*string{
+					"portNumber":      aws.StringSlice([]string{"8080"}),
+					"localPortNumber": aws.StringSlice([]string{"5432"}),
+				},
+				Target: aws.String("ecs:mockCluster_mockTask_mockRuntime"),
+			},
+			startSessionErr: mockErr,
+			mockSessStarter: func(m *mocks.MockssmSessionStarter, in *ssm.StartSessionInput) {},
+			wantedError:     &ErrStartSession{err: mockErr},
+		},
+		"return error if fail to start the session with the plugin": {
+			wantedIn: &ssm.StartSessionInput{
+				DocumentName: aws.String("AWS-StartPortForwardingSession"),
+				Parameters: map[string][]*string{
+					"portNumber":      aws.StringSlice([]string{"8080"}),
+					"localPortNumber": aws.StringSlice([]string{"5432"}),
+				},
+				Target: aws.String("ecs:mockCluster_mockTask_mockRuntime"),
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter, in *ssm.StartSessionInput) {
+				m.EXPECT().StartSSMSession(mockSess, in).Return(mockErr)
+			},
+			wantedError: errors.New("start session mockSessID using ssm plugin: some error"),
+		},
+		"forward to a remote host through the container": {
+			inRemoteHost: "db.cluster-abc.us-west-2.rds.amazonaws.com",
+			wantedIn: &ssm.StartSessionInput{
+				DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+				Parameters: map[string][]*string{
+					"portNumber":      aws.StringSlice([]string{"8080"}),
+					"localPortNumber": aws.StringSlice([]string{"5432"}),
+					"host":            aws.StringSlice([]string{"db.cluster-abc.us-west-2.rds.amazonaws.com"}),
+				},
+				Target: aws.String("ecs:mockCluster_mockTask_mockRuntime"),
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter, in *ssm.StartSessionInput) {
+				m.EXPECT().StartSSMSession(mockSess, in).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			mockSessStarter := mocks.NewMockssmSessionStarter(ctrl)
+			if tc.startSessionErr != nil {
+				mockSSMClient.EXPECT().StartSession(tc.wantedIn).Return(nil, tc.startSessionErr)
+			} else {
+				mockSSMClient.EXPECT().StartSession(tc.wantedIn).Return(mockSess, nil)
+			}
+			tc.mockSessStarter(mockSessStarter, tc.wantedIn)
+			client := SSM{
+				client: mockSSMClient,
+				newSessStarter: func() ssmSessionStarter {
+					return mockSessStarter
+				},
+			}
+
+			err := client.StartPortForwardingSession(PortForwardingSessionInput{
+				Cluster:    "mockCluster",
+				TaskID:     "mockTask",
+				RuntimeID:  "mockRuntime",
+				LocalPort:  5432,
+				RemotePort: 8080,
+				RemoteHost: tc.inRemoteHost,
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
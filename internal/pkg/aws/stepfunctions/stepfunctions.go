@@ -6,6 +6,7 @@ package stepfunctions
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -14,6 +15,48 @@ import (
 
 type api interface {
 	DescribeStateMachine(input *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error)
+	StartExecution(input *sfn.StartExecutionInput) (*sfn.StartExecutionOutput, error)
+	DescribeExecution(input *sfn.DescribeExecutionInput) (*sfn.DescribeExecutionOutput, error)
+	GetExecutionHistoryPages(input *sfn.GetExecutionHistoryInput, fn func(*sfn.GetExecutionHistoryOutput, bool) bool) error
+	ListExecutions(input *sfn.ListExecutionsInput) (*sfn.ListExecutionsOutput, error)
+}
+
+// Execution holds the status of a state machine execution.
+type Execution struct {
+	ARN       string
+	Status    string
+	StartDate time.Time
+	StopDate  *time.Time // Nil if the execution is still running.
+}
+
+// TaskHistory summarizes the task states of an execution.
+type TaskHistory struct {
+	// SubmittedOutputs are the outputs of the submitted tasks in chronological order.
+	// For example, the output of an "ecs:runTask" task is the JSON response of the ECS RunTask API.
+	SubmittedOutputs []string
+	// TimedOut is the number of tasks that timed out.
+	TimedOut int
+	// LastResult is the output of the last submitted task if it succeeded or the cause if it failed.
+	// Empty if the last submitted task hasn't finished yet or timed out.
+	LastResult string
+}
+
+// TaskState summarizes the attempts of a task state in an execution.
+type TaskState struct {
+	Name     string
+	Resource string // Type and name of the resource the task calls, for example "states:startExecution.sync:2".
+	Attempts int    // Number of times the task was scheduled, including retries.
+	Status   string // Status of the last attempt: "RUNNING", "SUCCEEDED", "FAILED" or "TIMED_OUT".
+}
+
+// IsRunning returns true if the execution hasn't finished yet.
+func (e *Execution) IsRunning() bool {
+	return e.Status == sfn.ExecutionStatusRunning
+}
+
+// IsSucceeded returns true if the execution finished successfully.
+func (e *Execution) IsSucceeded() bool {
+	return e.Status == sfn.ExecutionStatusSucceeded
 }
 
 // StepFunctions wraps an AWS StepFunctions client.
@@ -39,3 +82,146 @@ func (s *StepFunctions) StateMachineDefinition(stateMachineARN string) (string,
 
 	return aws.StringValue(out.Definition), nil
 }
+
+// StartExecution starts an execution of a state machine with a JSON input, and returns the ARN of the execution.
+func (s *StepFunctions) StartExecution(stateMachineARN, input string) (string, error) {
+	out, err := s.client.StartExecution(&sfn.StartExecutionInput{
+		StateMachineArn: aws.String(stateMachineARN),
+		Input:           aws.String(input),
+	})
+	if err != nil {
+		return "", fmt.Errorf("start execution of state machine %s: %w", stateMachineARN, err)
+	}
+	return aws.StringValue(out.ExecutionArn), nil
+}
+
+// DescribeExecution returns the status of an execution.
+func (s *StepFunctions) DescribeExecution(executionARN string) (*Execution, error) {
+	out, err := s.client.DescribeExecution(&sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(executionARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe execution %s: %w", executionARN, err)
+	}
+	return &Execution{
+		ARN:       aws.StringValue(out.ExecutionArn),
+		Status:    aws.StringValue(out.Status),
+		StartDate: aws.TimeValue(out.StartDate),
+		StopDate:  out.StopDate,
+	}, nil
+}
+
+// ListExecutions returns up to maxResults of the most recent executions of a state machine, the most recent first.
+func (s *StepFunctions) ListExecutions(stateMachineARN string, maxResults int) ([]*Execution, error) {
+	out, err := s.client.ListExecutions(&sfn.ListExecutionsInput{
+		StateMachineArn: aws.String(stateMachineARN),
+		MaxResults:      aws.Int64(int64(maxResults)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list executions of state machine %s: %w", stateMachineARN, err)
+	}
+	executions := make([]*Execution, len(out.Executions))
+	for i, execution := range out.Executions {
+		executions[i] = &Execution{
+			ARN:       aws.StringValue(execution.ExecutionArn),
+			Status:    aws.StringValue(execution.Status),
+			StartDate: aws.TimeValue(execution.StartDate),
+			StopDate:  execution.StopDate,
+		}
+	}
+	return executions, nil
+}
+
+// TaskSubmittedOutputs returns the outputs of the tasks submitted by an execution in chronological order.
+// For example, the output of an "ecs:runTask" task is the JSON response of the ECS RunTask API.
+func (s *StepFunctions) TaskSubmittedOutputs(executionARN string) ([]string, error) {
+	history, err := s.TaskHistory(executionARN)
+	if err != nil {
+		return nil, err
+	}
+	return history.SubmittedOutputs, nil
+}
+
+// TaskHistory returns a summary of the task states of an execution.
+func (s *StepFunctions) TaskHistory(executionARN string) (*TaskHistory, error) {
+	history := &TaskHistory{}
+	err := s.client.GetExecutionHistoryPages(&sfn.GetExecutionHistoryInput{
+		ExecutionArn: aws.String(executionARN),
+	}, func(page *sfn.GetExecutionHistoryOutput, lastPage bool) bool {
+		for _, event := range page.Events {
+			switch aws.StringValue(event.Type) {
+			case sfn.HistoryEventTypeTaskSubmitted:
+				if event.TaskSubmittedEventDetails != nil {
+					history.SubmittedOutputs = append(history.SubmittedOutputs, aws.StringValue(event.TaskSubmittedEventDetails.Output))
+				}
+				history.LastResult = ""
+			case sfn.HistoryEventTypeTaskSucceeded:
+				if event.TaskSucceededEventDetails != nil {
+					history.LastResult = aws.StringValue(event.TaskSucceededEventDetails.Output)
+				}
+			case sfn.HistoryEventTypeTaskFailed:
+				if event.TaskFailedEventDetails != nil {
+					history.LastResult = aws.StringValue(event.TaskFailedEventDetails.Cause)
+				}
+			case sfn.HistoryEventTypeTaskTimedOut:
+				history.TimedOut++
+				history.LastResult = ""
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get execution history of %s: %w", executionARN, err)
+	}
+	return history, nil
+}
+
+// TaskStates returns a summary of each task state entered by an execution in the order they were entered.
+func (s *StepFunctions) TaskStates(executionARN string) ([]*TaskState, error) {
+	var states []*TaskState
+	// Events are linked to their previous event, so the task state of an event is the one of its previous event.
+	// This holds for tasks that run in parallel branches as well.
+	stateOf := make(map[int64]*TaskState)
+	err := s.client.GetExecutionHistoryPages(&sfn.GetExecutionHistoryInput{
+		ExecutionArn: aws.String(executionARN),
+	}, func(page *sfn.GetExecutionHistoryOutput, lastPage bool) bool {
+		for _, event := range page.Events {
+			id := aws.Int64Value(event.Id)
+			if aws.StringValue(event.Type) == sfn.HistoryEventTypeTaskStateEntered {
+				if event.StateEnteredEventDetails == nil {
+					continue
+				}
+				state := &TaskState{
+					Name: aws.StringValue(event.StateEnteredEventDetails.Name),
+				}
+				states = append(states, state)
+				stateOf[id] = state
+				continue
+			}
+			state, ok := stateOf[aws.Int64Value(event.PreviousEventId)]
+			if !ok {
+				continue
+			}
+			stateOf[id] = state
+			switch aws.StringValue(event.Type) {
+			case sfn.HistoryEventTypeTaskScheduled:
+				state.Attempts++
+				state.Status = sfn.ExecutionStatusRunning
+				if details := event.TaskScheduledEventDetails; details != nil {
+					state.Resource = aws.StringValue(details.ResourceType) + ":" + aws.StringValue(details.Resource)
+				}
+			case sfn.HistoryEventTypeTaskSucceeded:
+				state.Status = sfn.ExecutionStatusSucceeded
+			case sfn.HistoryEventTypeTaskFailed, sfn.HistoryEventTypeTaskStartFailed, sfn.HistoryEventTypeTaskSubmitFailed:
+				state.Status = sfn.ExecutionStatusFailed
+			case sfn.HistoryEventTypeTaskTimedOut:
+				state.Status = sfn.ExecutionStatusTimedOut
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get execution history of %s: %w", executionARN, err)
+	}
+	return states, nil
+}
@@ -7,6 +7,7 @@ package stepfunctions
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
@@ -68,3 +69,375 @@ func TestStepFunctions_StateMachineDefinition(t *testing.T) {
 		})
 	}
 }
+
+func TestStepFunctions_StartExecution(t *testing.T) {
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedARN   string
+	}{
+		"fail to start execution": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartExecution(&sfn.StartExecutionInput{
+					StateMachineArn: aws.String("mockStateMachine"),
+					Input:           aws.String(`{}`),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("start execution of state machine mockStateMachine: some error"),
+		},
+		"success": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartExecution(&sfn.StartExecutionInput{
+					StateMachineArn: aws.String("mockStateMachine"),
+					Input:           aws.String(`{}`),
+				}).Return(&sfn.StartExecutionOutput{
+					ExecutionArn: aws.String("mockExecution"),
+				}, nil)
+			},
+			wantedARN: "mockExecution",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.StartExecution("mockStateMachine", `{}`)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedARN, out)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_DescribeExecution(t *testing.T) {
+	startDate := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError     error
+		wantedExecution *Execution
+	}{
+		"fail to describe execution": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeExecution(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe execution mockExecution: some error"),
+		},
+		"success": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeExecution(&sfn.DescribeExecutionInput{
+					ExecutionArn: aws.String("mockExecution"),
+				}).Return(&sfn.DescribeExecutionOutput{
+					ExecutionArn: aws.String("mockExecution"),
+					Status:       aws.String(sfn.ExecutionStatusRunning),
+					StartDate:    aws.Time(startDate),
+				}, nil)
+			},
+			wantedExecution: &Execution{
+				ARN:       "mockExecution",
+				Status:    "RUNNING",
+				StartDate: startDate,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.DescribeExecution("mockExecution")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecution, out)
+				require.True(t, out.IsRunning())
+			}
+		})
+	}
+}
+
+func TestStepFunctions_TaskSubmittedOutputs(t *testing.T) {
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError   error
+		wantedOutputs []string
+	}{
+		"fail to get execution history": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistoryPages(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("get execution history of mockExecution: some error"),
+		},
+		"success": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistoryPages(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("mockExecution"),
+				}, gomock.Any()).DoAndReturn(func(_ *sfn.GetExecutionHistoryInput, fn func(*sfn.GetExecutionHistoryOutput, bool) bool) error {
+					fn(&sfn.GetExecutionHistoryOutput{
+						Events: []*sfn.HistoryEvent{
+							{
+								Type: aws.String(sfn.HistoryEventTypeExecutionStarted),
+							},
+							{
+								Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+								TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+									Output: aws.String(`{"Tasks":[]}`),
+								},
+							},
+						},
+					}, false)
+					fn(&sfn.GetExecutionHistoryOutput{
+						Events: []*sfn.HistoryEvent{
+							{
+								Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+								TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+									Output: aws.String(`{"Tasks":[{}]}`),
+								},
+							},
+						},
+					}, true)
+					return nil
+				})
+			},
+			wantedOutputs: []string{`{"Tasks":[]}`, `{"Tasks":[{}]}`},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.TaskSubmittedOutputs("mockExecution")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutputs, out)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_ListExecutions(t *testing.T) {
+	startDate := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+	stopDate := time.Date(2022, time.March, 1, 0, 5, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError      error
+		wantedExecutions []*Execution
+	}{
+		"fail to list executions": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of state machine mockStateMachine: some error"),
+		},
+		"success": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String("mockStateMachine"),
+					MaxResults:      aws.Int64(10),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{
+							ExecutionArn: aws.String("mockRunningExecution"),
+							Status:       aws.String(sfn.ExecutionStatusRunning),
+							StartDate:    aws.Time(stopDate),
+						},
+						{
+							ExecutionArn: aws.String("mockExecution"),
+							Status:       aws.String(sfn.ExecutionStatusFailed),
+							StartDate:    aws.Time(startDate),
+							StopDate:     aws.Time(stopDate),
+						},
+					},
+				}, nil)
+			},
+			wantedExecutions: []*Execution{
+				{
+					ARN:       "mockRunningExecution",
+					Status:    "RUNNING",
+					StartDate: stopDate,
+				},
+				{
+					ARN:       "mockExecution",
+					Status:    "FAILED",
+					StartDate: startDate,
+					StopDate:  aws.Time(stopDate),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.ListExecutions("mockStateMachine", 10)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecutions, out)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_TaskHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+	mockStepFunctionsClient.EXPECT().GetExecutionHistoryPages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ *sfn.GetExecutionHistoryInput, fn func(*sfn.GetExecutionHistoryOutput, bool) bool) error {
+			fn(&sfn.GetExecutionHistoryOutput{
+				Events: []*sfn.HistoryEvent{
+					{
+						Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+						TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+							Output: aws.String(`{"Tasks":[{"TaskArn":"task1"}]}`),
+						},
+					},
+					{
+						Type: aws.String(sfn.HistoryEventTypeTaskFailed),
+						TaskFailedEventDetails: &sfn.TaskFailedEventDetails{
+							Cause: aws.String(`{"Containers":[{"ExitCode":2}]}`),
+						},
+					},
+					{
+						Type:                     aws.String(sfn.HistoryEventTypeTaskTimedOut),
+						TaskTimedOutEventDetails: &sfn.TaskTimedOutEventDetails{},
+					},
+					{
+						Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+						TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+							Output: aws.String(`{"Tasks":[{"TaskArn":"task2"}]}`),
+						},
+					},
+					{
+						Type: aws.String(sfn.HistoryEventTypeTaskFailed),
+						TaskFailedEventDetails: &sfn.TaskFailedEventDetails{
+							Cause: aws.String(`{"Containers":[{"ExitCode":1}]}`),
+						},
+					},
+				},
+			}, true)
+			return nil
+		})
+	sfn := StepFunctions{
+		client: mockStepFunctionsClient,
+	}
+
+	out, err := sfn.TaskHistory("mockExecution")
+
+	require.NoError(t, err)
+	require.Equal(t, &TaskHistory{
+		SubmittedOutputs: []string{`{"Tasks":[{"TaskArn":"task1"}]}`, `{"Tasks":[{"TaskArn":"task2"}]}`},
+		TimedOut:         1,
+		LastResult:       `{"Containers":[{"ExitCode":1}]}`,
+	}, out)
+}
+
+func TestStepFunctions_TaskStates(t *testing.T) {
+	event := func(id, previousID int64, eventType string) *sfn.HistoryEvent {
+		return &sfn.HistoryEvent{
+			Id:              aws.Int64(id),
+			PreviousEventId: aws.Int64(previousID),
+			Type:            aws.String(eventType),
+		}
+	}
+	entered := func(id, previousID int64, name string) *sfn.HistoryEvent {
+		e := event(id, previousID, sfn.HistoryEventTypeTaskStateEntered)
+		e.StateEnteredEventDetails = &sfn.StateEnteredEventDetails{
+			Name: aws.String(name),
+		}
+		return e
+	}
+	scheduled := func(id, previousID int64) *sfn.HistoryEvent {
+		e := event(id, previousID, sfn.HistoryEventTypeTaskScheduled)
+		e.TaskScheduledEventDetails = &sfn.TaskScheduledEventDetails{
+			ResourceType: aws.String("states"),
+			Resource:     aws.String("startExecution.sync:2"),
+		}
+		return e
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+	mockStepFunctionsClient.EXPECT().GetExecutionHistoryPages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ *sfn.GetExecutionHistoryInput, fn func(*sfn.GetExecutionHistoryOutput, bool) bool) error {
+			fn(&sfn.GetExecutionHistoryOutput{
+				Events: []*sfn.HistoryEvent{
+					event(1, 0, sfn.HistoryEventTypeExecutionStarted),
+					event(2, 1, sfn.HistoryEventTypeParallelStateEntered),
+					entered(3, 2, "orders"),
+					entered(4, 2, "users"),
+					scheduled(5, 3),
+					scheduled(6, 4),
+					event(7, 5, sfn.HistoryEventTypeTaskFailed),
+					event(8, 6, sfn.HistoryEventTypeTaskSucceeded),
+					event(9, 8, sfn.HistoryEventTypeTaskStateExited),
+					scheduled(10, 7),
+					event(11, 10, sfn.HistoryEventTypeTaskTimedOut),
+				},
+			}, true)
+			return nil
+		})
+	sfn := StepFunctions{
+		client: mockStepFunctionsClient,
+	}
+
+	out, err := sfn.TaskStates("mockExecution")
+
+	require.NoError(t, err)
+	require.Equal(t, []*TaskState{
+		{
+			Name:     "orders",
+			Resource: "states:startExecution.sync:2",
+			Attempts: 2,
+			Status:   "TIMED_OUT",
+		},
+		{
+			Name:     "users",
+			Resource: "states:startExecution.sync:2",
+			Attempts: 1,
+			Status:   "SUCCEEDED",
+		},
+	}, out)
+}
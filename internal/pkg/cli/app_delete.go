@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -48,6 +49,7 @@ var (
 type deleteAppVars struct {
 	name             string
 	skipConfirmation bool
+	outputFormat     string
 }
 
 type deleteAppOpts struct {
@@ -216,7 +218,9 @@ func (o *deleteAppOpts) Execute() error {
 	if err := o.deleteWs(); err != nil {
 		return err
 	}
-
+	event.Publish(event.Output{
+		Application: o.name,
+	})
 	return nil
 }
 
@@ -367,15 +371,18 @@ func buildAppDeleteCommand() *cobra.Command {
   Force delete the application with environments "test" and "prod".
   /code $ copilot app delete --yes`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeleteAppOpts(vars)
-			if err != nil {
-				return err
-			}
-			return run(opts)
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newDeleteAppOpts(vars)
+				if err != nil {
+					return err
+				}
+				return run(opts)
+			})
 		}),
 	}
 
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
 	return cmd
 }
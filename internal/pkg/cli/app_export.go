@@ -0,0 +1,548 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultExportDir = "copilot-export"
+
+	exportIndexFileName  = "index.yml"
+	exportEnvsDirName    = "environments"
+	exportWkldsDirName   = "workloads"
+	exportAssetsDirName  = "assets"
+	exportEnvStackType   = "Environment"
+	fmtExportEnvTemplate = "%s.stack.yml"
+	fmtExportEnvParams   = "%s.params.json"
+	fmtS3ObjectURL       = "https://%s.s3.%s.%s/%s"
+)
+
+type exportAppVars struct {
+	appName   string
+	outputDir string
+	tag       string
+}
+
+// exportAppOpts renders the stacks of every environment and workload of an application into a directory
+// that can be deployed with CloudFormation alone.
+type exportAppOpts struct {
+	exportAppVars
+
+	store           store
+	ws              wsWlDirReader
+	fs              afero.Fs
+	identity        identityService
+	appCFN          appResourcesGetter
+	uploader        customResourcesUploader
+	runner          runner
+	sessProvider    *sessions.Provider
+	unmarshal       func([]byte) (manifest.WorkloadManifest, error)
+	newInterpolator func(app, env string) interpolator
+	newAddonsClient func(wkld string) (templater, error)
+	newEnvAddons    func() (addonsTemplater, error)
+	newTplGenerator func(in *clideploy.WorkloadDeployerInput) (workloadTemplateGenerator, error)
+
+	// cached variables
+	app         *config.Application
+	rootUserARN string
+	resources   map[string]*stack.AppRegionalResources // Regional resources of the application keyed by region.
+	index       exportIndex
+}
+
+func newExportAppOpts(vars exportAppVars) (*exportAppOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app export"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	return &exportAppOpts{
+		exportAppVars: vars,
+
+		store:           config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region)),
+		ws:              ws,
+		fs:              &afero.Afero{Fs: afero.NewOsFs()},
+		identity:        identity.New(defaultSess),
+		appCFN:          cloudformation.New(defaultSess),
+		uploader:        template.New(),
+		runner:          exec.NewCmd(),
+		sessProvider:    sessProvider,
+		unmarshal:       manifest.UnmarshalWorkload,
+		newInterpolator: newManifestInterpolator,
+		newAddonsClient: func(wkld string) (templater, error) {
+			return addon.New(wkld)
+		},
+		newEnvAddons: func() (addonsTemplater, error) {
+			return addon.NewEnv()
+		},
+		newTplGenerator: newWorkloadTemplateGenerator,
+		resources:       make(map[string]*stack.AppRegionalResources),
+	}, nil
+}
+
+// exportIndex lists the stacks of an exported application in the order they must be deployed,
+// and the assets that must be copied to S3 beforehand.
+type exportIndex struct {
+	Application string          `yaml:"application"`
+	Assets      []exportedAsset `yaml:"assets,omitempty"`
+	Stacks      []exportedStack `yaml:"stacks"`
+}
+
+type exportedAsset struct {
+	File   string `yaml:"file"`
+	Bucket string `yaml:"bucket"`
+	Key    string `yaml:"key"`
+}
+
+type exportedStack struct {
+	Name             string   `yaml:"name"`
+	Type             string   `yaml:"type"`
+	Environment      string   `yaml:"environment"`
+	Workload         string   `yaml:"workload,omitempty"`
+	Region           string   `yaml:"region"`
+	AccountID        string   `yaml:"account_id"`
+	ExecutionRoleARN string   `yaml:"execution_role_arn"`
+	Template         string   `yaml:"template"`
+	Parameters       string   `yaml:"parameters"`
+	DependsOn        []string `yaml:"depends_on,omitempty"`
+}
+
+// Validate returns an error if the values provided by flags are invalid.
+func (o *exportAppOpts) Validate() error {
+	if o.appName == "" {
+		// NOTE: This command is required to be executed under a workspace, since workloads are rendered with their local manifest.
+		return errNoAppInWorkspace
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	o.app = app
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *exportAppOpts) Ask() error {
+	return nil
+}
+
+// Execute writes the templates and parameters of every environment and workload of the application,
+// the assets they reference and an index of the stacks to the output directory.
+func (o *exportAppOpts) Execute() error {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	wklds, err := o.localWorkloads()
+	if err != nil {
+		return err
+	}
+	caller, err := o.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+	o.rootUserARN = caller.RootUserARN
+	o.tag = imageTagFromGit(o.runner, o.tag) // Best effort assign git tag.
+	o.index = exportIndex{
+		Application: o.appName,
+	}
+	for _, env := range envs {
+		if err := o.exportEnv(env); err != nil {
+			return err
+		}
+	}
+	for _, wkld := range wklds {
+		for _, env := range envs {
+			if err := o.exportWorkload(wkld, env); err != nil {
+				return err
+			}
+		}
+	}
+	index, err := yaml.Marshal(o.index)
+	if err != nil {
+		return fmt.Errorf("marshal export index: %w", err)
+	}
+	if err := o.writeFile(exportIndexFileName, index); err != nil {
+		return err
+	}
+	log.Successf("Exported %d stacks of application %s to %s.\n", len(o.index.Stacks), color.HighlightUserInput(o.appName), color.HighlightResource(o.outputDir))
+	return nil
+}
+
+// RecommendActions logs the steps to deploy the exported stacks.
+func (o *exportAppOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Copy the files under %s to their S3 bucket and key listed in %s.",
+			color.HighlightResource(filepath.Join(o.outputDir, exportAssetsDirName)), color.HighlightResource(exportIndexFileName)),
+		fmt.Sprintf("Deploy the stacks listed in %s in order with their template, parameters and execution role.",
+			color.HighlightResource(exportIndexFileName)),
+	})
+	return nil
+}
+
+// localWorkloads returns the workloads of the application that have a manifest in the workspace.
+func (o *exportAppOpts) localWorkloads() ([]*config.Workload, error) {
+	wklds, err := o.store.ListWorkloads(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in application %s: %w", o.appName, err)
+	}
+	local, err := o.ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	var exported []*config.Workload
+	for _, wkld := range wklds {
+		if !contains(wkld.Name, local) {
+			log.Warningf("Skip exporting %s since its manifest is not in the workspace.\n", color.HighlightUserInput(wkld.Name))
+			continue
+		}
+		exported = append(exported, wkld)
+	}
+	return exported, nil
+}
+
+func (o *exportAppOpts) exportEnv(env *config.Environment) error {
+	resources, err := o.regionalResources(env.Region)
+	if err != nil {
+		return err
+	}
+	urls, err := o.uploader.UploadEnvironmentCustomResources(func(key string, objects ...s3.NamedBinary) (string, error) {
+		return o.writeZipAsset(resources, key, objects...)
+	})
+	if err != nil {
+		return fmt.Errorf("write custom resources for environment %s: %w", env.Name, err)
+	}
+	addons, err := o.writeEnvAddonsAsset(env, resources)
+	if err != nil {
+		return err
+	}
+	partition, err := partitions.Region(env.Region).Partition()
+	if err != nil {
+		return err
+	}
+	in := &deploy.CreateEnvironmentInput{
+		Version: deploy.LatestEnvTemplateVersion,
+		App: deploy.AppInformation{
+			Name:                o.appName,
+			DNSName:             o.app.Domain,
+			AccountPrincipalARN: o.rootUserARN,
+		},
+		Name:                 env.Name,
+		Prod:                 env.Prod,
+		AdditionalTags:       o.app.Tags,
+		ArtifactBucketARN:    s3.FormatARN(partition.ID(), resources.S3Bucket),
+		ArtifactBucketKeyARN: resources.KMSKeyARN,
+		CustomResourcesURLs:  urls,
+		Telemetry:            env.Telemetry,
+		Addons:               addons,
+	}
+	if env.CustomConfig != nil {
+		in.ImportVPCConfig = env.CustomConfig.ImportVPC
+		in.AdjustVPCConfig = env.CustomConfig.VPCConfig
+	}
+	conf := stack.NewEnvStackConfig(in)
+	tpl, err := conf.Template()
+	if err != nil {
+		return fmt.Errorf("generate template for environment %s: %w", env.Name, err)
+	}
+	params, err := conf.SerializedParameters()
+	if err != nil {
+		return fmt.Errorf("generate template configuration for environment %s: %w", env.Name, err)
+	}
+	return o.addStack(exportedStack{
+		Name:             conf.StackName(),
+		Type:             exportEnvStackType,
+		Environment:      env.Name,
+		Region:           env.Region,
+		AccountID:        env.AccountID,
+		ExecutionRoleARN: env.ExecutionRoleARN,
+		Template:         path.Join(exportEnvsDirName, fmt.Sprintf(fmtExportEnvTemplate, env.Name)),
+		Parameters:       path.Join(exportEnvsDirName, fmt.Sprintf(fmtExportEnvParams, env.Name)),
+	}, tpl, params)
+}
+
+func (o *exportAppOpts) exportWorkload(wkld *config.Workload, env *config.Environment) error {
+	mft, err := workloadManifest(&workloadManifestInput{
+		name:         wkld.Name,
+		appName:      o.appName,
+		envName:      env.Name,
+		interpolator: o.newInterpolator(o.appName, env.Name),
+		ws:           o.ws,
+		unmarshal:    o.unmarshal,
+	})
+	if err != nil {
+		return err
+	}
+	resources, err := o.regionalResources(env.Region)
+	if err != nil {
+		return err
+	}
+	addonsURL, err := o.writeAddonsAsset(wkld.Name, resources)
+	if err != nil {
+		return err
+	}
+	envFileARN, err := o.writeEnvFileAsset(mft, resources)
+	if err != nil {
+		return err
+	}
+	generator, err := o.newTplGenerator(&clideploy.WorkloadDeployerInput{
+		SessionProvider: o.sessProvider,
+		Name:            wkld.Name,
+		App:             o.app,
+		Env:             env,
+		ImageTag:        o.tag,
+		Mft:             mft,
+	})
+	if err != nil {
+		return err
+	}
+	out, err := generator.GenerateCloudFormationTemplate(&clideploy.GenerateCloudFormationTemplateInput{
+		StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
+			RootUserARN: o.rootUserARN,
+			Tags:        o.app.Tags,
+			ImageDigest: aws.String(""),
+			EnvFileARN:  envFileARN,
+			AddonsURL:   addonsURL,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("generate workload %s template against environment %s: %w", wkld.Name, env.Name, err)
+	}
+	return o.addStack(exportedStack{
+		Name:             stack.NameForService(o.appName, env.Name, wkld.Name),
+		Type:             wkld.Type,
+		Environment:      env.Name,
+		Workload:         wkld.Name,
+		Region:           env.Region,
+		AccountID:        env.AccountID,
+		ExecutionRoleARN: env.ExecutionRoleARN,
+		Template:         path.Join(exportWkldsDirName, fmt.Sprintf(deploy.WorkloadCfnTemplateNameFormat, wkld.Name, env.Name)),
+		Parameters:       path.Join(exportWkldsDirName, fmt.Sprintf(deploy.WorkloadCfnTemplateConfigurationNameFormat, wkld.Name, env.Name)),
+		DependsOn:        []string{stack.NameForEnv(o.appName, env.Name)},
+	}, out.Template, out.Parameters)
+}
+
+// writeAddonsAsset writes the addons template of the workload, if any, under the key it's deployed with
+// and returns the URL it will be available at.
+func (o *exportAppOpts) writeAddonsAsset(wkld string, resources *stack.AppRegionalResources) (string, error) {
+	addons, err := o.newAddonsClient(wkld)
+	if err != nil {
+		return "", fmt.Errorf("new addons client: %w", err)
+	}
+	tpl, err := addons.Template()
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieve addons template for %s: %w", wkld, err)
+	}
+	return o.writeAsset(resources, fmt.Sprintf(deploy.AddonsCfnTemplateNameFormat, wkld), []byte(tpl))
+}
+
+// writeEnvAddonsAsset writes the environment addons template of the workspace, if any, next to the environment template
+// and returns the configuration of the addons nested stack.
+// If there are no environment addons in the workspace, the addons the environment is deployed with are kept.
+func (o *exportAppOpts) writeEnvAddonsAsset(env *config.Environment, resources *stack.AppRegionalResources) (*config.EnvAddons, error) {
+	addons, err := o.newEnvAddons()
+	if err != nil {
+		return nil, fmt.Errorf("new environment addons client: %w", err)
+	}
+	tpl, err := addons.Template()
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			return env.Addons, nil
+		}
+		return nil, fmt.Errorf("retrieve environment addons template: %w", err)
+	}
+	params, err := addons.Parameters()
+	if err != nil {
+		return nil, fmt.Errorf("parse environment addons parameters: %w", err)
+	}
+	outputs, err := addon.Outputs(tpl)
+	if err != nil {
+		return nil, fmt.Errorf("get environment addons outputs: %w", err)
+	}
+	url, err := o.writeAsset(resources, fmt.Sprintf(deploy.EnvAddonsCfnTemplateNameFormat, env.Name), []byte(tpl))
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, out := range outputs {
+		names = append(names, out.Name)
+	}
+	return &config.EnvAddons{
+		TemplateURL: url,
+		Parameters:  params,
+		Outputs:     names,
+	}, nil
+}
+
+// writeEnvFileAsset writes the env file of the workload, if any, under the key it's deployed with
+// and returns the ARN it will be available at.
+func (o *exportAppOpts) writeEnvFileAsset(mft interface{}, resources *stack.AppRegionalResources) (string, error) {
+	type envFiler interface {
+		EnvFile() string
+	}
+	mf, ok := mft.(envFiler)
+	if !ok || mf.EnvFile() == "" {
+		return "", nil
+	}
+	wsPath, err := o.ws.Path()
+	if err != nil {
+		return "", fmt.Errorf("get workspace path: %w", err)
+	}
+	content, err := afero.ReadFile(o.fs, filepath.Join(wsPath, mf.EnvFile()))
+	if err != nil {
+		return "", fmt.Errorf("read env file %s: %w", mf.EnvFile(), err)
+	}
+	key := s3.MkdirSHA256(mf.EnvFile(), content)
+	if _, err := o.writeAsset(resources, key, content); err != nil {
+		return "", err
+	}
+	partition, err := partitions.Region(resources.Region).Partition()
+	if err != nil {
+		return "", err
+	}
+	return s3.FormatARN(partition.ID(), fmt.Sprintf("%s/%s", resources.S3Bucket, key)), nil
+}
+
+// writeZipAsset zips the objects into an asset.
+func (o *exportAppOpts) writeZipAsset(resources *stack.AppRegionalResources, key string, objects ...s3.NamedBinary) (string, error) {
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	for _, obj := range objects {
+		f, err := w.Create(obj.Name())
+		if err != nil {
+			return "", fmt.Errorf("create zip file %s: %w", obj.Name(), err)
+		}
+		if _, err := f.Write(obj.Content()); err != nil {
+			return "", fmt.Errorf("write zip file %s: %w", obj.Name(), err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return o.writeAsset(resources, key, buf.Bytes())
+}
+
+// writeAsset writes a file that must be copied to the regional bucket of the application under the key,
+// and returns the URL of the object.
+func (o *exportAppOpts) writeAsset(resources *stack.AppRegionalResources, key string, content []byte) (string, error) {
+	partition, err := partitions.Region(resources.Region).Partition()
+	if err != nil {
+		return "", err
+	}
+	url := fmt.Sprintf(fmtS3ObjectURL, resources.S3Bucket, resources.Region, partition.DNSSuffix(), key)
+	for _, asset := range o.index.Assets {
+		if asset.Bucket == resources.S3Bucket && asset.Key == key {
+			return url, nil
+		}
+	}
+	file := path.Join(exportAssetsDirName, resources.S3Bucket, key)
+	if err := o.writeFile(file, content); err != nil {
+		return "", err
+	}
+	o.index.Assets = append(o.index.Assets, exportedAsset{
+		File:   file,
+		Bucket: resources.S3Bucket,
+		Key:    key,
+	})
+	return url, nil
+}
+
+func (o *exportAppOpts) addStack(s exportedStack, tpl, params string) error {
+	if err := o.writeFile(s.Template, []byte(tpl)); err != nil {
+		return err
+	}
+	if err := o.writeFile(s.Parameters, []byte(params)); err != nil {
+		return err
+	}
+	o.index.Stacks = append(o.index.Stacks, s)
+	return nil
+}
+
+func (o *exportAppOpts) writeFile(name string, content []byte) error {
+	fpath := filepath.Join(o.outputDir, filepath.FromSlash(name))
+	if err := o.fs.MkdirAll(filepath.Dir(fpath), 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", filepath.Dir(fpath), err)
+	}
+	if err := afero.WriteFile(o.fs, fpath, content, 0644); err != nil {
+		return fmt.Errorf("write file %s: %w", fpath, err)
+	}
+	return nil
+}
+
+func (o *exportAppOpts) regionalResources(region string) (*stack.AppRegionalResources, error) {
+	if resources, ok := o.resources[region]; ok {
+		return resources, nil
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(o.app, region)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s resources from region %s: %w", o.appName, region, err)
+	}
+	o.resources[region] = resources
+	return resources, nil
+}
+
+// buildAppExportCmd builds the command for exporting the stacks of an application.
+func buildAppExportCmd() *cobra.Command {
+	vars := exportAppVars{}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the AWS CloudFormation stacks of an application.",
+		Long: `Exports the CloudFormation templates and parameters of every environment and workload of an application
+to a directory, along with the assets they reference and an index of the stacks in deployment order.
+The exported stacks can be deployed with CloudFormation, without Copilot.`,
+		Example: `
+  Export the "phonetool" application to the "infrastructure/" directory.
+  /code $ copilot app export -a phonetool --output-dir ./infrastructure
+  /code $ ls ./infrastructure
+  /code assets  environments  index.yml  workloads`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newExportAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, defaultExportDir, exportOutputDirFlagDescription)
+	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
+	return cmd
+}
@@ -0,0 +1,296 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type exportAppTestBinary struct{}
+
+func (exportAppTestBinary) Name() string    { return "index.js" }
+func (exportAppTestBinary) Content() []byte { return []byte("exports.handler = () => {};") }
+
+type exportAppMocks struct {
+	store     *mocks.Mockstore
+	ws        *mocks.MockwsWlDirReader
+	identity  *mocks.MockidentityService
+	appCFN    *mocks.MockappResourcesGetter
+	uploader  *mocks.MockcustomResourcesUploader
+	runner    *mocks.Mockrunner
+	itpl      *mocks.Mockinterpolator
+	addons    *mocks.Mocktemplater
+	envAddons *mocks.MockaddonsTemplater
+	generator *mocks.MockworkloadTemplateGenerator
+}
+
+func TestExportAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		setupMocks func(m *exportAppMocks)
+		wantedErr  error
+	}{
+		"should error if not in a workspace": {
+			setupMocks: func(m *exportAppMocks) {},
+			wantedErr:  errNoAppInWorkspace,
+		},
+		"should wrap the error if the application can't be retrieved": {
+			inAppName: "phonetool",
+			setupMocks: func(m *exportAppMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application phonetool: some error"),
+		},
+		"should succeed if the application exists": {
+			inAppName: "phonetool",
+			setupMocks: func(m *exportAppMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &exportAppMocks{
+				store: mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &exportAppOpts{
+				exportAppVars: exportAppVars{
+					appName: tc.inAppName,
+				},
+				store: m.store,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExportAppOpts_Execute(t *testing.T) {
+	const backendMft = `name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+  port: 8080
+`
+	testEnv := &config.Environment{
+		App:              "phonetool",
+		Name:             "test",
+		Region:           "us-west-2",
+		AccountID:        "1111",
+		ExecutionRoleARN: "arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole",
+	}
+	testResources := &stack.AppRegionalResources{
+		Region:   "us-west-2",
+		S3Bucket: "phonetool-bucket",
+	}
+	uploadEnvCustomResources := func(upload s3.CompressAndUploadFunc) (map[string]string, error) {
+		urls := make(map[string]string)
+		for _, name := range []string{template.DNSCertValidatorFileName, template.DNSDelegationFileName, template.CustomDomainFileName} {
+			url, err := upload("scripts/"+name+"/hash", exportAppTestBinary{})
+			if err != nil {
+				return nil, err
+			}
+			urls[name] = url
+		}
+		return urls, nil
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *exportAppMocks)
+
+		wantedIndex       exportIndex
+		wantedFiles       []string
+		wantedEnvTemplate string // Substring of the environment template.
+		wantedErr         error
+	}{
+		"should wrap the error if environments can't be listed": {
+			setupMocks: func(m *exportAppMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments in application phonetool: some error"),
+		},
+		"should wrap the error if the workload template can't be generated": {
+			setupMocks: func(m *exportAppMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{{Name: "api", Type: manifest.BackendServiceType}}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::1111:root"}, nil)
+				m.runner.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("not a git repository"))
+				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(testResources, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).DoAndReturn(uploadEnvCustomResources)
+				m.envAddons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(backendMft), nil)
+				m.itpl.EXPECT().Interpolate(backendMft).Return(backendMft, nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
+				m.generator.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("generate workload api template against environment test: some error"),
+		},
+		"should write the stacks, their assets and the index": {
+			setupMocks: func(m *exportAppMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{
+					{Name: "api", Type: manifest.BackendServiceType},
+					{Name: "legacy", Type: manifest.BackendServiceType},
+				}, nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::1111:root"}, nil)
+				m.runner.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("not a git repository"))
+				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(testResources, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).DoAndReturn(uploadEnvCustomResources)
+				m.envAddons.EXPECT().Template().Return("Resources:\n  Table:\n    Type: AWS::DynamoDB::Table\nOutputs:\n  TableName:\n    Value: !Ref Table\n", nil)
+				m.envAddons.EXPECT().Parameters().Return("", nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(backendMft), nil)
+				m.itpl.EXPECT().Interpolate(backendMft).Return(backendMft, nil)
+				m.addons.EXPECT().Template().Return("Resources: {}", nil)
+				m.generator.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).DoAndReturn(func(in *clideploy.GenerateCloudFormationTemplateInput) (*clideploy.GenerateCloudFormationTemplateOutput, error) {
+					require.Equal(t, "https://phonetool-bucket.s3.us-west-2.amazonaws.com/api.addons.stack.yml", in.AddonsURL)
+					require.Equal(t, "arn:aws:iam::1111:root", in.RootUserARN)
+					return &clideploy.GenerateCloudFormationTemplateOutput{
+						Template:   "api template",
+						Parameters: "api params",
+					}, nil
+				})
+			},
+			wantedIndex: exportIndex{
+				Application: "phonetool",
+				Stacks: []exportedStack{
+					{
+						Name:             "phonetool-test",
+						Type:             "Environment",
+						Environment:      "test",
+						Region:           "us-west-2",
+						AccountID:        "1111",
+						ExecutionRoleARN: "arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole",
+						Template:         "environments/test.stack.yml",
+						Parameters:       "environments/test.params.json",
+					},
+					{
+						Name:             "phonetool-test-api",
+						Type:             manifest.BackendServiceType,
+						Environment:      "test",
+						Workload:         "api",
+						Region:           "us-west-2",
+						AccountID:        "1111",
+						ExecutionRoleARN: "arn:aws:iam::1111:role/phonetool-test-CFNExecutionRole",
+						Template:         "workloads/api-test.stack.yml",
+						Parameters:       "workloads/api-test.params.json",
+						DependsOn:        []string{"phonetool-test"},
+					},
+				},
+			},
+			wantedEnvTemplate: "https://phonetool-bucket.s3.us-west-2.amazonaws.com/environments/test.addons.stack.yml",
+			wantedFiles: []string{
+				"export/environments/test.stack.yml",
+				"export/environments/test.params.json",
+				"export/workloads/api-test.stack.yml",
+				"export/workloads/api-test.params.json",
+				"export/assets/phonetool-bucket/api.addons.stack.yml",
+				"export/assets/phonetool-bucket/environments/test.addons.stack.yml",
+				"export/assets/phonetool-bucket/scripts/dns-cert-validator/hash",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &exportAppMocks{
+				store:     mocks.NewMockstore(ctrl),
+				ws:        mocks.NewMockwsWlDirReader(ctrl),
+				identity:  mocks.NewMockidentityService(ctrl),
+				appCFN:    mocks.NewMockappResourcesGetter(ctrl),
+				uploader:  mocks.NewMockcustomResourcesUploader(ctrl),
+				runner:    mocks.NewMockrunner(ctrl),
+				itpl:      mocks.NewMockinterpolator(ctrl),
+				addons:    mocks.NewMocktemplater(ctrl),
+				envAddons: mocks.NewMockaddonsTemplater(ctrl),
+				generator: mocks.NewMockworkloadTemplateGenerator(ctrl),
+			}
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			opts := &exportAppOpts{
+				exportAppVars: exportAppVars{
+					appName:   "phonetool",
+					outputDir: "export",
+				},
+				store:     m.store,
+				ws:        m.ws,
+				fs:        fs,
+				identity:  m.identity,
+				appCFN:    m.appCFN,
+				uploader:  m.uploader,
+				runner:    m.runner,
+				unmarshal: manifest.UnmarshalWorkload,
+				newInterpolator: func(app, env string) interpolator {
+					return m.itpl
+				},
+				newAddonsClient: func(wkld string) (templater, error) {
+					return m.addons, nil
+				},
+				newEnvAddons: func() (addonsTemplater, error) {
+					return m.envAddons, nil
+				},
+				newTplGenerator: func(in *clideploy.WorkloadDeployerInput) (workloadTemplateGenerator, error) {
+					return m.generator, nil
+				},
+				app:       &config.Application{Name: "phonetool"},
+				resources: make(map[string]*stack.AppRegionalResources),
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			raw, err := afero.ReadFile(fs, "export/index.yml")
+			require.NoError(t, err)
+			var index exportIndex
+			require.NoError(t, yaml.Unmarshal(raw, &index))
+			require.Equal(t, tc.wantedIndex.Stacks, index.Stacks)
+			for _, file := range tc.wantedFiles {
+				exists, err := afero.Exists(fs, file)
+				require.NoError(t, err)
+				require.True(t, exists, "file %s should exist", file)
+			}
+			for _, asset := range index.Assets {
+				exists, err := afero.Exists(fs, "export/"+asset.File)
+				require.NoError(t, err)
+				require.True(t, exists, "asset %s should exist", asset.File)
+				require.Equal(t, "phonetool-bucket", asset.Bucket)
+			}
+			require.Len(t, index.Assets, 5) // Three environment custom resources and the addons templates.
+			envTpl, err := afero.ReadFile(fs, "export/environments/test.stack.yml")
+			require.NoError(t, err)
+			require.Contains(t, string(envTpl), tc.wantedEnvTemplate)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -40,6 +41,7 @@ type initAppVars struct {
 	name         string
 	domainName   string
 	resourceTags map[string]string
+	outputFormat string
 }
 
 type initAppOpts struct {
@@ -216,6 +218,16 @@ func (o *initAppOpts) Execute() error {
 	}
 	log.Successf("The directory %s will hold service manifests for application %s.\n", color.HighlightResource(workspace.CopilotDirName), color.HighlightUserInput(o.name))
 	log.Infoln()
+	values := map[string]string{
+		"accountId": caller.Account,
+	}
+	if o.domainName != "" {
+		values["domain"] = o.domainName
+	}
+	event.Publish(event.Output{
+		Application: o.name,
+		Values:      values,
+	})
 	return nil
 }
 
@@ -322,17 +334,20 @@ An application is a collection of containerized services that operate together.`
   /code $ copilot app init --resource-tags department=MyDept,team=MyTeam`,
 		Args: reservedArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newInitAppOpts(vars)
-			if err != nil {
-				return err
-			}
-			if len(args) == 1 {
-				opts.name = args[0]
-			}
-			return run(opts)
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newInitAppOpts(vars)
+				if err != nil {
+					return err
+				}
+				if len(args) == 1 {
+					opts.name = args[0]
+				}
+				return run(opts)
+			})
 		}),
 	}
 	cmd.Flags().StringVar(&vars.domainName, domainNameFlag, "", domainNameFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
 	return cmd
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/dustin/go-humanize/english"
 
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -118,6 +119,27 @@ func run(cmd cmd) error {
 	return nil
 }
 
+// outputFormatJSON is the only supported value of the --output flag.
+const outputFormatJSON = "json"
+
+// runWithEvents runs f and, if format is set, writes the events published while running it to stdout.
+// If f fails, the error is published as the last event.
+func runWithEvents(format string, f func() error) error {
+	if format == "" {
+		return f()
+	}
+	if format != outputFormatJSON {
+		return fmt.Errorf("invalid --%s value %q: must be %q", outputFormatFlag, format, outputFormatJSON)
+	}
+	event.Enable(log.OutputWriter)
+	defer event.Disable()
+	err := f()
+	if err != nil {
+		event.Publish(event.NewError(err))
+	}
+	return err
+}
+
 func logRecommendedActions(actions []string) {
 	if len(actions) == 0 {
 		return
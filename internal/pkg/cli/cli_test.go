@@ -0,0 +1,70 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunWithEvents(t *testing.T) {
+	testCases := map[string]struct {
+		inFormat string
+		inErr    error
+
+		wantedErr    error
+		wantedEvents []string
+	}{
+		"should not write events if the format is not set": {
+			inErr:     errors.New("some error"),
+			wantedErr: errors.New("some error"),
+		},
+		"should error on unsupported formats": {
+			inFormat:  "yaml",
+			wantedErr: errors.New(`invalid --output value "yaml": must be "json"`),
+		},
+		"should write the events published while running": {
+			inFormat:     "json",
+			wantedEvents: []string{`"type":"output"`},
+		},
+		"should publish the error if running fails": {
+			inFormat:     "json",
+			inErr:        errors.New("some error"),
+			wantedErr:    errors.New("some error"),
+			wantedEvents: []string{`"type":"output"`, `"type":"error","timestamp":`, `"data":{"message":"some error"}`},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := new(strings.Builder)
+			w := log.OutputWriter
+			log.OutputWriter = buf
+			defer func() { log.OutputWriter = w }()
+
+			err := runWithEvents(tc.inFormat, func() error {
+				event.Publish(event.Output{Application: "phonetool"})
+				return tc.inErr
+			})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.False(t, event.Enabled())
+			if len(tc.wantedEvents) == 0 {
+				require.Empty(t, buf.String())
+			}
+			for _, e := range tc.wantedEvents {
+				require.Contains(t, buf.String(), e)
+			}
+		})
+	}
+}
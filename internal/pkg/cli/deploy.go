@@ -169,14 +169,13 @@ func BuildDeployCmd() *cobra.Command {
   Deploys a job named "mailer" with additional resource tags to a "prod" environment.
   /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeployOpts(vars)
-			if err != nil {
-				return err
-			}
-			if err := opts.Run(); err != nil {
-				return err
-			}
-			return nil
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newDeployOpts(vars)
+				if err != nil {
+					return err
+				}
+				return opts.Run()
+			})
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
@@ -185,6 +184,7 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -12,6 +12,8 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -98,6 +100,10 @@ type publicCIDRBlocksGetter interface {
 	PublicCIDRBlocks() ([]string, error)
 }
 
+type listenerRulesGetter interface {
+	ListenerRules() ([]*describe.ListenerRule, error)
+}
+
 type customResourcesUploader interface {
 	UploadEnvironmentCustomResources(upload s3.CompressAndUploadFunc) (map[string]string, error)
 	UploadRequestDrivenWebServiceCustomResources(upload s3.CompressAndUploadFunc) (map[string]string, error)
@@ -108,8 +114,17 @@ type snsTopicsLister interface {
 	ListSNSTopics(appName string, envName string) ([]deploy.Topic, error)
 }
 
+type deployedJobsLister interface {
+	ListDeployedJobs(appName string, envName string) ([]string, error)
+}
+
+type jobRetriesGetter interface {
+	JobRetries(app, env, job string) (int, error)
+}
+
 type serviceDeployer interface {
 	DeployService(out progress.FileWriter, conf cloudformation.StackConfiguration, bucketName string, opts ...awscloudformation.StackOption) error
+	PreviewService(conf cloudformation.StackConfiguration, bucketName string, opts ...awscloudformation.StackOption) (*cloudformation.StackPreview, error)
 }
 
 type serviceForceUpdater interface {
@@ -254,6 +269,7 @@ type lbSvcDeployer struct {
 	*svcDeployer
 	appVersionGetter       versionGetter
 	publicCIDRBlocksGetter publicCIDRBlocksGetter
+	listenerRulesGetter    listenerRulesGetter
 	lbMft                  *manifest.LoadBalancedWebService
 }
 
@@ -288,6 +304,7 @@ func NewLBDeployer(in *WorkloadDeployerInput) (*lbSvcDeployer, error) {
 		svcDeployer:            svcDeployer,
 		appVersionGetter:       versionGetter,
 		publicCIDRBlocksGetter: envDescriber,
+		listenerRulesGetter:    envDescriber,
 		lbMft:                  lbMft,
 	}, nil
 }
@@ -315,7 +332,10 @@ func NewBackendDeployer(in *WorkloadDeployerInput) (*backendSvcDeployer, error)
 
 type jobDeployer struct {
 	*workloadDeployer
-	jobMft *manifest.ScheduledJob
+	topicLister   snsTopicsLister
+	jobLister     deployedJobsLister
+	retriesGetter jobRetriesGetter
+	jobMft        *manifest.ScheduledJob
 }
 
 // NewJobDeployer is the constructor for jobDeployer.
@@ -324,12 +344,19 @@ func NewJobDeployer(in *WorkloadDeployerInput) (*jobDeployer, error) {
 	if err != nil {
 		return nil, err
 	}
+	deployStore, err := deploy.NewStore(in.SessionProvider, wkldDeployer.store)
+	if err != nil {
+		return nil, fmt.Errorf("new deploy store: %w", err)
+	}
 	jobMft, ok := in.Mft.(*manifest.ScheduledJob)
 	if !ok {
 		return nil, fmt.Errorf("manifest is not of type %s", manifest.ScheduledJobType)
 	}
 	return &jobDeployer{
 		workloadDeployer: wkldDeployer,
+		topicLister:      deployStore,
+		jobLister:        deployStore,
+		retriesGetter:    ecs.New(wkldDeployer.envSess),
 		jobMft:           jobMft,
 	}, nil
 }
@@ -850,6 +877,9 @@ func (d *lbSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*svcS
 	if err := validateLBWSRuntime(d.app, d.env.Name, d.lbMft, d.appVersionGetter); err != nil {
 		return nil, err
 	}
+	if err := d.validateRulePriorities(); err != nil {
+		return nil, err
+	}
 	var opts []stack.LoadBalancedWebServiceOption
 	if !d.lbMft.NLBConfig.IsEmpty() {
 		cidrBlocks, err := d.publicCIDRBlocksGetter.PublicCIDRBlocks()
@@ -880,6 +910,39 @@ func (d *lbSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*svcS
 	}, nil
 }
 
+// validateRulePriorities returns an error if a priority set in the manifest is already used on the listeners
+// of the environment by a rule that doesn't belong to the service.
+func (d *lbSvcDeployer) validateRulePriorities() error {
+	fields := make(map[string]string) // Manifest fields keyed by the priority they set.
+	if priority := d.lbMft.RoutingRule.Priority; priority != nil {
+		fields[strconv.Itoa(aws.IntValue(priority))] = `"http.priority"`
+	}
+	for i, rule := range d.lbMft.RoutingRule.AdditionalRules {
+		if rule.Priority != nil {
+			fields[strconv.Itoa(aws.IntValue(rule.Priority))] = fmt.Sprintf(`"http.additional_rules[%d].priority"`, i)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	rules, err := d.listenerRulesGetter.ListenerRules()
+	if err != nil {
+		return fmt.Errorf("get listener rules of environment %s: %w", d.env.Name, err)
+	}
+	for _, rule := range rules {
+		field, ok := fields[rule.Priority]
+		if !ok || rule.Service == d.name {
+			continue
+		}
+		owner := "a rule that doesn't belong to a service"
+		if rule.Service != "" {
+			owner = fmt.Sprintf("a rule of service %s", rule.Service)
+		}
+		return fmt.Errorf("priority %s of %s is already used by %s on the %s listener of environment %s", rule.Priority, field, owner, rule.Listener, d.env.Name)
+	}
+	return nil
+}
+
 func (d *backendSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*svcStackConfigurationOutput, error) {
 	rc, err := d.runtimeConfig(in)
 	if err != nil {
@@ -1006,6 +1069,25 @@ func (d *jobDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*jobSta
 	if err != nil {
 		return nil, err
 	}
+	if subs := d.jobMft.Subscriptions(); len(subs) > 0 {
+		topics, err := d.topicLister.ListSNSTopics(d.app.Name, d.env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("get SNS topics for app %s and environment %s: %w", d.app.Name, d.env.Name, err)
+		}
+		var topicARNs []string
+		for _, topic := range topics {
+			topicARNs = append(topicARNs, topic.ARN())
+		}
+		if err := validateTopicsExist(subs, topicARNs, d.app.Name, d.env.Name); err != nil {
+			return nil, err
+		}
+	}
+	if err := d.validateWorkflowJobsDeployed(); err != nil {
+		return nil, err
+	}
+	if err := d.validateWorkflowRetries(); err != nil {
+		return nil, err
+	}
 	conf, err := stack.NewScheduledJob(d.jobMft, d.env.Name, d.app.Name, *rc)
 	if err != nil {
 		return nil, fmt.Errorf("create stack configuration: %w", err)
@@ -1015,6 +1097,52 @@ func (d *jobDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*jobSta
 	}, nil
 }
 
+// validateWorkflowJobsDeployed returns an error if a step of the job's workflow is another job that isn't deployed in the environment.
+func (d *jobDeployer) validateWorkflowJobsDeployed() error {
+	var others []string
+	for step := range d.jobMft.Workflow {
+		if step != d.name {
+			others = append(others, step)
+		}
+	}
+	if len(others) == 0 {
+		return nil
+	}
+	deployed, err := d.jobLister.ListDeployedJobs(d.app.Name, d.env.Name)
+	if err != nil {
+		return fmt.Errorf("list deployed jobs for app %s and environment %s: %w", d.app.Name, d.env.Name, err)
+	}
+	sort.Strings(others)
+	for _, step := range others {
+		if !contains(step, deployed) {
+			return fmt.Errorf("job %s in the workflow is not deployed in environment %s", step, d.env.Name)
+		}
+	}
+	return nil
+}
+
+// validateWorkflowRetries returns an error if a step of the job's workflow sets "retries" for another job that retries itself,
+// since each retry of the step would run all the retries of the job.
+func (d *jobDeployer) validateWorkflowRetries() error {
+	var steps []string
+	for step, config := range d.jobMft.Workflow {
+		if step != d.name && config != nil && config.Retries != nil {
+			steps = append(steps, step)
+		}
+	}
+	sort.Strings(steps)
+	for _, step := range steps {
+		retries, err := d.retriesGetter.JobRetries(d.app.Name, d.env.Name, step)
+		if err != nil {
+			return fmt.Errorf("get retries of job %s: %w", step, err)
+		}
+		if retries > 0 {
+			return fmt.Errorf(`step %s of the workflow cannot set "retries" because job %s already retries %d times`, step, step, retries)
+		}
+	}
+	return nil
+}
+
 func buildArgs(name, imageTag, workspacePath string, unmarshaledManifest interface{}) (*dockerengine.BuildArguments, error) {
 	type dfArgs interface {
 		BuildArgs(rootDirectory string) *manifest.DockerBuildArgs
@@ -21,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -34,7 +35,10 @@ type deployMocks struct {
 	mockEndpointGetter         *mocks.MockendpointGetter
 	mockSpinner                *mocks.Mockspinner
 	mockPublicCIDRBlocksGetter *mocks.MockpublicCIDRBlocksGetter
+	mockListenerRulesGetter    *mocks.MocklistenerRulesGetter
 	mockSNSTopicsLister        *mocks.MocksnsTopicsLister
+	mockDeployedJobsLister     *mocks.MockdeployedJobsLister
+	mockJobRetriesGetter       *mocks.MockjobRetriesGetter
 	mockServiceDeployer        *mocks.MockserviceDeployer
 	mockServiceForceUpdater    *mocks.MockserviceForceUpdater
 	mockTemplater              *mocks.Mocktemplater
@@ -269,6 +273,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 	mockAfterTime := time.Unix(1494505756, 0)
 	tests := map[string]struct {
 		inAliases         manifest.Alias
+		inPriority        *int
+		inRules           []manifest.AdditionalRoutingRule
 		inNLB             manifest.NetworkLoadBalancerConfiguration
 		inApp             *config.Application
 		inEnvironment     *config.Environment
@@ -302,6 +308,46 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("get public CIDR blocks information from the VPC of environment mockEnv: some error"),
 		},
+		"fail to get listener rules": {
+			inPriority: aws.Int(10),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockListenerRulesGetter.EXPECT().ListenerRules().Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("get listener rules of environment mockEnv: some error"),
+		},
+		"priority used by a rule of another service": {
+			inPriority: aws.Int(10),
+			inRules: []manifest.AdditionalRoutingRule{
+				{
+					Priority: aws.Int(20),
+					Paths:    []string{"/beta"},
+				},
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockListenerRulesGetter.EXPECT().ListenerRules().Return([]*describe.ListenerRule{
+					{Listener: "HTTP", Priority: "10", Service: mockName},
+					{Listener: "HTTP", Priority: "20", Service: "api"},
+					{Listener: "HTTP", Priority: "default"},
+				}, nil)
+			},
+			wantErr: errors.New(`priority 20 of "http.additional_rules[0].priority" is already used by a rule of service api on the HTTP listener of environment mockEnv`),
+		},
 		"alias used while app is not associated with a domain": {
 			inAliases: manifest.Alias{String: aws.String("mockAlias")},
 			inEnvironment: &config.Environment{
@@ -566,6 +612,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				mockServiceForceUpdater:    mocks.NewMockserviceForceUpdater(ctrl),
 				mockSpinner:                mocks.NewMockspinner(ctrl),
 				mockPublicCIDRBlocksGetter: mocks.NewMockpublicCIDRBlocksGetter(ctrl),
+				mockListenerRulesGetter:    mocks.NewMocklistenerRulesGetter(ctrl),
 			}
 			tc.mock(m)
 
@@ -589,6 +636,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				},
 				appVersionGetter:       m.mockVersionGetter,
 				publicCIDRBlocksGetter: m.mockPublicCIDRBlocksGetter,
+				listenerRulesGetter:    m.mockListenerRulesGetter,
 				lbMft: &manifest.LoadBalancedWebService{
 					Workload: manifest.Workload{
 						Name: aws.String(mockName),
@@ -604,8 +652,10 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 						},
 						RoutingRule: manifest.RoutingRuleConfigOrBool{
 							RoutingRuleConfiguration: manifest.RoutingRuleConfiguration{
-								Path:  aws.String("/"),
-								Alias: tc.inAliases,
+								Path:            aws.String("/"),
+								Alias:           tc.inAliases,
+								Priority:        tc.inPriority,
+								AdditionalRules: tc.inRules,
 							},
 						},
 						NLBConfig: tc.inNLB,
@@ -953,6 +1003,186 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 	}
 }
 
+func TestJobDeployer_stackConfiguration(t *testing.T) {
+	mockError := errors.New("some error")
+	topic, _ := deploy.NewTopic("arn:aws:sns:us-west-2:0123456789012:mockApp-mockEnv-mockwkld-givesdogs", "mockApp", "mockEnv", "mockwkld")
+	const (
+		mockAppName = "mockApp"
+		mockEnvName = "mockEnv"
+		mockName    = "mockjob"
+	)
+	tests := map[string]struct {
+		inTopic    manifest.JobSNSTrigger
+		inWorkflow manifest.JobWorkflow
+
+		mock func(m *deployMocks)
+
+		wantErr error
+	}{
+		"skip listing topics if the job isn't triggered by a topic": {
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+		},
+		"fail to get deployed topics": {
+			inTopic: manifest.JobSNSTrigger{
+				Name:    aws.String("givesdogs"),
+				Service: aws.String("mockwkld"),
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockSNSTopicsLister.EXPECT().ListSNSTopics(mockAppName, mockEnvName).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("get SNS topics for app mockApp and environment mockEnv: %w", mockError),
+		},
+		"fail if the topic isn't deployed": {
+			inTopic: manifest.JobSNSTrigger{
+				Name:    aws.String("givescats"),
+				Service: aws.String("mockwkld"),
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockSNSTopicsLister.EXPECT().ListSNSTopics(mockAppName, mockEnvName).Return([]deploy.Topic{*topic}, nil)
+			},
+			wantErr: errors.New("SNS topic mockApp-mockEnv-mockwkld-givescats does not exist in environment mockEnv"),
+		},
+		"skip listing jobs if the workflow only runs the job itself": {
+			inWorkflow: manifest.JobWorkflow{
+				mockName: nil,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+		},
+		"fail to list deployed jobs": {
+			inWorkflow: manifest.JobWorkflow{
+				"extract": nil,
+				mockName:  {DependsOn: []string{"extract"}},
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockDeployedJobsLister.EXPECT().ListDeployedJobs(mockAppName, mockEnvName).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("list deployed jobs for app mockApp and environment mockEnv: %w", mockError),
+		},
+		"fail if a job of the workflow isn't deployed": {
+			inWorkflow: manifest.JobWorkflow{
+				"extract":   nil,
+				"transform": {DependsOn: []string{"extract"}},
+				mockName:    {DependsOn: []string{"transform"}},
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockDeployedJobsLister.EXPECT().ListDeployedJobs(mockAppName, mockEnvName).Return([]string{"extract", mockName}, nil)
+			},
+			wantErr: errors.New("job transform in the workflow is not deployed in environment mockEnv"),
+		},
+		"fail to get the retries of a job of the workflow": {
+			inWorkflow: manifest.JobWorkflow{
+				"extract": {
+					JobFailureHandlerConfig: manifest.JobFailureHandlerConfig{
+						Retries: aws.Int(2),
+					},
+				},
+				mockName: {DependsOn: []string{"extract"}},
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockDeployedJobsLister.EXPECT().ListDeployedJobs(mockAppName, mockEnvName).Return([]string{"extract"}, nil)
+				m.mockJobRetriesGetter.EXPECT().JobRetries(mockAppName, mockEnvName, "extract").Return(0, mockError)
+			},
+			wantErr: fmt.Errorf("get retries of job extract: %w", mockError),
+		},
+		"fail if a step sets retries for a job that retries itself": {
+			inWorkflow: manifest.JobWorkflow{
+				"extract": {
+					JobFailureHandlerConfig: manifest.JobFailureHandlerConfig{
+						Retries: aws.Int(2),
+					},
+				},
+				mockName: {DependsOn: []string{"extract"}},
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockDeployedJobsLister.EXPECT().ListDeployedJobs(mockAppName, mockEnvName).Return([]string{"extract"}, nil)
+				m.mockJobRetriesGetter.EXPECT().JobRetries(mockAppName, mockEnvName, "extract").Return(3, nil)
+			},
+			wantErr: errors.New(`step extract of the workflow cannot set "retries" because job extract already retries 3 times`),
+		},
+		"success": {
+			inTopic: manifest.JobSNSTrigger{
+				Name:    aws.String("givesdogs"),
+				Service: aws.String("mockwkld"),
+			},
+			inWorkflow: manifest.JobWorkflow{
+				"extract": {
+					JobFailureHandlerConfig: manifest.JobFailureHandlerConfig{
+						Retries: aws.Int(2),
+					},
+				},
+				mockName: {DependsOn: []string{"extract"}},
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockSNSTopicsLister.EXPECT().ListSNSTopics(mockAppName, mockEnvName).Return([]deploy.Topic{*topic}, nil)
+				m.mockDeployedJobsLister.EXPECT().ListDeployedJobs(mockAppName, mockEnvName).Return([]string{"extract"}, nil)
+				m.mockJobRetriesGetter.EXPECT().JobRetries(mockAppName, mockEnvName, "extract").Return(0, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &deployMocks{
+				mockEndpointGetter:     mocks.NewMockendpointGetter(ctrl),
+				mockSNSTopicsLister:    mocks.NewMocksnsTopicsLister(ctrl),
+				mockDeployedJobsLister: mocks.NewMockdeployedJobsLister(ctrl),
+				mockJobRetriesGetter:   mocks.NewMockjobRetriesGetter(ctrl),
+			}
+			tc.mock(m)
+
+			deployer := jobDeployer{
+				workloadDeployer: &workloadDeployer{
+					name: mockName,
+					app: &config.Application{
+						Name: mockAppName,
+					},
+					env: &config.Environment{
+						Name:   mockEnvName,
+						Region: "us-west-2",
+					},
+					resources:      &stack.AppRegionalResources{},
+					endpointGetter: m.mockEndpointGetter,
+				},
+				topicLister:   m.mockSNSTopicsLister,
+				jobLister:     m.mockDeployedJobsLister,
+				retriesGetter: m.mockJobRetriesGetter,
+				jobMft: &manifest.ScheduledJob{
+					Workload: manifest.Workload{
+						Name: aws.String(mockName),
+					},
+					ScheduledJobConfig: manifest.ScheduledJobConfig{
+						On: manifest.JobTriggerConfig{
+							SNS: tc.inTopic,
+						},
+						Workflow: tc.inWorkflow,
+					},
+				},
+			}
+
+			_, gotErr := deployer.stackConfiguration(&StackRuntimeConfiguration{})
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func Test_validateTopicsExist(t *testing.T) {
 	mockApp := "app"
 	mockEnv := "env"
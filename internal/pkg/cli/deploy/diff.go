@@ -0,0 +1,228 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"gopkg.in/yaml.v3"
+)
+
+// Display settings for the table of resource changes.
+const (
+	minCellWidth           = 10
+	tabWidth               = 4
+	cellPaddingWidth       = 2
+	paddingChar            = ' '
+	noAdditionalFormatting = 0
+)
+
+// Top level sections of a CloudFormation template that are compared in a diff.
+var templateDiffSections = []string{"Parameters", "Resources", "Outputs"}
+
+// DeployDiff returns a human-readable diff between the deployed load balanced web service stack
+// and the stack that would be deployed with the runtime configuration.
+func (d *lbSvcDeployer) DeployDiff(in *StackRuntimeConfiguration) (string, error) {
+	output, err := d.stackConfiguration(in)
+	if err != nil {
+		return "", err
+	}
+	return d.deployDiff(in, output.conf)
+}
+
+// DeployDiff returns a human-readable diff between the deployed backend service stack
+// and the stack that would be deployed with the runtime configuration.
+func (d *backendSvcDeployer) DeployDiff(in *StackRuntimeConfiguration) (string, error) {
+	output, err := d.stackConfiguration(in)
+	if err != nil {
+		return "", err
+	}
+	return d.deployDiff(in, output.conf)
+}
+
+// DeployDiff returns a human-readable diff between the deployed request-driven web service stack
+// and the stack that would be deployed with the runtime configuration.
+func (d *rdwsDeployer) DeployDiff(in *StackRuntimeConfiguration) (string, error) {
+	output, err := d.stackConfiguration(in)
+	if err != nil {
+		return "", err
+	}
+	return d.deployDiff(in, output.conf)
+}
+
+// DeployDiff returns a human-readable diff between the deployed worker service stack
+// and the stack that would be deployed with the runtime configuration.
+func (d *workerSvcDeployer) DeployDiff(in *StackRuntimeConfiguration) (string, error) {
+	output, err := d.stackConfiguration(in)
+	if err != nil {
+		return "", err
+	}
+	return d.deployDiff(in, output.conf)
+}
+
+func (d *workloadDeployer) deployDiff(in *StackRuntimeConfiguration, conf cloudformation.StackConfiguration) (string, error) {
+	unknownParams, err := d.unknownParameters(in)
+	if err != nil {
+		return "", err
+	}
+	preview, err := d.deployer.PreviewService(conf, d.resources.S3Bucket,
+		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
+		awscloudformation.WithPreviousParameterValues(unknownParams...))
+	if err != nil {
+		return "", fmt.Errorf("preview service deployment: %w", err)
+	}
+	return formatStackPreview(preview)
+}
+
+// unknownParameters returns the keys of the stack parameters whose values depend on artifacts
+// that were not uploaded, so that the values of the last deployment are used instead.
+func (d *workloadDeployer) unknownParameters(in *StackRuntimeConfiguration) ([]string, error) {
+	var keys []string
+	if in.ImageDigest == nil {
+		required, err := manifest.DockerfileBuildRequired(d.mft)
+		if err != nil {
+			return nil, err
+		}
+		if required {
+			keys = append(keys, stack.WorkloadContainerImageParamKey)
+		}
+	}
+	if in.EnvFileARN == "" && envFile(d.mft) != "" {
+		keys = append(keys, stack.WorkloadEnvFileARNParamKey)
+	}
+	if in.AddonsURL == "" {
+		_, err := d.templater.Template()
+		var notFoundErr *addon.ErrAddonsNotFound
+		switch {
+		case err == nil:
+			keys = append(keys, stack.WorkloadAddonsTemplateURLParamKey)
+		case !errors.As(err, &notFoundErr):
+			return nil, fmt.Errorf("retrieve addons template: %w", err)
+		}
+	}
+	return keys, nil
+}
+
+func formatStackPreview(preview *cloudformation.StackPreview) (string, error) {
+	var b bytes.Buffer
+	tplDiff, err := diff.Parse([]byte(preview.DeployedTemplate), []byte(preview.Template), diff.WithTopLevelKeys(templateDiffSections...))
+	if err != nil {
+		return "", fmt.Errorf("compare templates: %w", err)
+	}
+	b.WriteString(color.Bold.Sprint("Template\n\n"))
+	if err := writeTree(&b, tplDiff, "No changes to the template.\n"); err != nil {
+		return "", err
+	}
+
+	paramsDiff, err := parametersDiff(preview.DeployedParameters, preview.Parameters)
+	if err != nil {
+		return "", err
+	}
+	b.WriteString(color.Bold.Sprint("\nParameters\n\n"))
+	if err := writeTree(&b, paramsDiff, "No changes to the parameter values.\n"); err != nil {
+		return "", err
+	}
+
+	b.WriteString(color.Bold.Sprint("\nResource changes\n\n"))
+	switch {
+	case preview.DeployedTemplate == "":
+		b.WriteString("  The stack does not exist yet, all resources will be created.\n")
+	case len(preview.Changes) == 0:
+		b.WriteString("  No resources will be changed.\n")
+	default:
+		writeResourceChanges(&b, preview.Changes)
+	}
+	return b.String(), nil
+}
+
+func parametersDiff(deployed, proposed map[string]string) (diff.Tree, error) {
+	old, err := yaml.Marshal(deployed)
+	if err != nil {
+		return diff.Tree{}, fmt.Errorf("marshal deployed parameters: %w", err)
+	}
+	if len(deployed) == 0 {
+		old = nil
+	}
+	new, err := yaml.Marshal(proposed)
+	if err != nil {
+		return diff.Tree{}, fmt.Errorf("marshal parameters: %w", err)
+	}
+	tree, err := diff.Parse(old, new)
+	if err != nil {
+		return diff.Tree{}, fmt.Errorf("compare parameters: %w", err)
+	}
+	return tree, nil
+}
+
+func writeTree(b *bytes.Buffer, tree diff.Tree, emptyMsg string) error {
+	if tree.Empty() {
+		b.WriteString("  " + emptyMsg)
+		return nil
+	}
+	var out bytes.Buffer
+	if err := tree.Write(&out); err != nil {
+		return err
+	}
+	for _, line := range bytes.SplitAfter(out.Bytes(), []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		b.WriteString("  ")
+		b.WriteString(colorizeDiffLine(string(line)))
+	}
+	return nil
+}
+
+func colorizeDiffLine(line string) string {
+	switch line[0] {
+	case '+':
+		return color.Green.Sprint(line)
+	case '-':
+		return color.Red.Sprint(line)
+	case '~':
+		return color.Yellow.Sprint(line)
+	default:
+		return line
+	}
+}
+
+func writeResourceChanges(b *bytes.Buffer, changes []*sdkcloudformation.Change) {
+	var rows [][]string
+	for _, change := range changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		replacement := "-"
+		if aws.StringValue(rc.Action) == sdkcloudformation.ChangeActionModify {
+			replacement = aws.StringValue(rc.Replacement)
+		}
+		rows = append(rows, []string{aws.StringValue(rc.Action), aws.StringValue(rc.LogicalResourceId), aws.StringValue(rc.ResourceType), replacement})
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i][1] < rows[j][1]
+	})
+	writer := tabwriter.NewWriter(b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", "Action", "Logical ID", "Type", "Replacement")
+	for _, row := range rows {
+		if row[3] == sdkcloudformation.ReplacementTrue {
+			row[3] = color.Red.Sprint(row[3])
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", row[0], row[1], row[2], row[3])
+	}
+	writer.Flush()
+}
@@ -0,0 +1,208 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadDeployer_unknownParameters(t *testing.T) {
+	testCases := map[string]struct {
+		inMft       *mockWorkloadMft
+		inRuntime   *StackRuntimeConfiguration
+		setupMocks  func(m *mocks.Mocktemplater)
+		wanted      []string
+		wantedError error
+	}{
+		"reuse the deployed values of artifacts that were not uploaded": {
+			inMft: &mockWorkloadMft{
+				fileName:      "foo.env",
+				buildRequired: true,
+			},
+			inRuntime: &StackRuntimeConfiguration{},
+			setupMocks: func(m *mocks.Mocktemplater) {
+				m.EXPECT().Template().Return("addons", nil)
+			},
+			wanted: []string{"ContainerImage", "EnvFileARN", "AddonsTemplateURL"},
+		},
+		"no parameters are unknown if the artifacts were uploaded": {
+			inMft: &mockWorkloadMft{
+				fileName:      "foo.env",
+				buildRequired: true,
+			},
+			inRuntime: &StackRuntimeConfiguration{
+				ImageDigest: aws.String("sha256:1234"),
+				EnvFileARN:  "arn:aws:s3:::bucket/foo.env",
+				AddonsURL:   "https://bucket.s3.amazonaws.com/addons.yml",
+			},
+			setupMocks: func(m *mocks.Mocktemplater) {},
+		},
+		"no parameters are unknown if the workload doesn't have artifacts": {
+			inMft:     &mockWorkloadMft{},
+			inRuntime: &StackRuntimeConfiguration{},
+			setupMocks: func(m *mocks.Mocktemplater) {
+				m.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
+			},
+		},
+		"wrap error if the addons template cannot be read": {
+			inMft:     &mockWorkloadMft{},
+			inRuntime: &StackRuntimeConfiguration{},
+			setupMocks: func(m *mocks.Mocktemplater) {
+				m.EXPECT().Template().Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("retrieve addons template: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocktemplater(ctrl)
+			tc.setupMocks(m)
+			d := &workloadDeployer{
+				mft:       tc.inMft,
+				templater: m,
+			}
+
+			got, err := d.unknownParameters(tc.inRuntime)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_formatStackPreview(t *testing.T) {
+	testCases := map[string]struct {
+		in     *cloudformation.StackPreview
+		wanted string
+	}{
+		"a stack that is not deployed yet": {
+			in: &cloudformation.StackPreview{
+				Template: `Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+				Parameters: map[string]string{
+					"AppName": "phonetool",
+				},
+			},
+			wanted: `Template
+
+  + Resources:
+  +   Service:
+  +     Type: AWS::ECS::Service
+
+Parameters
+
+  + AppName: phonetool
+
+Resource changes
+
+  The stack does not exist yet, all resources will be created.
+`,
+		},
+		"a stack without changes": {
+			in: &cloudformation.StackPreview{
+				DeployedTemplate: "Description: old\nResources: {}",
+				Template:         "Description: new\nResources: {}",
+				DeployedParameters: map[string]string{
+					"AppName": "phonetool",
+				},
+				Parameters: map[string]string{
+					"AppName": "phonetool",
+				},
+			},
+			wanted: `Template
+
+  No changes to the template.
+
+Parameters
+
+  No changes to the parameter values.
+
+Resource changes
+
+  No resources will be changed.
+`,
+		},
+		"a stack that replaces a resource": {
+			in: &cloudformation.StackPreview{
+				DeployedTemplate: `Resources:
+  HTTPListenerRule:
+    Type: AWS::ElasticLoadBalancingV2::ListenerRule
+    Properties:
+      Priority: 1`,
+				Template: `Resources:
+  HTTPListenerRule:
+    Type: AWS::ElasticLoadBalancingV2::ListenerRule
+    Properties:
+      Priority: 2`,
+				DeployedParameters: map[string]string{
+					"TaskCount": "1",
+				},
+				Parameters: map[string]string{
+					"TaskCount": "2",
+				},
+				Changes: []*sdkcloudformation.Change{
+					{
+						ResourceChange: &sdkcloudformation.ResourceChange{
+							Action:            aws.String(sdkcloudformation.ChangeActionModify),
+							LogicalResourceId: aws.String("Service"),
+							ResourceType:      aws.String("AWS::ECS::Service"),
+							Replacement:       aws.String(sdkcloudformation.ReplacementFalse),
+						},
+					},
+					{
+						ResourceChange: &sdkcloudformation.ResourceChange{
+							Action:            aws.String(sdkcloudformation.ChangeActionModify),
+							LogicalResourceId: aws.String("HTTPListenerRule"),
+							ResourceType:      aws.String("AWS::ElasticLoadBalancingV2::ListenerRule"),
+							Replacement:       aws.String(sdkcloudformation.ReplacementTrue),
+						},
+					},
+				},
+			},
+			wanted: `Template
+
+    Resources:
+      HTTPListenerRule:
+        Properties:
+  ~       Priority: 1 -> 2
+
+Parameters
+
+  ~ TaskCount: "1" -> "2"
+
+Resource changes
+
+  Action  Logical ID        Type                                       Replacement
+  Modify  HTTPListenerRule  AWS::ElasticLoadBalancingV2::ListenerRule  True
+  Modify  Service           AWS::ECS::Service                          False
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := formatStackPreview(tc.in)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	describe "github.com/aws/copilot-cli/internal/pkg/describe"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	repository "github.com/aws/copilot-cli/internal/pkg/repository"
 	progress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -357,6 +358,44 @@ func (mr *MockpublicCIDRBlocksGetterMockRecorder) PublicCIDRBlocks() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicCIDRBlocks", reflect.TypeOf((*MockpublicCIDRBlocksGetter)(nil).PublicCIDRBlocks))
 }
 
+// MocklistenerRulesGetter is a mock of listenerRulesGetter interface.
+type MocklistenerRulesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklistenerRulesGetterMockRecorder
+}
+
+// MocklistenerRulesGetterMockRecorder is the mock recorder for MocklistenerRulesGetter.
+type MocklistenerRulesGetterMockRecorder struct {
+	mock *MocklistenerRulesGetter
+}
+
+// NewMocklistenerRulesGetter creates a new mock instance.
+func NewMocklistenerRulesGetter(ctrl *gomock.Controller) *MocklistenerRulesGetter {
+	mock := &MocklistenerRulesGetter{ctrl: ctrl}
+	mock.recorder = &MocklistenerRulesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklistenerRulesGetter) EXPECT() *MocklistenerRulesGetterMockRecorder {
+	return m.recorder
+}
+
+// ListenerRules mocks base method.
+func (m *MocklistenerRulesGetter) ListenerRules() ([]*describe.ListenerRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenerRules")
+	ret0, _ := ret[0].([]*describe.ListenerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListenerRules indicates an expected call of ListenerRules.
+func (mr *MocklistenerRulesGetterMockRecorder) ListenerRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRules", reflect.TypeOf((*MocklistenerRulesGetter)(nil).ListenerRules))
+}
+
 // MockcustomResourcesUploader is a mock of customResourcesUploader interface.
 type MockcustomResourcesUploader struct {
 	ctrl     *gomock.Controller
@@ -463,6 +502,82 @@ func (mr *MocksnsTopicsListerMockRecorder) ListSNSTopics(appName, envName interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSNSTopics", reflect.TypeOf((*MocksnsTopicsLister)(nil).ListSNSTopics), appName, envName)
 }
 
+// MockdeployedJobsLister is a mock of deployedJobsLister interface.
+type MockdeployedJobsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedJobsListerMockRecorder
+}
+
+// MockdeployedJobsListerMockRecorder is the mock recorder for MockdeployedJobsLister.
+type MockdeployedJobsListerMockRecorder struct {
+	mock *MockdeployedJobsLister
+}
+
+// NewMockdeployedJobsLister creates a new mock instance.
+func NewMockdeployedJobsLister(ctrl *gomock.Controller) *MockdeployedJobsLister {
+	mock := &MockdeployedJobsLister{ctrl: ctrl}
+	mock.recorder = &MockdeployedJobsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedJobsLister) EXPECT() *MockdeployedJobsListerMockRecorder {
+	return m.recorder
+}
+
+// ListDeployedJobs mocks base method.
+func (m *MockdeployedJobsLister) ListDeployedJobs(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedJobs", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedJobs indicates an expected call of ListDeployedJobs.
+func (mr *MockdeployedJobsListerMockRecorder) ListDeployedJobs(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedJobs", reflect.TypeOf((*MockdeployedJobsLister)(nil).ListDeployedJobs), appName, envName)
+}
+
+// MockjobRetriesGetter is a mock of jobRetriesGetter interface.
+type MockjobRetriesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobRetriesGetterMockRecorder
+}
+
+// MockjobRetriesGetterMockRecorder is the mock recorder for MockjobRetriesGetter.
+type MockjobRetriesGetterMockRecorder struct {
+	mock *MockjobRetriesGetter
+}
+
+// NewMockjobRetriesGetter creates a new mock instance.
+func NewMockjobRetriesGetter(ctrl *gomock.Controller) *MockjobRetriesGetter {
+	mock := &MockjobRetriesGetter{ctrl: ctrl}
+	mock.recorder = &MockjobRetriesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobRetriesGetter) EXPECT() *MockjobRetriesGetterMockRecorder {
+	return m.recorder
+}
+
+// JobRetries mocks base method.
+func (m *MockjobRetriesGetter) JobRetries(app, env, job string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobRetries", app, env, job)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobRetries indicates an expected call of JobRetries.
+func (mr *MockjobRetriesGetterMockRecorder) JobRetries(app, env, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobRetries", reflect.TypeOf((*MockjobRetriesGetter)(nil).JobRetries), app, env, job)
+}
+
 // MockserviceDeployer is a mock of serviceDeployer interface.
 type MockserviceDeployer struct {
 	ctrl     *gomock.Controller
@@ -505,6 +620,26 @@ func (mr *MockserviceDeployerMockRecorder) DeployService(out, conf, bucketName i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceDeployer)(nil).DeployService), varargs...)
 }
 
+// PreviewService mocks base method.
+func (m *MockserviceDeployer) PreviewService(conf cloudformation0.StackConfiguration, bucketName string, opts ...cloudformation.StackOption) (*cloudformation0.StackPreview, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf, bucketName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewService", varargs...)
+	ret0, _ := ret[0].(*cloudformation0.StackPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewService indicates an expected call of PreviewService.
+func (mr *MockserviceDeployerMockRecorder) PreviewService(conf, bucketName interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf, bucketName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewService", reflect.TypeOf((*MockserviceDeployer)(nil).PreviewService), varargs...)
+}
+
 // MockserviceForceUpdater is a mock of serviceForceUpdater interface.
 type MockserviceForceUpdater struct {
 	ctrl     *gomock.Controller
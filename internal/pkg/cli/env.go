@@ -20,6 +20,7 @@ Environments are deployment stages shared between services.`,
 	}
 
 	cmd.AddCommand(buildEnvInitCmd())
+	cmd.AddCommand(buildEnvDeployCmd())
 	cmd.AddCommand(buildEnvListCmd())
 	cmd.AddCommand(buildEnvDeleteCmd())
 	cmd.AddCommand(buildEnvShowCmd())
@@ -20,6 +20,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -56,6 +57,7 @@ type deleteEnvVars struct {
 	appName          string
 	name             string
 	skipConfirmation bool
+	outputFormat     string
 }
 
 type deleteEnvOpts struct {
@@ -175,6 +177,10 @@ func (o *deleteEnvOpts) Execute() error {
 		return err
 	}
 	o.prog.Stop(log.Ssuccessf(fmtDeleteEnvComplete, o.name, o.appName))
+	event.Publish(event.Output{
+		Application: o.appName,
+		Environment: o.name,
+	})
 	return nil
 }
 
@@ -387,15 +393,18 @@ func buildEnvDeleteCmd() *cobra.Command {
   Delete the "test" environment without prompting.
   /code $ copilot env delete --name test --yes`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeleteEnvOpts(vars)
-			if err != nil {
-				return err
-			}
-			return run(opts)
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newDeleteEnvOpts(vars)
+				if err != nil {
+					return err
+				}
+				return run(opts)
+			})
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
 	return cmd
 }
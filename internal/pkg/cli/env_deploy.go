@@ -0,0 +1,343 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+)
+
+const (
+	envDeployAppPrompt = "In which application is your environment?"
+
+	envDeployEnvPrompt = "Which environment do you want to deploy?"
+	envDeployEnvHelp   = `Deploys the environment manifest under copilot/environments/<name>/manifest.yml
+to the environment's AWS CloudFormation stack.`
+
+	fmtEnvDeployStart    = "Deploying environment %s."
+	fmtEnvDeployFailed   = "Failed to deploy environment %s.\n"
+	fmtEnvDeployComplete = "Deployed environment %s.\n"
+)
+
+// deployEnvVars holds flag values.
+type deployEnvVars struct {
+	appName      string // Required. Name of the application.
+	name         string // Required. Name of the environment.
+	outputFormat string // The format of the events written to stdout.
+}
+
+// deployEnvOpts represents the env deploy command and holds the necessary data
+// and clients to execute the command.
+type deployEnvOpts struct {
+	deployEnvVars
+
+	store    store
+	ws       wsEnvironmentReader
+	sel      appEnvSelector
+	prog     progress
+	appCFN   appResourcesGetter
+	uploader customResourcesUploader
+	addons   addonsTemplater
+
+	// Constructors for clients that can be initialized only at runtime.
+	// These functions are overridden in tests to provide mocks.
+	newEnvVersionGetter func(app, env string) (versionGetter, error)
+	newEnvDeployer      func(conf *config.Environment) (envUpgrader, error)
+	newS3               func(region string) (uploader, error)
+}
+
+func newDeployEnvOpts(vars deployEnvVars) (*deployEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env deploy"))
+	defaultSession, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	addons, err := addon.NewEnv()
+	if err != nil {
+		return nil, fmt.Errorf("initiate environment addons: %w", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	return &deployEnvOpts{
+		deployEnvVars: vars,
+
+		store:    store,
+		ws:       ws,
+		sel:      selector.NewSelect(prompt.New(), store),
+		prog:     termprogress.NewSpinner(log.DiagnosticWriter),
+		uploader: template.New(),
+		appCFN:   cloudformation.New(defaultSession),
+		addons:   addons,
+
+		newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env describer for environment %s in app %s: %v", env, app, err)
+			}
+			return d, nil
+		},
+		newEnvDeployer: func(conf *config.Environment) (envUpgrader, error) {
+			sess, err := sessProvider.FromRole(conf.ManagerRoleARN, conf.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from role %s and region %s: %v", conf.ManagerRoleARN, conf.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+		newS3: func(region string) (uploader, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %v", region, err)
+			}
+			return s3.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *deployEnvOpts) Validate() error {
+	if o.name == "" {
+		return nil
+	}
+	if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+		var errEnvDoesNotExist *config.ErrNoSuchEnvironment
+		if errors.As(err, &errEnvDoesNotExist) {
+			return err
+		}
+		return fmt.Errorf("get environment %s configuration from application %s: %v", o.name, o.appName, err)
+	}
+	return nil
+}
+
+// Ask prompts for any required flags that are not set by the user.
+func (o *deployEnvOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(envDeployAppPrompt, "")
+		if err != nil {
+			return fmt.Errorf("select application: %v", err)
+		}
+		o.appName = app
+	}
+	if o.name == "" {
+		env, err := o.sel.Environment(envDeployEnvPrompt, envDeployEnvHelp, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %v", err)
+		}
+		o.name = env
+	}
+	return nil
+}
+
+// Execute deploys the environment manifest to the environment's CloudFormation stack,
+// and records the resulting configuration in the config store.
+func (o *deployEnvOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s in application %s: %w", o.name, o.appName, err)
+	}
+	mft, err := o.readManifest()
+	if err != nil {
+		return err
+	}
+	if err := o.validateEnvVersion(); err != nil {
+		return err
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
+	if err != nil {
+		return fmt.Errorf("get app resources: %w", err)
+	}
+	s3Client, err := o.newS3(env.Region)
+	if err != nil {
+		return err
+	}
+	urls, err := o.uploader.UploadEnvironmentCustomResources(s3.CompressAndUploadFunc(func(key string, objects ...s3.NamedBinary) (string, error) {
+		return s3Client.ZipAndUpload(resources.S3Bucket, key, objects...)
+	}))
+	if err != nil {
+		return fmt.Errorf("upload custom resources to bucket %s: %w", resources.S3Bucket, err)
+	}
+	addons, err := o.uploadAddons(s3Client, resources.S3Bucket)
+	if err != nil {
+		return err
+	}
+	partition, err := partitions.Region(env.Region).Partition()
+	if err != nil {
+		return err
+	}
+	deployer, err := o.newEnvDeployer(env)
+	if err != nil {
+		return err
+	}
+	o.prog.Start(fmt.Sprintf(fmtEnvDeployStart, color.HighlightUserInput(o.name)))
+	if err := deployer.UpgradeEnvironment(&deploy.CreateEnvironmentInput{
+		Version: deploy.LatestEnvTemplateVersion,
+		App: deploy.AppInformation{
+			Name: o.appName,
+		},
+		Name:                 o.name,
+		ArtifactBucketKeyARN: resources.KMSKeyARN,
+		ArtifactBucketARN:    s3.FormatARN(partition.ID(), resources.S3Bucket),
+		CustomResourcesURLs:  urls,
+		ImportVPCConfig:      mft.ImportedVPC(),
+		AdjustVPCConfig:      mft.AdjustedVPC(),
+		Telemetry:            mft.TelemetryConfig(),
+		Addons:               addons,
+		CFNServiceRoleARN:    env.ExecutionRoleARN,
+	}); err != nil {
+		o.prog.Stop(log.Serrorf(fmtEnvDeployFailed, color.HighlightUserInput(o.name)))
+		return fmt.Errorf("deploy environment %s: %w", o.name, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtEnvDeployComplete, color.HighlightUserInput(o.name)))
+
+	// Keep the environment configuration in sync with the manifest so that "env upgrade" reuses it.
+	env.CustomConfig = config.NewCustomizeEnv(mft.ImportedVPC(), mft.AdjustedVPC())
+	env.Telemetry = mft.TelemetryConfig()
+	env.Addons = addons
+	if err := o.store.UpdateEnvironment(env); err != nil {
+		return fmt.Errorf("update environment %s configuration: %w", o.name, err)
+	}
+	event.Publish(event.Output{
+		Application: o.appName,
+		Environment: o.name,
+		Values: map[string]string{
+			"region": env.Region,
+		},
+	})
+	return nil
+}
+
+// RecommendActions is a no-op for this command.
+func (o *deployEnvOpts) RecommendActions() error {
+	return nil
+}
+
+func (o *deployEnvOpts) readManifest() (*manifest.Environment, error) {
+	raw, err := o.ws.ReadEnvironmentManifest(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest for environment %s: %w", o.name, err)
+	}
+	mft, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for environment %s: %w", o.name, err)
+	}
+	if err := mft.Validate(); err != nil {
+		return nil, fmt.Errorf("validate manifest for environment %s: %w", o.name, err)
+	}
+	return mft, nil
+}
+
+// uploadAddons uploads the merged template under copilot/environments/addons/ to the bucket.
+// If there are no environment addons, it returns nil.
+func (o *deployEnvOpts) uploadAddons(s3Client uploader, bucket string) (*config.EnvAddons, error) {
+	tpl, err := o.addons.Template()
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieve environment addons template: %w", err)
+	}
+	params, err := o.addons.Parameters()
+	if err != nil {
+		return nil, fmt.Errorf("parse environment addons parameters: %w", err)
+	}
+	outputs, err := addon.Outputs(tpl)
+	if err != nil {
+		return nil, fmt.Errorf("get environment addons outputs: %w", err)
+	}
+	key := s3.MkdirSHA256(fmt.Sprintf(deploy.EnvAddonsCfnTemplateNameFormat, o.name), []byte(tpl))
+	url, err := s3Client.Upload(bucket, key, strings.NewReader(tpl))
+	if err != nil {
+		return nil, fmt.Errorf("put environment addons artifact to bucket %s: %w", bucket, err)
+	}
+	var names []string
+	for _, out := range outputs {
+		names = append(names, out.Name)
+	}
+	return &config.EnvAddons{
+		TemplateURL: url,
+		Parameters:  params,
+		Outputs:     names,
+	}, nil
+}
+
+func (o *deployEnvOpts) validateEnvVersion() error {
+	getter, err := o.newEnvVersionGetter(o.appName, o.name)
+	if err != nil {
+		return err
+	}
+	version, err := getter.Version()
+	if err != nil {
+		return fmt.Errorf("get template version of environment %s in app %s: %v", o.name, o.appName, err)
+	}
+	if version == deploy.LegacyEnvTemplateVersion {
+		return fmt.Errorf("environment %s is on a legacy template, run %s first",
+			o.name, color.HighlightCode(fmt.Sprintf("copilot env upgrade -n %s", o.name)))
+	}
+	if semver.Compare(version, deploy.LatestEnvTemplateVersion) > 0 {
+		return fmt.Errorf("environment %s is on version %s which is newer than the latest version %s supported by this CLI",
+			o.name, version, deploy.LatestEnvTemplateVersion)
+	}
+	return nil
+}
+
+// buildEnvDeployCmd builds the command to deploy an environment manifest.
+func buildEnvDeployCmd() *cobra.Command {
+	vars := deployEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploys an environment to an application.",
+		Long:  "Deploys the infrastructure described in an environment manifest to the environment.",
+		Example: `
+  Deploy the "test" environment's manifest.
+  /code $ copilot env deploy --name test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newDeployEnvOpts(vars)
+				if err != nil {
+					return err
+				}
+				return run(opts)
+			})
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
+	return cmd
+}
@@ -0,0 +1,416 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		given     func(ctrl *gomock.Controller) *deployEnvOpts
+		wantedErr error
+	}{
+		"should not error if the environment exists": {
+			given: func(ctrl *gomock.Controller) *deployEnvOpts {
+				m := mocks.NewMockstore(ctrl)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, nil)
+				return &deployEnvOpts{
+					deployEnvVars: deployEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store: m,
+				}
+			},
+		},
+		"should return config.ErrNoSuchEnvironment if the environment is not found": {
+			given: func(ctrl *gomock.Controller) *deployEnvOpts {
+				m := mocks.NewMockstore(ctrl)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{
+					ApplicationName: "phonetool",
+					EnvironmentName: "test",
+				})
+				return &deployEnvOpts{
+					deployEnvVars: deployEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store: m,
+				}
+			},
+			wantedErr: &config.ErrNoSuchEnvironment{
+				ApplicationName: "phonetool",
+				EnvironmentName: "test",
+			},
+		},
+		"should wrap unexpected config errors": {
+			given: func(ctrl *gomock.Controller) *deployEnvOpts {
+				m := mocks.NewMockstore(ctrl)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+				return &deployEnvOpts{
+					deployEnvVars: deployEnvVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store: m,
+				}
+			},
+			wantedErr: errors.New("get environment test configuration from application phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			err := tc.given(ctrl).Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeployEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		given func(ctrl *gomock.Controller) *deployEnvOpts
+
+		wantedAppName string
+		wantedEnvName string
+		wantedErr     error
+	}{
+		"should prompt for application and environment if not set": {
+			given: func(ctrl *gomock.Controller) *deployEnvOpts {
+				m := mocks.NewMockappEnvSelector(ctrl)
+				m.EXPECT().Application(envDeployAppPrompt, "").Return("phonetool", nil)
+				m.EXPECT().Environment(envDeployEnvPrompt, envDeployEnvHelp, "phonetool").Return("test", nil)
+				return &deployEnvOpts{
+					sel: m,
+				}
+			},
+			wantedAppName: "phonetool",
+			wantedEnvName: "test",
+		},
+		"should wrap error if fails to select environment": {
+			given: func(ctrl *gomock.Controller) *deployEnvOpts {
+				m := mocks.NewMockappEnvSelector(ctrl)
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), "phonetool").Return("", errors.New("some error"))
+				return &deployEnvOpts{
+					deployEnvVars: deployEnvVars{
+						appName: "phonetool",
+					},
+					sel: m,
+				}
+			},
+			wantedErr: errors.New("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			opts := tc.given(ctrl)
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAppName, opts.appName)
+				require.Equal(t, tc.wantedEnvName, opts.name)
+			}
+		})
+	}
+}
+
+func TestDeployEnvOpts_Execute(t *testing.T) {
+	const mockManifest = `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-123
+    subnets:
+      public:
+        - id: subnet-1
+        - id: subnet-2
+observability:
+  container_insights: true
+`
+	const mockEnvAddonsTemplate = `Resources:
+  ClusterSecret:
+    Type: AWS::SecretsManager::Secret
+Outputs:
+  ClusterEndpoint:
+    Value: !GetAtt Cluster.Endpoint.Address
+  ClusterSecret:
+    Value: !Ref ClusterSecret
+`
+	mockEnv := func() *config.Environment {
+		return &config.Environment{
+			App:              "phonetool",
+			Name:             "test",
+			Region:           "us-west-2",
+			ExecutionRoleARN: "execARN",
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *deployEnvMocks)
+		wantedErr  error
+	}{
+		"should return error if the manifest cannot be read": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("read manifest for environment test: some error"),
+		},
+		"should return error if the manifest is invalid": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(`type: Environment
+network:
+  vpc:
+    id: vpc-123
+    cidr: 10.0.0.0/16`), nil)
+			},
+			wantedErr: errors.New(`validate manifest for environment test: "name" must be specified`),
+		},
+		"should not deploy legacy environments": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return(deploy.LegacyEnvTemplateVersion, nil)
+			},
+			wantedErr: errors.New("environment test is on a legacy template, run `copilot env upgrade -n test` first"),
+		},
+		"should return error if the environment addons parameters are invalid": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+				m.addons.EXPECT().Template().Return(mockEnvAddonsTemplate, nil)
+				m.addons.EXPECT().Parameters().Return("", errors.New("reserved parameters 'App' and 'Env' cannot be declared"))
+			},
+			wantedErr: errors.New("parse environment addons parameters: reserved parameters 'App' and 'Env' cannot be declared"),
+		},
+		"should wrap error if fails to upload the environment addons template": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+				m.addons.EXPECT().Template().Return(mockEnvAddonsTemplate, nil)
+				m.addons.EXPECT().Parameters().Return("", nil)
+				m.s3.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("put environment addons artifact to bucket mockBucket: some error"),
+		},
+		"should deploy the environment addons and record them in the environment configuration": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+				m.addons.EXPECT().Template().Return(mockEnvAddonsTemplate, nil)
+				m.addons.EXPECT().Parameters().Return("VpcId: !Ref VPC\n", nil)
+				m.s3.EXPECT().Upload("mockBucket", s3.MkdirSHA256("environments/test.addons.stack.yml", []byte(mockEnvAddonsTemplate)), gomock.Any()).
+					Return("https://mockBucket.s3.us-west-2.amazonaws.com/addons.yml", nil)
+				wantedAddons := &config.EnvAddons{
+					TemplateURL: "https://mockBucket.s3.us-west-2.amazonaws.com/addons.yml",
+					Parameters:  "VpcId: !Ref VPC\n",
+					Outputs:     []string{"ClusterEndpoint", "ClusterSecret"},
+				}
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpgradeEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.CreateEnvironmentInput) error {
+					require.Equal(t, wantedAddons, in.Addons)
+					return nil
+				})
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).DoAndReturn(func(env *config.Environment) error {
+					require.Equal(t, wantedAddons, env.Addons)
+					return nil
+				})
+			},
+		},
+		"should wrap error if fails to deploy the environment": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{WlName: "environments"})
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpgradeEnvironment(gomock.Any()).Return(errors.New("some error"))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("deploy environment test: some error"),
+		},
+		"should use the partition of the environment's region for the artifact bucket": {
+			setupMocks: func(m *deployEnvMocks) {
+				env := mockEnv()
+				env.Region = "cn-north-1"
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(env, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "cn-north-1").
+					Return(&stack.AppRegionalResources{
+						S3Bucket:  "mockBucket",
+						KMSKeyARN: "mockKMS",
+					}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{WlName: "environments"})
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpgradeEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.CreateEnvironmentInput) error {
+					require.Equal(t, "arn:aws-cn:s3:::mockBucket", in.ArtifactBucketARN)
+					return nil
+				})
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).Return(nil)
+			},
+		},
+		"should deploy the manifest and update the environment configuration": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket:  "mockBucket",
+						KMSKeyARN: "mockKMS",
+					}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{WlName: "environments"})
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().UpgradeEnvironment(&deploy.CreateEnvironmentInput{
+					Version: deploy.LatestEnvTemplateVersion,
+					App: deploy.AppInformation{
+						Name: "phonetool",
+					},
+					Name: "test",
+					ImportVPCConfig: &config.ImportVPC{
+						ID:              "vpc-123",
+						PublicSubnetIDs: []string{"subnet-1", "subnet-2"},
+					},
+					Telemetry: &config.Telemetry{
+						EnableContainerInsights: true,
+					},
+					CFNServiceRoleARN:    "execARN",
+					CustomResourcesURLs:  map[string]string{"mockCustomResource": "mockURL"},
+					ArtifactBucketARN:    "arn:aws:s3:::mockBucket",
+					ArtifactBucketKeyARN: "mockKMS",
+				}).Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().UpdateEnvironment(&config.Environment{
+					App:              "phonetool",
+					Name:             "test",
+					Region:           "us-west-2",
+					ExecutionRoleARN: "execARN",
+					CustomConfig: &config.CustomizeEnv{
+						ImportVPC: &config.ImportVPC{
+							ID:              "vpc-123",
+							PublicSubnetIDs: []string{"subnet-1", "subnet-2"},
+						},
+					},
+					Telemetry: &config.Telemetry{
+						EnableContainerInsights: true,
+					},
+				}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &deployEnvMocks{
+				store:         mocks.NewMockstore(ctrl),
+				ws:            mocks.NewMockwsEnvironmentReader(ctrl),
+				prog:          mocks.NewMockprogress(ctrl),
+				appCFN:        mocks.NewMockappResourcesGetter(ctrl),
+				uploader:      mocks.NewMockcustomResourcesUploader(ctrl),
+				versionGetter: mocks.NewMockversionGetter(ctrl),
+				deployer:      mocks.NewMockenvUpgrader(ctrl),
+				addons:        mocks.NewMockaddonsTemplater(ctrl),
+				s3:            mocks.NewMockuploader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: "phonetool",
+					name:    "test",
+				},
+				store:    m.store,
+				ws:       m.ws,
+				prog:     m.prog,
+				appCFN:   m.appCFN,
+				uploader: m.uploader,
+				addons:   m.addons,
+				newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+					return m.versionGetter, nil
+				},
+				newEnvDeployer: func(_ *config.Environment) (envUpgrader, error) {
+					return m.deployer, nil
+				},
+				newS3: func(_ string) (uploader, error) {
+					return m.s3, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type deployEnvMocks struct {
+	store         *mocks.Mockstore
+	ws            *mocks.MockwsEnvironmentReader
+	prog          *mocks.Mockprogress
+	appCFN        *mocks.MockappResourcesGetter
+	uploader      *mocks.MockcustomResourcesUploader
+	versionGetter *mocks.MockversionGetter
+	deployer      *mocks.MockenvUpgrader
+	addons        *mocks.MockaddonsTemplater
+	s3            *mocks.Mockuploader
+}
@@ -294,6 +294,7 @@ func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.E
 		AdjustVPCConfig:      adjustedVPC,
 		CFNServiceRoleARN:    conf.ExecutionRoleARN,
 		Telemetry:            conf.Telemetry,
+		Addons:               conf.Addons,
 	}); err != nil {
 		return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
 	}
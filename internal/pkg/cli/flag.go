@@ -41,6 +41,12 @@ const (
 	endTimeFlag           = "end-time"
 	tasksFlag             = "tasks"
 	logGroupFlag          = "log-group"
+	workloadsFlag         = "workloads"
+	containersFlag        = "containers"
+	logFilterFlag         = "filter"
+	highlightFlag         = "highlight"
+	insightsFlag          = "insights"
+	exportLogsFlag        = "export"
 	prodEnvFlag           = "prod"
 	deployFlag            = "deploy"
 	resourcesFlag         = "resources"
@@ -53,6 +59,9 @@ const (
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	dryRunFlag            = "dry-run"
+	outputFormatFlag      = "output"
+	addonsTemplateFlag    = "template"
 
 	noSubscriptionFlag  = "no-subscribe"
 	subscribeTopicsFlag = "subscribe-topics"
@@ -67,6 +76,14 @@ const (
 	storageRDSInitialDBFlag      = "initial-db"
 	storageRDSParameterGroupFlag = "parameter-group"
 
+	storageRedisNodeTypeFlag           = "node-type"
+	storageRedisReplicasFlag           = "replicas"
+	storageOpenSearchInstanceTypeFlag  = "instance-type"
+	storageOpenSearchInstanceCountFlag = "instance-count"
+	storageOpenSearchVolumeSizeFlag    = "volume-size"
+	storageSQSFIFOFlag                 = "fifo"
+	storageSQSMaxReceiveCountFlag      = "max-receive-count"
+
 	taskGroupNameFlag            = "task-group-name"
 	countFlag                    = "count"
 	cpuFlag                      = "cpu"
@@ -112,6 +129,12 @@ const (
 	taskIDFlag    = "task-id"
 	containerFlag = "container"
 
+	portFlag       = "port"
+	remoteHostFlag = "remote-host"
+
+	srcFlag = "src"
+	dstFlag = "dst"
+
 	valuesFlag        = "values"
 	overwriteFlag     = "overwrite"
 	inputFilePathFlag = "cli-input-yaml"
@@ -179,13 +202,16 @@ Cannot be specified with '%s', '%s' or '%s'.`, taskDefaultFlag, subnetsFlag, sec
 
 	secretNameFlagDescription = fmt.Sprintf(`The name of the secret.
 Mutually exclusive with the --%s flag.`, inputFilePathFlag)
+	secretRotateValuesFlagDescription = `Optional. New values of the secret in each environment.
+Specified as <environment>=<value> separated by commas.`
 	secretValuesFlagDescription = fmt.Sprintf(`Values of the secret in each environment. Specified as <environment>=<value> separated by commas.
 Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 	secretInputFilePathFlagDescription = fmt.Sprintf(`Optional. A YAML file in which the secret values are specified.
 Mutually exclusive with the -%s ,--%s and --%s flags.`, nameFlagShort, nameFlag, valuesFlag)
 
 	repoURLFlagDescription = fmt.Sprintf(`The repository URL to trigger your pipeline.
-Supported providers are: %s.`, strings.Join(manifest.PipelineProviders, ", "))
+Supported providers are: %s.
+Other Git repositories can trigger your pipeline with a webhook.`, strings.Join(manifest.PipelineProviders, ", "))
 )
 
 const (
@@ -193,6 +219,7 @@ const (
 	envFlagDescription        = "Name of the environment."
 	svcFlagDescription        = "Name of the service."
 	jobFlagDescription        = "Name of the job."
+	secretFlagDescription     = "Name of the secret."
 	workloadFlagDescription   = "Name of the service or job."
 	nameFlagDescription       = "Name of the service, job, or task group."
 	pipelineFlagDescription   = "Name of the pipeline."
@@ -205,12 +232,20 @@ const (
 rollback in case of deployment failure.
 We do not recommend using this flag for a
 production environment.`
+	dryRunFlagDescription = `Optional. Print the differences between the deployed
+stack and the stack that would be deployed, without deploying.`
+	outputFormatFlagDescription = `Optional. The format of the events written to stdout.
+Must be "json": writes newline-delimited JSON events
+for stack updates, deployments, pushed images, outputs and errors.`
+	addonsTemplateFlagDescription = `Optional. Writes the merged addons template to stdout
+instead of the merge report.`
 
 	imageTagFlagDescription     = `Optional. The container image tag.`
 	resourceTagsFlagDescription = `Optional. Labels with a key and value separated by commas.
 Allows you to categorize resources.`
-	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
-	uploadAssetsFlagDescription   = `Optional. Whether to upload assets (container images, Lambda functions, etc.).
+	stackOutputDirFlagDescription  = "Optional. Writes the stack template and template configuration to a directory."
+	exportOutputDirFlagDescription = "Optional. The directory to write the exported stacks to."
+	uploadAssetsFlagDescription    = `Optional. Whether to upload assets (container images, Lambda functions, etc.).
 Uploaded asset locations are filled in the template configuration.`
 	prodEnvFlagDescription = "If the environment contains production services."
 
@@ -226,6 +261,16 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	tasksLogsFlagDescription               = "Optional. Only return logs from specific task IDs."
 	includeStateMachineLogsFlagDescription = "Optional. Include logs from the state machine executions."
 	logGroupFlagDescription                = "Optional. Only return logs from specific log group."
+	workloadsLogsFlagDescription           = `Optional. Names of the services and jobs of the environment
+to return the logs of together, ordered by time.`
+	containersLogsFlagDescription = "Optional. Only return logs from specific containers, for example sidecars."
+	logFilterFlagDescription      = `Optional. Only return logs matching a CloudWatch Logs filter pattern.
+For example: "ERROR" or "{ $.status = 500 }".`
+	highlightFlagDescription = "Optional. Highlight the matches of a regular expression in the logs."
+	insightsFlagDescription  = `Optional. Run a CloudWatch Logs Insights query over the logs and display its results.
+Defaults to the last hour of logs.`
+	exportLogsFlagDescription = `Optional. Directory to write the logs to, as a gzipped file
+of newline-delimited JSON per log stream.`
 
 	deployTestFlagDescription        = `Deploy your service or job to a "test" environment.`
 	githubURLFlagDescription         = "(Deprecated.) Use '--url' instead. Repository URL to trigger your pipeline."
@@ -260,6 +305,18 @@ Must be either "MySQL" or "PostgreSQL".`
 	storageRDSInitialDBFlagDescription      = "The initial database to create in the cluster."
 	storageRDSParameterGroupFlagDescription = "Optional. The name of the parameter group to associate with the cluster."
 
+	storageRedisNodeTypeFlagDescription = `The node type of the Redis replication group.
+For example: "cache.t4g.micro".`
+	storageRedisReplicasFlagDescription          = "The number of read replicas of the primary node, between 0 and 5."
+	storageOpenSearchInstanceTypeFlagDescription = `The instance type of the data nodes of the OpenSearch domain.
+For example: "t3.small.search".`
+	storageOpenSearchInstanceCountFlagDescription = `The number of data nodes of the OpenSearch domain.
+Must be 1 or an even number to spread them across two Availability Zones.`
+	storageOpenSearchVolumeSizeFlagDescription = "Optional. The size in GiB of the EBS volume of each data node."
+	storageSQSFIFOFlagDescription              = "Whether the SQS queue delivers messages exactly once and in order."
+	storageSQSMaxReceiveCountFlagDescription   = `Optional. The number of times a message is received
+before it's moved to the dead-letter queue.`
+
 	countFlagDescription         = "Optional. The number of tasks to set up."
 	cpuFlagDescription           = "Optional. The number of CPU units to reserve for each task."
 	memoryFlagDescription        = "Optional. The amount of memory to reserve in MiB for each task."
@@ -315,5 +372,22 @@ are also accepted.`
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
 	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
 
+	portForwardPortFlagDescription = `Ports to forward, specified as <local port>:<remote port>.
+If a single port is given, the same port is used locally.`
+	portForwardRemoteHostFlagDescription = `Optional. Private host reachable from the task to forward to, for example a database endpoint.
+By default the port of the container is forwarded.`
+	portForwardTaskIDFlagDescription    = "Optional. ID of the task you want to forward ports to."
+	portForwardContainerFlagDescription = "Optional. The specific container you want to forward ports to. By default the first essential container will be used."
+
+	cpSrcFlagDescription = `Path of the file or directory to copy.
+Paths in a container are written as [<container>]:<absolute path>, by default the first essential container is used.`
+	cpDstFlagDescription = `Path to copy the file or directory to.
+Paths in a container are written as [<container>]:<absolute path>, by default the first essential container is used.`
+	cpTaskIDFlagDescription = "Optional. ID of the task you want to copy files to or from."
+
+	jobRunEnvVarsFlagDescription = `Optional. Environment variables of the job's main container specified by key=value separated by commas.
+They are added to the environment variables of the task definition.`
+	jobRunCommandFlagDescription = "Optional. The command that overrides the default command of the job's main container."
+
 	secretOverwriteFlagDescription = "Optional. Whether to overwrite an existing secret."
 )
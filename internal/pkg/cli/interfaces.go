@@ -17,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
@@ -112,6 +113,7 @@ type applicationDeleter interface {
 
 type environmentStore interface {
 	environmentCreator
+	environmentUpdater
 	environmentGetter
 	environmentLister
 	environmentDeleter
@@ -121,6 +123,10 @@ type environmentCreator interface {
 	CreateEnvironment(env *config.Environment) error
 }
 
+type environmentUpdater interface {
+	UpdateEnvironment(env *config.Environment) error
+}
+
 type environmentGetter interface {
 	GetEnvironment(appName string, environmentName string) (*config.Environment, error)
 }
@@ -178,12 +184,24 @@ type repositoryService interface {
 
 type logEventsWriter interface {
 	WriteLogEvents(opts logging.WriteLogEventsOpts) error
+	WriteQueryResults(opts logging.WriteQueryResultsOpts) error
+	ExportLogEvents(opts logging.ExportLogEventsOpts) ([]string, error)
 }
 
 type templater interface {
 	Template() (string, error)
 }
 
+type addonsTemplater interface {
+	templater
+	Parameters() (string, error)
+}
+
+type addonsMergeReporter interface {
+	templater
+	MergeReport() (*addon.MergeReport, error)
+}
+
 type runner interface {
 	Run(name string, args []string, options ...exec.CmdOption) error
 }
@@ -232,6 +250,10 @@ type manifestReader interface {
 	ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error)
 }
 
+type wsEnvironmentReader interface {
+	ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error)
+}
+
 type workspacePathGetter interface {
 	Path() (string, error)
 }
@@ -267,6 +289,11 @@ type wlLister interface {
 	ListWorkloads() ([]string, error)
 }
 
+type wsWlManifestReader interface {
+	wlLister
+	manifestReader
+}
+
 type wsJobDirReader interface {
 	wsJobReader
 	workspacePathGetter
@@ -471,7 +498,9 @@ type configSelector interface {
 
 type deploySelector interface {
 	appSelector
+	Environment(prompt, help, app string, additionalOpts ...string) (string, error)
 	DeployedService(prompt, help string, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedService, error)
+	DeployedJob(prompt, help string, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedJob, error)
 }
 
 type pipelineEnvSelector interface {
@@ -548,6 +577,20 @@ type ecsCommandExecutor interface {
 	ExecuteCommand(in awsecs.ExecuteCommandInput) error
 }
 
+type jobRunner interface {
+	StartJob(app, env, job string, overrides ecs.JobOverrides) (string, error)
+	DescribeJobExecution(executionARN string) (*ecs.JobExecution, error)
+}
+
+type fileCopier interface {
+	Upload(localPath, remotePath string) (*ecs.CopyResult, error)
+	Download(remotePath, localPath string) (*ecs.CopyResult, error)
+}
+
+type ecsPortForwarder interface {
+	StartPortForwardingSession(in ssm.PortForwardingSessionInput) error
+}
+
 type ssmPluginManager interface {
 	ValidateBinary() error
 	InstallLatestBinary() error
@@ -586,6 +629,18 @@ type dockerEngine interface {
 	GetPlatform() (string, string, error)
 }
 
+type localContainerRunner interface {
+	Build(args *dockerengine.BuildArguments) error
+	Run(options *dockerengine.RunOptions) error
+	RemoveContainer(name string) error
+	CreateNetwork(name string) error
+	RemoveNetwork(name string) error
+}
+
+type secretGetter interface {
+	GetSecretValue(name string) (string, error)
+}
+
 type codestar interface {
 	GetConnectionARN(string) (string, error)
 }
@@ -602,6 +657,17 @@ type secretPutter interface {
 	PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error)
 }
 
+type secretStore interface {
+	secretPutter
+	ListSecrets(path string) ([]ssm.SecretMetadata, error)
+	DescribeSecret(name string) (*ssm.SecretMetadata, error)
+	DeleteSecret(name string) error
+}
+
+type secretConsumerFinder interface {
+	Consumers(app, env, paramName string) ([]*describe.SecretConsumer, error)
+}
+
 type servicePauser interface {
 	PauseService(svcARN string) error
 }
@@ -615,6 +681,11 @@ type workloadDeployer interface {
 	DeployWorkload(in *clideploy.DeployWorkloadInput) (clideploy.ActionRecommender, error)
 }
 
+type workloadDeployDiffer interface {
+	workloadDeployer
+	DeployDiff(in *clideploy.StackRuntimeConfiguration) (string, error)
+}
+
 type workloadTemplateGenerator interface {
 	UploadArtifacts() (*clideploy.UploadArtifactsOutput, error)
 	GenerateCloudFormationTemplate(in *clideploy.GenerateCloudFormationTemplateInput) (
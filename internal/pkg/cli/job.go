@@ -22,9 +22,12 @@ Jobs are tasks that are triggered by events.`,
 	cmd.AddCommand(buildJobInitCmd())
 	cmd.AddCommand(buildJobListCmd())
 	cmd.AddCommand(buildJobPackageCmd())
+	cmd.AddCommand(buildJobValidateCmd())
 	cmd.AddCommand(buildJobDeployCmd())
 	cmd.AddCommand(buildJobDeleteCmd())
 	cmd.AddCommand(buildJobLogsCmd())
+	cmd.AddCommand(buildJobStatusCmd())
+	cmd.AddCommand(buildJobRunCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -23,6 +23,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -59,6 +60,7 @@ type deleteJobVars struct {
 	skipConfirmation bool
 	name             string
 	envName          string
+	outputFormat     string
 }
 
 type deleteJobOpts struct {
@@ -173,6 +175,11 @@ func (o *deleteJobOpts) Execute() error {
 	// Skip removing the job from the application if
 	// we are only removing the stack from a particular environment.
 	if !o.needsAppCleanup() {
+		event.Publish(event.Output{
+			Application: o.appName,
+			Environment: o.envName,
+			Workload:    o.name,
+		})
 		return nil
 	}
 
@@ -187,6 +194,10 @@ func (o *deleteJobOpts) Execute() error {
 	}
 
 	log.Successf("Deleted job %s from application %s.\n", o.name, o.appName)
+	event.Publish(event.Output{
+		Application: o.appName,
+		Workload:    o.name,
+	})
 
 	return nil
 }
@@ -378,11 +389,13 @@ func buildJobDeleteCmd() *cobra.Command {
   Delete the "report-generator" job without confirmation prompt.
   /code $ copilot job delete --name report-generator --yes`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeleteJobOpts(vars)
-			if err != nil {
-				return err
-			}
-			return run(opts)
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newDeleteJobOpts(vars)
+				if err != nil {
+					return err
+				}
+				return run(opts)
+			})
 		}),
 	}
 
@@ -390,5 +403,6 @@ func buildJobDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
 	return cmd
 }
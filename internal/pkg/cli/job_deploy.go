@@ -21,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -174,6 +175,11 @@ func (o *deployJobOpts) Execute() error {
 		return fmt.Errorf("deploy job %s to environment %s: %w", o.name, o.envName, err)
 	}
 	log.Successf("Deployed %s.\n", color.HighlightUserInput(o.name))
+	event.Publish(event.Output{
+		Application: o.appName,
+		Environment: o.envName,
+		Workload:    o.name,
+	})
 	return nil
 }
 
@@ -279,11 +285,13 @@ func buildJobDeployCmd() *cobra.Command {
   Deploys a job with additional resource tags.
   /code $ copilot job deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newJobDeployOpts(vars)
-			if err != nil {
-				return err
-			}
-			return run(opts)
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newJobDeployOpts(vars)
+				if err != nil {
+					return err
+				}
+				return run(opts)
+			})
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
@@ -291,6 +299,7 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
 
 	return cmd
 }
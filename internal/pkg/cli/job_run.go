@@ -0,0 +1,317 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobRunNamePrompt     = "Which job would you like to run?"
+	jobRunNameHelpPrompt = "Copilot starts an execution of the job's state machine, as if the job was triggered by its schedule."
+
+	jobRunPollInterval = 3 * time.Second
+)
+
+// errJobExecutionFailed is returned when an execution of a job fails or its main container exits with a non-zero code.
+type errJobExecutionFailed struct {
+	job      string
+	status   string
+	exitCode int
+}
+
+func (e *errJobExecutionFailed) Error() string {
+	return fmt.Sprintf("execution of job %s ended with status %s and exit code %d", e.job, e.status, e.exitCode)
+}
+
+// ExitCode returns the exit code of the job's main container, so that the command exits with the same code.
+func (e *errJobExecutionFailed) ExitCode() int {
+	return e.exitCode
+}
+
+type jobRunVars struct {
+	appName string
+	envName string
+	name    string
+	envVars map[string]string
+	command string
+}
+
+type jobRunOpts struct {
+	jobRunVars
+
+	store         store
+	sel           deploySelector
+	sessProvider  *sessions.Provider
+	spinner       progress
+	newJobRunner  func(*session.Session) jobRunner
+	newLogsWriter func(*session.Session) (logEventsWriter, error)
+	// Override in unit test.
+	sleep func(time.Duration)
+}
+
+func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("job run"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	ssmStore := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &jobRunOpts{
+		jobRunVars:   vars,
+		store:        ssmStore,
+		sel:          selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
+		sessProvider: sessProvider,
+		spinner:      termprogress.NewSpinner(log.DiagnosticWriter),
+		newJobRunner: func(s *session.Session) jobRunner {
+			return ecs.New(s)
+		},
+		sleep: time.Sleep,
+	}
+	opts.newLogsWriter = func(s *session.Session) (logEventsWriter, error) {
+		return logging.NewServiceClient(&logging.NewServiceLogsConfig{
+			Sess: s,
+			App:  opts.appName,
+			Env:  opts.envName,
+			Svc:  opts.name,
+		})
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *jobRunOpts) Validate() error {
+	if _, err := shlex.Split(o.command); err != nil {
+		return fmt.Errorf(`split "--%s" %s: %w`, commandFlag, o.command, err)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *jobRunOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskJobEnvName()
+}
+
+// Execute starts an execution of the job, follows the logs of the tasks that it starts and waits for it to stop.
+func (o *jobRunOpts) Execute() error {
+	var command []string
+	if o.command != "" {
+		parsed, err := shlex.Split(o.command)
+		if err != nil {
+			return fmt.Errorf(`split "--%s" %s: %w`, commandFlag, o.command, err)
+		}
+		command = parsed
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+	runner := o.newJobRunner(sess)
+	executionARN, err := runner.StartJob(o.appName, o.envName, o.name, ecs.JobOverrides{
+		Command: command,
+		EnvVars: o.envVars,
+	})
+	if err != nil {
+		return fmt.Errorf("start job %s in environment %s: %w", o.name, o.envName, err)
+	}
+	log.Successf("Started execution %s of job %s in environment %s.\n", color.HighlightResource(executionARN), color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName))
+
+	logsWriter, err := o.newLogsWriter(sess)
+	if err != nil {
+		return err
+	}
+	execution, err := o.followExecution(runner, logsWriter, executionARN)
+	if err != nil {
+		return err
+	}
+	if execution.Task == nil {
+		return fmt.Errorf("execution %s of job %s ended with status %s before starting a task", executionARN, o.name, execution.Status)
+	}
+	exitCode := o.mainContainerExitCode(execution.Task)
+	if execution.IsSucceeded() && exitCode == 0 {
+		log.Successf("Job %s completed successfully.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	if exitCode == 0 {
+		exitCode = 1
+	}
+	return &errJobExecutionFailed{
+		job:      o.name,
+		status:   execution.Status,
+		exitCode: exitCode,
+	}
+}
+
+// followExecution writes the logs of each task started by the execution until the execution stops.
+func (o *jobRunOpts) followExecution(runner jobRunner, logsWriter logEventsWriter, executionARN string) (*ecs.JobExecution, error) {
+	followed := make(map[string]bool)
+	waiting := true
+	o.spinner.Start(fmt.Sprintf("Waiting for execution of job %s to start a task.", o.name))
+	stopWaiting := func() {
+		if waiting {
+			o.spinner.Stop("")
+			waiting = false
+		}
+	}
+	for {
+		execution, err := runner.DescribeJobExecution(executionARN)
+		if err != nil {
+			stopWaiting()
+			return nil, fmt.Errorf("describe execution %s: %w", executionARN, err)
+		}
+		if execution.Task != nil && !followed[aws.StringValue(execution.Task.TaskArn)] {
+			stopWaiting()
+			followed[aws.StringValue(execution.Task.TaskArn)] = true
+			if err := o.followTaskLogs(runner, logsWriter, executionARN, execution.Task); err != nil {
+				return nil, err
+			}
+			// Describe the execution again right away to find out if it retried the task.
+			continue
+		}
+		if !execution.IsRunning() {
+			stopWaiting()
+			return execution, nil
+		}
+		o.sleep(jobRunPollInterval)
+	}
+}
+
+// followTaskLogs writes the logs of the job's main container in the task until the task stops.
+func (o *jobRunOpts) followTaskLogs(runner jobRunner, logsWriter logEventsWriter, executionARN string, task *awsecs.Task) error {
+	taskARN := aws.StringValue(task.TaskArn)
+	taskID, err := awsecs.TaskID(taskARN)
+	if err != nil {
+		return err
+	}
+	var startTime *int64
+	if task.CreatedAt != nil {
+		startTime = aws.Int64(task.CreatedAt.UnixMilli())
+	}
+	log.Infof("Following the logs of task %s.\n", color.HighlightResource(taskID))
+	err = logsWriter.WriteLogEvents(logging.WriteLogEventsOpts{
+		Follow:    true,
+		StartTime: startTime,
+		TaskIDs:   []string{taskID},
+		OnEvents:  logging.WriteHumanLogs,
+		Stopped: func() (bool, error) {
+			execution, err := runner.DescribeJobExecution(executionARN)
+			if err != nil {
+				return false, fmt.Errorf("describe execution %s: %w", executionARN, err)
+			}
+			if !execution.IsRunning() || execution.Task == nil {
+				return true, nil
+			}
+			return aws.StringValue(execution.Task.TaskArn) != taskARN || aws.StringValue(execution.Task.LastStatus) == awsecs.DesiredStatusStopped, nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("write logs of task %s: %w", taskID, err)
+	}
+	return nil
+}
+
+// mainContainerExitCode returns the exit code of the container named after the job, or 0 if it's unknown.
+func (o *jobRunOpts) mainContainerExitCode(task *awsecs.Task) int {
+	for _, container := range task.Containers {
+		if aws.StringValue(container.Name) == o.name {
+			return int(aws.Int64Value(container.ExitCode))
+		}
+	}
+	return 0
+}
+
+func (o *jobRunOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(jobAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *jobRunOpts) validateAndAskJobEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetJob(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	deployedJob, err := o.sel.DeployedJob(jobRunNamePrompt, jobRunNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed job for application %s: %w", o.appName, err)
+	}
+	o.name = deployedJob.Name
+	o.envName = deployedJob.Env
+	return nil
+}
+
+// buildJobRunCmd builds the command for running a deployed job on demand.
+func buildJobRunCmd() *cobra.Command {
+	vars := jobRunVars{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Runs a deployed job once and follows its logs until it completes.",
+		Long: `Runs a deployed job once and follows its logs until it completes.
+The command exits with the exit code of the job's main container.`,
+		Example: `
+  Run the "report" job in the "test" environment.
+  /code $ copilot job run -n report -e test
+  Run the job with an overridden command and an additional environment variable.
+  /code $ copilot job run -n report -e test --command "python report.py --dry-run" --env-vars LOG_LEVEL=debug`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobRunOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.envVars, envVarsFlag, nil, jobRunEnvVarsFlagDescription)
+	cmd.Flags().StringVar(&vars.command, commandFlag, "", jobRunCommandFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
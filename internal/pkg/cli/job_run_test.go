@@ -0,0 +1,312 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type jobRunMocks struct {
+	store      *mocks.Mockstore
+	sel        *mocks.MockdeploySelector
+	runner     *mocks.MockjobRunner
+	logsWriter *mocks.MocklogEventsWriter
+	spinner    *mocks.Mockprogress
+}
+
+func TestJobRun_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inJob string
+
+		setupMocks func(m jobRunMocks)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedJob   string
+		wantedError error
+	}{
+		"validate app env and job with all flags passed in": {
+			inApp: "my-app",
+			inEnv: "test",
+			inJob: "report",
+			setupMocks: func(m jobRunMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.store.EXPECT().GetJob("my-app", "report").Return(&config.Workload{}, nil)
+				m.sel.EXPECT().DeployedJob(jobRunNamePrompt, jobRunNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Name: "report",
+						Env:  "test",
+					}, nil)
+			},
+			wantedApp: "my-app",
+			wantedEnv: "test",
+			wantedJob: "report",
+		},
+		"error if fail to select application": {
+			setupMocks: func(m jobRunMocks) {
+				m.sel.EXPECT().Application(jobAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+		"error if fail to select deployed job": {
+			inApp: "my-app",
+			setupMocks: func(m jobRunMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.sel.EXPECT().DeployedJob(jobRunNamePrompt, jobRunNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed job for application my-app: some error"),
+		},
+		"prompt for job and env": {
+			setupMocks: func(m jobRunMocks) {
+				m.sel.EXPECT().Application(jobAppNamePrompt, svcAppNameHelpPrompt).Return("my-app", nil)
+				m.sel.EXPECT().DeployedJob(jobRunNamePrompt, jobRunNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Name: "report",
+						Env:  "test",
+					}, nil)
+			},
+			wantedApp: "my-app",
+			wantedEnv: "test",
+			wantedJob: "report",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobRunMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &jobRunOpts{
+				jobRunVars: jobRunVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					name:    tc.inJob,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedJob, opts.name)
+		})
+	}
+}
+
+func TestJobRun_Execute(t *testing.T) {
+	const (
+		mockExecutionARN = "arn:aws:states:us-west-2:123456789:execution:my-app-test-report:mockExecution"
+		mockTaskARN      = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
+		mockRetryTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockRetryTaskID"
+	)
+	mockCreatedAt := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	runningExecution := &stepfunctions.Execution{ARN: mockExecutionARN, Status: "RUNNING"}
+	succeededExecution := &stepfunctions.Execution{ARN: mockExecutionARN, Status: "SUCCEEDED"}
+	failedExecution := &stepfunctions.Execution{ARN: mockExecutionARN, Status: "FAILED"}
+	task := func(arn, status string, exitCode *int64) *awsecs.Task {
+		return &awsecs.Task{
+			TaskArn:    aws.String(arn),
+			LastStatus: aws.String(status),
+			CreatedAt:  aws.Time(mockCreatedAt),
+			Containers: []*sdkecs.Container{
+				{
+					Name:     aws.String("report"),
+					ExitCode: exitCode,
+				},
+			},
+		}
+	}
+	followUntilStopped := func(wantedTaskID string) func(opts logging.WriteLogEventsOpts) error {
+		return func(opts logging.WriteLogEventsOpts) error {
+			require.True(t, opts.Follow)
+			require.Equal(t, []string{wantedTaskID}, opts.TaskIDs)
+			require.Equal(t, aws.Int64(mockCreatedAt.UnixMilli()), opts.StartTime)
+			for {
+				stopped, err := opts.Stopped()
+				if err != nil {
+					return err
+				}
+				if stopped {
+					return nil
+				}
+			}
+		}
+	}
+	testCases := map[string]struct {
+		inCommand  string
+		inEnvVars  map[string]string
+		setupMocks func(m jobRunMocks)
+
+		wantedError    error
+		wantedExitCode int
+	}{
+		"error if fail to start the job": {
+			setupMocks: func(m jobRunMocks) {
+				m.runner.EXPECT().StartJob("my-app", "test", "report", ecs.JobOverrides{}).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("start job report in environment test: some error"),
+		},
+		"error if the execution stops before starting a task": {
+			setupMocks: func(m jobRunMocks) {
+				m.runner.EXPECT().StartJob("my-app", "test", "report", ecs.JobOverrides{}).Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.spinner.EXPECT().Stop(gomock.Any())
+				gomock.InOrder(
+					m.runner.EXPECT().DescribeJobExecution(mockExecutionARN).Return(&ecs.JobExecution{Execution: runningExecution}, nil),
+					m.runner.EXPECT().DescribeJobExecution(mockExecutionARN).Return(&ecs.JobExecution{Execution: failedExecution}, nil),
+				)
+			},
+			wantedError: fmt.Errorf("execution %s of job report ended with status FAILED before starting a task", mockExecutionARN),
+		},
+		"error if fail to follow the logs of the task": {
+			setupMocks: func(m jobRunMocks) {
+				m.runner.EXPECT().StartJob("my-app", "test", "report", ecs.JobOverrides{}).Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.runner.EXPECT().DescribeJobExecution(mockExecutionARN).Return(&ecs.JobExecution{
+					Execution: runningExecution,
+					Task:      task(mockTaskARN, "PROVISIONING", nil),
+				}, nil)
+				m.logsWriter.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("write logs of task mockTaskID: some error"),
+		},
+		"exit with the exit code of the main container of the retried task": {
+			setupMocks: func(m jobRunMocks) {
+				m.runner.EXPECT().StartJob("my-app", "test", "report", ecs.JobOverrides{}).Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.spinner.EXPECT().Stop(gomock.Any())
+				gomock.InOrder(
+					m.runner.EXPECT().DescribeJobExecution(mockExecutionARN).Return(&ecs.JobExecution{
+						Execution: runningExecution,
+						Task:      task(mockTaskARN, "RUNNING", nil),
+					}, nil),
+					// The execution retries the task after it stops.
+					m.runner.EXPECT().DescribeJobExecution(mockExecutionARN).Return(&ecs.JobExecution{
+						Execution: runningExecution,
+						Task:      task(mockRetryTaskARN, "PROVISIONING", nil),
+					}, nil),
+					m.runner.EXPECT().DescribeJobExecution(mockExecutionARN).Return(&ecs.JobExecution{
+						Execution: runningExecution,
+						Task:      task(mockRetryTaskARN, "PROVISIONING", nil),
+					}, nil),
+					m.runner.EXPECT().DescribeJobExecution(mockExecutionARN).Return(&ecs.JobExecution{
+						Execution: failedExecution,
+						Task:      task(mockRetryTaskARN, "STOPPED", aws.Int64(3)),
+					}, nil),
+					m.runner.EXPECT().DescribeJobExecution(mockExecutionARN).Return(&ecs.JobExecution{
+						Execution: failedExecution,
+						Task:      task(mockRetryTaskARN, "STOPPED", aws.Int64(3)),
+					}, nil),
+				)
+				gomock.InOrder(
+					m.logsWriter.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(followUntilStopped("mockTaskID")),
+					m.logsWriter.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(followUntilStopped("mockRetryTaskID")),
+				)
+			},
+			wantedError:    errors.New("execution of job report ended with status FAILED and exit code 3"),
+			wantedExitCode: 3,
+		},
+		"success with overrides": {
+			inCommand: `python report.py --title "Monthly report"`,
+			inEnvVars: map[string]string{"LOG_LEVEL": "debug"},
+			setupMocks: func(m jobRunMocks) {
+				m.runner.EXPECT().StartJob("my-app", "test", "report", ecs.JobOverrides{
+					Command: []string{"python", "report.py", "--title", "Monthly report"},
+					EnvVars: map[string]string{"LOG_LEVEL": "debug"},
+				}).Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.spinner.EXPECT().Stop(gomock.Any())
+				gomock.InOrder(
+					m.runner.EXPECT().DescribeJobExecution(mockExecutionARN).Return(&ecs.JobExecution{
+						Execution: runningExecution,
+						Task:      task(mockTaskARN, "RUNNING", nil),
+					}, nil),
+					m.runner.EXPECT().DescribeJobExecution(mockExecutionARN).Return(&ecs.JobExecution{
+						Execution: succeededExecution,
+						Task:      task(mockTaskARN, "STOPPED", aws.Int64(0)),
+					}, nil).Times(2),
+				)
+				m.logsWriter.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(followUntilStopped("mockTaskID"))
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobRunMocks{
+				store:      mocks.NewMockstore(ctrl),
+				runner:     mocks.NewMockjobRunner(ctrl),
+				logsWriter: mocks.NewMocklogEventsWriter(ctrl),
+				spinner:    mocks.NewMockprogress(ctrl),
+			}
+			m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			tc.setupMocks(m)
+			opts := &jobRunOpts{
+				jobRunVars: jobRunVars{
+					appName: "my-app",
+					envName: "test",
+					name:    "report",
+					command: tc.inCommand,
+					envVars: tc.inEnvVars,
+				},
+				store:        m.store,
+				sessProvider: sessions.ImmutableProvider(),
+				spinner:      m.spinner,
+				newJobRunner: func(_ *session.Session) jobRunner {
+					return m.runner
+				},
+				newLogsWriter: func(_ *session.Session) (logEventsWriter, error) {
+					return m.logsWriter, nil
+				},
+				sleep: func(_ time.Duration) {},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.wantedExitCode != 0 {
+				var errExecution *errJobExecutionFailed
+				require.True(t, errors.As(err, &errExecution))
+				require.Equal(t, tc.wantedExitCode, errExecution.ExitCode())
+			}
+		})
+	}
+}
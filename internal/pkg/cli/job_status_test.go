@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	describeMocks "github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobStatus_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inJob string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedJob   string
+		wantedError error
+	}{
+		"validate app env and job with all flags passed in": {
+			inApp: "my-app",
+			inEnv: "test",
+			inJob: "report",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				store.EXPECT().GetJob("my-app", "report").Return(&config.Workload{}, nil)
+				sel.EXPECT().DeployedJob(jobStatusNamePrompt, jobStatusNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Name: "report",
+						Env:  "test",
+					}, nil)
+			},
+			wantedApp: "my-app",
+			wantedEnv: "test",
+			wantedJob: "report",
+		},
+		"error if fail to select application": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				sel.EXPECT().Application(jobAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+		"error if fail to select deployed job": {
+			inApp: "my-app",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				sel.EXPECT().DeployedJob(jobStatusNamePrompt, jobStatusNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed jobs for application my-app: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &jobStatusOpts{
+				jobStatusVars: jobStatusVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					jobName: tc.inJob,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedJob, opts.jobName)
+		})
+	}
+}
+
+func TestJobStatus_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		shouldOutputJSON    bool
+		mockStatusDescriber func(m *mocks.MockstatusDescriber, status *describeMocks.MockHumanJSONStringer)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to describe the status of the job": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, _ *describeMocks.MockHumanJSONStringer) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe status of job report: some error"),
+		},
+		"success with human output": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, status *describeMocks.MockHumanJSONStringer) {
+				m.EXPECT().Describe().Return(status, nil)
+				status.EXPECT().HumanString().Return("Recent Executions\n")
+			},
+			wantedContent: "Recent Executions\n",
+		},
+		"success with json output": {
+			shouldOutputJSON: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, status *describeMocks.MockHumanJSONStringer) {
+				m.EXPECT().Describe().Return(status, nil)
+				status.EXPECT().JSONString().Return("{\"executions\":[]}\n", nil)
+			},
+			wantedContent: "{\"executions\":[]}\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockStatusDescriber := mocks.NewMockstatusDescriber(ctrl)
+			tc.mockStatusDescriber(mockStatusDescriber, describeMocks.NewMockHumanJSONStringer(ctrl))
+
+			opts := &jobStatusOpts{
+				jobStatusVars: jobStatusVars{
+					jobName:          "report",
+					envName:          "test",
+					shouldOutputJSON: tc.shouldOutputJSON,
+					appName:          "my-app",
+				},
+				statusDescriber:     mockStatusDescriber,
+				initStatusDescriber: func(*jobStatusOpts) error { return nil },
+				w:                   b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
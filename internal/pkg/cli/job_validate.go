@@ -0,0 +1,34 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// buildJobValidateCmd builds the command for validating a job's manifest.
+func buildJobValidateCmd() *cobra.Command {
+	vars := validateWkldVars{}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates the manifest of a job.",
+		Long: `Validates the manifest of a job against each environment of the application, without deploying it.
+Every problem found is printed with its line and column in the manifest.
+IAM actions in "permissions" are checked against a catalog bundled with Copilot.
+Actions of services whose actions are not in the catalog are reported as warnings.`,
+		Example: `
+  Validate the manifest of the "report-generator" job.
+  /code $ copilot job validate -n report-generator`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newValidateWkldOpts(wkldKindJob, vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -10,6 +10,7 @@ import (
 	reflect "reflect"
 
 	session "github.com/aws/aws-sdk-go/aws/session"
+	addon "github.com/aws/copilot-cli/internal/pkg/addon"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
@@ -797,6 +798,20 @@ func (mr *MockenvironmentStoreMockRecorder) ListEnvironments(appName interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockenvironmentStore)(nil).ListEnvironments), appName)
 }
 
+// UpdateEnvironment mocks base method.
+func (m *MockenvironmentStore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockenvironmentStoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentStore)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentCreator is a mock of environmentCreator interface.
 type MockenvironmentCreator struct {
 	ctrl     *gomock.Controller
@@ -834,6 +849,43 @@ func (mr *MockenvironmentCreatorMockRecorder) CreateEnvironment(env interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironment", reflect.TypeOf((*MockenvironmentCreator)(nil).CreateEnvironment), env)
 }
 
+// MockenvironmentUpdater is a mock of environmentUpdater interface.
+type MockenvironmentUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentUpdaterMockRecorder
+}
+
+// MockenvironmentUpdaterMockRecorder is the mock recorder for MockenvironmentUpdater.
+type MockenvironmentUpdaterMockRecorder struct {
+	mock *MockenvironmentUpdater
+}
+
+// NewMockenvironmentUpdater creates a new mock instance.
+func NewMockenvironmentUpdater(ctrl *gomock.Controller) *MockenvironmentUpdater {
+	mock := &MockenvironmentUpdater{ctrl: ctrl}
+	mock.recorder = &MockenvironmentUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvironmentUpdater) EXPECT() *MockenvironmentUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateEnvironment mocks base method.
+func (m *MockenvironmentUpdater) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockenvironmentUpdaterMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentUpdater)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentGetter is a mock of environmentGetter interface.
 type MockenvironmentGetter struct {
 	ctrl     *gomock.Controller
@@ -1246,6 +1298,20 @@ func (mr *MockstoreMockRecorder) UpdateApplication(app interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*Mockstore)(nil).UpdateApplication), app)
 }
 
+// UpdateEnvironment mocks base method.
+func (m *Mockstore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockstoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*Mockstore)(nil).UpdateEnvironment), env)
+}
+
 // MockdeployedEnvironmentLister is a mock of deployedEnvironmentLister interface.
 type MockdeployedEnvironmentLister struct {
 	ctrl     *gomock.Controller
@@ -1608,6 +1674,21 @@ func (m *MocklogEventsWriter) EXPECT() *MocklogEventsWriterMockRecorder {
 	return m.recorder
 }
 
+// ExportLogEvents mocks base method.
+func (m *MocklogEventsWriter) ExportLogEvents(opts logging.ExportLogEventsOpts) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportLogEvents", opts)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportLogEvents indicates an expected call of ExportLogEvents.
+func (mr *MocklogEventsWriterMockRecorder) ExportLogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportLogEvents", reflect.TypeOf((*MocklogEventsWriter)(nil).ExportLogEvents), opts)
+}
+
 // WriteLogEvents mocks base method.
 func (m *MocklogEventsWriter) WriteLogEvents(opts logging.WriteLogEventsOpts) error {
 	m.ctrl.T.Helper()
@@ -1622,6 +1703,20 @@ func (mr *MocklogEventsWriterMockRecorder) WriteLogEvents(opts interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteLogEvents", reflect.TypeOf((*MocklogEventsWriter)(nil).WriteLogEvents), opts)
 }
 
+// WriteQueryResults mocks base method.
+func (m *MocklogEventsWriter) WriteQueryResults(opts logging.WriteQueryResultsOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteQueryResults", opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteQueryResults indicates an expected call of WriteQueryResults.
+func (mr *MocklogEventsWriterMockRecorder) WriteQueryResults(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteQueryResults", reflect.TypeOf((*MocklogEventsWriter)(nil).WriteQueryResults), opts)
+}
+
 // Mocktemplater is a mock of templater interface.
 type Mocktemplater struct {
 	ctrl     *gomock.Controller
@@ -1660,6 +1755,112 @@ func (mr *MocktemplaterMockRecorder) Template() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*Mocktemplater)(nil).Template))
 }
 
+// MockaddonsTemplater is a mock of addonsTemplater interface.
+type MockaddonsTemplater struct {
+	ctrl     *gomock.Controller
+	recorder *MockaddonsTemplaterMockRecorder
+}
+
+// MockaddonsTemplaterMockRecorder is the mock recorder for MockaddonsTemplater.
+type MockaddonsTemplaterMockRecorder struct {
+	mock *MockaddonsTemplater
+}
+
+// NewMockaddonsTemplater creates a new mock instance.
+func NewMockaddonsTemplater(ctrl *gomock.Controller) *MockaddonsTemplater {
+	mock := &MockaddonsTemplater{ctrl: ctrl}
+	mock.recorder = &MockaddonsTemplaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaddonsTemplater) EXPECT() *MockaddonsTemplaterMockRecorder {
+	return m.recorder
+}
+
+// Parameters mocks base method.
+func (m *MockaddonsTemplater) Parameters() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parameters")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parameters indicates an expected call of Parameters.
+func (mr *MockaddonsTemplaterMockRecorder) Parameters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parameters", reflect.TypeOf((*MockaddonsTemplater)(nil).Parameters))
+}
+
+// Template mocks base method.
+func (m *MockaddonsTemplater) Template() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockaddonsTemplaterMockRecorder) Template() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockaddonsTemplater)(nil).Template))
+}
+
+// MockaddonsMergeReporter is a mock of addonsMergeReporter interface.
+type MockaddonsMergeReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockaddonsMergeReporterMockRecorder
+}
+
+// MockaddonsMergeReporterMockRecorder is the mock recorder for MockaddonsMergeReporter.
+type MockaddonsMergeReporterMockRecorder struct {
+	mock *MockaddonsMergeReporter
+}
+
+// NewMockaddonsMergeReporter creates a new mock instance.
+func NewMockaddonsMergeReporter(ctrl *gomock.Controller) *MockaddonsMergeReporter {
+	mock := &MockaddonsMergeReporter{ctrl: ctrl}
+	mock.recorder = &MockaddonsMergeReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaddonsMergeReporter) EXPECT() *MockaddonsMergeReporterMockRecorder {
+	return m.recorder
+}
+
+// MergeReport mocks base method.
+func (m *MockaddonsMergeReporter) MergeReport() (*addon.MergeReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeReport")
+	ret0, _ := ret[0].(*addon.MergeReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeReport indicates an expected call of MergeReport.
+func (mr *MockaddonsMergeReporterMockRecorder) MergeReport() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeReport", reflect.TypeOf((*MockaddonsMergeReporter)(nil).MergeReport))
+}
+
+// Template mocks base method.
+func (m *MockaddonsMergeReporter) Template() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockaddonsMergeReporterMockRecorder) Template() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockaddonsMergeReporter)(nil).Template))
+}
+
 // Mockrunner is a mock of runner interface.
 type Mockrunner struct {
 	ctrl     *gomock.Controller
@@ -2140,6 +2341,44 @@ func (mr *MockmanifestReaderMockRecorder) ReadWorkloadManifest(name interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockmanifestReader)(nil).ReadWorkloadManifest), name)
 }
 
+// MockwsEnvironmentReader is a mock of wsEnvironmentReader interface.
+type MockwsEnvironmentReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvironmentReaderMockRecorder
+}
+
+// MockwsEnvironmentReaderMockRecorder is the mock recorder for MockwsEnvironmentReader.
+type MockwsEnvironmentReaderMockRecorder struct {
+	mock *MockwsEnvironmentReader
+}
+
+// NewMockwsEnvironmentReader creates a new mock instance.
+func NewMockwsEnvironmentReader(ctrl *gomock.Controller) *MockwsEnvironmentReader {
+	mock := &MockwsEnvironmentReader{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsEnvironmentReader) EXPECT() *MockwsEnvironmentReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsEnvironmentReader) ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", mftDirName)
+	ret0, _ := ret[0].(workspace.EnvironmentManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsEnvironmentReaderMockRecorder) ReadEnvironmentManifest(mftDirName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvironmentReader)(nil).ReadEnvironmentManifest), mftDirName)
+}
+
 // MockworkspacePathGetter is a mock of workspacePathGetter interface.
 type MockworkspacePathGetter struct {
 	ctrl     *gomock.Controller
@@ -2489,6 +2728,59 @@ func (mr *MockwlListerMockRecorder) ListWorkloads() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwlLister)(nil).ListWorkloads))
 }
 
+// MockwsWlManifestReader is a mock of wsWlManifestReader interface.
+type MockwsWlManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWlManifestReaderMockRecorder
+}
+
+// MockwsWlManifestReaderMockRecorder is the mock recorder for MockwsWlManifestReader.
+type MockwsWlManifestReaderMockRecorder struct {
+	mock *MockwsWlManifestReader
+}
+
+// NewMockwsWlManifestReader creates a new mock instance.
+func NewMockwsWlManifestReader(ctrl *gomock.Controller) *MockwsWlManifestReader {
+	mock := &MockwsWlManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsWlManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWlManifestReader) EXPECT() *MockwsWlManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsWlManifestReader) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsWlManifestReaderMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsWlManifestReader)(nil).ListWorkloads))
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWlManifestReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWlManifestReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWlManifestReader)(nil).ReadWorkloadManifest), name)
+}
+
 // MockwsJobDirReader is a mock of wsJobDirReader interface.
 type MockwsJobDirReader struct {
 	ctrl     *gomock.Controller
@@ -5021,6 +5313,26 @@ func (mr *MockdeploySelectorMockRecorder) Application(prompt, help interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeploySelector)(nil).Application), varargs...)
 }
 
+// DeployedJob mocks base method.
+func (m *MockdeploySelector) DeployedJob(prompt, help, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployedJob", varargs...)
+	ret0, _ := ret[0].(*selector.DeployedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedJob indicates an expected call of DeployedJob.
+func (mr *MockdeploySelectorMockRecorder) DeployedJob(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedJob", reflect.TypeOf((*MockdeploySelector)(nil).DeployedJob), varargs...)
+}
+
 // DeployedService mocks base method.
 func (m *MockdeploySelector) DeployedService(prompt, help, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedService, error) {
 	m.ctrl.T.Helper()
@@ -5041,6 +5353,26 @@ func (mr *MockdeploySelectorMockRecorder) DeployedService(prompt, help, app inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedService", reflect.TypeOf((*MockdeploySelector)(nil).DeployedService), varargs...)
 }
 
+// Environment mocks base method.
+func (m *MockdeploySelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Environment", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environment indicates an expected call of Environment.
+func (mr *MockdeploySelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockdeploySelector)(nil).Environment), varargs...)
+}
+
 // MockpipelineEnvSelector is a mock of pipelineEnvSelector interface.
 type MockpipelineEnvSelector struct {
 	ctrl     *gomock.Controller
@@ -5804,6 +6136,149 @@ func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
 }
 
+// MockjobRunner is a mock of jobRunner interface.
+type MockjobRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobRunnerMockRecorder
+}
+
+// MockjobRunnerMockRecorder is the mock recorder for MockjobRunner.
+type MockjobRunnerMockRecorder struct {
+	mock *MockjobRunner
+}
+
+// NewMockjobRunner creates a new mock instance.
+func NewMockjobRunner(ctrl *gomock.Controller) *MockjobRunner {
+	mock := &MockjobRunner{ctrl: ctrl}
+	mock.recorder = &MockjobRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobRunner) EXPECT() *MockjobRunnerMockRecorder {
+	return m.recorder
+}
+
+// DescribeJobExecution mocks base method.
+func (m *MockjobRunner) DescribeJobExecution(executionARN string) (*ecs0.JobExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeJobExecution", executionARN)
+	ret0, _ := ret[0].(*ecs0.JobExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeJobExecution indicates an expected call of DescribeJobExecution.
+func (mr *MockjobRunnerMockRecorder) DescribeJobExecution(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeJobExecution", reflect.TypeOf((*MockjobRunner)(nil).DescribeJobExecution), executionARN)
+}
+
+// StartJob mocks base method.
+func (m *MockjobRunner) StartJob(app, env, job string, overrides ecs0.JobOverrides) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartJob", app, env, job, overrides)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartJob indicates an expected call of StartJob.
+func (mr *MockjobRunnerMockRecorder) StartJob(app, env, job, overrides interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartJob", reflect.TypeOf((*MockjobRunner)(nil).StartJob), app, env, job, overrides)
+}
+
+// MockfileCopier is a mock of fileCopier interface.
+type MockfileCopier struct {
+	ctrl     *gomock.Controller
+	recorder *MockfileCopierMockRecorder
+}
+
+// MockfileCopierMockRecorder is the mock recorder for MockfileCopier.
+type MockfileCopierMockRecorder struct {
+	mock *MockfileCopier
+}
+
+// NewMockfileCopier creates a new mock instance.
+func NewMockfileCopier(ctrl *gomock.Controller) *MockfileCopier {
+	mock := &MockfileCopier{ctrl: ctrl}
+	mock.recorder = &MockfileCopierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfileCopier) EXPECT() *MockfileCopierMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockfileCopier) Download(remotePath, localPath string) (*ecs0.CopyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", remotePath, localPath)
+	ret0, _ := ret[0].(*ecs0.CopyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockfileCopierMockRecorder) Download(remotePath, localPath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockfileCopier)(nil).Download), remotePath, localPath)
+}
+
+// Upload mocks base method.
+func (m *MockfileCopier) Upload(localPath, remotePath string) (*ecs0.CopyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", localPath, remotePath)
+	ret0, _ := ret[0].(*ecs0.CopyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockfileCopierMockRecorder) Upload(localPath, remotePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockfileCopier)(nil).Upload), localPath, remotePath)
+}
+
+// MockecsPortForwarder is a mock of ecsPortForwarder interface.
+type MockecsPortForwarder struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsPortForwarderMockRecorder
+}
+
+// MockecsPortForwarderMockRecorder is the mock recorder for MockecsPortForwarder.
+type MockecsPortForwarderMockRecorder struct {
+	mock *MockecsPortForwarder
+}
+
+// NewMockecsPortForwarder creates a new mock instance.
+func NewMockecsPortForwarder(ctrl *gomock.Controller) *MockecsPortForwarder {
+	mock := &MockecsPortForwarder{ctrl: ctrl}
+	mock.recorder = &MockecsPortForwarderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsPortForwarder) EXPECT() *MockecsPortForwarderMockRecorder {
+	return m.recorder
+}
+
+// StartPortForwardingSession mocks base method.
+func (m *MockecsPortForwarder) StartPortForwardingSession(in ssm.PortForwardingSessionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPortForwardingSession", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartPortForwardingSession indicates an expected call of StartPortForwardingSession.
+func (mr *MockecsPortForwarderMockRecorder) StartPortForwardingSession(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPortForwardingSession", reflect.TypeOf((*MockecsPortForwarder)(nil).StartPortForwardingSession), in)
+}
+
 // MockssmPluginManager is a mock of ssmPluginManager interface.
 type MockssmPluginManager struct {
 	ctrl     *gomock.Controller
@@ -6195,6 +6670,137 @@ func (mr *MockdockerEngineMockRecorder) GetPlatform() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatform", reflect.TypeOf((*MockdockerEngine)(nil).GetPlatform))
 }
 
+// MocklocalContainerRunner is a mock of localContainerRunner interface.
+type MocklocalContainerRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MocklocalContainerRunnerMockRecorder
+}
+
+// MocklocalContainerRunnerMockRecorder is the mock recorder for MocklocalContainerRunner.
+type MocklocalContainerRunnerMockRecorder struct {
+	mock *MocklocalContainerRunner
+}
+
+// NewMocklocalContainerRunner creates a new mock instance.
+func NewMocklocalContainerRunner(ctrl *gomock.Controller) *MocklocalContainerRunner {
+	mock := &MocklocalContainerRunner{ctrl: ctrl}
+	mock.recorder = &MocklocalContainerRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklocalContainerRunner) EXPECT() *MocklocalContainerRunnerMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MocklocalContainerRunner) Build(args *dockerengine.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Build indicates an expected call of Build.
+func (mr *MocklocalContainerRunnerMockRecorder) Build(args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MocklocalContainerRunner)(nil).Build), args)
+}
+
+// CreateNetwork mocks base method.
+func (m *MocklocalContainerRunner) CreateNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MocklocalContainerRunnerMockRecorder) CreateNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MocklocalContainerRunner)(nil).CreateNetwork), name)
+}
+
+// RemoveContainer mocks base method.
+func (m *MocklocalContainerRunner) RemoveContainer(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveContainer", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveContainer indicates an expected call of RemoveContainer.
+func (mr *MocklocalContainerRunnerMockRecorder) RemoveContainer(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveContainer", reflect.TypeOf((*MocklocalContainerRunner)(nil).RemoveContainer), name)
+}
+
+// RemoveNetwork mocks base method.
+func (m *MocklocalContainerRunner) RemoveNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNetwork indicates an expected call of RemoveNetwork.
+func (mr *MocklocalContainerRunnerMockRecorder) RemoveNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetwork", reflect.TypeOf((*MocklocalContainerRunner)(nil).RemoveNetwork), name)
+}
+
+// Run mocks base method.
+func (m *MocklocalContainerRunner) Run(options *dockerengine.RunOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MocklocalContainerRunnerMockRecorder) Run(options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocklocalContainerRunner)(nil).Run), options)
+}
+
+// MocksecretGetter is a mock of secretGetter interface.
+type MocksecretGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretGetterMockRecorder
+}
+
+// MocksecretGetterMockRecorder is the mock recorder for MocksecretGetter.
+type MocksecretGetterMockRecorder struct {
+	mock *MocksecretGetter
+}
+
+// NewMocksecretGetter creates a new mock instance.
+func NewMocksecretGetter(ctrl *gomock.Controller) *MocksecretGetter {
+	mock := &MocksecretGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretGetter) EXPECT() *MocksecretGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSecretValue mocks base method.
+func (m *MocksecretGetter) GetSecretValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretValue", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretValue indicates an expected call of GetSecretValue.
+func (mr *MocksecretGetterMockRecorder) GetSecretValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MocksecretGetter)(nil).GetSecretValue), name)
+}
+
 // Mockcodestar is a mock of codestar interface.
 type Mockcodestar struct {
 	ctrl     *gomock.Controller
@@ -6346,6 +6952,126 @@ func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
 }
 
+// MocksecretStore is a mock of secretStore interface.
+type MocksecretStore struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretStoreMockRecorder
+}
+
+// MocksecretStoreMockRecorder is the mock recorder for MocksecretStore.
+type MocksecretStoreMockRecorder struct {
+	mock *MocksecretStore
+}
+
+// NewMocksecretStore creates a new mock instance.
+func NewMocksecretStore(ctrl *gomock.Controller) *MocksecretStore {
+	mock := &MocksecretStore{ctrl: ctrl}
+	mock.recorder = &MocksecretStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretStore) EXPECT() *MocksecretStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecret mocks base method.
+func (m *MocksecretStore) DeleteSecret(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MocksecretStoreMockRecorder) DeleteSecret(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MocksecretStore)(nil).DeleteSecret), name)
+}
+
+// DescribeSecret mocks base method.
+func (m *MocksecretStore) DescribeSecret(name string) (*ssm.SecretMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSecret", name)
+	ret0, _ := ret[0].(*ssm.SecretMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSecret indicates an expected call of DescribeSecret.
+func (mr *MocksecretStoreMockRecorder) DescribeSecret(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecret", reflect.TypeOf((*MocksecretStore)(nil).DescribeSecret), name)
+}
+
+// ListSecrets mocks base method.
+func (m *MocksecretStore) ListSecrets(path string) ([]ssm.SecretMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", path)
+	ret0, _ := ret[0].([]ssm.SecretMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MocksecretStoreMockRecorder) ListSecrets(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MocksecretStore)(nil).ListSecrets), path)
+}
+
+// PutSecret mocks base method.
+func (m *MocksecretStore) PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(*ssm.PutSecretOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MocksecretStoreMockRecorder) PutSecret(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretStore)(nil).PutSecret), in)
+}
+
+// MocksecretConsumerFinder is a mock of secretConsumerFinder interface.
+type MocksecretConsumerFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretConsumerFinderMockRecorder
+}
+
+// MocksecretConsumerFinderMockRecorder is the mock recorder for MocksecretConsumerFinder.
+type MocksecretConsumerFinderMockRecorder struct {
+	mock *MocksecretConsumerFinder
+}
+
+// NewMocksecretConsumerFinder creates a new mock instance.
+func NewMocksecretConsumerFinder(ctrl *gomock.Controller) *MocksecretConsumerFinder {
+	mock := &MocksecretConsumerFinder{ctrl: ctrl}
+	mock.recorder = &MocksecretConsumerFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretConsumerFinder) EXPECT() *MocksecretConsumerFinderMockRecorder {
+	return m.recorder
+}
+
+// Consumers mocks base method.
+func (m *MocksecretConsumerFinder) Consumers(app, env, paramName string) ([]*describe.SecretConsumer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consumers", app, env, paramName)
+	ret0, _ := ret[0].([]*describe.SecretConsumer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consumers indicates an expected call of Consumers.
+func (mr *MocksecretConsumerFinderMockRecorder) Consumers(app, env, paramName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consumers", reflect.TypeOf((*MocksecretConsumerFinder)(nil).Consumers), app, env, paramName)
+}
+
 // MockservicePauser is a mock of servicePauser interface.
 type MockservicePauser struct {
 	ctrl     *gomock.Controller
@@ -6474,6 +7200,74 @@ func (mr *MockworkloadDeployerMockRecorder) UploadArtifacts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadDeployer)(nil).UploadArtifacts))
 }
 
+// MockworkloadDeployDiffer is a mock of workloadDeployDiffer interface.
+type MockworkloadDeployDiffer struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadDeployDifferMockRecorder
+}
+
+// MockworkloadDeployDifferMockRecorder is the mock recorder for MockworkloadDeployDiffer.
+type MockworkloadDeployDifferMockRecorder struct {
+	mock *MockworkloadDeployDiffer
+}
+
+// NewMockworkloadDeployDiffer creates a new mock instance.
+func NewMockworkloadDeployDiffer(ctrl *gomock.Controller) *MockworkloadDeployDiffer {
+	mock := &MockworkloadDeployDiffer{ctrl: ctrl}
+	mock.recorder = &MockworkloadDeployDifferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadDeployDiffer) EXPECT() *MockworkloadDeployDifferMockRecorder {
+	return m.recorder
+}
+
+// DeployDiff mocks base method.
+func (m *MockworkloadDeployDiffer) DeployDiff(in *deploy.StackRuntimeConfiguration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployDiff", in)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MockworkloadDeployDifferMockRecorder) DeployDiff(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadDeployDiffer)(nil).DeployDiff), in)
+}
+
+// DeployWorkload mocks base method.
+func (m *MockworkloadDeployDiffer) DeployWorkload(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployWorkload", in)
+	ret0, _ := ret[0].(deploy.ActionRecommender)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployWorkload indicates an expected call of DeployWorkload.
+func (mr *MockworkloadDeployDifferMockRecorder) DeployWorkload(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployWorkload", reflect.TypeOf((*MockworkloadDeployDiffer)(nil).DeployWorkload), in)
+}
+
+// UploadArtifacts mocks base method.
+func (m *MockworkloadDeployDiffer) UploadArtifacts() (*deploy.UploadArtifactsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadArtifacts")
+	ret0, _ := ret[0].(*deploy.UploadArtifactsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadArtifacts indicates an expected call of UploadArtifacts.
+func (mr *MockworkloadDeployDifferMockRecorder) UploadArtifacts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadDeployDiffer)(nil).UploadArtifacts))
+}
+
 // MockworkloadTemplateGenerator is a mock of workloadTemplateGenerator interface.
 type MockworkloadTemplateGenerator struct {
 	ctrl     *gomock.Controller
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -44,6 +45,7 @@ type deletePipelineVars struct {
 	name               string
 	skipConfirmation   bool
 	shouldDeleteSecret bool
+	outputFormat       string
 }
 
 type deletePipelineOpts struct {
@@ -140,7 +142,12 @@ func (o *deletePipelineOpts) Execute() error {
 	if err := o.deleteStack(); err != nil {
 		return err
 	}
-
+	event.Publish(event.Output{
+		Application: o.appName,
+		Values: map[string]string{
+			"pipeline": o.name,
+		},
+	})
 	return nil
 }
 
@@ -227,16 +234,19 @@ func buildPipelineDeleteCmd() *cobra.Command {
   /code $ copilot pipeline delete
 `,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeletePipelineOpts(vars)
-			if err != nil {
-				return err
-			}
-			return run(opts)
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newDeletePipelineOpts(vars)
+				if err != nil {
+					return err
+				}
+				return run(opts)
+			})
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldDeleteSecret, deleteSecretFlag, false, deleteSecretFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
 	return cmd
 }
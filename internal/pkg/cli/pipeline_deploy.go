@@ -22,6 +22,7 @@ import (
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -53,10 +54,16 @@ const (
 
 const connectionsURL = "https://console.aws.amazon.com/codesuite/settings/connections"
 
+const (
+	fmtPipelineSourceObjectKey    = "%s/source.zip"    // Ex: "pipeline-appName-repoName/source.zip"
+	fmtPipelineWebhookTokenSecret = "%s-webhook-token" // Ex: "pipeline-appName-repoName-webhook-token"
+)
+
 type deployPipelineVars struct {
 	appName          string
 	name             string
 	skipConfirmation bool
+	outputFormat     string
 }
 
 type deployPipelineOpts struct {
@@ -78,6 +85,7 @@ type deployPipelineOpts struct {
 	wsAppName                    string
 	pipeline                     *workspace.PipelineManifest
 	shouldPromptUpdateConnection bool
+	usesWebhook                  bool
 	pipelineMft                  *manifest.Pipeline
 	svcBuffer                    *bytes.Buffer
 	jobBuffer                    *bytes.Buffer
@@ -204,6 +212,12 @@ func (o *deployPipelineOpts) Execute() error {
 		return fmt.Errorf("read source from manifest: %w", err)
 	}
 	o.shouldPromptUpdateConnection = bool
+	if s3Source, ok := source.(*deploy.S3Source); ok {
+		if err := o.setS3SourceDefaults(s3Source, pipeline.Name); err != nil {
+			return err
+		}
+		o.usesWebhook = true
+	}
 
 	// convert environments to deployment stages
 	stages, err := o.convertStages(pipeline.Stages)
@@ -230,7 +244,12 @@ func (o *deployPipelineOpts) Execute() error {
 	if err := o.deployPipeline(deployPipelineInput); err != nil {
 		return err
 	}
-
+	event.Publish(event.Output{
+		Application: o.appName,
+		Values: map[string]string{
+			"pipeline": pipeline.Name,
+		},
+	})
 	return nil
 }
 
@@ -292,8 +311,17 @@ func (o *deployPipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 			return nil, fmt.Errorf("get environment %s in application %s: %w", stage.Name, o.appName, err)
 		}
 
+		stageWorkloads := workloads
+		if len(stage.Deployments) != 0 {
+			for _, name := range stage.Deployments {
+				if !contains(name, workloads) {
+					return nil, fmt.Errorf("workload %s to deploy in stage %s is not in the workspace", name, stage.Name)
+				}
+			}
+			stageWorkloads = stage.Deployments
+		}
 		pipelineStage := deploy.PipelineStage{
-			LocalWorkloads: workloads,
+			LocalWorkloads: stageWorkloads,
 			AssociatedEnvironment: &deploy.AssociatedEnvironment{
 				Name:      stage.Name,
 				Region:    env.Region,
@@ -301,6 +329,8 @@ func (o *deployPipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 			},
 			RequiresApproval: stage.RequiresApproval,
 			TestCommands:     stage.TestCommands,
+			PreDeployments:   deploy.PipelineActionsFromManifest(stage.PreDeployments),
+			PostDeployments:  deploy.PipelineActionsFromManifest(stage.PostDeployments),
 		}
 		stages = append(stages, pipelineStage)
 	}
@@ -358,6 +388,22 @@ func (o *deployPipelineOpts) getBucketName() (string, error) {
 	return resources.S3Bucket, nil
 }
 
+// setS3SourceDefaults packages the source code into the versioned artifact bucket of the pipeline's region
+// unless the manifest specifies another location.
+func (o *deployPipelineOpts) setS3SourceDefaults(source *deploy.S3Source, pipelineName string) error {
+	if source.Bucket == "" {
+		bucketName, err := o.getBucketName()
+		if err != nil {
+			return fmt.Errorf("get bucket name: %w", err)
+		}
+		source.Bucket = bucketName
+	}
+	if source.ObjectKey == "" {
+		source.ObjectKey = fmt.Sprintf(fmtPipelineSourceObjectKey, pipelineName)
+	}
+	return nil
+}
+
 func (o *deployPipelineOpts) shouldUpdate() (bool, error) {
 	if o.skipConfirmation {
 		return true, nil
@@ -430,10 +476,16 @@ func (o *deployPipelineOpts) deployPipeline(in *deploy.CreatePipelineInput) erro
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deployPipelineOpts) RecommendedActions() []string {
-	return []string{
+	var actions []string
+	if o.usesWebhook {
+		actions = append(actions, fmt.Sprintf("Register the %s output of stack %s as a push webhook of your repository, and send the token stored in secret %s with the %s header.",
+			color.HighlightResource("SourceWebhookURL"), color.HighlightUserInput(o.pipeline.Name),
+			color.HighlightUserInput(fmt.Sprintf(fmtPipelineWebhookTokenSecret, o.pipeline.Name)), color.HighlightCode("X-Copilot-Token")))
+	}
+	return append(actions,
 		fmt.Sprintf("Run %s to see the state of your pipeline.", color.HighlightCode("copilot pipeline status")),
 		fmt.Sprintf("Run %s for info about your pipeline.", color.HighlightCode("copilot pipeline show")),
-	}
+	)
 }
 
 // BuildPipelineDeployCmd build the command for deploying a new pipeline or updating an existing pipeline.
@@ -449,15 +501,18 @@ func buildPipelineDeployCmd() *cobra.Command {
   /code $ copilot pipeline deploy
 `,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeployPipelineOpts(vars)
-			if err != nil {
-				return err
-			}
-			return run(opts)
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newDeployPipelineOpts(vars)
+				if err != nil {
+					return err
+				}
+				return run(opts)
+			})
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
 	return cmd
 }
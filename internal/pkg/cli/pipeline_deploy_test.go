@@ -585,6 +585,63 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 
 			expectedError: fmt.Errorf("update pipeline: some error"),
 		},
+		"create and deploy pipeline with a source packaged into the artifact bucket by a webhook": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m deployPipelineMocks) {
+				mockPipelineManifest := &manifest.Pipeline{
+					Name:    "pipepiper",
+					Version: 1,
+					Source: &manifest.Source{
+						ProviderName: "S3",
+						Properties: map[string]interface{}{
+							"repository": "https://git.example.com/aws/somethingCool.git",
+							"branch":     "main",
+						},
+					},
+					Stages: []manifest.PipelineStage{
+						{
+							Name: "chicken",
+						},
+					},
+				}
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployResourcesComplete, appName)).Times(1),
+					m.ws.EXPECT().PipelineManifestLegacyPath().Return(pipelineManifestLegacyPath, nil),
+					m.ws.EXPECT().ReadPipelineManifest(pipelineManifestLegacyPath).Return(mockPipelineManifest, nil),
+
+					// setS3SourceDefaults
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.actionCmd.EXPECT().Execute().Times(2),
+
+					// convertStages
+					m.store.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+
+					// deployPipeline
+					m.deployer.EXPECT().PipelineExists(gomock.Any()).Return(false, nil),
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineDeployStart, pipelineName)).Times(1),
+					m.deployer.EXPECT().CreatePipeline(gomock.Any(), gomock.Any()).DoAndReturn(func(in *deploy.CreatePipelineInput, _ string) error {
+						require.Equal(t, &deploy.S3Source{
+							ProviderName:  "S3",
+							RepositoryURL: "https://git.example.com/aws/somethingCool.git",
+							Branch:        "main",
+							Bucket:        "someOtherBucket",
+							ObjectKey:     "pipepiper/source.zip",
+						}, in.Source)
+						return nil
+					}),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineDeployComplete, pipelineName)).Times(1),
+				)
+			},
+			expectedError: nil,
+		},
 		"update and deploy pipeline with specifying build property": {
 			inApp:     &app,
 			inAppName: appName,
@@ -820,6 +877,85 @@ func TestDeployPipelineOpts_convertStages(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"converts stages with selected deployments and actions": {
+			stages: []manifest.PipelineStage{
+				{
+					Name:        "test",
+					Deployments: []string{"backend"},
+					PreDeployments: []manifest.PipelineAction{
+						{
+							Name: "migrate",
+							Build: &manifest.ActionBuild{
+								Buildspec: "copilot/pipelines/migrate.yml",
+							},
+						},
+					},
+					PostDeployments: []manifest.PipelineAction{
+						{
+							Name: "report",
+							Job:  "report-generator",
+						},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m deployPipelineMocks) {
+				mockEnv := &config.Environment{
+					Name:      "test",
+					App:       "badgoose",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}
+				gomock.InOrder(
+					m.actionCmd.EXPECT().Execute().Times(2),
+					m.store.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalWorkloads: []string{"backend"},
+					PreDeployments: []deploy.PipelineAction{
+						{
+							Name: "migrate",
+							Build: &deploy.ActionBuild{
+								Image:           "aws/codebuild/amazonlinux2-x86_64-standard:3.0",
+								EnvironmentType: "LINUX_CONTAINER",
+								Buildspec:       "copilot/pipelines/migrate.yml",
+							},
+						},
+					},
+					PostDeployments: []deploy.PipelineAction{
+						{
+							Name:    "report",
+							JobName: "report-generator",
+						},
+					},
+				},
+			},
+		},
+		"returns an error if a stage deploys a workload that is not in the workspace": {
+			stages: []manifest.PipelineStage{
+				{
+					Name:        "test",
+					Deployments: []string{"api"},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m deployPipelineMocks) {
+				gomock.InOrder(
+					m.actionCmd.EXPECT().Execute().Times(2),
+					m.store.EXPECT().GetEnvironment("badgoose", "test").Return(&config.Environment{Name: "test"}, nil).Times(1),
+				)
+			},
+
+			expectedError: errors.New("workload api to deploy in stage test is not in the workspace"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -49,6 +49,10 @@ const (
 	pipelineSelectURLHelpPrompt = `The repository linked to your pipeline.
 Pushing to this repository will trigger your pipeline build stage.
 Please enter full repository URL, e.g. "https://github.com/myCompany/myRepo", or the owner/rep, e.g. "myCompany/myRepo"`
+
+	fmtPipelineWebhookPrompt  = "Repository %s isn't hosted by a supported provider. Would you like to trigger your pipeline with a webhook instead?"
+	pipelineWebhookHelpPrompt = `A webhook packages the source code of your repository into an S3 bucket, which triggers your pipeline.
+After running "copilot pipeline deploy", register the webhook URL with your Git host so that it's invoked on every push.`
 )
 
 const (
@@ -66,6 +70,9 @@ const (
 	// For a Bitbucket repository.
 	bbURL        = "bitbucket.org"
 	fmtBBRepoURL = "https://%s/%s/%s" // Ex: "https://bitbucket.org/repoOwner/repoName"
+	// For a GitLab repository.
+	glURL        = "gitlab.com"
+	fmtGLRepoURL = "https://%s/%s/%s" // Ex: "https://gitlab.com/repoOwner/repoName"
 )
 
 var (
@@ -76,6 +83,13 @@ var (
 // Pipeline init errors.
 var (
 	fmtErrInvalidPipelineProvider = "repository %s must be from a supported provider: %s"
+	fmtErrSelfManagedGitLab       = "repository %s must be hosted on %s: self-managed GitLab instances are not supported"
+)
+
+var (
+	// Git repositories hosted elsewhere can trigger the pipeline through a webhook.
+	// Ex: "https://git.example.com/repoOwner/repoName.git", "ssh://git@git.example.com/repoOwner/repoName.git", "git@git.example.com:repoOwner/repoName.git"
+	gitURLRegexp = regexp.MustCompile(`^((https?|ssh|git)://[^/\s]+/|[\w.-]+@[\w.-]+:)[^\s]+$`)
 )
 
 type initPipelineVars struct {
@@ -100,11 +114,12 @@ type initPipelineOpts struct {
 	sel            pipelineEnvSelector
 
 	// Outputs stored on successful actions.
-	secret    string
-	provider  string
-	repoName  string
-	repoOwner string
-	ccRegion  string
+	secret     string
+	provider   string
+	repoName   string
+	repoOwner  string
+	ccRegion   string
+	useWebhook bool
 
 	// Cached variables
 	wsAppName  string
@@ -227,12 +242,41 @@ func (o *initPipelineOpts) RequiredActions() []string {
 func (o *initPipelineOpts) validateURL(url string) error {
 	// Note: no longer calling `validateDomainName` because if users use git-remote-codecommit
 	// (the HTTPS (GRC) protocol) to connect to CodeCommit, the url does not have any periods.
-	if !strings.Contains(url, githubURL) && !strings.Contains(url, ccIdentifier) && !strings.Contains(url, bbURL) {
-		return fmt.Errorf(fmtErrInvalidPipelineProvider, url, english.WordSeries(manifest.PipelineProviders, "or"))
+	if isSupportedRepoURL(url) {
+		return nil
+	}
+	if !gitURLRegexp.MatchString(url) {
+		return errUnsupportedRepoURL(url)
 	}
+	useWebhook, err := o.prompt.Confirm(fmt.Sprintf(fmtPipelineWebhookPrompt, color.HighlightUserInput(url)), pipelineWebhookHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("confirm webhook for repository %s: %w", url, err)
+	}
+	if !useWebhook {
+		return errUnsupportedRepoURL(url)
+	}
+	o.useWebhook = true
 	return nil
 }
 
+// isSupportedRepoURL returns true if the repository is hosted by one of the pipeline providers.
+func isSupportedRepoURL(url string) bool {
+	return strings.Contains(url, githubURL) || strings.Contains(url, ccIdentifier) || strings.Contains(url, bbURL) || isGitLabURL(url)
+}
+
+// isGitLabURL returns true if the repository is hosted on gitlab.com, as opposed to a self-managed GitLab instance.
+func isGitLabURL(url string) bool {
+	return strings.Contains(url, glURL+"/") || strings.Contains(url, glURL+":")
+}
+
+// errUnsupportedRepoURL returns the error for a repository that isn't hosted by one of the pipeline providers.
+func errUnsupportedRepoURL(url string) error {
+	if strings.Contains(url, "gitlab") {
+		return fmt.Errorf(fmtErrSelfManagedGitLab, url, glURL)
+	}
+	return fmt.Errorf(fmtErrInvalidPipelineProvider, url, english.WordSeries(manifest.PipelineProviders, "or"))
+}
+
 // To avoid duplicating calls to GetEnvironment, validate and get config in the same step.
 func (o *initPipelineOpts) validateEnvs() error {
 	var envConfigs []*config.Environment
@@ -276,8 +320,12 @@ func (o *initPipelineOpts) parseRepoDetails() error {
 		return o.parseCodeCommitRepoDetails()
 	case strings.Contains(o.repoURL, bbURL):
 		return o.parseBitbucketRepoDetails()
+	case isGitLabURL(o.repoURL):
+		return o.parseGitLabRepoDetails()
+	case o.useWebhook:
+		return o.parseWebhookRepoDetails()
 	default:
-		return fmt.Errorf(fmtErrInvalidPipelineProvider, o.repoURL, english.WordSeries(manifest.PipelineProviders, "or"))
+		return errUnsupportedRepoURL(o.repoURL)
 	}
 }
 
@@ -353,6 +401,32 @@ func (o *initPipelineOpts) parseBitbucketRepoDetails() error {
 	return nil
 }
 
+func (o *initPipelineOpts) parseGitLabRepoDetails() error {
+	o.provider = manifest.GitLabProviderName
+	repoDetails, err := glRepoURL(o.repoURL).parse()
+	if err != nil {
+		return err
+	}
+	o.repoName = repoDetails.name
+	o.repoOwner = repoDetails.owner
+
+	return nil
+}
+
+func (o *initPipelineOpts) parseWebhookRepoDetails() error {
+	o.provider = manifest.S3ProviderName
+	// Rather than check for the SCP-like syntax, split on colon first; other URLs will be unaffected.
+	splitURL := strings.Split(strings.TrimSuffix(strings.TrimSuffix(o.repoURL, "/"), ".git"), ":")
+	segments := strings.Split(splitURL[len(splitURL)-1], "/")
+	repoName := segments[len(segments)-1]
+	if repoName == "" {
+		return fmt.Errorf("unable to parse the repository name from %s", o.repoURL)
+	}
+	o.repoName = repoName
+
+	return nil
+}
+
 func (o *initPipelineOpts) selectURL() error {
 	// Fetches and parses all remote repositories.
 	err := o.runner.Run("git", []string{"remote", "-v"}, exec.Stdout(&o.buffer))
@@ -400,6 +474,8 @@ You may make changes in the generated pipeline manifest before deployment.
 // ssh		ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (push)
 // bbhttps	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (fetch)
 // bbssh	ssh://git@bitbucket.org:teamsinspace/documentation-tests.git (fetch)
+// glhttps	https://gitlab.com/huanjani/aws-copilot-sample-service.git (fetch)
+// glssh	git@gitlab.com:huanjani/subgroup/aws-copilot-sample-service.git (fetch)
 
 // parseGitRemoteResults returns just the trimmed middle column (url) of the `git remote -v` results,
 // and skips urls from unsupported sources.
@@ -408,7 +484,7 @@ func (o *initPipelineOpts) parseGitRemoteResult(s string) ([]string, error) {
 	urlSet := make(map[string]bool)
 	items := strings.Split(s, "\n")
 	for _, item := range items {
-		if !strings.Contains(item, githubURL) && !strings.Contains(item, ccIdentifier) && !strings.Contains(item, bbURL) {
+		if !isSupportedRepoURL(item) {
 			continue
 		}
 		cols := strings.Split(item, "\t")
@@ -438,6 +514,12 @@ type bbRepoDetails struct {
 	owner string
 }
 
+type glRepoURL string
+type glRepoDetails struct {
+	name  string
+	owner string // The namespace of the repository, which may include subgroups.
+}
+
 func (url ghRepoURL) parse() (ghRepoDetails, error) {
 	urlString := string(url)
 	regexPattern := regexp.MustCompile(`.*(github.com)(:|\/)`)
@@ -510,6 +592,24 @@ func (url bbRepoURL) parse() (bbRepoDetails, error) {
 	}, nil
 }
 
+// GitLab URLs, post-parseGitRemoteResults(), may look like:
+// https://gitlab.com/huanjani/aws-copilot-sample-service
+// git@gitlab.com:huanjani/subgroup/aws-copilot-sample-service
+func (url glRepoURL) parse() (glRepoDetails, error) {
+	urlString := string(url)
+	regexPattern := regexp.MustCompile(`.*(gitlab.com)(:|\/)`)
+	parsedURL := strings.TrimPrefix(urlString, regexPattern.FindString(urlString))
+	parsedURL = strings.TrimSuffix(parsedURL, ".git")
+	splitURL := strings.Split(parsedURL, "/")
+	if len(splitURL) < 2 || splitURL[len(splitURL)-1] == "" {
+		return glRepoDetails{}, fmt.Errorf("unable to parse the GitLab repository owner and name from %s: please pass the repository URL with the format `--url https://gitlab.com/{owner}/{repositoryName}`", url)
+	}
+	return glRepoDetails{
+		name:  splitURL[len(splitURL)-1],
+		owner: strings.Join(splitURL[:len(splitURL)-1], "/"),
+	}, nil
+}
+
 func (o *initPipelineOpts) storeGitHubAccessToken() error {
 	secretName := o.secretName()
 	_, err := o.secretsmanager.CreateSecret(secretName, o.githubAccessToken)
@@ -656,6 +756,16 @@ func (o *initPipelineOpts) pipelineProvider() (manifest.Provider, error) {
 			RepositoryURL: fmt.Sprintf(fmtBBRepoURL, bbURL, o.repoOwner, o.repoName),
 			Branch:        o.repoBranch,
 		}
+	case manifest.GitLabProviderName:
+		config = &manifest.GitLabProperties{
+			RepositoryURL: fmt.Sprintf(fmtGLRepoURL, glURL, o.repoOwner, o.repoName),
+			Branch:        o.repoBranch,
+		}
+	case manifest.S3ProviderName:
+		config = &manifest.S3Properties{
+			RepositoryURL: o.repoURL,
+			Branch:        o.repoBranch,
+		}
 	default:
 		return nil, fmt.Errorf("unable to create pipeline source provider for %s", o.repoName)
 	}
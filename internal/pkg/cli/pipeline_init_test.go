@@ -5,6 +5,7 @@ package cli
 
 import (
 	"bytes"
+	"encoding"
 	"errors"
 	"fmt"
 	"testing"
@@ -104,7 +105,8 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 		mockStore        func(m *mocks.Mockstore)
 		buffer           bytes.Buffer
 
-		expectedError error
+		expectedWebhook bool
+		expectedError   error
 	}{
 		"passed-in URL to unsupported repo provider": {
 			inRepoURL:        "unsupported.org/repositories/repoName",
@@ -115,7 +117,63 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			mockPrompt:       func(m *mocks.Mockprompter) {},
 			mockSessProvider: func(m *mocks.MocksessionProvider) {},
 
-			expectedError: errors.New("repository unsupported.org/repositories/repoName must be from a supported provider: GitHub, CodeCommit or Bitbucket"),
+			expectedError: errors.New("repository unsupported.org/repositories/repoName must be from a supported provider: GitHub, CodeCommit, Bitbucket or GitLab"),
+		},
+		"passed-in URL to a git repository from an unsupported provider without a webhook": {
+			inRepoURL:      "https://git.example.com/badGoose/chaOS.git",
+			inEnvironments: []string{"test"},
+			mockStore:      func(m *mocks.Mockstore) {},
+			mockSelector:   func(m *mocks.MockpipelineEnvSelector) {},
+			mockRunner:     func(m *mocks.Mockrunner) {},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(fmt.Sprintf(fmtPipelineWebhookPrompt, "https://git.example.com/badGoose/chaOS.git"), pipelineWebhookHelpPrompt).Return(false, nil)
+			},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+
+			expectedError: errors.New("repository https://git.example.com/badGoose/chaOS.git must be from a supported provider: GitHub, CodeCommit, Bitbucket or GitLab"),
+		},
+		"returns error if fail to confirm webhook": {
+			inRepoURL:      "git@git.example.com:badGoose/chaOS.git",
+			inEnvironments: []string{"test"},
+			mockStore:      func(m *mocks.Mockstore) {},
+			mockSelector:   func(m *mocks.MockpipelineEnvSelector) {},
+			mockRunner:     func(m *mocks.Mockrunner) {},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+
+			expectedError: errors.New("confirm webhook for repository git@git.example.com:badGoose/chaOS.git: some error"),
+		},
+		"success with a git repository from an unsupported provider with a webhook": {
+			inRepoURL:      "https://git.example.com/badGoose/chaOS.git",
+			inEnvironments: []string{"test"},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{
+					Name: "test",
+				}, nil)
+			},
+			mockSelector: func(m *mocks.MockpipelineEnvSelector) {},
+			mockRunner:   func(m *mocks.Mockrunner) {},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
+
+			expectedWebhook: true,
+		},
+		"success with GitLab repo without prompting for a webhook": {
+			inRepoURL:      "git@gitlab.com:badGoose/chaOS.git",
+			inEnvironments: []string{"test"},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{
+					Name: "test",
+				}, nil)
+			},
+			mockSelector:     func(m *mocks.MockpipelineEnvSelector) {},
+			mockRunner:       func(m *mocks.Mockrunner) {},
+			mockPrompt:       func(m *mocks.Mockprompter) {},
+			mockSessProvider: func(m *mocks.MocksessionProvider) {},
 		},
 		"passed-in invalid environments": {
 			inRepoURL:      "https://github.com/badGoose/chaOS",
@@ -323,6 +381,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.expectedWebhook, opts.useWebhook)
 			}
 		})
 	}
@@ -338,6 +397,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		inRepoURL      string
 		inBranch       string
 		inAppName      string
+		inUseWebhook   bool
 
 		mockSecretsManager          func(m *mocks.MocksecretsManager)
 		mockWsWriter                func(m *mocks.MockwsPipelineWriter)
@@ -603,6 +663,99 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			expectedError:  nil,
 			expectedBranch: "main",
 		},
+		"writes manifest and buildspec for GitLab provider": {
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoURL: "git@gitlab.com:badgoose/flock/goose.git",
+			inAppName: "badgoose",
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
+			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any()).DoAndReturn(func(in encoding.BinaryMarshaler) (string, error) {
+					out, err := in.MarshalBinary()
+					require.NoError(t, err)
+					require.Contains(t, string(out), "provider: GitLab")
+					require.Contains(t, string(out), "repository: https://gitlab.com/badgoose/flock/goose")
+					return "/pipeline.yml", nil
+				})
+				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+			},
+			mockStoreSvc: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
+					Name: "badgoose",
+				}, nil)
+			},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetRegionalAppResources(&config.Application{
+					Name: "badgoose",
+				}).Return([]*stack.AppRegionalResources{
+					{
+						Region:   "us-west-2",
+						S3Bucket: "gooseBucket",
+					},
+				}, nil)
+			},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedError:  nil,
+			expectedBranch: "main",
+		},
+		"writes manifest and buildspec for S3 provider with a webhook": {
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoURL:    "ssh://git@git.example.com/badgoose/goose.git",
+			inAppName:    "badgoose",
+			inUseWebhook: true,
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
+			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any()).DoAndReturn(func(in encoding.BinaryMarshaler) (string, error) {
+					out, err := in.MarshalBinary()
+					require.NoError(t, err)
+					require.Contains(t, string(out), "provider: S3")
+					require.Contains(t, string(out), "repository: ssh://git@git.example.com/badgoose/goose.git")
+					return "/pipeline.yml", nil
+				})
+				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+			},
+			mockStoreSvc: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("badgoose").Return(&config.Application{
+					Name: "badgoose",
+				}, nil)
+			},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetRegionalAppResources(&config.Application{
+					Name: "badgoose",
+				}).Return([]*stack.AppRegionalResources{
+					{
+						Region:   "us-west-2",
+						S3Bucket: "gooseBucket",
+					},
+				}, nil)
+			},
+			mockRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedError:  nil,
+			expectedBranch: "main",
+		},
 		"does not return an error if secret already exists": {
 			inEnvConfigs: []*config.Environment{
 				{
@@ -857,10 +1010,22 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			expectedError: fmt.Errorf("write buildspec to workspace: some error"),
 		},
 		"returns error when repository URL is not from a supported git provider": {
+			inRepoURL:     "https://git.company.com/group/project.git",
+			inBranch:      "main",
+			inAppName:     "demo",
+			expectedError: errors.New("repository https://git.company.com/group/project.git must be from a supported provider: GitHub, CodeCommit, Bitbucket or GitLab"),
+		},
+		"returns error when GitLab repository is not hosted on gitlab.com": {
 			inRepoURL:     "https://gitlab.company.com/group/project.git",
 			inBranch:      "main",
 			inAppName:     "demo",
-			expectedError: errors.New("repository https://gitlab.company.com/group/project.git must be from a supported provider: GitHub, CodeCommit or Bitbucket"),
+			expectedError: errors.New("repository https://gitlab.company.com/group/project.git must be hosted on gitlab.com: self-managed GitLab instances are not supported"),
+		},
+		"returns error when GitLab repository URL is of unknown format": {
+			inRepoURL:     "https://gitlab.com/goose",
+			inBranch:      "main",
+			inAppName:     "demo",
+			expectedError: errors.New("unable to parse the GitLab repository owner and name from https://gitlab.com/goose: please pass the repository URL with the format `--url https://gitlab.com/{owner}/{repositoryName}`"),
 		},
 		"returns error when GitHub repository URL is of unknown format": {
 			inRepoURL:     "thisisnotevenagithub.comrepository",
@@ -956,6 +1121,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				fs:             memFs,
 				buffer:         tc.buffer,
 				envConfigs:     tc.inEnvConfigs,
+				useWebhook:     tc.inUseWebhook,
 			}
 
 			// WHEN
@@ -1028,12 +1194,13 @@ koke	git://github.com/koke/grit.git (push)
 https	https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (fetch)
 fed	codecommit::us-west-2://aws-sample (fetch)
 ssh	ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample (push)
-bb	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (push)`,
+bb	https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service.git (push)
+gl	git@gitlab.com:huanjani/subgroup/aws-copilot-sample-service.git (fetch)`,
 
-			expectedURLs: []string{"git@github.com:badgoose/grit", "https://github.com/badgoose/cli", "https://github.com/koke/grit", "git://github.com/koke/grit", "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "codecommit::us-west-2://aws-sample", "ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service"},
+			expectedURLs: []string{"git@github.com:badgoose/grit", "https://github.com/badgoose/cli", "https://github.com/koke/grit", "git://github.com/koke/grit", "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "codecommit::us-west-2://aws-sample", "ssh://git-codecommit.us-west-2.amazonaws.com/v1/repos/aws-sample", "https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service", "git@gitlab.com:huanjani/subgroup/aws-copilot-sample-service"},
 		},
-		"don't add to URL list if it is not a GitHub or CodeCommit or Bitbucket URL": {
-			inRemoteResult: `badgoose	verybad@gitlab.com/whatever (fetch)`,
+		"don't add to URL list if it is not a GitHub or CodeCommit or Bitbucket or GitLab URL": {
+			inRemoteResult: `badgoose	verybad@gitlab.company.com/whatever (fetch)`,
 
 			expectedURLs: []string{},
 		},
@@ -1188,3 +1355,48 @@ func TestInitPipelineBBRepoURL_parse(t *testing.T) {
 		})
 	}
 }
+
+func TestInitPipelineGLRepoURL_parse(t *testing.T) {
+	testCases := map[string]struct {
+		inRepoURL glRepoURL
+
+		expectedDetails glRepoDetails
+		expectedError   error
+	}{
+		"successfully parses https url": {
+			inRepoURL: "https://gitlab.com/huanjani/aws-copilot-sample-service.git",
+
+			expectedDetails: glRepoDetails{
+				name:  "aws-copilot-sample-service",
+				owner: "huanjani",
+			},
+		},
+		"successfully parses ssh url with subgroups": {
+			inRepoURL: "git@gitlab.com:huanjani/subgroup/aws-copilot-sample-service",
+
+			expectedDetails: glRepoDetails{
+				name:  "aws-copilot-sample-service",
+				owner: "huanjani/subgroup",
+			},
+		},
+		"returns an error if the owner is missing": {
+			inRepoURL: "https://gitlab.com/aws-copilot-sample-service",
+
+			expectedError: errors.New("unable to parse the GitLab repository owner and name from https://gitlab.com/aws-copilot-sample-service: please pass the repository URL with the format `--url https://gitlab.com/{owner}/{repositoryName}`"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			details, err := glRepoURL.parse(tc.inRepoURL)
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.Equal(t, tc.expectedDetails, details)
+			}
+		})
+	}
+}
@@ -4,8 +4,17 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +27,10 @@ Secrets are sensitive information that you need in your application.`,
 	}
 
 	cmd.AddCommand(buildSecretInitCmd())
+	cmd.AddCommand(buildSecretListCmd())
+	cmd.AddCommand(buildSecretShowCmd())
+	cmd.AddCommand(buildSecretRotateCmd())
+	cmd.AddCommand(buildSecretRmCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -25,3 +38,100 @@ Secrets are sensitive information that you need in your application.`,
 	}
 	return cmd
 }
+
+// secretParameterPath returns the path of the SSM parameters that hold the secrets of an environment.
+func secretParameterPath(app, env string) string {
+	return fmt.Sprintf("/copilot/%s/%s/secrets", app, env)
+}
+
+// newEnvSecretStore returns a function that creates a client for the secrets of an environment
+// with the environment's manager role.
+func newEnvSecretStore(sessProvider *sessions.Provider) func(env *config.Environment) (secretStore, error) {
+	return func(env *config.Environment) (secretStore, error) {
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		return ssm.New(sess), nil
+	}
+}
+
+// newSecretConsumerFinder returns a finder of the workloads in the workspace that refer to a secret.
+// If the command isn't run from a workspace, no workload refers to the secret.
+func newSecretConsumerFinder() secretConsumerFinder {
+	ws, err := workspace.New()
+	if err != nil {
+		return noSecretConsumers{}
+	}
+	return &wsSecretConsumers{
+		ws:              ws,
+		unmarshal:       manifest.UnmarshalWorkload,
+		newInterpolator: newManifestInterpolator,
+	}
+}
+
+// wsSecretConsumers finds the workloads whose manifest in the workspace refers to a secret.
+type wsSecretConsumers struct {
+	ws              wsWlManifestReader
+	unmarshal       func([]byte) (manifest.WorkloadManifest, error)
+	newInterpolator func(app, env string) interpolator
+}
+
+// Consumers returns the workloads whose manifest, with the overrides of the environment applied,
+// refers to the SSM parameter by name or ARN.
+func (f *wsSecretConsumers) Consumers(app, env, paramName string) ([]*describe.SecretConsumer, error) {
+	names, err := f.ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	var consumers []*describe.SecretConsumer
+	for _, name := range names {
+		raw, err := f.ws.ReadWorkloadManifest(name)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest file for %s: %w", name, err)
+		}
+		interpolated, err := f.newInterpolator(app, env).Interpolate(string(raw))
+		if err != nil {
+			return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", name, err)
+		}
+		mft, err := f.unmarshal([]byte(interpolated))
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal manifest for %s: %w", name, err)
+		}
+		envMft, err := mft.ApplyEnv(env)
+		if err != nil {
+			return nil, fmt.Errorf("apply environment %s override to manifest for %s: %w", env, name, err)
+		}
+		containers, ok := envMft.(interface {
+			ContainerSecrets() []manifest.Secret
+		})
+		if !ok {
+			continue
+		}
+		for _, secret := range containers.ContainerSecrets() {
+			if secret.IsSecretsManagerName() || !refersToParameter(secret.Value(), paramName) {
+				continue
+			}
+			consumers = append(consumers, &describe.SecretConsumer{
+				Environment:   env,
+				Workload:      name,
+				PinnedVersion: secret.Version(),
+			})
+			break
+		}
+	}
+	return consumers, nil
+}
+
+// refersToParameter returns true if the value of a secret in a manifest is the name or the ARN of the SSM parameter.
+func refersToParameter(value, paramName string) bool {
+	return value == paramName || (strings.HasPrefix(value, "arn:") && strings.HasSuffix(value, ":parameter"+paramName))
+}
+
+// noSecretConsumers is a secretConsumerFinder for commands that aren't run from a workspace.
+type noSecretConsumers struct{}
+
+// Consumers returns no workloads.
+func (noSecretConsumers) Consumers(app, env, paramName string) ([]*describe.SecretConsumer, error) {
+	return nil, nil
+}
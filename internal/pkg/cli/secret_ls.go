@@ -0,0 +1,202 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretListAppNamePrompt = "Which application's secrets would you like to list?"
+	secretListAppNameHelp   = "An application is a collection of related services."
+)
+
+type listSecretVars struct {
+	appName          string
+	envName          string
+	shouldOutputJSON bool
+}
+
+type listSecretOpts struct {
+	listSecretVars
+
+	store          store
+	sel            appSelector
+	newSecretStore func(env *config.Environment) (secretStore, error)
+	w              io.Writer
+}
+
+func newListSecretOpts(vars listSecretVars) (*listSecretOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret ls"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &listSecretOpts{
+		listSecretVars: vars,
+		store:          store,
+		sel:            selector.NewSelect(prompt.New(), store),
+		newSecretStore: newEnvSecretStore(sessProvider),
+		w:              os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *listSecretOpts) Validate() error {
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the application if it's not provided.
+func (o *listSecretOpts) Ask() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(secretListAppNamePrompt, secretListAppNameHelp)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute writes the metadata of the secrets in every environment of the application, or in the environment
+// passed by flag. The values of the secrets are never retrieved.
+func (o *listSecretOpts) Execute() error {
+	envs, err := targetSecretEnvs(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	secrets, err := listAppSecrets(o.appName, envs, o.newSecretStore)
+	if err != nil {
+		return err
+	}
+	list := &describe.SecretList{
+		App:     o.appName,
+		Secrets: secrets,
+	}
+	if o.shouldOutputJSON {
+		data, err := list.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, list.HumanString())
+	return nil
+}
+
+// RecommendActions is a no-op.
+func (o *listSecretOpts) RecommendActions() error {
+	return nil
+}
+
+// targetSecretEnvs returns the environment if one is provided, or all the environments of the application otherwise.
+func targetSecretEnvs(store store, app, env string) ([]*config.Environment, error) {
+	if env != "" {
+		e, err := store.GetEnvironment(app, env)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in application %s: %w", env, app, err)
+		}
+		return []*config.Environment{e}, nil
+	}
+	envs, err := store.ListEnvironments(app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", app, err)
+	}
+	return envs, nil
+}
+
+// listAppSecrets returns the secrets in the environments sorted by name, with their parameters in the order of the environments.
+func listAppSecrets(app string, envs []*config.Environment, newSecretStore func(env *config.Environment) (secretStore, error)) ([]*describe.Secret, error) {
+	secrets := make(map[string]*describe.Secret)
+	for _, env := range envs {
+		client, err := newSecretStore(env)
+		if err != nil {
+			return nil, err
+		}
+		path := secretParameterPath(app, env.Name)
+		params, err := client.ListSecrets(path)
+		if err != nil {
+			return nil, fmt.Errorf("list secrets in environment %s: %w", env.Name, err)
+		}
+		for _, param := range params {
+			name := strings.TrimPrefix(param.Name, path+"/")
+			if _, ok := secrets[name]; !ok {
+				secrets[name] = &describe.Secret{
+					Name: name,
+					App:  app,
+				}
+			}
+			secrets[name].Parameters = append(secrets[name].Parameters, &describe.SecretParameter{
+				Environment:      env.Name,
+				Name:             param.Name,
+				Version:          param.Version,
+				LastModifiedDate: param.LastModifiedDate,
+				LastModifiedUser: param.LastModifiedUser,
+			})
+		}
+	}
+	out := make([]*describe.Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		out = append(out, secret)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out, nil
+}
+
+// buildSecretListCmd builds the command for listing the secrets of an application.
+func buildSecretListCmd() *cobra.Command {
+	vars := listSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the secrets of an application.",
+		Long: `Lists the secrets of an application with their version in each environment.
+The values of the secrets are never retrieved.`,
+		Example: `
+  Lists the secrets of the application in every environment.
+  /code $ copilot secret ls
+  Lists the secrets in the "test" environment in JSON format.
+  /code $ copilot secret ls --env test --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,145 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSecretOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     listSecretVars
+		setupMocks func(m *mocks.Mockstore)
+		wantedErr  error
+	}{
+		"skip validation if the application is not provided": {
+			setupMocks: func(m *mocks.Mockstore) {},
+		},
+		"wrap error if the application doesn't exist": {
+			inVars: listSecretVars{appName: "phonetool"},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application phonetool: some error"),
+		},
+		"wrap error if the environment doesn't exist": {
+			inVars: listSecretVars{appName: "phonetool", envName: "test"},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get environment test in application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstore(ctrl)
+			tc.setupMocks(m)
+			opts := &listSecretOpts{
+				listSecretVars: tc.inVars,
+				store:          m,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestListSecretOpts_Execute(t *testing.T) {
+	testEnv := &config.Environment{Name: "test"}
+	prodEnv := &config.Environment{Name: "prod"}
+	testCases := map[string]struct {
+		inVars     listSecretVars
+		setupMocks func(store *mocks.Mockstore, test, prod *mocks.MocksecretStore)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"wrap error if environments can't be listed": {
+			setupMocks: func(store *mocks.Mockstore, test, prod *mocks.MocksecretStore) {
+				store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments in application phonetool: some error"),
+		},
+		"wrap error if secrets can't be listed": {
+			setupMocks: func(store *mocks.Mockstore, test, prod *mocks.MocksecretStore) {
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				test.EXPECT().ListSecrets("/copilot/phonetool/test/secrets").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list secrets in environment test: some error"),
+		},
+		"write the secrets of every environment in JSON": {
+			inVars: listSecretVars{shouldOutputJSON: true},
+			setupMocks: func(store *mocks.Mockstore, test, prod *mocks.MocksecretStore) {
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+				test.EXPECT().ListSecrets("/copilot/phonetool/test/secrets").Return([]ssm.SecretMetadata{
+					{Name: "/copilot/phonetool/test/secrets/token", Version: 2},
+				}, nil)
+				prod.EXPECT().ListSecrets("/copilot/phonetool/prod/secrets").Return([]ssm.SecretMetadata{
+					{Name: "/copilot/phonetool/prod/secrets/db-password", Version: 1},
+					{Name: "/copilot/phonetool/prod/secrets/token", Version: 5},
+				}, nil)
+			},
+			wantedOutput: `{"application":"phonetool","secrets":[` +
+				`{"name":"db-password","parameters":[{"environment":"prod","parameter":"/copilot/phonetool/prod/secrets/db-password","version":1,"lastModifiedDate":"0001-01-01T00:00:00Z","lastModifiedUser":""}]},` +
+				`{"name":"token","parameters":[{"environment":"test","parameter":"/copilot/phonetool/test/secrets/token","version":2,"lastModifiedDate":"0001-01-01T00:00:00Z","lastModifiedUser":""},` +
+				`{"environment":"prod","parameter":"/copilot/phonetool/prod/secrets/token","version":5,"lastModifiedDate":"0001-01-01T00:00:00Z","lastModifiedUser":""}]}]}` + "\n",
+		},
+		"only list the secrets of the environment passed by flag": {
+			inVars: listSecretVars{envName: "prod", shouldOutputJSON: true},
+			setupMocks: func(store *mocks.Mockstore, test, prod *mocks.MocksecretStore) {
+				store.EXPECT().GetEnvironment("phonetool", "prod").Return(prodEnv, nil)
+				prod.EXPECT().ListSecrets("/copilot/phonetool/prod/secrets").Return(nil, nil)
+			},
+			wantedOutput: `{"application":"phonetool","secrets":[]}` + "\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			test, prod := mocks.NewMocksecretStore(ctrl), mocks.NewMocksecretStore(ctrl)
+			tc.setupMocks(store, test, prod)
+			b := &bytes.Buffer{}
+			tc.inVars.appName = "phonetool"
+			opts := &listSecretOpts{
+				listSecretVars: tc.inVars,
+				store:          store,
+				newSecretStore: func(env *config.Environment) (secretStore, error) {
+					if env.Name == "test" {
+						return test, nil
+					}
+					return prod, nil
+				},
+				w: b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
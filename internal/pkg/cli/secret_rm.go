@@ -0,0 +1,212 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretRmAppNamePrompt    = "Which application's secret would you like to delete?"
+	secretRmSecretNamePrompt = "Which secret would you like to delete?"
+
+	fmtSecretRmConfirmPrompt        = "Are you sure you want to delete secret %s from all the environments of application %s?"
+	fmtSecretRmFromEnvConfirmPrompt = "Are you sure you want to delete secret %s from environment %s?"
+	secretRmConfirmHelp             = "This will delete every version of the secret. Workloads that refer to it will fail to start new tasks."
+)
+
+var errSecretRmCancelled = errors.New("secret rm cancelled - no changes made")
+
+type rmSecretVars struct {
+	appName          string
+	name             string
+	envName          string
+	skipConfirmation bool
+}
+
+type rmSecretOpts struct {
+	rmSecretVars
+
+	store          store
+	sel            appSelector
+	prompt         prompter
+	newSecretStore func(env *config.Environment) (secretStore, error)
+	consumers      secretConsumerFinder
+
+	// Cached values after the secret is deleted.
+	brokenConsumers []*describe.SecretConsumer
+}
+
+func newRmSecretOpts(vars rmSecretVars) (*rmSecretOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret rm"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &rmSecretOpts{
+		rmSecretVars:   vars,
+		store:          store,
+		sel:            selector.NewSelect(prompter, store),
+		prompt:         prompter,
+		newSecretStore: newEnvSecretStore(sessProvider),
+		consumers:      newSecretConsumerFinder(),
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *rmSecretOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+		if o.envName != "" {
+			if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+				return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+			}
+		}
+	}
+	if o.name != "" {
+		return validateSecretName(o.name)
+	}
+	return nil
+}
+
+// Ask prompts for the application and the secret if they're not provided, and confirms the deletion.
+func (o *rmSecretOpts) Ask() error {
+	app, err := askSecretApp(o.sel, o.appName, secretRmAppNamePrompt)
+	if err != nil {
+		return err
+	}
+	o.appName = app
+	name, err := askSecretName(o.prompt, o.store, o.newSecretStore, o.appName, o.name, secretRmSecretNamePrompt)
+	if err != nil {
+		return err
+	}
+	o.name = name
+	if o.skipConfirmation {
+		return nil
+	}
+	msg := fmt.Sprintf(fmtSecretRmConfirmPrompt, o.name, o.appName)
+	if o.envName != "" {
+		msg = fmt.Sprintf(fmtSecretRmFromEnvConfirmPrompt, o.name, o.envName)
+	}
+	confirmed, err := o.prompt.Confirm(msg, secretRmConfirmHelp, prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("secret rm confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errSecretRmCancelled
+	}
+	return nil
+}
+
+// Execute deletes every version of the secret from the environment passed by flag, or from all environments.
+func (o *rmSecretOpts) Execute() error {
+	envs, err := targetSecretEnvs(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	var deleted int
+	for _, env := range envs {
+		client, err := o.newSecretStore(env)
+		if err != nil {
+			return err
+		}
+		paramName := fmt.Sprintf(fmtSecretParameterName, o.appName, env.Name, o.name)
+		err = client.DeleteSecret(paramName)
+		var notFoundErr *ssm.ErrParameterNotFound
+		if errors.As(err, &notFoundErr) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("delete secret %s from environment %s: %w", o.name, env.Name, err)
+		}
+		deleted++
+		log.Successf("Deleted secret %s from environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(env.Name))
+
+		consumers, err := o.consumers.Consumers(o.appName, env.Name, paramName)
+		if err != nil {
+			return fmt.Errorf("find workloads that refer to secret %s in environment %s: %w", o.name, env.Name, err)
+		}
+		o.brokenConsumers = append(o.brokenConsumers, consumers...)
+	}
+	if deleted == 0 {
+		if o.envName != "" {
+			return fmt.Errorf("secret %s not found in environment %s", o.name, o.envName)
+		}
+		return fmt.Errorf("secret %s not found in any environment of application %s", o.name, o.appName)
+	}
+	for _, consumer := range o.brokenConsumers {
+		log.Warningf("%s still refers to secret %s in environment %s, its new tasks will fail to start.\n",
+			color.HighlightUserInput(consumer.Workload), o.name, consumer.Environment)
+	}
+	return nil
+}
+
+// RecommendActions shows how to fix the workloads that still refer to the deleted secret.
+func (o *rmSecretOpts) RecommendActions() error {
+	if len(o.brokenConsumers) == 0 {
+		return nil
+	}
+	var workloads []string
+	seen := make(map[string]bool)
+	for _, consumer := range o.brokenConsumers {
+		if seen[consumer.Workload] {
+			continue
+		}
+		seen[consumer.Workload] = true
+		workloads = append(workloads, color.HighlightUserInput(consumer.Workload))
+	}
+	logRecommendedActions([]string{
+		fmt.Sprintf("Remove secret %s from the %s of %s and redeploy, or run %s to create it again.",
+			o.name, color.HighlightCode("secrets"), english.WordSeries(workloads, "and"),
+			color.HighlightCode(fmt.Sprintf("copilot secret init --name %s", o.name))),
+	})
+	return nil
+}
+
+// buildSecretRmCmd builds the command for deleting a secret.
+func buildSecretRmCmd() *cobra.Command {
+	vars := rmSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "rm",
+		Short: "Deletes a secret from SSM Parameter Store.",
+		Long: `Deletes every version of a secret from all the environments of an application, or from one environment.
+Warns about the workloads in the workspace that still refer to it.`,
+		Example: `
+  Delete the "db-password" secret from all environments.
+  /code $ copilot secret rm -n db-password
+  Delete the "db-password" secret from the "test" environment without confirmation.
+  /code $ copilot secret rm -n db-password --env test --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRmSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
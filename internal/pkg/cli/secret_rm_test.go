@@ -0,0 +1,137 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRmSecretOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     rmSecretVars
+		setupMocks func(m *mocks.Mockprompter)
+		wantedErr  error
+	}{
+		"should not confirm if --yes is set": {
+			inVars:     rmSecretVars{skipConfirmation: true},
+			setupMocks: func(m *mocks.Mockprompter) {},
+		},
+		"should confirm the deletion from all environments": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm("Are you sure you want to delete secret token from all the environments of application phonetool?", secretRmConfirmHelp, gomock.Any()).Return(true, nil)
+			},
+		},
+		"should confirm the deletion from an environment": {
+			inVars: rmSecretVars{envName: "test"},
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm("Are you sure you want to delete secret token from environment test?", secretRmConfirmHelp, gomock.Any()).Return(true, nil)
+			},
+		},
+		"should error if the deletion is cancelled": {
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedErr: errSecretRmCancelled,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(m)
+			tc.inVars.appName, tc.inVars.name = "phonetool", "token"
+			opts := &rmSecretOpts{
+				rmSecretVars: tc.inVars,
+				prompt:       m,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRmSecretOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv      string
+		setupMocks func(store *mocks.Mockstore, secrets *mocks.MocksecretStore, consumers *mocks.MocksecretConsumerFinder)
+
+		wantedConsumers []*describe.SecretConsumer
+		wantedErr       error
+	}{
+		"should error if the secret doesn't exist in the environment": {
+			inEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, secrets *mocks.MocksecretStore, consumers *mocks.MocksecretConsumerFinder) {
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				secrets.EXPECT().DeleteSecret("/copilot/phonetool/test/secrets/token").Return(&ssm.ErrParameterNotFound{})
+			},
+			wantedErr: errors.New("secret token not found in environment test"),
+		},
+		"should wrap error if the secret can't be deleted": {
+			setupMocks: func(store *mocks.Mockstore, secrets *mocks.MocksecretStore, consumers *mocks.MocksecretConsumerFinder) {
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				secrets.EXPECT().DeleteSecret("/copilot/phonetool/test/secrets/token").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("delete secret token from environment test: some error"),
+		},
+		"should delete the secret from every environment it exists in": {
+			setupMocks: func(store *mocks.Mockstore, secrets *mocks.MocksecretStore, consumers *mocks.MocksecretConsumerFinder) {
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				secrets.EXPECT().DeleteSecret("/copilot/phonetool/test/secrets/token").Return(nil)
+				consumers.EXPECT().Consumers("phonetool", "test", "/copilot/phonetool/test/secrets/token").Return([]*describe.SecretConsumer{
+					{Environment: "test", Workload: "api"},
+				}, nil)
+				secrets.EXPECT().DeleteSecret("/copilot/phonetool/prod/secrets/token").Return(&ssm.ErrParameterNotFound{})
+			},
+			wantedConsumers: []*describe.SecretConsumer{
+				{Environment: "test", Workload: "api"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			secrets := mocks.NewMocksecretStore(ctrl)
+			consumers := mocks.NewMocksecretConsumerFinder(ctrl)
+			tc.setupMocks(store, secrets, consumers)
+			opts := &rmSecretOpts{
+				rmSecretVars: rmSecretVars{
+					appName: "phonetool",
+					name:    "token",
+					envName: tc.inEnv,
+				},
+				store: store,
+				newSecretStore: func(env *config.Environment) (secretStore, error) {
+					return secrets, nil
+				},
+				consumers: consumers,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedConsumers, opts.brokenConsumers)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretRotateAppNamePrompt    = "Which application's secret would you like to rotate?"
+	secretRotateSecretNamePrompt = "Which secret would you like to rotate?"
+
+	fmtSecretRotateValuePrompt     = "What is the new value of secret %s in environment %s?"
+	fmtSecretRotateValuePromptHelp = "If you do not wish to rotate the secret %s in environment %s, you can leave this blank by pressing 'Enter' without entering any value."
+)
+
+type rotateSecretVars struct {
+	appName string
+	name    string
+	values  map[string]string
+}
+
+type rotateSecretOpts struct {
+	rotateSecretVars
+
+	store          store
+	sel            appSelector
+	prompt         prompter
+	newSecretStore func(env *config.Environment) (secretStore, error)
+	consumers      secretConsumerFinder
+
+	// Cached values after the secret is rotated.
+	versions       map[string]int64 // New version of the secret in each environment.
+	staleConsumers []*describe.SecretConsumer
+}
+
+func newRotateSecretOpts(vars rotateSecretVars) (*rotateSecretOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret rotate"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &rotateSecretOpts{
+		rotateSecretVars: vars,
+		store:            store,
+		sel:              selector.NewSelect(prompter, store),
+		prompt:           prompter,
+		newSecretStore:   newEnvSecretStore(sessProvider),
+		consumers:        newSecretConsumerFinder(),
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *rotateSecretOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+		for env := range o.values {
+			if _, err := o.store.GetEnvironment(o.appName, env); err != nil {
+				return fmt.Errorf("get environment %s in application %s: %w", env, o.appName, err)
+			}
+		}
+	}
+	if o.name != "" {
+		return validateSecretName(o.name)
+	}
+	return nil
+}
+
+// Ask prompts for the application, the secret and its new value in each environment the secret exists in.
+func (o *rotateSecretOpts) Ask() error {
+	app, err := askSecretApp(o.sel, o.appName, secretRotateAppNamePrompt)
+	if err != nil {
+		return err
+	}
+	o.appName = app
+	name, err := askSecretName(o.prompt, o.store, o.newSecretStore, o.appName, o.name, secretRotateSecretNamePrompt)
+	if err != nil {
+		return err
+	}
+	o.name = name
+	if o.values != nil {
+		return nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	values := make(map[string]string)
+	for _, env := range envs {
+		if _, err := o.describeSecret(env); err != nil {
+			var notFoundErr *ssm.ErrParameterNotFound
+			if errors.As(err, &notFoundErr) {
+				continue
+			}
+			return err
+		}
+		value, err := o.prompt.GetSecret(
+			fmt.Sprintf(fmtSecretRotateValuePrompt, color.HighlightUserInput(o.name), env.Name),
+			fmt.Sprintf(fmtSecretRotateValuePromptHelp, color.HighlightUserInput(o.name), env.Name),
+			prompt.WithFinalMessage(fmt.Sprintf("%s secret value:", strings.Title(env.Name))),
+		)
+		if err != nil {
+			return fmt.Errorf("get new value of secret %s in environment %s: %w", o.name, env.Name, err)
+		}
+		if value != "" {
+			values[env.Name] = value
+		}
+	}
+	o.values = values
+	return nil
+}
+
+// Execute puts a new version of the secret in each environment that has a new value.
+// The previous versions are kept so that workloads pinned to them are not affected.
+func (o *rotateSecretOpts) Execute() error {
+	if len(o.values) == 0 {
+		log.Infof("No new value provided for secret %s, nothing to rotate.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	envNames := make([]string, 0, len(o.values))
+	for env := range o.values {
+		envNames = append(envNames, env)
+	}
+	sort.Strings(envNames)
+	o.versions = make(map[string]int64)
+	for _, envName := range envNames {
+		env, err := o.store.GetEnvironment(o.appName, envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", envName, o.appName, err)
+		}
+		if _, err := o.describeSecret(env); err != nil {
+			var notFoundErr *ssm.ErrParameterNotFound
+			if errors.As(err, &notFoundErr) {
+				return fmt.Errorf("secret %s does not exist in environment %s, run %s to create it", o.name, envName,
+					color.HighlightCode(fmt.Sprintf("copilot secret init --name %s", o.name)))
+			}
+			return err
+		}
+		client, err := o.newSecretStore(env)
+		if err != nil {
+			return err
+		}
+		paramName := fmt.Sprintf(fmtSecretParameterName, o.appName, envName, o.name)
+		out, err := client.PutSecret(ssm.PutSecretInput{
+			Name:      paramName,
+			Value:     o.values[envName],
+			Overwrite: true,
+			Tags: map[string]string{
+				deploy.AppTagKey: o.appName,
+				deploy.EnvTagKey: envName,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("put new value of secret %s in environment %s: %w", o.name, envName, err)
+		}
+		version := aws.Int64Value(out.Version)
+		o.versions[envName] = version
+		log.Successf("Rotated secret %s in environment %s to version %d.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(envName), version)
+
+		consumers, err := o.consumers.Consumers(o.appName, envName, paramName)
+		if err != nil {
+			return fmt.Errorf("find workloads that refer to secret %s in environment %s: %w", o.name, envName, err)
+		}
+		o.staleConsumers = append(o.staleConsumers, consumers...)
+	}
+	return nil
+}
+
+// RecommendActions shows how the workloads that refer to the secret can pick up its new version.
+func (o *rotateSecretOpts) RecommendActions() error {
+	var actions []string
+	for _, consumer := range o.staleConsumers {
+		if consumer.PinnedVersion != nil {
+			actions = append(actions, fmt.Sprintf("%s is pinned to version %d in environment %s. Update %s in its manifest to %d and run %s to use the new value.",
+				color.HighlightUserInput(consumer.Workload), *consumer.PinnedVersion, consumer.Environment,
+				color.HighlightCode("version"), o.versions[consumer.Environment],
+				color.HighlightCode(fmt.Sprintf("copilot deploy --name %s --env %s", consumer.Workload, consumer.Environment))))
+			continue
+		}
+		actions = append(actions, fmt.Sprintf("Run %s so that the tasks of %s pick up the new value.",
+			color.HighlightCode(fmt.Sprintf("copilot deploy --name %s --env %s", consumer.Workload, consumer.Environment)),
+			color.HighlightUserInput(consumer.Workload)))
+	}
+	logRecommendedActions(actions)
+	return nil
+}
+
+func (o *rotateSecretOpts) describeSecret(env *config.Environment) (*ssm.SecretMetadata, error) {
+	client, err := o.newSecretStore(env)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := client.DescribeSecret(fmt.Sprintf(fmtSecretParameterName, o.appName, env.Name, o.name))
+	if err != nil {
+		return nil, fmt.Errorf("describe secret %s in environment %s: %w", o.name, env.Name, err)
+	}
+	return secret, nil
+}
+
+// buildSecretRotateCmd builds the command for putting a new version of a secret.
+func buildSecretRotateCmd() *cobra.Command {
+	vars := rotateSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Puts a new version of a secret.",
+		Long: `Puts a new version of a secret in the environments it exists in.
+Previous versions are kept, so workloads that pin a version in their manifest are not affected.`,
+		Example: `
+  Rotate the "db-password" secret with prompts for the new value in each environment.
+  /code $ copilot secret rotate -n db-password
+  Rotate the "db-password" secret in the "test" environment only.
+  /code $ copilot secret rotate -n db-password --values test=n3wPassw0rd`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRotateSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, secretRotateValuesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,128 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateSecretOpts_Ask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mocks.NewMockstore(ctrl)
+	secrets := mocks.NewMocksecretStore(ctrl)
+	prompter := mocks.NewMockprompter(ctrl)
+	store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+	secrets.EXPECT().DescribeSecret("/copilot/phonetool/test/secrets/token").Return(&ssm.SecretMetadata{}, nil)
+	secrets.EXPECT().DescribeSecret("/copilot/phonetool/prod/secrets/token").Return(nil, &ssm.ErrParameterNotFound{})
+	prompter.EXPECT().GetSecret(gomock.Any(), gomock.Any(), gomock.Any()).Return("n3wPassw0rd", nil)
+	opts := &rotateSecretOpts{
+		rotateSecretVars: rotateSecretVars{
+			appName: "phonetool",
+			name:    "token",
+		},
+		store:  store,
+		prompt: prompter,
+		newSecretStore: func(env *config.Environment) (secretStore, error) {
+			return secrets, nil
+		},
+	}
+
+	err := opts.Ask()
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"test": "n3wPassw0rd"}, opts.values)
+}
+
+func TestRotateSecretOpts_Execute(t *testing.T) {
+	const paramName = "/copilot/phonetool/test/secrets/token"
+	testEnv := &config.Environment{Name: "test"}
+	testCases := map[string]struct {
+		setupMocks func(store *mocks.Mockstore, secrets *mocks.MocksecretStore, consumers *mocks.MocksecretConsumerFinder)
+
+		wantedVersions  map[string]int64
+		wantedConsumers []*describe.SecretConsumer
+		wantedErr       error
+	}{
+		"should error if the secret doesn't exist in the environment": {
+			setupMocks: func(store *mocks.Mockstore, secrets *mocks.MocksecretStore, consumers *mocks.MocksecretConsumerFinder) {
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				secrets.EXPECT().DescribeSecret(paramName).Return(nil, &ssm.ErrParameterNotFound{})
+			},
+			wantedErr: errors.New("secret token does not exist in environment test, run `copilot secret init --name token` to create it"),
+		},
+		"should wrap error if the new value can't be put": {
+			setupMocks: func(store *mocks.Mockstore, secrets *mocks.MocksecretStore, consumers *mocks.MocksecretConsumerFinder) {
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				secrets.EXPECT().DescribeSecret(paramName).Return(&ssm.SecretMetadata{}, nil)
+				secrets.EXPECT().PutSecret(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("put new value of secret token in environment test: some error"),
+		},
+		"should overwrite the secret and find the workloads to redeploy": {
+			setupMocks: func(store *mocks.Mockstore, secrets *mocks.MocksecretStore, consumers *mocks.MocksecretConsumerFinder) {
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				secrets.EXPECT().DescribeSecret(paramName).Return(&ssm.SecretMetadata{}, nil)
+				secrets.EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:      paramName,
+					Value:     "n3wPassw0rd",
+					Overwrite: true,
+					Tags: map[string]string{
+						deploy.AppTagKey: "phonetool",
+						deploy.EnvTagKey: "test",
+					},
+				}).Return(&ssm.PutSecretOutput{Version: aws.Int64(4)}, nil)
+				consumers.EXPECT().Consumers("phonetool", "test", paramName).Return([]*describe.SecretConsumer{
+					{Environment: "test", Workload: "api"},
+				}, nil)
+			},
+			wantedVersions: map[string]int64{"test": 4},
+			wantedConsumers: []*describe.SecretConsumer{
+				{Environment: "test", Workload: "api"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			secrets := mocks.NewMocksecretStore(ctrl)
+			consumers := mocks.NewMocksecretConsumerFinder(ctrl)
+			tc.setupMocks(store, secrets, consumers)
+			opts := &rotateSecretOpts{
+				rotateSecretVars: rotateSecretVars{
+					appName: "phonetool",
+					name:    "token",
+					values:  map[string]string{"test": "n3wPassw0rd"},
+				},
+				store: store,
+				newSecretStore: func(env *config.Environment) (secretStore, error) {
+					return secrets, nil
+				},
+				consumers: consumers,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVersions, opts.versions)
+			require.Equal(t, tc.wantedConsumers, opts.staleConsumers)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretShowAppNamePrompt    = "Which application's secret would you like to show?"
+	secretShowSecretNamePrompt = "Which secret would you like to show?"
+)
+
+type showSecretVars struct {
+	appName          string
+	name             string
+	shouldOutputJSON bool
+}
+
+type showSecretOpts struct {
+	showSecretVars
+
+	store          store
+	sel            appSelector
+	prompt         prompter
+	newSecretStore func(env *config.Environment) (secretStore, error)
+	consumers      secretConsumerFinder
+	w              io.Writer
+}
+
+func newShowSecretOpts(vars showSecretVars) (*showSecretOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret show"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &showSecretOpts{
+		showSecretVars: vars,
+		store:          store,
+		sel:            selector.NewSelect(prompter, store),
+		prompt:         prompter,
+		newSecretStore: newEnvSecretStore(sessProvider),
+		consumers:      newSecretConsumerFinder(),
+		w:              log.OutputWriter,
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *showSecretOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	}
+	if o.name != "" {
+		return validateSecretName(o.name)
+	}
+	return nil
+}
+
+// Ask prompts for the application and the secret if they're not provided.
+func (o *showSecretOpts) Ask() error {
+	app, err := askSecretApp(o.sel, o.appName, secretShowAppNamePrompt)
+	if err != nil {
+		return err
+	}
+	o.appName = app
+	name, err := askSecretName(o.prompt, o.store, o.newSecretStore, o.appName, o.name, secretShowSecretNamePrompt)
+	if err != nil {
+		return err
+	}
+	o.name = name
+	return nil
+}
+
+// Execute writes the metadata of the secret in each environment along with the workloads
+// in the workspace that refer to it. The value of the secret is never retrieved.
+func (o *showSecretOpts) Execute() error {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	secret := &describe.Secret{
+		Name: o.name,
+		App:  o.appName,
+	}
+	for _, env := range envs {
+		client, err := o.newSecretStore(env)
+		if err != nil {
+			return err
+		}
+		paramName := fmt.Sprintf(fmtSecretParameterName, o.appName, env.Name, o.name)
+		param, err := client.DescribeSecret(paramName)
+		var notFoundErr *ssm.ErrParameterNotFound
+		if errors.As(err, &notFoundErr) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("describe secret %s in environment %s: %w", o.name, env.Name, err)
+		}
+		secret.Parameters = append(secret.Parameters, &describe.SecretParameter{
+			Environment:      env.Name,
+			Name:             param.Name,
+			Version:          param.Version,
+			LastModifiedDate: param.LastModifiedDate,
+			LastModifiedUser: param.LastModifiedUser,
+		})
+		consumers, err := o.consumers.Consumers(o.appName, env.Name, paramName)
+		if err != nil {
+			return fmt.Errorf("find workloads that refer to secret %s in environment %s: %w", o.name, env.Name, err)
+		}
+		secret.Consumers = append(secret.Consumers, consumers...)
+	}
+	if len(secret.Parameters) == 0 {
+		return fmt.Errorf("secret %s not found in any environment of application %s", o.name, o.appName)
+	}
+	if o.shouldOutputJSON {
+		data, err := secret.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, secret.HumanString())
+	return nil
+}
+
+// RecommendActions is a no-op.
+func (o *showSecretOpts) RecommendActions() error {
+	return nil
+}
+
+func askSecretApp(sel appSelector, app, msg string) (string, error) {
+	if app != "" {
+		return app, nil
+	}
+	app, err := sel.Application(msg, "")
+	if err != nil {
+		return "", fmt.Errorf("select application: %w", err)
+	}
+	return app, nil
+}
+
+// askSecretName prompts for one of the secrets of the application if the name isn't provided.
+func askSecretName(p prompter, store store, newSecretStore func(env *config.Environment) (secretStore, error), app, name, msg string) (string, error) {
+	if name != "" {
+		return name, nil
+	}
+	envs, err := store.ListEnvironments(app)
+	if err != nil {
+		return "", fmt.Errorf("list environments in application %s: %w", app, err)
+	}
+	secrets, err := listAppSecrets(app, envs, newSecretStore)
+	if err != nil {
+		return "", err
+	}
+	if len(secrets) == 0 {
+		return "", fmt.Errorf("no secrets found in application %s", app)
+	}
+	names := make([]string, len(secrets))
+	for i, secret := range secrets {
+		names[i] = secret.Name
+	}
+	name, err = p.SelectOne(msg, "", names, prompt.WithFinalMessage("Secret:"))
+	if err != nil {
+		return "", fmt.Errorf("select secret: %w", err)
+	}
+	return name, nil
+}
+
+// buildSecretShowCmd builds the command for showing the metadata of a secret.
+func buildSecretShowCmd() *cobra.Command {
+	vars := showSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows the metadata of a secret.",
+		Long: `Shows the version of a secret in each environment and the workloads in the workspace that refer to it.
+The value of the secret is never retrieved.`,
+		Example: `
+  Shows the metadata of the "db-password" secret.
+  /code $ copilot secret show -n db-password`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newShowSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
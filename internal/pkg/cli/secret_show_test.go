@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type showSecretMocks struct {
+	store     *mocks.Mockstore
+	prompt    *mocks.Mockprompter
+	secrets   *mocks.MocksecretStore
+	consumers *mocks.MocksecretConsumerFinder
+}
+
+func TestShowSecretOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m *showSecretMocks)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"should not prompt if the name is provided": {
+			inName:     "token",
+			setupMocks: func(m *showSecretMocks) {},
+			wantedName: "token",
+		},
+		"should error if the application has no secrets": {
+			setupMocks: func(m *showSecretMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.secrets.EXPECT().ListSecrets("/copilot/phonetool/test/secrets").Return(nil, nil)
+			},
+			wantedErr: errors.New("no secrets found in application phonetool"),
+		},
+		"should prompt for one of the secrets of the application": {
+			setupMocks: func(m *showSecretMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.secrets.EXPECT().ListSecrets("/copilot/phonetool/test/secrets").Return([]ssm.SecretMetadata{
+					{Name: "/copilot/phonetool/test/secrets/token"},
+					{Name: "/copilot/phonetool/test/secrets/db-password"},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(secretShowSecretNamePrompt, "", []string{"db-password", "token"}, gomock.Any()).Return("token", nil)
+			},
+			wantedName: "token",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &showSecretMocks{
+				store:   mocks.NewMockstore(ctrl),
+				prompt:  mocks.NewMockprompter(ctrl),
+				secrets: mocks.NewMocksecretStore(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showSecretOpts{
+				showSecretVars: showSecretVars{
+					appName: "phonetool",
+					name:    tc.inName,
+				},
+				store:  m.store,
+				prompt: m.prompt,
+				newSecretStore: func(env *config.Environment) (secretStore, error) {
+					return m.secrets, nil
+				},
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestShowSecretOpts_Execute(t *testing.T) {
+	const paramName = "/copilot/phonetool/test/secrets/token"
+	testCases := map[string]struct {
+		setupMocks func(m *showSecretMocks)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"should error if the secret doesn't exist in any environment": {
+			setupMocks: func(m *showSecretMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.secrets.EXPECT().DescribeSecret(paramName).Return(nil, &ssm.ErrParameterNotFound{})
+			},
+			wantedErr: errors.New("secret token not found in any environment of application phonetool"),
+		},
+		"should wrap error if the secret can't be described": {
+			setupMocks: func(m *showSecretMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.secrets.EXPECT().DescribeSecret(paramName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe secret token in environment test: some error"),
+		},
+		"should write the metadata and the consumers of the secret": {
+			setupMocks: func(m *showSecretMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.secrets.EXPECT().DescribeSecret(paramName).Return(&ssm.SecretMetadata{
+					Name:    paramName,
+					Version: 3,
+				}, nil)
+				m.consumers.EXPECT().Consumers("phonetool", "test", paramName).Return([]*describe.SecretConsumer{
+					{Environment: "test", Workload: "api", PinnedVersion: aws.Int(2)},
+				}, nil)
+			},
+			wantedOutput: `{"name":"token","application":"phonetool",` +
+				`"parameters":[{"environment":"test","parameter":"/copilot/phonetool/test/secrets/token","version":3,"lastModifiedDate":"0001-01-01T00:00:00Z","lastModifiedUser":""}],` +
+				`"consumers":[{"environment":"test","workload":"api","pinnedVersion":2}]}` + "\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &showSecretMocks{
+				store:     mocks.NewMockstore(ctrl),
+				secrets:   mocks.NewMocksecretStore(ctrl),
+				consumers: mocks.NewMocksecretConsumerFinder(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &showSecretOpts{
+				showSecretVars: showSecretVars{
+					appName:          "phonetool",
+					name:             "token",
+					shouldOutputJSON: true,
+				},
+				store: m.store,
+				newSecretStore: func(env *config.Environment) (secretStore, error) {
+					return m.secrets, nil
+				},
+				consumers: m.consumers,
+				w:         b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
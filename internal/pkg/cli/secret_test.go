@@ -0,0 +1,91 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWsSecretConsumers_Consumers(t *testing.T) {
+	const paramName = "/copilot/phonetool/test/secrets/token"
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockwsWlManifestReader)
+
+		wanted    []*describe.SecretConsumer
+		wantedErr error
+	}{
+		"should wrap error if workloads can't be listed": {
+			setupMocks: func(m *mocks.MockwsWlManifestReader) {
+				m.EXPECT().ListWorkloads().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list workloads in the workspace: some error"),
+		},
+		"should return the workloads referring to the parameter by name or ARN": {
+			setupMocks: func(m *mocks.MockwsWlManifestReader) {
+				m.EXPECT().ListWorkloads().Return([]string{"api", "worker", "frontend"}, nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+secrets:
+  TOKEN: /copilot/phonetool/test/secrets/token
+`), nil)
+				m.EXPECT().ReadWorkloadManifest("worker").Return([]byte(`name: worker
+type: Worker Service
+image:
+  location: nginx
+environments:
+  test:
+    secrets:
+      TOKEN:
+        from: arn:aws:ssm:us-west-2:123456789012:parameter/copilot/phonetool/test/secrets/token
+        version: 2
+`), nil)
+				m.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(`name: frontend
+type: Backend Service
+image:
+  location: nginx
+secrets:
+  TOKEN: /copilot/phonetool/test/secrets/other
+`), nil)
+			},
+			wanted: []*describe.SecretConsumer{
+				{Environment: "test", Workload: "api"},
+				{Environment: "test", Workload: "worker", PinnedVersion: aws.Int(2)},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockwsWlManifestReader(ctrl)
+			tc.setupMocks(m)
+			finder := &wsSecretConsumers{
+				ws:        m,
+				unmarshal: manifest.UnmarshalWorkload,
+				newInterpolator: func(app, env string) interpolator {
+					return manifest.NewInterpolator(app, env)
+				},
+			}
+
+			got, err := finder.Consumers("phonetool", "test", paramName)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -28,28 +28,40 @@ import (
 )
 
 const (
-	dynamoDBStorageType = "DynamoDB"
-	s3StorageType       = "S3"
-	rdsStorageType      = "Aurora"
+	dynamoDBStorageType   = "DynamoDB"
+	s3StorageType         = "S3"
+	rdsStorageType        = "Aurora"
+	redisStorageType      = "Redis"
+	openSearchStorageType = "OpenSearch"
+	sqsStorageType        = "SQS"
 )
 
 var storageTypes = []string{
 	dynamoDBStorageType,
 	s3StorageType,
 	rdsStorageType,
+	redisStorageType,
+	openSearchStorageType,
+	sqsStorageType,
 }
 
 // Displayed options for storage types
 const (
-	dynamoDBStorageTypeOption = "DynamoDB"
-	s3StorageTypeOption       = "S3"
-	rdsStorageTypeOption      = "Aurora Serverless"
+	dynamoDBStorageTypeOption   = "DynamoDB"
+	s3StorageTypeOption         = "S3"
+	rdsStorageTypeOption        = "Aurora Serverless"
+	redisStorageTypeOption      = "ElastiCache Redis"
+	openSearchStorageTypeOption = "OpenSearch"
+	sqsStorageTypeOption        = "SQS"
 )
 
 var optionToStorageType = map[string]string{
-	dynamoDBStorageTypeOption: dynamoDBStorageType,
-	s3StorageTypeOption:       s3StorageType,
-	rdsStorageTypeOption:      rdsStorageType,
+	dynamoDBStorageTypeOption:   dynamoDBStorageType,
+	s3StorageTypeOption:         s3StorageType,
+	rdsStorageTypeOption:        rdsStorageType,
+	redisStorageTypeOption:      redisStorageType,
+	openSearchStorageTypeOption: openSearchStorageType,
+	sqsStorageTypeOption:        sqsStorageType,
 }
 
 var storageTypeOptions = map[string]prompt.Option{
@@ -65,12 +77,27 @@ var storageTypeOptions = map[string]prompt.Option{
 		Value: rdsStorageTypeOption,
 		Hint:  "SQL",
 	},
+	redisStorageType: {
+		Value: redisStorageTypeOption,
+		Hint:  "In-memory cache",
+	},
+	openSearchStorageType: {
+		Value: openSearchStorageTypeOption,
+		Hint:  "Search",
+	},
+	sqsStorageType: {
+		Value: sqsStorageTypeOption,
+		Hint:  "Work queue",
+	},
 }
 
 const (
 	s3BucketFriendlyText      = "S3 Bucket"
 	dynamoDBTableFriendlyText = "DynamoDB Table"
 	rdsFriendlyText           = "Database Cluster"
+	redisFriendlyText         = "Redis Replication Group"
+	openSearchFriendlyText    = "OpenSearch Domain"
+	sqsFriendlyText           = "SQS Queue"
 )
 
 // General-purpose prompts, collected for all storage resources.
@@ -80,6 +107,9 @@ var (
 DynamoDB is a key-value and document database that delivers single-digit millisecond performance at any scale.
 S3 is a web object store built to store and retrieve any amount of data from anywhere on the Internet.
 Aurora Serverless is an on-demand autoscaling configuration for Amazon Aurora, a MySQL and PostgreSQL-compatible relational database.
+ElastiCache Redis is an in-memory data store, in the private subnets of your environment, to use as a cache or message broker.
+OpenSearch is a search and analytics engine, in the private subnets of your environment, to index and query documents and logs.
+SQS is a message queue with a dead-letter queue, to distribute work across the tasks of your services and jobs.
 `
 
 	fmtStorageInitNamePrompt = "What would you like to " + color.Emphasize("name") + " this %s?"
@@ -143,6 +173,27 @@ var engineTypes = []string{
 	engineTypePostgreSQL,
 }
 
+// ElastiCache Redis, OpenSearch and SQS specific questions and help prompts.
+var (
+	storageInitRedisNodeTypePrompt = "Which " + color.Emphasize("node type") + " would you like to use for your Redis replication group?"
+	storageInitRedisNodeTypeHelp   = `The compute and memory capacity of each node. For example: "cache.t4g.micro" or "cache.r6g.large".
+See https://docs.aws.amazon.com/AmazonElastiCache/latest/red-ug/CacheNodes.SupportedTypes.html`
+	storageInitRedisReplicaPrompt = "Would you like to add a read replica to fail over to?"
+	storageInitRedisReplicaHelp   = `A read replica in another Availability Zone is promoted to primary if the primary node fails.
+It doubles the cost of the replication group.`
+
+	storageInitOpenSearchInstanceTypePrompt = "Which " + color.Emphasize("instance type") + " would you like to use for the data nodes of your domain?"
+	storageInitOpenSearchInstanceTypeHelp   = `The instance type of the data nodes. For example: "t3.small.search" or "r6g.large.search".
+See https://docs.aws.amazon.com/opensearch-service/latest/developerguide/supported-instance-types.html`
+	storageInitOpenSearchMultiAZPrompt = "Would you like to spread the domain across two Availability Zones?"
+	storageInitOpenSearchMultiAZHelp   = `The domain runs two data nodes, one per Availability Zone, so that it stays available if a zone fails.
+Otherwise it runs a single data node.`
+
+	storageInitSQSFIFOPrompt = "Would you like the queue to be " + color.Emphasize("FIFO") + "?"
+	storageInitSQSFIFOHelp   = `A FIFO queue delivers messages exactly once and in the order they are sent, at a lower throughput.
+A standard queue delivers messages at least once and in best-effort order.`
+)
+
 var errUnavailableAddonParams = errors.New("addon does not require parameters")
 
 type initStorageVars struct {
@@ -161,6 +212,19 @@ type initStorageVars struct {
 	rdsEngine         string
 	rdsParameterGroup string
 	rdsInitialDBName  string
+
+	// ElastiCache Redis specific values collected via flags or prompts
+	redisNodeType string
+	redisReplicas *int // Nil if the number of replicas must be prompted for.
+
+	// OpenSearch specific values collected via flags or prompts
+	openSearchInstanceType  string
+	openSearchInstanceCount int // Zero if the number of data nodes must be prompted for.
+	openSearchVolumeSize    int
+
+	// SQS specific values collected via flags or prompts
+	sqsFIFO            *bool // Nil if the queue type must be prompted for.
+	sqsMaxReceiveCount int
 }
 
 type initStorageOpts struct {
@@ -227,6 +291,8 @@ func (o *initStorageOpts) Validate() error {
 			err = s3BucketNameValidation(o.storageName)
 		case rdsStorageType:
 			err = rdsNameValidation(o.storageName)
+		case redisStorageType, openSearchStorageType, sqsStorageType:
+			err = storageLogicalIDNameValidation(o.storageName)
 		default:
 			// use dynamo since it's a superset of s3
 			err = dynamoTableNameValidation(o.storageName)
@@ -244,6 +310,24 @@ func (o *initStorageOpts) Validate() error {
 			return err
 		}
 	}
+	return o.validateRedisOpenSearchSQS()
+}
+
+func (o *initStorageOpts) validateRedisOpenSearchSQS() error {
+	switch o.storageType {
+	case redisStorageType:
+		if o.redisReplicas != nil {
+			return validateRedisReplicas(aws.IntValue(o.redisReplicas))
+		}
+	case openSearchStorageType:
+		count := o.openSearchInstanceCount
+		if count == 0 {
+			count = 1 // The number of data nodes is prompted for later, only validate the volume size.
+		}
+		return validateOpenSearchNodes(count, o.openSearchVolumeSize)
+	case sqsStorageType:
+		return validateSQSMaxReceiveCount(o.sqsMaxReceiveCount)
+	}
 	return nil
 }
 
@@ -306,6 +390,24 @@ func (o *initStorageOpts) Ask() error {
 		if err := o.askAuroraInitialDBName(); err != nil {
 			return err
 		}
+	case redisStorageType:
+		if err := o.askRedisNodeType(); err != nil {
+			return err
+		}
+		if err := o.askRedisReplicas(); err != nil {
+			return err
+		}
+	case openSearchStorageType:
+		if err := o.askOpenSearchInstanceType(); err != nil {
+			return err
+		}
+		if err := o.askOpenSearchInstanceCount(); err != nil {
+			return err
+		}
+	case sqsStorageType:
+		if err := o.askSQSFIFO(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -335,6 +437,9 @@ func (o *initStorageOpts) validateStorageType() error {
 		ws:           o.ws,
 		workloadName: o.workloadName,
 	}); err != nil {
+		if errors.Is(err, errStorageTypeNotSupportedByRDWS) {
+			log.Errorf("A %s resource is only reachable from the private subnets of your environment, it can't be used by a %s yet.\n", o.storageType, manifest.RequestDrivenWebServiceType)
+		}
 		if errors.Is(err, errRDWSNotConnectedToVPC) {
 			log.Errorf(`Your %s needs to be connected to a VPC in order to use a %s resource.
 You can enable VPC connectivity by updating your manifest with:
@@ -378,6 +483,15 @@ func (o *initStorageOpts) askStorageName() error {
 		friendlyText = dynamoDBTableFriendlyText
 	case rdsStorageType:
 		return o.askStorageNameWithDefault(rdsFriendlyText, fmt.Sprintf(fmtRDSStorageNameDefault, o.workloadName), rdsNameValidation)
+	case redisStorageType:
+		validator = storageLogicalIDNameValidation
+		friendlyText = redisFriendlyText
+	case openSearchStorageType:
+		validator = storageLogicalIDNameValidation
+		friendlyText = openSearchFriendlyText
+	case sqsStorageType:
+		validator = storageLogicalIDNameValidation
+		friendlyText = sqsFriendlyText
 	}
 
 	name, err := o.prompt.Get(fmt.Sprintf(fmtStorageInitNamePrompt,
@@ -586,6 +700,81 @@ func (o *initStorageOpts) askAuroraInitialDBName() error {
 	return nil
 }
 
+func (o *initStorageOpts) askRedisNodeType() error {
+	if o.redisNodeType != "" {
+		return nil
+	}
+	nodeType, err := o.prompt.Get(storageInitRedisNodeTypePrompt,
+		storageInitRedisNodeTypeHelp,
+		basicNameValidation,
+		prompt.WithFinalMessage("Node type:"),
+		prompt.WithDefaultInput(addon.DefaultRedisNodeType))
+	if err != nil {
+		return fmt.Errorf("input Redis node type: %w", err)
+	}
+	o.redisNodeType = nodeType
+	return nil
+}
+
+func (o *initStorageOpts) askRedisReplicas() error {
+	if o.redisReplicas != nil {
+		return nil
+	}
+	withReplica, err := o.prompt.Confirm(storageInitRedisReplicaPrompt, storageInitRedisReplicaHelp, prompt.WithFinalMessage("Read replica:"))
+	if err != nil {
+		return fmt.Errorf("confirm Redis read replica: %w", err)
+	}
+	replicas := 0
+	if withReplica {
+		replicas = 1
+	}
+	o.redisReplicas = aws.Int(replicas)
+	return nil
+}
+
+func (o *initStorageOpts) askOpenSearchInstanceType() error {
+	if o.openSearchInstanceType != "" {
+		return nil
+	}
+	instanceType, err := o.prompt.Get(storageInitOpenSearchInstanceTypePrompt,
+		storageInitOpenSearchInstanceTypeHelp,
+		basicNameValidation,
+		prompt.WithFinalMessage("Instance type:"),
+		prompt.WithDefaultInput(addon.DefaultOpenSearchInstanceType))
+	if err != nil {
+		return fmt.Errorf("input OpenSearch instance type: %w", err)
+	}
+	o.openSearchInstanceType = instanceType
+	return nil
+}
+
+func (o *initStorageOpts) askOpenSearchInstanceCount() error {
+	if o.openSearchInstanceCount != 0 {
+		return nil
+	}
+	multiAZ, err := o.prompt.Confirm(storageInitOpenSearchMultiAZPrompt, storageInitOpenSearchMultiAZHelp, prompt.WithFinalMessage("Multiple Availability Zones:"))
+	if err != nil {
+		return fmt.Errorf("confirm OpenSearch multiple Availability Zones: %w", err)
+	}
+	o.openSearchInstanceCount = 1
+	if multiAZ {
+		o.openSearchInstanceCount = 2
+	}
+	return nil
+}
+
+func (o *initStorageOpts) askSQSFIFO() error {
+	if o.sqsFIFO != nil {
+		return nil
+	}
+	fifo, err := o.prompt.Confirm(storageInitSQSFIFOPrompt, storageInitSQSFIFOHelp, prompt.WithFinalMessage("FIFO queue:"))
+	if err != nil {
+		return fmt.Errorf("confirm SQS FIFO queue: %w", err)
+	}
+	o.sqsFIFO = aws.Bool(fifo)
+	return nil
+}
+
 func (o *initStorageOpts) validateWorkloadName() error {
 	names, err := o.ws.ListWorkloads()
 	if err != nil {
@@ -672,6 +861,12 @@ func (o *initStorageOpts) newAddonTemplate() (encoding.BinaryMarshaler, error) {
 		templateBlob, err = o.newS3Template()
 	case rdsStorageType:
 		templateBlob, err = o.newRDSTemplate()
+	case redisStorageType:
+		templateBlob, err = o.newRedisTemplate()
+	case openSearchStorageType:
+		templateBlob, err = o.newOpenSearchTemplate()
+	case sqsStorageType:
+		templateBlob, err = o.newSQSTemplate()
 	}
 	if err != nil {
 		return nil, err
@@ -750,6 +945,39 @@ func (o *initStorageOpts) newRDSTemplate() (*addon.RDSTemplate, error) {
 	}), nil
 }
 
+func (o *initStorageOpts) newRedisTemplate() (*addon.RedisTemplate, error) {
+	return addon.NewRedisTemplate(&addon.RedisProps{
+		StorageProps: &addon.StorageProps{
+			Name: o.storageName,
+		},
+		NodeType:      o.redisNodeType,
+		Replicas:      aws.IntValue(o.redisReplicas),
+		EngineVersion: addon.DefaultRedisEngineVersion,
+	}), nil
+}
+
+func (o *initStorageOpts) newOpenSearchTemplate() (*addon.OpenSearchTemplate, error) {
+	return addon.NewOpenSearchTemplate(&addon.OpenSearchProps{
+		StorageProps: &addon.StorageProps{
+			Name: o.storageName,
+		},
+		InstanceType:  o.openSearchInstanceType,
+		InstanceCount: o.openSearchInstanceCount,
+		VolumeSize:    o.openSearchVolumeSize,
+		EngineVersion: addon.DefaultOpenSearchEngineVersion,
+	}), nil
+}
+
+func (o *initStorageOpts) newSQSTemplate() (*addon.SQSTemplate, error) {
+	return addon.NewSQSTemplate(&addon.SQSProps{
+		StorageProps: &addon.StorageProps{
+			Name: o.storageName,
+		},
+		FIFO:            aws.BoolValue(o.sqsFIFO),
+		MaxReceiveCount: o.sqsMaxReceiveCount,
+	}), nil
+}
+
 func (o *initStorageOpts) environmentNames() ([]string, error) {
 	var envNames []string
 	envs, err := o.store.ListEnvironments(o.appName)
@@ -796,6 +1024,19 @@ const client = new AWS.SecretsManager({
 const dbSecret = await client.getSecretValue({SecretId: process.env.%s}).promise();
 const {username, host, dbname, password, port} = JSON.parse(dbSecret.SecretString);`, newVar)
 		}
+	case redisStorageType:
+		prefix := template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName))
+		newVar = fmt.Sprintf("%s_ENDPOINT", prefix)
+		retrieveEnvVarCode = fmt.Sprintf(`const { createClient } = require('redis');
+const client = createClient({
+    url: `+"`"+`rediss://:${process.env.%[1]s_AUTH_TOKEN}@${process.env.%[1]s_ENDPOINT}:${process.env.%[1]s_PORT}`+"`"+`,
+});`, prefix)
+	case openSearchStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "Endpoint")
+		retrieveEnvVarCode = fmt.Sprintf("const endpoint = process.env.%s", newVar)
+	case sqsStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "URL")
+		retrieveEnvVarCode = fmt.Sprintf("const queueURL = process.env.%s", newVar)
 	}
 
 	actionRetrieveEnvVar := fmt.Sprintf(
@@ -818,6 +1059,8 @@ For example, in JavaScript you can write:
 // buildStorageInitCmd builds the command and adds it to the CLI.
 func buildStorageInitCmd() *cobra.Command {
 	vars := initStorageVars{}
+	var redisReplicas int
+	var sqsFIFO bool
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Creates a new AWS CloudFormation template for a storage resource.",
@@ -832,12 +1075,24 @@ Resource names are injected into your containers as environment variables for ea
   Create a DynamoDB table with multiple alternate sort keys.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --lsi Points:N --lsi Goodness:N
   Create an RDS Aurora Serverless cluster using PostgreSQL as the database engine.
-  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL`,
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL
+  Create an ElastiCache Redis replication group with a read replica attached to the "api" service.
+  /code $ copilot storage init -n my-cache -t Redis -w api --node-type cache.t4g.small --replicas 1
+  Create an OpenSearch domain with two data nodes attached to the "api" service.
+  /code $ copilot storage init -n my-search -t OpenSearch -w api --instance-type t3.small.search --instance-count 2
+  Create a FIFO SQS queue attached to the "worker" service.
+  /code $ copilot storage init -n my-queue -t SQS -w worker --fifo`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
 				return err
 			}
+			if cmd.Flags().Changed(storageRedisReplicasFlag) {
+				opts.redisReplicas = aws.Int(redisReplicas)
+			}
+			if cmd.Flags().Changed(storageSQSFIFOFlag) {
+				opts.sqsFIFO = aws.Bool(sqsFIFO)
+			}
 			return run(opts)
 		}),
 	}
@@ -855,6 +1110,16 @@ Resource names are injected into your containers as environment variables for ea
 	cmd.Flags().StringVar(&vars.rdsInitialDBName, storageRDSInitialDBFlag, "", storageRDSInitialDBFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsParameterGroup, storageRDSParameterGroupFlag, "", storageRDSParameterGroupFlagDescription)
 
+	cmd.Flags().StringVar(&vars.redisNodeType, storageRedisNodeTypeFlag, "", storageRedisNodeTypeFlagDescription)
+	cmd.Flags().IntVar(&redisReplicas, storageRedisReplicasFlag, 0, storageRedisReplicasFlagDescription)
+
+	cmd.Flags().StringVar(&vars.openSearchInstanceType, storageOpenSearchInstanceTypeFlag, "", storageOpenSearchInstanceTypeFlagDescription)
+	cmd.Flags().IntVar(&vars.openSearchInstanceCount, storageOpenSearchInstanceCountFlag, 0, storageOpenSearchInstanceCountFlagDescription)
+	cmd.Flags().IntVar(&vars.openSearchVolumeSize, storageOpenSearchVolumeSizeFlag, addon.DefaultOpenSearchVolumeSize, storageOpenSearchVolumeSizeFlagDescription)
+
+	cmd.Flags().BoolVar(&sqsFIFO, storageSQSFIFOFlag, false, storageSQSFIFOFlagDescription)
+	cmd.Flags().IntVar(&vars.sqsMaxReceiveCount, storageSQSMaxReceiveCountFlag, addon.DefaultSQSMaxReceiveCount, storageSQSMaxReceiveCountFlagDescription)
+
 	requiredFlags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
 	requiredFlags.AddFlag(cmd.Flags().Lookup(nameFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(storageTypeFlag))
@@ -872,12 +1137,28 @@ Resource names are injected into your containers as environment variables for ea
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSInitialDBFlag))
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSParameterGroupFlag))
 
+	redisFlags := pflag.NewFlagSet("ElastiCache Redis", pflag.ContinueOnError)
+	redisFlags.AddFlag(cmd.Flags().Lookup(storageRedisNodeTypeFlag))
+	redisFlags.AddFlag(cmd.Flags().Lookup(storageRedisReplicasFlag))
+
+	openSearchFlags := pflag.NewFlagSet("OpenSearch", pflag.ContinueOnError)
+	openSearchFlags.AddFlag(cmd.Flags().Lookup(storageOpenSearchInstanceTypeFlag))
+	openSearchFlags.AddFlag(cmd.Flags().Lookup(storageOpenSearchInstanceCountFlag))
+	openSearchFlags.AddFlag(cmd.Flags().Lookup(storageOpenSearchVolumeSizeFlag))
+
+	sqsFlags := pflag.NewFlagSet("SQS", pflag.ContinueOnError)
+	sqsFlags.AddFlag(cmd.Flags().Lookup(storageSQSFIFOFlag))
+	sqsFlags.AddFlag(cmd.Flags().Lookup(storageSQSMaxReceiveCountFlag))
+
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":          `Required,DynamoDB,Aurora Serverless`,
+		"sections":          `Required,DynamoDB,Aurora Serverless,ElastiCache Redis,OpenSearch,SQS`,
 		"Required":          requiredFlags.FlagUsages(),
 		"DynamoDB":          ddbFlags.FlagUsages(),
 		"Aurora Serverless": auroraFlags.FlagUsages(),
+		"ElastiCache Redis": redisFlags.FlagUsages(),
+		"OpenSearch":        openSearchFlags.FlagUsages(),
+		"SQS":               sqsFlags.FlagUsages(),
 	}
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
   {{.UseLine}}{{end}}{{$annotations := .Annotations}}{{$sections := split .Annotations.sections ","}}{{if gt (len $sections) 0}}
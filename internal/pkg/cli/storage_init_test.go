@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
@@ -31,6 +32,11 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 		inNoLSI       bool
 		inEngine      string
 
+		inRedisReplicas        *int
+		inOpenSearchNodes      int
+		inOpenSearchVolumeSize int
+		inSQSMaxReceiveCount   int
+
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
 
@@ -173,6 +179,71 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 
 			wantedErr: errors.New("invalid engine type mysql: must be one of \"MySQL\", \"PostgreSQL\""),
 		},
+		"invalid redis storage name": {
+			inAppName:       "bowie",
+			inStorageType:   redisStorageType,
+			inStorageName:   "1-cache",
+			inRedisReplicas: aws.Int(1),
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errInvalidStorageNameCharacters,
+		},
+		"invalid number of redis replicas": {
+			inAppName:       "bowie",
+			inStorageType:   redisStorageType,
+			inStorageName:   "my-cache",
+			inRedisReplicas: aws.Int(6),
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid number of replicas 6: must be between 0 and 5"),
+		},
+		"odd number of opensearch data nodes": {
+			inAppName:              "bowie",
+			inStorageType:          openSearchStorageType,
+			inStorageName:          "my-search",
+			inOpenSearchNodes:      3,
+			inOpenSearchVolumeSize: 10,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid number of data nodes 3: must be 1 or an even number"),
+		},
+		"opensearch volume too small": {
+			inAppName:              "bowie",
+			inStorageType:          openSearchStorageType,
+			inStorageName:          "my-search",
+			inOpenSearchVolumeSize: 5,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid volume size 5: must be between 10 and 16384 GiB"),
+		},
+		"invalid sqs maximum receive count": {
+			inAppName:            "bowie",
+			inStorageType:        sqsStorageType,
+			inStorageName:        "my-queue",
+			inSQSMaxReceiveCount: 0,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid maximum receive count 0: must be between 1 and 1000"),
+		},
+		"successfully validates sqs queue": {
+			inAppName:            "bowie",
+			inStorageType:        sqsStorageType,
+			inStorageName:        "my-queue",
+			inSQSMaxReceiveCount: 10,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -194,6 +265,11 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 					noLSI:        tc.inNoLSI,
 					noSort:       tc.inNoSort,
 					rdsEngine:    tc.inEngine,
+
+					redisReplicas:           tc.inRedisReplicas,
+					openSearchInstanceCount: tc.inOpenSearchNodes,
+					openSearchVolumeSize:    tc.inOpenSearchVolumeSize,
+					sqsMaxReceiveCount:      tc.inSQSMaxReceiveCount,
 				},
 				appName: tc.inAppName,
 				ws:      mockWs,
@@ -266,6 +342,18 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 						Value: rdsStorageTypeOption,
 						Hint:  "SQL",
 					},
+					{
+						Value: redisStorageTypeOption,
+						Hint:  "In-memory cache",
+					},
+					{
+						Value: openSearchStorageTypeOption,
+						Hint:  "Search",
+					},
+					{
+						Value: sqsStorageTypeOption,
+						Hint:  "Work queue",
+					},
 				}
 				m.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Eq(options), gomock.Any()).Return(s3StorageType, nil)
 			},
@@ -851,6 +939,99 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 			wantedErr: fmt.Errorf("input initial database name: some error"),
 		},
+		"asks for redis node type and replicas": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-cache",
+			inStorageType: redisStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(storageInitRedisNodeTypePrompt, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("cache.t4g.small", nil)
+				m.EXPECT().Confirm(storageInitRedisReplicaPrompt, gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Backend Service"), nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:   redisStorageType,
+				storageName:   "my-cache",
+				workloadName:  wantedSvcName,
+				redisNodeType: "cache.t4g.small",
+				redisReplicas: aws.Int(1),
+			},
+		},
+		"error if redis is selected for a RDWS": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-cache",
+			inStorageType: redisStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockCfg:    func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Request-Driven Web Service"), nil)
+			},
+
+			wantedErr: errors.New("invalid storage type Redis: not supported for a Request-Driven Web Service"),
+		},
+		"asks for opensearch instance type and availability zones": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-search",
+			inStorageType: openSearchStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(storageInitOpenSearchInstanceTypePrompt, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("t3.small.search", nil)
+				m.EXPECT().Confirm(storageInitOpenSearchMultiAZPrompt, gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:             openSearchStorageType,
+				storageName:             "my-search",
+				workloadName:            wantedSvcName,
+				openSearchInstanceType:  "t3.small.search",
+				openSearchInstanceCount: 1,
+			},
+		},
+		"asks whether the sqs queue is FIFO": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-queue",
+			inStorageType: sqsStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(storageInitSQSFIFOPrompt, gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedVars: &initStorageVars{
+				storageType:  sqsStorageType,
+				storageName:  "my-queue",
+				workloadName: wantedSvcName,
+				sqsFIFO:      aws.Bool(true),
+			},
+		},
+		"error if fail to confirm sqs FIFO queue": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-queue",
+			inStorageType: sqsStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(storageInitSQSFIFOPrompt, gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+
+			wantedErr: errors.New("confirm SQS FIFO queue: some error"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -925,6 +1106,9 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 		inInitialDBName  string
 		inParameterGroup string
 
+		inRedisReplicas *int
+		inSQSFIFO       *bool
+
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
 
@@ -1008,6 +1192,38 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 			},
 			wantedErr: nil,
 		},
+		"happy calls for Redis": {
+			inStorageType:   redisStorageType,
+			inSvcName:       wantedSvcName,
+			inStorageName:   "my-cache",
+			inRedisReplicas: aws.Int(1),
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Backend Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-cache").Return("/frontend/addons/my-cache.yml", nil)
+			},
+		},
+		"happy calls for OpenSearch": {
+			inStorageType: openSearchStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-search",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Backend Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-search").Return("/frontend/addons/my-search.yml", nil)
+			},
+		},
+		"happy calls for SQS": {
+			inStorageType: sqsStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-queue",
+			inSQSFIFO:     aws.Bool(true),
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Worker Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-queue").Return("/frontend/addons/my-queue.yml", nil)
+			},
+		},
 		"error addon exists": {
 			inAppName:     wantedAppName,
 			inStorageType: s3StorageType,
@@ -1071,6 +1287,9 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 					rdsEngine:         tc.inEngine,
 					rdsParameterGroup: tc.inParameterGroup,
+
+					redisReplicas: tc.inRedisReplicas,
+					sqsFIFO:       tc.inSQSFIFO,
 				},
 				appName: tc.inAppName,
 				ws:      mockAddon,
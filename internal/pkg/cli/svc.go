@@ -26,12 +26,17 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcInitCmd())
 	cmd.AddCommand(buildSvcListCmd())
 	cmd.AddCommand(buildSvcPackageCmd())
+	cmd.AddCommand(buildSvcValidateCmd())
+	cmd.AddCommand(buildSvcAddonsCmd())
 	cmd.AddCommand(buildSvcDeployCmd())
 	cmd.AddCommand(buildSvcDeleteCmd())
 	cmd.AddCommand(buildSvcShowCmd())
 	cmd.AddCommand(buildSvcStatusCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
 	cmd.AddCommand(buildSvcExecCmd())
+	cmd.AddCommand(buildSvcPortForwardCmd())
+	cmd.AddCommand(buildSvcCpCmd())
+	cmd.AddCommand(buildSvcRunLocalCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
 	cmd.AddCommand(buildSvcResumeCmd())
 
@@ -0,0 +1,24 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/spf13/cobra"
+)
+
+// buildSvcAddonsCmd builds the command for working with the addons of a service.
+func buildSvcAddonsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addons",
+		Short: "Commands for working with the addons of a service.",
+		Long: `Commands for working with the addons of a service.
+Addons are the CloudFormation templates under the addons/ directory of a service.`,
+	}
+
+	cmd.AddCommand(buildSvcAddonsShowCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,175 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcAddonsShowNamePrompt = "Which service's addons would you like to show?"
+)
+
+type showSvcAddonsVars struct {
+	appName          string
+	name             string
+	shouldShowTpl    bool
+	shouldOutputJSON bool
+}
+
+// showSvcAddonsOpts reports how the addons templates of a service are merged without calling any AWS API.
+type showSvcAddonsOpts struct {
+	showSvcAddonsVars
+
+	ws        serviceLister
+	prompt    prompter
+	newAddons func(svcName string) (addonsMergeReporter, error)
+	w         io.Writer
+}
+
+func newShowSvcAddonsOpts(vars showSvcAddonsVars) (*showSvcAddonsOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &showSvcAddonsOpts{
+		showSvcAddonsVars: vars,
+
+		ws:     ws,
+		prompt: prompt.New(),
+		newAddons: func(svcName string) (addonsMergeReporter, error) {
+			return addon.New(svcName)
+		},
+		w: log.OutputWriter,
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *showSvcAddonsOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.shouldShowTpl && o.shouldOutputJSON {
+		return fmt.Errorf("cannot specify both --%s and --%s", addonsTemplateFlag, jsonFlag)
+	}
+	if o.name == "" {
+		return nil
+	}
+	names, err := o.ws.ListServices()
+	if err != nil {
+		return fmt.Errorf("list services in the workspace: %w", err)
+	}
+	if !contains(o.name, names) {
+		return fmt.Errorf("service %s not found in the workspace", color.HighlightUserInput(o.name))
+	}
+	return nil
+}
+
+// Ask prompts for the service if there is more than one in the workspace.
+func (o *showSvcAddonsOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	names, err := o.ws.ListServices()
+	if err != nil {
+		return fmt.Errorf("list services in the workspace: %w", err)
+	}
+	switch len(names) {
+	case 0:
+		return errors.New("no services found in the workspace")
+	case 1:
+		log.Infof("Found only one service, defaulting to: %s\n", color.HighlightUserInput(names[0]))
+		o.name = names[0]
+		return nil
+	}
+	name, err := o.prompt.SelectOne(svcAddonsShowNamePrompt, "", names, prompt.WithFinalMessage("Service:"))
+	if err != nil {
+		return fmt.Errorf("select service: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute writes the file that each logical ID of the addons comes from and the conflicts between files,
+// or the merged template if requested.
+func (o *showSvcAddonsOpts) Execute() error {
+	addons, err := o.newAddons(o.name)
+	if err != nil {
+		return fmt.Errorf("new addons for service %s: %w", o.name, err)
+	}
+	report, err := addons.MergeReport()
+	var notFoundErr *addon.ErrAddonsNotFound
+	if errors.As(err, &notFoundErr) {
+		log.Infof("No addons found for service %s.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("report how the addons of service %s are merged: %w", o.name, err)
+	}
+	if o.shouldShowTpl && !report.HasConflicts() {
+		tpl, err := addons.Template()
+		if err != nil {
+			return fmt.Errorf("merge the addons of service %s: %w", o.name, err)
+		}
+		fmt.Fprint(o.w, tpl)
+		return nil
+	}
+	if o.shouldOutputJSON {
+		data, err := report.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, report.HumanString())
+	}
+	if report.HasConflicts() {
+		return fmt.Errorf("addons of service %s have %d %s", o.name, len(report.Conflicts), english.PluralWord(len(report.Conflicts), "conflict", "conflicts"))
+	}
+	return nil
+}
+
+// RecommendActions is a no-op.
+func (o *showSvcAddonsOpts) RecommendActions() error {
+	return nil
+}
+
+// buildSvcAddonsShowCmd builds the command for showing how the addons of a service are merged.
+func buildSvcAddonsShowCmd() *cobra.Command {
+	vars := showSvcAddonsVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows how the addons templates of a service are merged.",
+		Long: `Shows how the addons templates of a service are merged into a single template.
+Lists every parameter, mapping, condition, resource and output along with the files that define it,
+and prints both definitions side by side for each logical ID that is defined differently across files.`,
+		Example: `
+  Show where each logical ID of the "frontend" service's addons comes from.
+  /code $ copilot svc addons show -n frontend
+  Write the merged addons template of the "frontend" service to a file.
+  /code $ copilot svc addons show -n frontend --template > addons.yml`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newShowSvcAddonsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowTpl, addonsTemplateFlag, false, addonsTemplateFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
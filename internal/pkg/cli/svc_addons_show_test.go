@@ -0,0 +1,254 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type showSvcAddonsMocks struct {
+	ws     *mocks.MockserviceLister
+	prompt *mocks.Mockprompter
+	addons *mocks.MockaddonsMergeReporter
+}
+
+func TestShowSvcAddonsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     showSvcAddonsVars
+		setupMocks func(m *showSvcAddonsMocks)
+		wantedErr  error
+	}{
+		"should error if not in a workspace": {
+			setupMocks: func(m *showSvcAddonsMocks) {},
+			wantedErr:  errNoAppInWorkspace,
+		},
+		"should error if both --template and --json are set": {
+			inVars: showSvcAddonsVars{
+				appName:          "phonetool",
+				shouldShowTpl:    true,
+				shouldOutputJSON: true,
+			},
+			setupMocks: func(m *showSvcAddonsMocks) {},
+			wantedErr:  errors.New("cannot specify both --template and --json"),
+		},
+		"should error if the service is not in the workspace": {
+			inVars: showSvcAddonsVars{
+				appName: "phonetool",
+				name:    "frontend",
+			},
+			setupMocks: func(m *showSvcAddonsMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"backend"}, nil)
+			},
+			wantedErr: errors.New("service frontend not found in the workspace"),
+		},
+		"should succeed if the service is in the workspace": {
+			inVars: showSvcAddonsVars{
+				appName: "phonetool",
+				name:    "frontend",
+			},
+			setupMocks: func(m *showSvcAddonsMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"frontend"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &showSvcAddonsMocks{
+				ws: mocks.NewMockserviceLister(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showSvcAddonsOpts{
+				showSvcAddonsVars: tc.inVars,
+				ws:                m.ws,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestShowSvcAddonsOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m *showSvcAddonsMocks)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"should not prompt if the name is provided": {
+			inName:     "frontend",
+			setupMocks: func(m *showSvcAddonsMocks) {},
+			wantedName: "frontend",
+		},
+		"should error if there are no services in the workspace": {
+			setupMocks: func(m *showSvcAddonsMocks) {
+				m.ws.EXPECT().ListServices().Return(nil, nil)
+			},
+			wantedErr: errors.New("no services found in the workspace"),
+		},
+		"should default to the only service in the workspace": {
+			setupMocks: func(m *showSvcAddonsMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"frontend"}, nil)
+			},
+			wantedName: "frontend",
+		},
+		"should prompt for the service": {
+			setupMocks: func(m *showSvcAddonsMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"frontend", "backend"}, nil)
+				m.prompt.EXPECT().SelectOne(svcAddonsShowNamePrompt, "", []string{"frontend", "backend"}, gomock.Any()).Return("backend", nil)
+			},
+			wantedName: "backend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &showSvcAddonsMocks{
+				ws:     mocks.NewMockserviceLister(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showSvcAddonsOpts{
+				showSvcAddonsVars: showSvcAddonsVars{
+					name: tc.inName,
+				},
+				ws:     m.ws,
+				prompt: m.prompt,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestShowSvcAddonsOpts_Execute(t *testing.T) {
+	report := &addon.MergeReport{
+		WlName: "frontend",
+		Entries: []*addon.MergeEntry{
+			{Section: "Resources", LogicalID: "Bucket", Files: []string{"bucket.yml"}},
+		},
+	}
+	conflictingReport := &addon.MergeReport{
+		WlName: "frontend",
+		Entries: []*addon.MergeEntry{
+			{Section: "Resources", LogicalID: "Bucket", Files: []string{"a.yml"}},
+		},
+		Conflicts: []*addon.MergeConflict{
+			{
+				Section:   "Resources",
+				LogicalID: "Bucket",
+				First:     addon.Definition{File: "a.yml", Line: 2, Column: 3, Body: "Type: AWS::S3::Bucket\n"},
+				Second:    addon.Definition{File: "b.yml", Line: 2, Column: 3, Body: "Type: AWS::SQS::Queue\n"},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inVars     showSvcAddonsVars
+		setupMocks func(m *showSvcAddonsMocks)
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"should not write anything if the service has no addons": {
+			setupMocks: func(m *showSvcAddonsMocks) {
+				m.addons.EXPECT().MergeReport().Return(nil, &addon.ErrAddonsNotFound{WlName: "frontend"})
+			},
+		},
+		"should wrap the error if the report can't be generated": {
+			setupMocks: func(m *showSvcAddonsMocks) {
+				m.addons.EXPECT().MergeReport().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("report how the addons of service frontend are merged: some error"),
+		},
+		"should write the report": {
+			setupMocks: func(m *showSvcAddonsMocks) {
+				m.addons.EXPECT().MergeReport().Return(report, nil)
+			},
+			wantedOutput: report.HumanString(),
+		},
+		"should write the report in JSON": {
+			inVars: showSvcAddonsVars{
+				shouldOutputJSON: true,
+			},
+			setupMocks: func(m *showSvcAddonsMocks) {
+				m.addons.EXPECT().MergeReport().Return(report, nil)
+			},
+			wantedOutput: `{"name":"frontend","entries":[{"section":"Resources","logicalID":"Bucket","files":["bucket.yml"]}]}` + "\n",
+		},
+		"should write the merged template": {
+			inVars: showSvcAddonsVars{
+				shouldShowTpl: true,
+			},
+			setupMocks: func(m *showSvcAddonsMocks) {
+				m.addons.EXPECT().MergeReport().Return(report, nil)
+				m.addons.EXPECT().Template().Return("Resources:\n  Bucket:\n    Type: AWS::S3::Bucket\n", nil)
+			},
+			wantedOutput: "Resources:\n  Bucket:\n    Type: AWS::S3::Bucket\n",
+		},
+		"should write the report instead of the template and error if there are conflicts": {
+			inVars: showSvcAddonsVars{
+				shouldShowTpl: true,
+			},
+			setupMocks: func(m *showSvcAddonsMocks) {
+				m.addons.EXPECT().MergeReport().Return(conflictingReport, nil)
+			},
+			wantedOutput: conflictingReport.HumanString(),
+			wantedErr:    errors.New("addons of service frontend have 1 conflict"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &showSvcAddonsMocks{
+				addons: mocks.NewMockaddonsMergeReporter(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			tc.inVars.name = "frontend"
+			opts := &showSvcAddonsOpts{
+				showSvcAddonsVars: tc.inVars,
+				newAddons: func(svcName string) (addonsMergeReporter, error) {
+					return m.addons, nil
+				},
+				w: b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
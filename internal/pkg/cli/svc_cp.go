@@ -0,0 +1,300 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcCpNamePrompt     = "Which service would you like to copy files to or from?"
+	svcCpNameHelpPrompt = `Copilot copies files to or from one of your chosen service's tasks.
+The task is chosen at random, and the first essential container is used unless the path names another container.`
+	svcCpSrcPrompt      = "Which file or directory would you like to copy?"
+	svcCpDstPrompt      = "Where would you like to copy it to?"
+	svcCpPathHelpPrompt = `Paths in a container are written as [<container>]:<absolute path>, for example ":/var/log/app.log".
+Exactly one of the source and the destination must be in a container.`
+)
+
+var errCpNoRemotePath = errors.New(`exactly one of "--src" and "--dst" must be a path in a container, written as [<container>]:<absolute path>`)
+
+type svcCpVars struct {
+	appName          string
+	envName          string
+	name             string
+	src              string
+	dst              string
+	taskID           string
+	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+}
+
+type svcCpOpts struct {
+	svcCpVars
+	store            store
+	sel              deploySelector
+	newSvcDescriber  func(*session.Session) serviceDescriber
+	newFileCopier    func(sess *session.Session, cluster, task, container string) fileCopier
+	ssmPluginManager ssmPluginManager
+	prompter         prompter
+	sessProvider     *sessions.Provider
+	// Override in unit test
+	randInt func(int) int
+
+	// Cached variables.
+	upload     bool   // True if files are copied from the local machine to the container.
+	container  string // Name of the container, empty for the first essential container.
+	localPath  string
+	remotePath string
+}
+
+func newSvcCpOpts(vars svcCpVars) (*svcCpOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc cp"))
+	defaultSession, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	ssmStore := config.NewSSMStore(identity.New(defaultSession), awsssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcCpOpts{
+		svcCpVars: vars,
+		store:     ssmStore,
+		sel:       selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
+		newSvcDescriber: func(s *session.Session) serviceDescriber {
+			return ecs.New(s)
+		},
+		newFileCopier: func(s *session.Session, cluster, task, container string) fileCopier {
+			return ecs.NewFileCopier(s, afero.NewOsFs(), cluster, task, container, log.DiagnosticWriter)
+		},
+		randInt: func(x int) int {
+			rand.Seed(time.Now().Unix())
+			return rand.Intn(x)
+		},
+		ssmPluginManager: exec.NewSSMPluginCommand(nil),
+		prompter:         prompt.New(),
+		sessProvider:     sessProvider,
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcCpOpts) Validate() error {
+	if o.src != "" && o.dst != "" {
+		if err := o.setPaths(); err != nil {
+			return err
+		}
+	}
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcCpOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateAndAskSvcEnvName(); err != nil {
+		return err
+	}
+	return o.askPaths()
+}
+
+// Execute copies the files between the local machine and a running container.
+func (o *svcCpOpts) Execute() error {
+	wkld, err := o.store.GetWorkload(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get workload: %w", err)
+	}
+	if wkld.Type == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("copying files to or from a running container part of a service is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+	}
+	task, err := selectRunningTask(awsecs.FilterRunningTasks(svcDesc.Tasks), o.name, o.envName, o.taskID, o.randInt)
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return err
+	}
+	container := o.container
+	if container == "" {
+		// The first essential container is named with the workload name.
+		container = o.name
+	}
+
+	copier := o.newFileCopier(sess, svcDesc.ClusterName, taskID, container)
+	var result *ecs.CopyResult
+	if o.upload {
+		result, err = copier.Upload(o.localPath, o.remotePath)
+	} else {
+		result, err = copier.Download(o.remotePath, o.localPath)
+	}
+	if err != nil {
+		var errExecCmd *awsecs.ErrExecuteCommand
+		if errors.As(err, &errExecCmd) {
+			log.Errorf("Failed to copy files. Is %s set in your manifest?\n", color.HighlightCode("exec: true"))
+		}
+		return fmt.Errorf("copy %s to %s: %w", o.src, o.dst, err)
+	}
+	log.Successf("Copied %s to %s in task %s.\n", color.HighlightUserInput(o.src), color.HighlightUserInput(o.dst), color.HighlightResource(taskID))
+	log.Infof("%d files and directories, %s archive with SHA-256 checksum %s verified.\n",
+		result.Files, humanize.Bytes(uint64(result.Bytes)), result.Checksum)
+	return nil
+}
+
+func (o *svcCpOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcCpOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcCpNamePrompt, svcCpNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *svcCpOpts) askPaths() error {
+	if o.src == "" {
+		src, err := o.prompter.Get(svcCpSrcPrompt, svcCpPathHelpPrompt, nil, prompt.WithFinalMessage("Source:"))
+		if err != nil {
+			return fmt.Errorf("get source path: %w", err)
+		}
+		o.src = src
+	}
+	if o.dst == "" {
+		dst, err := o.prompter.Get(svcCpDstPrompt, svcCpPathHelpPrompt, nil, prompt.WithFinalMessage("Destination:"))
+		if err != nil {
+			return fmt.Errorf("get destination path: %w", err)
+		}
+		o.dst = dst
+	}
+	return o.setPaths()
+}
+
+func (o *svcCpOpts) setPaths() error {
+	srcContainer, srcPath, srcRemote := parseCopyPath(o.src)
+	dstContainer, dstPath, dstRemote := parseCopyPath(o.dst)
+	switch {
+	case srcRemote == dstRemote:
+		return errCpNoRemotePath
+	case dstRemote:
+		o.upload, o.container, o.localPath, o.remotePath = true, dstContainer, srcPath, dstPath
+	default:
+		o.upload, o.container, o.localPath, o.remotePath = false, srcContainer, dstPath, srcPath
+	}
+	return nil
+}
+
+// parseCopyPath returns the container and the path of a path written as [<container>]:<path>.
+// If the path is not in a container, remote is false.
+func parseCopyPath(p string) (container string, path string, remote bool) {
+	i := strings.Index(p, ":")
+	if i < 0 || isWindowsDrive(p, i) {
+		return "", p, false
+	}
+	return p[:i], p[i+1:], true
+}
+
+// isWindowsDrive returns true if the colon at index i follows the drive letter of a Windows path, like C:\Users.
+func isWindowsDrive(p string, i int) bool {
+	return i == 1 && len(p) > 2 && (p[2] == '\\' || p[2] == '/')
+}
+
+// buildSvcCpCmd builds the command for copying files between the local machine and a running container in a service.
+func buildSvcCpCmd() *cobra.Command {
+	vars := svcCpVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "cp",
+		Short: "Copy files or directories between your machine and a running container part of a service.",
+		Long: `Copy files or directories between your machine and a running container part of a service.
+The files are sent through ECS Exec in a tar archive of up to 5 MiB, so the container must have "tar", "base64" and "sha256sum" installed.`,
+		Example: `
+  Download the "/var/log/app" directory of the "api" service's main container to the current directory.
+  /code $ copilot svc cp -n api -e test --src :/var/log/app --dst .
+  Upload "seed.sql" to the "/tmp" directory of the "postgres" sidecar in the task prefixed with ID "8c38184".
+  /code $ copilot svc cp -n api -e test --task-id 8c38184 --src ./seed.sql --dst postgres:/tmp/`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcCpOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().StringVar(&vars.src, srcFlag, "", cpSrcFlagDescription)
+	cmd.Flags().StringVar(&vars.dst, dstFlag, "", cpDstFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", cpTaskIDFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,170 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcCp_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSrc string
+		inDst string
+
+		wantedUpload     bool
+		wantedContainer  string
+		wantedLocalPath  string
+		wantedRemotePath string
+		wantedError      error
+	}{
+		"error if both paths are local": {
+			inSrc:       "./seed.sql",
+			inDst:       `C:\seed.sql`,
+			wantedError: errCpNoRemotePath,
+		},
+		"error if both paths are in a container": {
+			inSrc:       ":/tmp/seed.sql",
+			inDst:       "postgres:/tmp/seed.sql",
+			wantedError: errCpNoRemotePath,
+		},
+		"upload to the main container": {
+			inSrc:            "./seed.sql",
+			inDst:            ":/tmp/",
+			wantedUpload:     true,
+			wantedLocalPath:  "./seed.sql",
+			wantedRemotePath: "/tmp/",
+		},
+		"download from a sidecar to a Windows path": {
+			inSrc:            "nginx:/etc/nginx/nginx.conf",
+			inDst:            `C:\Users\me\nginx.conf`,
+			wantedContainer:  "nginx",
+			wantedLocalPath:  `C:\Users\me\nginx.conf`,
+			wantedRemotePath: "/etc/nginx/nginx.conf",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcCpOpts{
+				svcCpVars: svcCpVars{
+					src:              tc.inSrc,
+					dst:              tc.inDst,
+					skipConfirmation: aws.Bool(false), // Skip the ssm plugin validation.
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedUpload, opts.upload)
+			require.Equal(t, tc.wantedContainer, opts.container)
+			require.Equal(t, tc.wantedLocalPath, opts.localPath)
+			require.Equal(t, tc.wantedRemotePath, opts.remotePath)
+		})
+	}
+}
+
+func TestSvcCp_Execute(t *testing.T) {
+	const mockTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
+	mockSvcDesc := &ecs.ServiceDesc{
+		ClusterName: "mockCluster",
+		Tasks: []*awsecs.Task{
+			{
+				TaskArn:    aws.String(mockTaskARN),
+				LastStatus: aws.String("RUNNING"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inUpload    bool
+		inContainer string
+		setupMocks  func(copier *mocks.MockfileCopier)
+
+		wantedContainer string
+		wantedError     error
+	}{
+		"wrap error if fail to copy": {
+			inUpload: true,
+			setupMocks: func(copier *mocks.MockfileCopier) {
+				copier.EXPECT().Upload("./seed.sql", "/tmp/").Return(nil, errors.New("some error"))
+			},
+			wantedContainer: "mockSvc",
+			wantedError:     errors.New("copy ./seed.sql to :/tmp/: some error"),
+		},
+		"upload to the main container": {
+			inUpload: true,
+			setupMocks: func(copier *mocks.MockfileCopier) {
+				copier.EXPECT().Upload("./seed.sql", "/tmp/").Return(&ecs.CopyResult{Files: 1, Bytes: 2048, Checksum: "abc"}, nil)
+			},
+			wantedContainer: "mockSvc",
+		},
+		"download from a sidecar": {
+			inContainer: "nginx",
+			setupMocks: func(copier *mocks.MockfileCopier) {
+				copier.EXPECT().Download("/tmp/", "./seed.sql").Return(&ecs.CopyResult{Files: 1, Bytes: 2048, Checksum: "abc"}, nil)
+			},
+			wantedContainer: "nginx",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			describer := mocks.NewMockserviceDescriber(ctrl)
+			copier := mocks.NewMockfileCopier(ctrl)
+			store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&config.Workload{Type: "Backend Service"}, nil)
+			store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{Name: "mockEnv"}, nil)
+			describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil)
+			tc.setupMocks(copier)
+			opts := &svcCpOpts{
+				svcCpVars: svcCpVars{
+					appName: "mockApp",
+					envName: "mockEnv",
+					name:    "mockSvc",
+					src:     "./seed.sql",
+					dst:     ":/tmp/",
+				},
+				store: store,
+				newSvcDescriber: func(_ *session.Session) serviceDescriber {
+					return describer
+				},
+				newFileCopier: func(_ *session.Session, cluster, task, container string) fileCopier {
+					require.Equal(t, "mockCluster", cluster)
+					require.Equal(t, "mockTaskID", task)
+					require.Equal(t, tc.wantedContainer, container)
+					return copier
+				},
+				randInt:      func(i int) int { return 0 },
+				sessProvider: sessions.ImmutableProvider(),
+				upload:       tc.inUpload,
+				container:    tc.inContainer,
+				localPath:    "./seed.sql",
+				remotePath:   "/tmp/",
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
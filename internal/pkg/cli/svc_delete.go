@@ -22,6 +22,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -54,6 +55,7 @@ type deleteSvcVars struct {
 	skipConfirmation bool
 	name             string
 	envName          string
+	outputFormat     string
 }
 
 type deleteSvcOpts struct {
@@ -175,6 +177,11 @@ func (o *deleteSvcOpts) Execute() error {
 	// Skip removing the service from the application if
 	// we are only removing the stack from a particular environment.
 	if !o.needsAppCleanup() {
+		event.Publish(event.Output{
+			Application: o.appName,
+			Environment: o.envName,
+			Workload:    o.name,
+		})
 		return nil
 	}
 
@@ -190,6 +197,10 @@ func (o *deleteSvcOpts) Execute() error {
 
 	log.Infoln()
 	log.Successf("Deleted service %s from application %s.\n", o.name, o.appName)
+	event.Publish(event.Output{
+		Application: o.appName,
+		Workload:    o.name,
+	})
 
 	return nil
 }
@@ -352,11 +363,13 @@ func buildSvcDeleteCmd() *cobra.Command {
   Delete the "test" service without confirmation prompt.
   /code $ copilot svc delete --name test --yes`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newDeleteSvcOpts(vars)
-			if err != nil {
-				return err
-			}
-			return run(opts)
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newDeleteSvcOpts(vars)
+				if err != nil {
+					return err
+				}
+				return run(opts)
+			})
 		}),
 	}
 
@@ -364,5 +377,6 @@ func buildSvcDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
 	return cmd
 }
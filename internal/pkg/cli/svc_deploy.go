@@ -5,6 +5,8 @@ package cli
 
 import (
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -22,6 +24,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -37,6 +40,8 @@ type deployWkldVars struct {
 	resourceTags    map[string]string
 	forceNewUpdate  bool
 	disableRollback bool
+	dryRun          bool
+	outputFormat    string
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -57,6 +62,7 @@ type deploySvcOpts struct {
 	spinner progress
 	sel     wsSelector
 	prompt  prompter
+	w       io.Writer
 
 	// cached variables
 	targetApp       *config.Application
@@ -94,6 +100,7 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		cmd:             exec.NewCmd(),
 		sessProvider:    sessProvider,
 		newSvcDeployer:  newSvcDeployer,
+		w:               os.Stdout,
 	}
 	return opts, err
 }
@@ -136,6 +143,9 @@ func newManifestInterpolator(app, env string) interpolator {
 
 // Validate returns an error for any invalid optional flags.
 func (o *deploySvcOpts) Validate() error {
+	if o.dryRun && o.outputFormat != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s", dryRunFlag, outputFormatFlag)
+	}
 	return nil
 }
 
@@ -167,8 +177,10 @@ func (o *deploySvcOpts) Execute() error {
 			return err
 		}
 	}
-	if err := o.envUpgradeCmd.Execute(); err != nil {
-		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.envName, err)
+	if !o.dryRun {
+		if err := o.envUpgradeCmd.Execute(); err != nil {
+			return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.envName, err)
+		}
 	}
 	mft, err := workloadManifest(&workloadManifestInput{
 		name:         o.name,
@@ -186,6 +198,9 @@ func (o *deploySvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.dryRun {
+		return o.showDeployDiff(deployer)
+	}
 	uploadOut, err := deployer.UploadArtifacts()
 	if err != nil {
 		return fmt.Errorf("upload deploy resources for service %s: %w", o.name, err)
@@ -226,14 +241,44 @@ After fixing the deployment, you can:
 	return nil
 }
 
+// showDeployDiff prints the changes that deploying the service would make to its stack.
+// No artifacts are uploaded, the values of the last deployment are used for the image, env file and addons instead.
+func (o *deploySvcOpts) showDeployDiff(deployer workloadDeployer) error {
+	differ, ok := deployer.(workloadDeployDiffer)
+	if !ok {
+		return fmt.Errorf("--%s is not supported for service %s", dryRunFlag, o.name)
+	}
+	targetApp, err := o.getTargetApp()
+	if err != nil {
+		return err
+	}
+	diff, err := differ.DeployDiff(&deploy.StackRuntimeConfiguration{
+		RootUserARN: o.rootUserARN,
+		Tags:        tags.Merge(targetApp.Tags, o.resourceTags),
+	})
+	if err != nil {
+		return fmt.Errorf("preview deployment of service %s to environment %s: %w", o.name, o.envName, err)
+	}
+	fmt.Fprint(o.w, diff)
+	return nil
+}
+
 // RecommendActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deploySvcOpts) RecommendActions() error {
-	var recommendations []string
-	uriRecs, err := o.uriRecommendedActions()
+	if o.dryRun {
+		return nil
+	}
+	uri, err := o.serviceURI()
 	if err != nil {
 		return err
 	}
-	recommendations = append(recommendations, uriRecs...)
+	event.Publish(event.Output{
+		Application: o.appName,
+		Environment: o.envName,
+		Workload:    o.name,
+		URI:         uri,
+	})
+	recommendations := o.uriRecommendedActions(uri)
 	if o.deployRecs != nil {
 		recommendations = append(recommendations, o.deployRecs.RecommendedActions()...)
 	}
@@ -358,35 +403,41 @@ func workloadManifest(in *workloadManifestInput) (interface{}, error) {
 	return envMft, nil
 }
 
-func (o *deploySvcOpts) uriRecommendedActions() ([]string, error) {
+// serviceURI returns the URI of the deployed service, or an empty string if the service doesn't expose a port.
+func (o *deploySvcOpts) serviceURI() (string, error) {
 	type reachable interface {
 		Port() (uint16, bool)
 	}
 	mft, ok := o.appliedManifest.(reachable)
 	if !ok {
-		return nil, nil
+		return "", nil
 	}
 	if _, ok := mft.Port(); !ok { // No exposed port.
-		return nil, nil
+		return "", nil
 	}
 
 	describer, err := describe.NewReachableService(o.appName, o.name, o.store)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	uri, err := describer.URI(o.envName)
 	if err != nil {
-		return nil, fmt.Errorf("get uri for environment %s: %w", o.envName, err)
+		return "", fmt.Errorf("get uri for environment %s: %w", o.envName, err)
 	}
+	return uri, nil
+}
 
+func (o *deploySvcOpts) uriRecommendedActions(uri string) []string {
+	if uri == "" {
+		return nil
+	}
 	network := "over the internet."
 	if o.svcType == manifest.BackendServiceType {
 		network = "with service discovery."
 	}
-	recs := []string{
+	return []string{
 		fmt.Sprintf("You can access your service at %s %s", color.HighlightResource(uri), network),
 	}
-	return recs, nil
 }
 
 func (o *deploySvcOpts) publishRecommendedActions() []string {
@@ -432,13 +483,17 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Shows the changes to the "frontend" service's stack in the "test" environment without deploying.
+  /code $ copilot svc deploy --name frontend --env test --dry-run`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newSvcDeployOpts(vars)
-			if err != nil {
-				return err
-			}
-			return run(opts)
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newSvcDeployOpts(vars)
+				if err != nil {
+					return err
+				}
+				return run(opts)
+			})
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
@@ -448,6 +503,8 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, dryRunFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
 
 	return cmd
 }
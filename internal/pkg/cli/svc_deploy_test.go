@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
@@ -18,7 +19,39 @@ import (
 )
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
-	// There is no validation for svc deploy.
+	testCases := map[string]struct {
+		inDryRun       bool
+		inOutputFormat string
+
+		wantedError error
+	}{
+		"should succeed without flags": {},
+		"should error if both --dry-run and --output are set": {
+			inDryRun:       true,
+			inOutputFormat: "json",
+
+			wantedError: errors.New("cannot specify both --dry-run and --output"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					dryRun:       tc.inDryRun,
+					outputFormat: tc.inOutputFormat,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
 }
 
 type svcDeployAskMocks struct {
@@ -236,6 +269,92 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 	}
 }
 
+func TestSvcDeployOpts_Execute_DryRun(t *testing.T) {
+	testCases := map[string]struct {
+		mock func(m *mocks.MockworkloadDeployDiffer)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"wrap error if the deployment cannot be previewed": {
+			mock: func(m *mocks.MockworkloadDeployDiffer) {
+				m.EXPECT().DeployDiff(gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("preview deployment of service frontend to environment prod-iad: some error"),
+		},
+		"print the diff without uploading artifacts or deploying": {
+			mock: func(m *mocks.MockworkloadDeployDiffer) {
+				m.EXPECT().UploadArtifacts().Times(0)
+				m.EXPECT().DeployWorkload(gomock.Any()).Times(0)
+				m.EXPECT().DeployDiff(&deploy.StackRuntimeConfiguration{
+					RootUserARN: "arn:aws:iam::123456789012:root",
+					Tags: map[string]string{
+						"owner": "team",
+					},
+				}).Return("~ DesiredCount: 1 -> 2\n", nil)
+			},
+			wantedOutput: "~ DesiredCount: 1 -> 2\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDiffer := mocks.NewMockworkloadDeployDiffer(ctrl)
+			mockWsReader := mocks.NewMockwsWlDirReader(ctrl)
+			mockInterpolator := mocks.NewMockinterpolator(ctrl)
+			mockEnvUpgrader := mocks.NewMockactionCommand(ctrl)
+			mockEnvUpgrader.EXPECT().Execute().Times(0)
+			mockWsReader.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(""), nil)
+			mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+			tc.mock(mockDiffer)
+			buf := new(bytes.Buffer)
+
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					appName: "phonetool",
+					name:    "frontend",
+					envName: "prod-iad",
+					dryRun:  true,
+
+					clientConfigured: true,
+				},
+				newSvcDeployer: func(dso *deploySvcOpts) (workloadDeployer, error) {
+					return mockDiffer, nil
+				},
+				envUpgradeCmd: mockEnvUpgrader,
+				newInterpolator: func(app, env string) interpolator {
+					return mockInterpolator
+				},
+				ws: mockWsReader,
+				unmarshal: func(b []byte) (manifest.WorkloadManifest, error) {
+					return &mockWorkloadMft{}, nil
+				},
+				targetApp: &config.Application{
+					Tags: map[string]string{
+						"owner": "team",
+					},
+				},
+				rootUserARN: "arn:aws:iam::123456789012:root",
+				w:           buf,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutput, buf.String())
+			}
+		})
+	}
+}
+
 type mockWorkloadMft struct{}
 
 func (m *mockWorkloadMft) ApplyEnv(envName string) (manifest.WorkloadManifest, error) {
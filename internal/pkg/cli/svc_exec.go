@@ -192,26 +192,31 @@ func (o *svcExecOpts) envSession() (*session.Session, error) {
 }
 
 func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (string, error) {
+	task, err := selectRunningTask(tasks, o.name, o.envName, o.taskID, o.randInt)
+	if err != nil {
+		return "", err
+	}
+	return awsecs.TaskID(aws.StringValue(task.TaskArn))
+}
+
+// selectRunningTask returns the task whose ID is prefixed with taskIDPrefix, or a random task if the prefix is empty.
+func selectRunningTask(tasks []*awsecs.Task, svc, env, taskIDPrefix string, randInt func(int) int) (*awsecs.Task, error) {
 	if len(tasks) == 0 {
-		return "", fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+		return nil, fmt.Errorf("found no running task for service %s in environment %s", svc, env)
 	}
-	if o.taskID != "" {
-		for _, task := range tasks {
-			taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
-			if err != nil {
-				return "", err
-			}
-			if strings.HasPrefix(taskID, o.taskID) {
-				return taskID, nil
-			}
-		}
-		return "", fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
+	if taskIDPrefix == "" {
+		return tasks[randInt(len(tasks))], nil
 	}
-	taskID, err := awsecs.TaskID(aws.StringValue(tasks[o.randInt(len(tasks))].TaskArn))
-	if err != nil {
-		return "", err
+	for _, task := range tasks {
+		taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(taskID, taskIDPrefix) {
+			return task, nil
+		}
 	}
-	return taskID, nil
+	return nil, fmt.Errorf("found no running task whose ID is prefixed with %s", taskIDPrefix)
 }
 
 func (o *svcExecOpts) selectContainer() string {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -17,15 +18,19 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/cobra"
 )
 
 const (
 	svcLogNamePrompt     = "Which service's logs would you like to show?"
 	svcLogNameHelpPrompt = "The logs of a deployed service will be shown."
+	svcLogEnvPrompt      = "Which environment's workloads would you like to show the logs of?"
+	svcLogEnvHelpPrompt  = "The logs of the services and jobs deployed in the environment will be shown."
 
 	cwGetLogEventsLimitMin = 1
 	cwGetLogEventsLimitMax = 10000
@@ -43,6 +48,12 @@ type wkldLogsVars struct {
 	taskIDs          []string
 	since            time.Duration
 	logGroup         string
+	workloads        []string // Names of the services and jobs to interleave the logs of.
+	containers       []string
+	filterPattern    string
+	highlight        string
+	insightsQuery    string
+	exportDir        string
 }
 
 type svcLogsOpts struct {
@@ -54,8 +65,9 @@ type svcLogsOpts struct {
 
 type wkldLogOpts struct {
 	// internal states
-	startTime *int64
-	endTime   *int64
+	startTime   *int64
+	endTime     *int64
+	highlightRe *regexp.Regexp
 
 	w           io.Writer
 	configStore store
@@ -91,6 +103,9 @@ func newSvcLogOpts(vars wkldLogsVars) (*svcLogsOpts, error) {
 		if err != nil {
 			return fmt.Errorf("get environment: %w", err)
 		}
+		if len(opts.workloads) != 0 {
+			return opts.initWorkloadsLogsSvc(sessProvider, env)
+		}
 		workload, err := configStore.GetWorkload(opts.appName, opts.name)
 		if err != nil {
 			return fmt.Errorf("get workload: %w", err)
@@ -117,8 +132,56 @@ func newSvcLogOpts(vars wkldLogsVars) (*svcLogsOpts, error) {
 	return opts, nil
 }
 
+func (o *svcLogsOpts) initWorkloadsLogsSvc(sessProvider *sessions.Provider, env *config.Environment) error {
+	sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+	configs := make([]*logging.NewServiceLogsConfig, len(o.workloads))
+	for i, name := range o.workloads {
+		workload, err := o.configStore.GetWorkload(o.appName, name)
+		if err != nil {
+			return fmt.Errorf("get workload %s: %w", name, err)
+		}
+		configs[i] = &logging.NewServiceLogsConfig{
+			App:         o.appName,
+			Env:         o.envName,
+			Svc:         name,
+			Sess:        sess,
+			WkldType:    workload.Type,
+			ConfigStore: o.configStore,
+		}
+	}
+	o.logsSvc, err = logging.NewWorkloadsClient(configs)
+	return err
+}
+
 // Validate returns an error for any invalid optional flags.
 func (o *svcLogsOpts) Validate() error {
+	if len(o.workloads) != 0 {
+		if o.name != "" {
+			return errors.New("only one of --name or --workloads may be used")
+		}
+		if o.taskIDs != nil {
+			return errors.New("only one of --tasks or --workloads may be used")
+		}
+		if o.logGroup != "" {
+			return errors.New("only one of --log-group or --workloads may be used")
+		}
+	}
+
+	if err := o.validateInsightsAndExport(); err != nil {
+		return err
+	}
+
+	if o.highlight != "" {
+		re, err := regexp.Compile(o.highlight)
+		if err != nil {
+			return fmt.Errorf(`invalid argument %s for "--highlight" flag: %w`, o.highlight, err)
+		}
+		o.highlightRe = re
+	}
+
 	if o.since != 0 && o.humanStartTime != "" {
 		return errors.New("only one of --since or --start-time may be used")
 	}
@@ -158,11 +221,51 @@ func (o *svcLogsOpts) Validate() error {
 	return nil
 }
 
+func (o *svcLogsOpts) validateInsightsAndExport() error {
+	if o.insightsQuery != "" && o.exportDir != "" {
+		return errors.New("only one of --insights or --export may be used")
+	}
+	var flag string
+	switch {
+	case o.insightsQuery != "":
+		flag = insightsFlag
+	case o.exportDir != "":
+		flag = exportLogsFlag
+	default:
+		return nil
+	}
+	if o.follow {
+		return fmt.Errorf("only one of --follow or --%s may be used", flag)
+	}
+	if o.filterPattern != "" {
+		return fmt.Errorf("only one of --filter or --%s may be used", flag)
+	}
+	if o.highlight != "" {
+		return fmt.Errorf("only one of --highlight or --%s may be used", flag)
+	}
+	if flag == insightsFlag {
+		if o.taskIDs != nil || o.containers != nil {
+			return errors.New("--tasks and --containers cannot be used with --insights, filter on @logStream in the query instead")
+		}
+		return nil
+	}
+	if o.shouldOutputJSON {
+		return errors.New("only one of --json or --export may be used")
+	}
+	if o.limit != 0 {
+		return errors.New("only one of --limit or --export may be used")
+	}
+	return nil
+}
+
 // Ask prompts for and validates any required flags.
 func (o *svcLogsOpts) Ask() error {
 	if err := o.validateOrAskApp(); err != nil {
 		return err
 	}
+	if len(o.workloads) != 0 {
+		return o.validateAndAskWorkloadsEnvName()
+	}
 	return o.validateAndAskSvcEnvName()
 }
 
@@ -171,7 +274,16 @@ func (o *svcLogsOpts) Execute() error {
 	if err := o.initLogsSvc(); err != nil {
 		return err
 	}
+	if o.insightsQuery != "" {
+		return o.writeQueryResults()
+	}
+	if o.exportDir != "" {
+		return o.exportLogEvents()
+	}
 	eventsWriter := logging.WriteHumanLogs
+	if o.highlightRe != nil {
+		eventsWriter = logging.HighlightHumanLogs(o.highlightRe)
+	}
 	if o.shouldOutputJSON {
 		eventsWriter = logging.WriteJSONLogs
 	}
@@ -180,19 +292,72 @@ func (o *svcLogsOpts) Execute() error {
 		limit = aws.Int64(int64(o.limit))
 	}
 	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
-		Follow:    o.follow,
+		Follow:        o.follow,
+		Limit:         limit,
+		EndTime:       o.endTime,
+		StartTime:     o.startTime,
+		TaskIDs:       o.taskIDs,
+		Containers:    o.containers,
+		FilterPattern: o.filterPattern,
+		OnEvents:      eventsWriter,
+	})
+	if err != nil {
+		if len(o.workloads) != 0 {
+			return fmt.Errorf("write log events for workloads %s: %w", english.WordSeries(o.workloads, "and"), err)
+		}
+		return fmt.Errorf("write log events for service %s: %w", o.name, err)
+	}
+	return nil
+}
+
+func (o *svcLogsOpts) writeQueryResults() error {
+	resultsWriter := logging.WriteHumanLogs
+	if o.shouldOutputJSON {
+		resultsWriter = logging.WriteJSONLogs
+	}
+	var limit *int64
+	if o.limit != 0 {
+		limit = aws.Int64(int64(o.limit))
+	}
+	err := o.logsSvc.WriteQueryResults(logging.WriteQueryResultsOpts{
+		Query:     o.insightsQuery,
 		Limit:     limit,
-		EndTime:   o.endTime,
 		StartTime: o.startTime,
-		TaskIDs:   o.taskIDs,
-		OnEvents:  eventsWriter,
+		EndTime:   o.endTime,
+		OnResults: resultsWriter,
 	})
 	if err != nil {
-		return fmt.Errorf("write log events for service %s: %w", o.name, err)
+		return fmt.Errorf("query logs of %s: %w", o.logsTarget(), err)
 	}
 	return nil
 }
 
+func (o *svcLogsOpts) exportLogEvents() error {
+	files, err := o.logsSvc.ExportLogEvents(logging.ExportLogEventsOpts{
+		Dir:        o.exportDir,
+		StartTime:  o.startTime,
+		EndTime:    o.endTime,
+		TaskIDs:    o.taskIDs,
+		Containers: o.containers,
+	})
+	if err != nil {
+		return fmt.Errorf("export logs of %s: %w", o.logsTarget(), err)
+	}
+	if len(files) == 0 {
+		log.Infof("No logs of %s to export.\n", o.logsTarget())
+		return nil
+	}
+	log.Successf("Exported the logs of %s to %s in %s.\n", o.logsTarget(), color.HighlightResource(o.exportDir), english.Plural(len(files), "file", "files"))
+	return nil
+}
+
+func (o *svcLogsOpts) logsTarget() string {
+	if len(o.workloads) != 0 {
+		return "workloads " + english.WordSeries(o.workloads, "and")
+	}
+	return "service " + o.name
+}
+
 func (o *svcLogsOpts) validateOrAskApp() error {
 	if o.appName != "" {
 		_, err := o.configStore.GetApplication(o.appName)
@@ -229,6 +394,25 @@ func (o *svcLogsOpts) validateAndAskSvcEnvName() error {
 	return nil
 }
 
+func (o *svcLogsOpts) validateAndAskWorkloadsEnvName() error {
+	if o.envName == "" {
+		env, err := o.sel.Environment(svcLogEnvPrompt, svcLogEnvHelpPrompt, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = env
+	}
+	if _, err := o.getTargetEnv(); err != nil {
+		return err
+	}
+	for _, name := range o.workloads {
+		if _, err := o.configStore.GetWorkload(o.appName, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (o *svcLogsOpts) getTargetEnv() (*config.Environment, error) {
 	if o.targetEnv != nil {
 		return o.targetEnv, nil
@@ -274,7 +458,15 @@ func buildSvcLogsCmd() *cobra.Command {
   Displays logs in real time.
   /code $ copilot svc logs --follow
   Display logs from specific log group.
-  /code $ copilot svc logs --log-group system`,
+  /code $ copilot svc logs --log-group system
+  Displays the errors of the "frontend" and "api" services and the "worker" job together in real time.
+  /code $ copilot svc logs -e test --workloads frontend,api,worker --filter ERROR --follow
+  Displays logs of the "envoy" sidecar and highlights the request IDs.
+  /code $ copilot svc logs -n my-svc --containers envoy --highlight "req-[0-9a-f]+"
+  Displays the number of errors per 5 minutes over the last day with a Logs Insights query.
+  /code $ copilot svc logs -n my-svc --since 24h --insights "filter @message like /ERROR/ | stats count(*) by bin(5m)"
+  Writes the logs of the last week to the "logs" directory.
+  /code $ copilot svc logs -n my-svc --since 168h --export logs/`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcLogOpts(vars)
 			if err != nil {
@@ -294,5 +486,11 @@ func buildSvcLogsCmd() *cobra.Command {
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
 	cmd.Flags().StringVar(&vars.logGroup, logGroupFlag, "", logGroupFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.workloads, workloadsFlag, nil, workloadsLogsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.containers, containersFlag, nil, containersLogsFlagDescription)
+	cmd.Flags().StringVar(&vars.filterPattern, logFilterFlag, "", logFilterFlagDescription)
+	cmd.Flags().StringVar(&vars.highlight, highlightFlag, "", highlightFlagDescription)
+	cmd.Flags().StringVar(&vars.insightsQuery, insightsFlag, "", insightsFlagDescription)
+	cmd.Flags().StringVar(&vars.exportDir, exportLogsFlag, "", exportLogsFlagDescription)
 	return cmd
 }
@@ -40,6 +40,13 @@ func TestSvcLogs_Validate(t *testing.T) {
 		inputStartTime string
 		inputEndTime   string
 		inputSince     time.Duration
+		inputWorkloads []string
+		inputTaskIDs   []string
+		inputHighlight string
+		inputJSON      bool
+		inputFilter    string
+		inputInsights  string
+		inputExportDir string
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -99,6 +106,84 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("--limit 10001 is out-of-bounds, value must be between 1 and 10000"),
 		},
+		"returns error if name and workloads flags are set together": {
+			inputSvc:       "frontend",
+			inputWorkloads: []string{"frontend", "api"},
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --name or --workloads may be used"),
+		},
+		"returns error if tasks and workloads flags are set together": {
+			inputTaskIDs:   []string{"mockTaskID"},
+			inputWorkloads: []string{"frontend", "api"},
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --tasks or --workloads may be used"),
+		},
+		"returns error if invalid highlight flag value": {
+			inputHighlight: "req-[",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("invalid argument req-[ for \"--highlight\" flag: error parsing regexp: missing closing ]: `[`"),
+		},
+		"returns error if insights and export flags are set together": {
+			inputInsights:  "fields @message",
+			inputExportDir: "logs",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --insights or --export may be used"),
+		},
+		"returns error if insights and follow flags are set together": {
+			inputInsights: "fields @message",
+			inputFollow:   true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --follow or --insights may be used"),
+		},
+		"returns error if insights and tasks flags are set together": {
+			inputInsights: "fields @message",
+			inputTaskIDs:  []string{"mockTaskID"},
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--tasks and --containers cannot be used with --insights, filter on @logStream in the query instead"),
+		},
+		"returns error if export and filter flags are set together": {
+			inputExportDir: "logs",
+			inputFilter:    "ERROR",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --filter or --export may be used"),
+		},
+		"returns error if export and json flags are set together": {
+			inputExportDir: "logs",
+			inputJSON:      true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --json or --export may be used"),
+		},
+		"returns error if export and limit flags are set together": {
+			inputExportDir: "logs",
+			inputLimit:     10,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --limit or --export may be used"),
+		},
+		"success with insights and json flags": {
+			inputInsights: "fields @message",
+			inputJSON:     true,
+			inputLimit:    10,
+
+			mockstore: func(m *mocks.Mockstore) {},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -111,14 +196,21 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			svcLogs := &svcLogsOpts{
 				wkldLogsVars: wkldLogsVars{
-					follow:         tc.inputFollow,
-					limit:          tc.inputLimit,
-					envName:        tc.inputEnvName,
-					humanStartTime: tc.inputStartTime,
-					humanEndTime:   tc.inputEndTime,
-					since:          tc.inputSince,
-					name:           tc.inputSvc,
-					appName:        tc.inputApp,
+					follow:           tc.inputFollow,
+					limit:            tc.inputLimit,
+					envName:          tc.inputEnvName,
+					humanStartTime:   tc.inputStartTime,
+					humanEndTime:     tc.inputEndTime,
+					since:            tc.inputSince,
+					name:             tc.inputSvc,
+					appName:          tc.inputApp,
+					workloads:        tc.inputWorkloads,
+					taskIDs:          tc.inputTaskIDs,
+					highlight:        tc.inputHighlight,
+					shouldOutputJSON: tc.inputJSON,
+					filterPattern:    tc.inputFilter,
+					insightsQuery:    tc.inputInsights,
+					exportDir:        tc.inputExportDir,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
@@ -145,9 +237,10 @@ func TestSvcLogs_Ask(t *testing.T) {
 		inputSvc = "my-svc"
 	)
 	testCases := map[string]struct {
-		inputApp     string
-		inputSvc     string
-		inputEnvName string
+		inputApp       string
+		inputSvc       string
+		inputEnvName   string
+		inputWorkloads []string
 
 		setupMocks func(mocks svcLogsMock)
 
@@ -228,6 +321,33 @@ func TestSvcLogs_Ask(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("select deployed services for application my-app: some error"),
 		},
+		"prompt for env and validate the workloads": {
+			inputApp:       inputApp,
+			inputWorkloads: []string{"frontend", "worker"},
+			setupMocks: func(m svcLogsMock) {
+				gomock.InOrder(
+					m.configStore.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil),
+					m.sel.EXPECT().Environment(svcLogEnvPrompt, svcLogEnvHelpPrompt, "my-app").Return("my-env", nil),
+					m.configStore.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{Name: "my-env"}, nil),
+					m.configStore.EXPECT().GetWorkload("my-app", "frontend").Return(&config.Workload{}, nil),
+					m.configStore.EXPECT().GetWorkload("my-app", "worker").Return(&config.Workload{}, nil),
+				)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedApp: inputApp,
+			wantedEnv: inputEnv,
+		},
+		"return error if a workload does not exist": {
+			inputApp:       inputApp,
+			inputEnvName:   inputEnv,
+			inputWorkloads: []string{"frontend"},
+			setupMocks: func(m svcLogsMock) {
+				m.configStore.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.configStore.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{Name: "my-env"}, nil)
+				m.configStore.EXPECT().GetWorkload("my-app", "frontend").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -247,9 +367,10 @@ func TestSvcLogs_Ask(t *testing.T) {
 
 			svcLogs := &svcLogsOpts{
 				wkldLogsVars: wkldLogsVars{
-					envName: tc.inputEnvName,
-					name:    tc.inputSvc,
-					appName: tc.inputApp,
+					envName:   tc.inputEnvName,
+					name:      tc.inputSvc,
+					appName:   tc.inputApp,
+					workloads: tc.inputWorkloads,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
@@ -279,12 +400,17 @@ func TestSvcLogs_Execute(t *testing.T) {
 	mockLimit := int64(10)
 	var mockNilLimit *int64
 	testCases := map[string]struct {
-		inputSvc  string
-		follow    bool
-		limit     int
-		endTime   int64
-		startTime int64
-		taskIDs   []string
+		inputSvc       string
+		inputWorkloads []string
+		follow         bool
+		limit          int
+		endTime        int64
+		startTime      int64
+		taskIDs        []string
+		containers     []string
+		filterPattern  string
+		insightsQuery  string
+		exportDir      string
 
 		mocklogsSvc func(ctrl *gomock.Controller) logEventsWriter
 
@@ -348,6 +474,100 @@ func TestSvcLogs_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("write log events for service mockSvc: some error"),
 		},
+		"success with containers and filter pattern": {
+			inputSvc:      "mockSvc",
+			containers:    []string{"envoy"},
+			filterPattern: "ERROR",
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, []string{"envoy"}, param.Containers)
+					require.Equal(t, "ERROR", param.FilterPattern)
+				}).Return(nil)
+
+				return m
+			},
+		},
+		"returns error if fail to get event logs of workloads": {
+			inputWorkloads: []string{"frontend", "api", "worker"},
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).
+					Return(errors.New("some error"))
+
+				return m
+			},
+
+			wantedError: fmt.Errorf("write log events for workloads frontend, api and worker: some error"),
+		},
+		"success with insights query": {
+			inputSvc:      "mockSvc",
+			startTime:     mockStartTime,
+			endTime:       mockEndTime,
+			limit:         10,
+			insightsQuery: "stats count(*) by bin(5m)",
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteQueryResults(gomock.Any()).Do(func(param logging.WriteQueryResultsOpts) {
+					require.Equal(t, "stats count(*) by bin(5m)", param.Query)
+					require.Equal(t, &mockStartTime, param.StartTime)
+					require.Equal(t, &mockEndTime, param.EndTime)
+					require.Equal(t, &mockLimit, param.Limit)
+				}).Return(nil)
+
+				return m
+			},
+		},
+		"returns error if fail to query logs of workloads": {
+			inputWorkloads: []string{"frontend", "api"},
+			insightsQuery:  "fields @message",
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteQueryResults(gomock.Any()).Return(errors.New("some error"))
+
+				return m
+			},
+
+			wantedError: fmt.Errorf("query logs of workloads frontend and api: some error"),
+		},
+		"success with export": {
+			inputSvc:   "mockSvc",
+			startTime:  mockStartTime,
+			endTime:    mockEndTime,
+			taskIDs:    []string{"mockTaskID"},
+			containers: []string{"envoy"},
+			exportDir:  "logs",
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().ExportLogEvents(logging.ExportLogEventsOpts{
+					Dir:        "logs",
+					StartTime:  &mockStartTime,
+					EndTime:    &mockEndTime,
+					TaskIDs:    []string{"mockTaskID"},
+					Containers: []string{"envoy"},
+				}).Return([]string{"logs/copilot/envoy/mockTaskID.ndjson.gz"}, nil)
+
+				return m
+			},
+		},
+		"returns error if fail to export logs": {
+			inputSvc:  "mockSvc",
+			exportDir: "logs",
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().ExportLogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+
+				return m
+			},
+
+			wantedError: fmt.Errorf("export logs of service mockSvc: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -357,10 +577,15 @@ func TestSvcLogs_Execute(t *testing.T) {
 
 			svcLogs := &svcLogsOpts{
 				wkldLogsVars: wkldLogsVars{
-					name:    tc.inputSvc,
-					follow:  tc.follow,
-					limit:   tc.limit,
-					taskIDs: tc.taskIDs,
+					name:          tc.inputSvc,
+					workloads:     tc.inputWorkloads,
+					follow:        tc.follow,
+					limit:         tc.limit,
+					taskIDs:       tc.taskIDs,
+					containers:    tc.containers,
+					filterPattern: tc.filterPattern,
+					insightsQuery: tc.insightsQuery,
+					exportDir:     tc.exportDir,
 				},
 				wkldLogOpts: wkldLogOpts{
 					startTime:   &tc.startTime,
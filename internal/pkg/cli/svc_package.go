@@ -126,26 +126,30 @@ func newWkldTplGenerator(o *packageSvcOpts) (workloadTemplateGenerator, error) {
 	if err != nil {
 		return nil, err
 	}
-	var deployer workloadTemplateGenerator
-	in := clideploy.WorkloadDeployerInput{
+	return newWorkloadTemplateGenerator(&clideploy.WorkloadDeployerInput{
 		SessionProvider: o.sessProvider,
 		Name:            o.name,
 		App:             targetApp,
 		Env:             targetEnv,
 		ImageTag:        o.tag,
 		Mft:             o.appliedManifest,
-	}
-	switch t := o.appliedManifest.(type) {
+	})
+}
+
+func newWorkloadTemplateGenerator(in *clideploy.WorkloadDeployerInput) (workloadTemplateGenerator, error) {
+	var deployer workloadTemplateGenerator
+	var err error
+	switch t := in.Mft.(type) {
 	case *manifest.LoadBalancedWebService:
-		deployer, err = clideploy.NewLBDeployer(&in)
+		deployer, err = clideploy.NewLBDeployer(in)
 	case *manifest.BackendService:
-		deployer, err = clideploy.NewBackendDeployer(&in)
+		deployer, err = clideploy.NewBackendDeployer(in)
 	case *manifest.RequestDrivenWebService:
-		deployer, err = clideploy.NewRDWSDeployer(&in)
+		deployer, err = clideploy.NewRDWSDeployer(in)
 	case *manifest.WorkerService:
-		deployer, err = clideploy.NewWorkerSvcDeployer(&in)
+		deployer, err = clideploy.NewWorkerSvcDeployer(in)
 	case *manifest.ScheduledJob:
-		deployer, err = clideploy.NewJobDeployer(&in)
+		deployer, err = clideploy.NewJobDeployer(in)
 	default:
 		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
 	}
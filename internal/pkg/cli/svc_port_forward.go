@@ -0,0 +1,320 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPortForwardNamePrompt     = "To which service would you like to forward ports?"
+	svcPortForwardNameHelpPrompt = `Copilot forwards ports to one of your chosen service's tasks.
+The task is chosen at random, and the first essential container is used.`
+	svcPortForwardPortPrompt     = "Which ports would you like to forward?"
+	svcPortForwardPortHelpPrompt = `Specify the ports as <local port>:<remote port>, for example 5432:8080.
+If a single port is given, the same port is used locally.`
+)
+
+const (
+	minPort = 1
+	maxPort = 65535
+)
+
+type svcPortForwardVars struct {
+	appName          string
+	envName          string
+	name             string
+	port             string
+	remoteHost       string
+	taskID           string
+	containerName    string
+	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+}
+
+type svcPortForwardOpts struct {
+	svcPortForwardVars
+	store            store
+	sel              deploySelector
+	newSvcDescriber  func(*session.Session) serviceDescriber
+	newPortForwarder func(*session.Session) ecsPortForwarder
+	ssmPluginManager ssmPluginManager
+	prompter         prompter
+	sessProvider     *sessions.Provider
+	// Override in unit test
+	randInt func(int) int
+
+	// Cached variables.
+	localPort  int
+	remotePort int
+}
+
+func newSvcPortForwardOpts(vars svcPortForwardVars) (*svcPortForwardOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc port-forward"))
+	defaultSession, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	ssmStore := config.NewSSMStore(identity.New(defaultSession), awsssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcPortForwardOpts{
+		svcPortForwardVars: vars,
+		store:              ssmStore,
+		sel:                selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
+		newSvcDescriber: func(s *session.Session) serviceDescriber {
+			return ecs.New(s)
+		},
+		newPortForwarder: func(s *session.Session) ecsPortForwarder {
+			return ssm.New(s)
+		},
+		randInt: func(x int) int {
+			rand.Seed(time.Now().Unix())
+			return rand.Intn(x)
+		},
+		ssmPluginManager: exec.NewSSMPluginCommand(nil),
+		prompter:         prompt.New(),
+		sessProvider:     sessProvider,
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcPortForwardOpts) Validate() error {
+	if o.port != "" {
+		if err := o.setPorts(); err != nil {
+			return err
+		}
+	}
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcPortForwardOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateAndAskSvcEnvName(); err != nil {
+		return err
+	}
+	return o.askPorts()
+}
+
+// Execute starts a port forwarding session to a running container and blocks until it ends.
+func (o *svcPortForwardOpts) Execute() error {
+	wkld, err := o.store.GetWorkload(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get workload: %w", err)
+	}
+	if wkld.Type == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("forwarding ports to a running container part of a service is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+	}
+	task, err := selectRunningTask(awsecs.FilterRunningTasks(svcDesc.Tasks), o.name, o.envName, o.taskID, o.randInt)
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return err
+	}
+	container := o.selectContainer()
+	runtimeID, err := containerRuntimeID(task, container)
+	if err != nil {
+		return fmt.Errorf("%w in task %s", err, taskID)
+	}
+
+	destination := fmt.Sprintf("port %d of container %s", o.remotePort, color.HighlightUserInput(container))
+	if o.remoteHost != "" {
+		destination = fmt.Sprintf("%s through container %s", color.HighlightUserInput(fmt.Sprintf("%s:%d", o.remoteHost, o.remotePort)), color.HighlightUserInput(container))
+	}
+	log.Infof("Forward local port %s to %s in task %s.\n", color.HighlightUserInput(strconv.Itoa(o.localPort)), destination, color.HighlightResource(taskID))
+	log.Infof("Press %s to stop forwarding.\n", color.HighlightCode("Ctrl+C"))
+	if err := o.newPortForwarder(sess).StartPortForwardingSession(ssm.PortForwardingSessionInput{
+		Cluster:    svcDesc.ClusterName,
+		TaskID:     taskID,
+		RuntimeID:  runtimeID,
+		LocalPort:  o.localPort,
+		RemotePort: o.remotePort,
+		RemoteHost: o.remoteHost,
+	}); err != nil {
+		var errStartSess *ssm.ErrStartSession
+		if errors.As(err, &errStartSess) {
+			log.Errorf("Failed to start the port forwarding session. Is %s set in your manifest?\n", color.HighlightCode("exec: true"))
+		}
+		return fmt.Errorf("forward ports to container %s: %w", container, err)
+	}
+	return nil
+}
+
+func (o *svcPortForwardOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcPortForwardOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcPortForwardNamePrompt, svcPortForwardNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *svcPortForwardOpts) askPorts() error {
+	if o.port != "" {
+		return nil
+	}
+	port, err := o.prompter.Get(svcPortForwardPortPrompt, svcPortForwardPortHelpPrompt, func(v interface{}) error {
+		_, _, err := parsePortMapping(v.(string))
+		return err
+	}, prompt.WithFinalMessage("Ports:"))
+	if err != nil {
+		return fmt.Errorf("get ports to forward: %w", err)
+	}
+	o.port = port
+	return o.setPorts()
+}
+
+func (o *svcPortForwardOpts) setPorts() error {
+	local, remote, err := parsePortMapping(o.port)
+	if err != nil {
+		return err
+	}
+	o.localPort, o.remotePort = local, remote
+	return nil
+}
+
+func (o *svcPortForwardOpts) selectContainer() string {
+	if o.containerName != "" {
+		return o.containerName
+	}
+	// The first essential container is named with the workload name.
+	return o.name
+}
+
+// parsePortMapping parses ports formatted as "<local port>:<remote port>", or a single port used on both sides.
+func parsePortMapping(mapping string) (local int, remote int, err error) {
+	parts := strings.Split(mapping, ":")
+	if len(parts) > 2 {
+		return 0, 0, fmt.Errorf(`port %s must be formatted as "<local port>:<remote port>" or "<port>"`, mapping)
+	}
+	var ports []int
+	for _, part := range parts {
+		port, err := strconv.Atoi(part)
+		if err != nil || port < minPort || port > maxPort {
+			return 0, 0, fmt.Errorf("port %s must be an integer between %d and %d", part, minPort, maxPort)
+		}
+		ports = append(ports, port)
+	}
+	return ports[0], ports[len(ports)-1], nil
+}
+
+// containerRuntimeID returns the runtime ID of the container in the task, needed to start an SSM session to it.
+func containerRuntimeID(task *awsecs.Task, container string) (string, error) {
+	for _, c := range task.Containers {
+		if aws.StringValue(c.Name) != container {
+			continue
+		}
+		if aws.StringValue(c.RuntimeId) == "" {
+			return "", fmt.Errorf("container %s is not running yet", container)
+		}
+		return aws.StringValue(c.RuntimeId), nil
+	}
+	return "", fmt.Errorf("container %s not found", container)
+}
+
+// buildSvcPortForwardCmd builds the command for forwarding local ports to a running container in a service.
+func buildSvcPortForwardCmd() *cobra.Command {
+	vars := svcPortForwardVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "port-forward",
+		Short: "Forward local ports to a running container part of a service, or to a private host through it.",
+		Example: `
+  Forward local port 8080 to port 80 of a task part of the "frontend" service.
+  /code $ copilot svc port-forward -a my-app -e test -n frontend --port 8080:80
+  Forward local port 5432 to an Aurora cluster through the task prefixed with ID "8c38184" within the "api" service.
+  /code $ copilot svc port-forward -n api -e test --task-id 8c38184 --port 5432 \
+  /code --remote-host db.cluster-abc123.us-west-2.rds.amazonaws.com`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPortForwardOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().StringVar(&vars.port, portFlag, "", portForwardPortFlagDescription)
+	cmd.Flags().StringVar(&vars.remoteHost, remoteHostFlag, "", portForwardRemoteHostFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", portForwardTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", portForwardContainerFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,198 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestSvcPortForward_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inPort string
+
+		wantedLocalPort  int
+		wantedRemotePort int
+		wantedError      error
+	}{
+		"use the same port locally if a single port is given": {
+			inPort:           "8080",
+			wantedLocalPort:  8080,
+			wantedRemotePort: 8080,
+		},
+		"parse local and remote ports": {
+			inPort:           "5432:8080",
+			wantedLocalPort:  5432,
+			wantedRemotePort: 8080,
+		},
+		"error if the ports are not integers": {
+			inPort:      "5432:http",
+			wantedError: errors.New("port http must be an integer between 1 and 65535"),
+		},
+		"error if a port is out of range": {
+			inPort:      "0:8080",
+			wantedError: errors.New("port 0 must be an integer between 1 and 65535"),
+		},
+		"error if more than two ports are given": {
+			inPort:      "1:2:3",
+			wantedError: errors.New(`port 1:2:3 must be formatted as "<local port>:<remote port>" or "<port>"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					port:             tc.inPort,
+					skipConfirmation: aws.Bool(false), // Skip the ssm plugin validation.
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedLocalPort, opts.localPort)
+			require.Equal(t, tc.wantedRemotePort, opts.remotePort)
+		})
+	}
+}
+
+func TestSvcPortForward_Execute(t *testing.T) {
+	const mockTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
+	mockWl := &config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Backend Service",
+	}
+	mockSvcDesc := &ecs.ServiceDesc{
+		ClusterName: "mockCluster",
+		Tasks: []*awsecs.Task{
+			{
+				TaskArn:    aws.String(mockTaskARN),
+				LastStatus: aws.String("RUNNING"),
+				Containers: []*sdkecs.Container{
+					{
+						Name:      aws.String("mockSvc"),
+						RuntimeId: aws.String("mockTaskID-123"),
+					},
+					{
+						Name: aws.String("sidecar"),
+					},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inContainer  string
+		inRemoteHost string
+		setupMocks   func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockecsPortForwarder)
+
+		wantedError error
+	}{
+		"return error if service type is Request-Driven Web Service": {
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockecsPortForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&config.Workload{Type: "Request-Driven Web Service"}, nil)
+			},
+			wantedError: errors.New("forwarding ports to a running container part of a service is not supported for services with type: 'Request-Driven Web Service'"),
+		},
+		"return error if the container is not found in the task": {
+			inContainer: "nginx",
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockecsPortForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{Name: "mockEnv"}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil)
+			},
+			wantedError: errors.New("container nginx not found in task mockTaskID"),
+		},
+		"return error if the container has no runtime ID yet": {
+			inContainer: "sidecar",
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockecsPortForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{Name: "mockEnv"}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil)
+			},
+			wantedError: errors.New("container sidecar is not running yet in task mockTaskID"),
+		},
+		"return error if fail to start the session": {
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockecsPortForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{Name: "mockEnv"}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil)
+				forwarder.EXPECT().StartPortForwardingSession(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("forward ports to container mockSvc: some error"),
+		},
+		"forward ports to a remote host through the main container": {
+			inRemoteHost: "db.cluster-abc.us-west-2.rds.amazonaws.com",
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockecsPortForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{Name: "mockEnv"}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil)
+				forwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardingSessionInput{
+					Cluster:    "mockCluster",
+					TaskID:     "mockTaskID",
+					RuntimeID:  "mockTaskID-123",
+					LocalPort:  5432,
+					RemotePort: 3306,
+					RemoteHost: "db.cluster-abc.us-west-2.rds.amazonaws.com",
+				}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			describer := mocks.NewMockserviceDescriber(ctrl)
+			forwarder := mocks.NewMockecsPortForwarder(ctrl)
+			tc.setupMocks(store, describer, forwarder)
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					appName:       "mockApp",
+					envName:       "mockEnv",
+					name:          "mockSvc",
+					containerName: tc.inContainer,
+					remoteHost:    tc.inRemoteHost,
+				},
+				store: store,
+				newSvcDescriber: func(_ *session.Session) serviceDescriber {
+					return describer
+				},
+				newPortForwarder: func(_ *session.Session) ecsPortForwarder {
+					return forwarder
+				},
+				randInt:      func(i int) int { return 0 },
+				sessProvider: sessions.ImmutableProvider(),
+				localPort:    5432,
+				remotePort:   3306,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
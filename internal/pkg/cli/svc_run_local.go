@@ -0,0 +1,521 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	awssecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	copilotssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRunLocalNamePrompt = "Which service would you like to run locally?"
+	svcRunLocalEnvPrompt  = "Which environment's configuration should the service run with?"
+	svcRunLocalEnvHelp    = `The service's manifest is merged with the environment's overrides,
+and its secrets are read from the environment's region.`
+
+	fmtRunLocalNetworkName   = "%s-%s-%s" // Application, environment and service names.
+	fmtRunLocalContainerName = "%s-%s"    // Network and container names.
+	fmtRunLocalImageURI      = "%s/%s"    // Application and service names.
+)
+
+type runLocalSvcVars struct {
+	appName string
+	name    string
+	envName string
+}
+
+type runLocalSvcOpts struct {
+	runLocalSvcVars
+
+	store           store
+	ws              wsWlDirReader
+	sel             wsSelector
+	unmarshal       func([]byte) (manifest.WorkloadManifest, error)
+	newInterpolator func(app, env string) interpolator
+	docker          localContainerRunner
+
+	// Constructors for clients that read secrets from the environment's region.
+	// These functions are overridden in tests to provide fakes.
+	newSSM            func(region string) (secretGetter, error)
+	newSecretsManager func(region string) (secretGetter, error)
+
+	// Clients initialized once the environment is known.
+	ssm            secretGetter
+	secretsManager secretGetter
+}
+
+func newRunLocalSvcOpts(vars runLocalSvcVars) (*runLocalSvcOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc run-local"))
+	defaultSession, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	return &runLocalSvcOpts{
+		runLocalSvcVars: vars,
+
+		store:           store,
+		ws:              ws,
+		sel:             selector.NewWorkspaceSelect(prompt.New(), store, ws),
+		unmarshal:       manifest.UnmarshalWorkload,
+		newInterpolator: newManifestInterpolator,
+		docker:          dockerengine.New(exec.NewCmd()),
+		newSSM: func(region string) (secretGetter, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %v", region, err)
+			}
+			return copilotssm.New(sess), nil
+		},
+		newSecretsManager: func(region string) (secretGetter, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %v", region, err)
+			}
+			return secretsmanager.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *runLocalSvcOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name != "" {
+		names, err := o.ws.ListServices()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		if !contains(o.name, names) {
+			return fmt.Errorf("service %s not found in the workspace", color.HighlightUserInput(o.name))
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for any required flags that are not set by the user.
+func (o *runLocalSvcOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Service(svcRunLocalNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.name = name
+	}
+	if o.envName == "" {
+		name, err := o.sel.Environment(svcRunLocalEnvPrompt, svcRunLocalEnvHelp, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = name
+	}
+	return nil
+}
+
+// Execute builds the service's image and runs its containers locally with the environment's configuration.
+// The sidecars run in the background while the main container's output is streamed until it exits.
+func (o *runLocalSvcOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	mft, err := workloadManifest(&workloadManifestInput{
+		name:         o.name,
+		appName:      o.appName,
+		envName:      o.envName,
+		interpolator: o.newInterpolator(o.appName, o.envName),
+		ws:           o.ws,
+		unmarshal:    o.unmarshal,
+	})
+	if err != nil {
+		return err
+	}
+	wl, err := newLocalWorkload(mft)
+	if err != nil {
+		return err
+	}
+	if err := o.configureSecretClients(env.Region); err != nil {
+		return err
+	}
+	network := fmt.Sprintf(fmtRunLocalNetworkName, o.appName, o.envName, o.name)
+	main, err := o.mainContainer(wl, mft, network)
+	if err != nil {
+		return err
+	}
+	sidecars, err := o.sidecarContainers(wl, network)
+	if err != nil {
+		return err
+	}
+
+	if err := o.docker.CreateNetwork(network); err != nil {
+		return err
+	}
+	defer func() {
+		if err := o.docker.RemoveNetwork(network); err != nil {
+			log.Warningf("Failed to remove network %s: %v\n", network, err)
+		}
+	}()
+	for _, sidecar := range sidecars {
+		if err := o.docker.Run(sidecar); err != nil {
+			return fmt.Errorf("run sidecar %s: %w", sidecar.NetworkAlias, err)
+		}
+		name := sidecar.ContainerName
+		defer func() {
+			if err := o.docker.RemoveContainer(name); err != nil {
+				log.Warningf("Failed to remove container %s: %v\n", name, err)
+			}
+		}()
+	}
+	log.Infof("Running service %s locally with the configuration of environment %s.\n",
+		color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName))
+	if err := o.docker.Run(main); err != nil {
+		return fmt.Errorf("run service %s: %w", o.name, err)
+	}
+	return nil
+}
+
+// RecommendActions is a no-op for this command.
+func (o *runLocalSvcOpts) RecommendActions() error {
+	return nil
+}
+
+// localWorkload holds the container configuration of a service that can run locally.
+type localWorkload struct {
+	image       manifest.Image
+	port        *uint16
+	healthCheck manifest.ContainerHealthCheck
+	override    manifest.ImageOverride
+	task        manifest.TaskConfig
+	sidecars    map[string]*manifest.SidecarConfig
+}
+
+func newLocalWorkload(mft interface{}) (*localWorkload, error) {
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		return &localWorkload{
+			image:       t.ImageConfig.Image,
+			port:        t.ImageConfig.Port,
+			healthCheck: t.ImageConfig.HealthCheck,
+			override:    t.ImageOverride,
+			task:        t.TaskConfig,
+			sidecars:    t.Sidecars,
+		}, nil
+	case *manifest.BackendService:
+		return &localWorkload{
+			image:       t.ImageConfig.Image,
+			port:        t.ImageConfig.Port,
+			healthCheck: t.ImageConfig.HealthCheck,
+			override:    t.ImageOverride,
+			task:        t.TaskConfig,
+			sidecars:    t.Sidecars,
+		}, nil
+	case *manifest.WorkerService:
+		return &localWorkload{
+			image:       t.ImageConfig.Image,
+			healthCheck: t.ImageConfig.HealthCheck,
+			override:    t.ImageOverride,
+			task:        t.TaskConfig,
+			sidecars:    t.Sidecars,
+		}, nil
+	default:
+		return nil, fmt.Errorf("running manifest type %T locally is not supported", t)
+	}
+}
+
+func (o *runLocalSvcOpts) configureSecretClients(region string) error {
+	ssmClient, err := o.newSSM(region)
+	if err != nil {
+		return err
+	}
+	o.ssm = ssmClient
+	smClient, err := o.newSecretsManager(region)
+	if err != nil {
+		return err
+	}
+	o.secretsManager = smClient
+	return nil
+}
+
+func (o *runLocalSvcOpts) mainContainer(wl *localWorkload, mft interface{}, network string) (*dockerengine.RunOptions, error) {
+	wsPath, err := o.ws.Path()
+	if err != nil {
+		return nil, fmt.Errorf("get workspace path: %w", err)
+	}
+	imageURI := wl.image.GetLocation()
+	if imageURI == "" {
+		imageURI = fmt.Sprintf(fmtRunLocalImageURI, o.appName, o.name)
+		if err := o.buildImage(imageURI, wsPath, mft); err != nil {
+			return nil, err
+		}
+	}
+	entrypoint, err := wl.override.EntryPoint.ToStringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("convert entrypoint of service %s: %w", o.name, err)
+	}
+	command, err := wl.override.Command.ToStringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("convert command of service %s: %w", o.name, err)
+	}
+	secrets, err := o.secretValues(wl.task.Secrets)
+	if err != nil {
+		return nil, fmt.Errorf("read secrets of service %s: %w", o.name, err)
+	}
+	vars := map[string]string{
+		"COPILOT_APPLICATION_NAME":           o.appName,
+		"COPILOT_ENVIRONMENT_NAME":           o.envName,
+		"COPILOT_SERVICE_NAME":               o.name,
+		"COPILOT_SERVICE_DISCOVERY_ENDPOINT": fmt.Sprintf("%s.%s.local", o.envName, o.appName),
+	}
+	var envFile string
+	if path := aws.StringValue(wl.task.EnvFile); path != "" {
+		envFile = filepath.Join(wsPath, path)
+	}
+	var ports map[string]string
+	if wl.port != nil {
+		port := fmt.Sprintf("%d", aws.Uint16Value(wl.port))
+		ports = map[string]string{port: port}
+	}
+	return &dockerengine.RunOptions{
+		ImageURI:      imageURI,
+		ContainerName: fmt.Sprintf(fmtRunLocalContainerName, network, o.name),
+		Network:       network,
+		NetworkAlias:  o.name,
+		Ports:         ports,
+		EnvVars:       mergeEnvVars(vars, wl.task.Variables),
+		Secrets:       secrets,
+		EnvFile:       envFile,
+		EntryPoint:    entrypoint,
+		Command:       command,
+		HealthCheck:   localHealthCheck(wl.healthCheck),
+	}, nil
+}
+
+func (o *runLocalSvcOpts) sidecarContainers(wl *localWorkload, network string) ([]*dockerengine.RunOptions, error) {
+	names := make([]string, 0, len(wl.sidecars))
+	for name := range wl.sidecars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sidecars []*dockerengine.RunOptions
+	for _, name := range names {
+		sidecar := wl.sidecars[name]
+		entrypoint, err := sidecar.EntryPoint.ToStringSlice()
+		if err != nil {
+			return nil, fmt.Errorf("convert entrypoint of sidecar %s: %w", name, err)
+		}
+		command, err := sidecar.Command.ToStringSlice()
+		if err != nil {
+			return nil, fmt.Errorf("convert command of sidecar %s: %w", name, err)
+		}
+		secrets, err := o.secretValues(sidecar.Secrets)
+		if err != nil {
+			return nil, fmt.Errorf("read secrets of sidecar %s: %w", name, err)
+		}
+		// Each sidecar runs in its own container, so its port is published on that container.
+		port, protocol, err := manifest.ParsePortMapping(sidecar.Port)
+		if err != nil {
+			return nil, fmt.Errorf("parse port mapping of sidecar %s: %w", name, err)
+		}
+		var ports map[string]string
+		if port != nil {
+			ports = map[string]string{aws.StringValue(port): aws.StringValue(port)}
+			if protocol != nil {
+				ports[aws.StringValue(port)] = fmt.Sprintf("%s/%s", aws.StringValue(port), aws.StringValue(protocol))
+			}
+		}
+		sidecars = append(sidecars, &dockerengine.RunOptions{
+			ImageURI:      aws.StringValue(sidecar.Image),
+			ContainerName: fmt.Sprintf(fmtRunLocalContainerName, network, name),
+			Network:       network,
+			NetworkAlias:  name,
+			Ports:         ports,
+			EnvVars:       sidecar.Variables,
+			Secrets:       secrets,
+			EntryPoint:    entrypoint,
+			Command:       command,
+			HealthCheck:   localHealthCheck(sidecar.HealthCheck),
+			Detach:        true,
+		})
+	}
+	return sidecars, nil
+}
+
+func (o *runLocalSvcOpts) buildImage(uri, wsPath string, mft interface{}) error {
+	type dfArgs interface {
+		BuildArgs(rootDirectory string) *manifest.DockerBuildArgs
+		ContainerPlatform() string
+	}
+	mf, ok := mft.(dfArgs)
+	if !ok {
+		return fmt.Errorf("%s does not have required methods BuildArgs() and ContainerPlatform()", o.name)
+	}
+	args := mf.BuildArgs(wsPath)
+	if err := o.docker.Build(&dockerengine.BuildArguments{
+		URI:        uri,
+		Dockerfile: aws.StringValue(args.Dockerfile),
+		Context:    aws.StringValue(args.Context),
+		Args:       args.Args,
+		CacheFrom:  args.CacheFrom,
+		Target:     aws.StringValue(args.Target),
+		Platform:   mf.ContainerPlatform(),
+	}); err != nil {
+		return fmt.Errorf("build image for service %s: %w", o.name, err)
+	}
+	return nil
+}
+
+// secretValues returns the values of the secrets keyed by environment variable name.
+func (o *runLocalSvcOpts) secretValues(secrets map[string]manifest.Secret) (map[string]string, error) {
+	if len(secrets) == 0 {
+		return nil, nil
+	}
+	values := make(map[string]string, len(secrets))
+	for name, secret := range secrets {
+		value, err := o.secretValue(secret)
+		if err != nil {
+			return nil, fmt.Errorf("get value of secret %s: %w", name, err)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
+func (o *runLocalSvcOpts) secretValue(secret manifest.Secret) (string, error) {
+	ref := secret.Value()
+	if !secret.IsSecretsManagerName() {
+		parsed, err := arn.Parse(ref)
+		if err != nil || parsed.Service != awssecretsmanager.ServiceName {
+			// The secret is an SSM parameter name or ARN, optionally pinned to a version.
+			if version := secret.Version(); version != nil {
+				ref = fmt.Sprintf("%s:%d", ref, aws.IntValue(version))
+			}
+			return o.ssm.GetSecretValue(ref)
+		}
+	}
+	id, jsonKey := splitSecretsManagerRef(ref)
+	if key := secret.JSONKey(); key != "" {
+		jsonKey = key
+	}
+	value, err := o.secretsManager.GetSecretValue(id)
+	if err != nil {
+		return "", err
+	}
+	if jsonKey == "" {
+		return value, nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &fields); err != nil {
+		return "", fmt.Errorf("unmarshal secret %s to read key %s: %w", id, jsonKey, err)
+	}
+	field, ok := fields[jsonKey]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s", jsonKey, id)
+	}
+	return fmt.Sprintf("%v", field), nil
+}
+
+// splitSecretsManagerRef splits a Secrets Manager secret name or ARN that ends with the optional
+// ":<json-key>:<version-stage>:<version-id>" suffix into the secret ID and the JSON key.
+// The version stage and ID are ignored, the current version of the secret is always read.
+func splitSecretsManagerRef(ref string) (id, jsonKey string) {
+	n := 1
+	if strings.HasPrefix(ref, "arn:") {
+		n = 7 // "arn:<partition>:secretsmanager:<region>:<account>:secret:<name>"
+	}
+	parts := strings.Split(ref, ":")
+	if len(parts) <= n {
+		return ref, ""
+	}
+	return strings.Join(parts[:n], ":"), parts[n]
+}
+
+// mergeEnvVars merges the environment variables from left to right, later values take precedence.
+func mergeEnvVars(vars ...map[string]string) map[string]string {
+	merged := make(map[string]string)
+	for _, m := range vars {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func localHealthCheck(hc manifest.ContainerHealthCheck) *dockerengine.HealthCheck {
+	if hc.IsEmpty() {
+		return nil
+	}
+	// Make sure that unset fields in the healthcheck gets a default value.
+	hc.ApplyIfNotSet(manifest.NewDefaultContainerHealthCheck())
+	// Docker runs the healthcheck command in a shell, so drop the ECS "CMD" or "CMD-SHELL" prefix.
+	command := hc.Command
+	if len(command) > 0 && (command[0] == "CMD" || command[0] == "CMD-SHELL") {
+		command = command[1:]
+	}
+	return &dockerengine.HealthCheck{
+		Command:     strings.Join(command, " "),
+		Interval:    *hc.Interval,
+		Timeout:     *hc.Timeout,
+		StartPeriod: *hc.StartPeriod,
+		Retries:     aws.IntValue(hc.Retries),
+	}
+}
+
+// buildSvcRunLocalCmd builds the command for running a service locally.
+func buildSvcRunLocalCmd() *cobra.Command {
+	vars := runLocalSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "run-local",
+		Short: "Runs a service locally with an environment's configuration.",
+		Long: `Runs a service locally with an environment's configuration.
+The main container and its sidecars run on a local Docker network with the manifest's
+variables, env_file, secrets, port mappings and healthchecks.`,
+		Example: `
+  Run the "frontend" service locally with the "test" environment's configuration.
+  /code $ copilot svc run-local --name frontend --env test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunLocalSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	return cmd
+}
@@ -0,0 +1,397 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// fakeParameterStore is an in-memory secretGetter keyed by parameter or secret name.
+type fakeParameterStore map[string]string
+
+func (s fakeParameterStore) GetSecretValue(name string) (string, error) {
+	value, ok := s[name]
+	if !ok {
+		return "", fmt.Errorf("parameter %s not found", name)
+	}
+	return value, nil
+}
+
+func TestRunLocalSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     runLocalSvcVars
+		setupMocks func(m *runLocalSvcMocks)
+		wantedErr  error
+	}{
+		"should error if not in a workspace": {
+			setupMocks: func(m *runLocalSvcMocks) {},
+			wantedErr:  errNoAppInWorkspace,
+		},
+		"should error if the service is not in the workspace": {
+			inVars: runLocalSvcVars{
+				appName: "phonetool",
+				name:    "frontend",
+			},
+			setupMocks: func(m *runLocalSvcMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"backend"}, nil)
+			},
+			wantedErr: errors.New("service frontend not found in the workspace"),
+		},
+		"should error if the environment does not exist": {
+			inVars: runLocalSvcVars{
+				appName: "phonetool",
+				envName: "test",
+			},
+			setupMocks: func(m *runLocalSvcMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get environment test configuration: some error"),
+		},
+		"should succeed if the service and environment exist": {
+			inVars: runLocalSvcVars{
+				appName: "phonetool",
+				name:    "frontend",
+				envName: "test",
+			},
+			setupMocks: func(m *runLocalSvcMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"frontend"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newRunLocalSvcMocks(ctrl)
+			tc.setupMocks(m)
+			opts := &runLocalSvcOpts{
+				runLocalSvcVars: tc.inVars,
+				store:           m.store,
+				ws:              m.ws,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRunLocalSvcOpts_Execute(t *testing.T) {
+	const mockManifest = `name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 8080
+  healthcheck:
+    command: ["CMD-SHELL", "curl -f http://localhost:8080/ || exit 1"]
+http:
+  path: '/'
+variables:
+  LOG_LEVEL: info
+env_file: frontend/.env
+secrets:
+  DB_PASSWORD: /copilot/phonetool/test/secrets/db-password
+  API_KEY:
+    secretsmanager: 'demo/api:key::'
+sidecars:
+  nginx:
+    port: 80
+    image: nginx
+    variables:
+      NGINX_PORT: "80"
+environments:
+  test:
+    variables:
+      LOG_LEVEL: debug
+`
+	fakeSSM := fakeParameterStore{
+		"/copilot/phonetool/test/secrets/db-password": "hunter2",
+	}
+	fakeSecretsManager := fakeParameterStore{
+		"demo/api": `{"key": "abc123"}`,
+	}
+	wantedMain := &dockerengine.RunOptions{
+		ImageURI:      "phonetool/frontend",
+		ContainerName: "phonetool-test-frontend-frontend",
+		Network:       "phonetool-test-frontend",
+		NetworkAlias:  "frontend",
+		Ports: map[string]string{
+			"8080": "8080",
+		},
+		EnvVars: map[string]string{
+			"COPILOT_APPLICATION_NAME":           "phonetool",
+			"COPILOT_ENVIRONMENT_NAME":           "test",
+			"COPILOT_SERVICE_NAME":               "frontend",
+			"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "test.phonetool.local",
+			"LOG_LEVEL":                          "debug",
+		},
+		Secrets: map[string]string{
+			"DB_PASSWORD": "hunter2",
+			"API_KEY":     "abc123",
+		},
+		EnvFile: "/ws/frontend/.env",
+		HealthCheck: &dockerengine.HealthCheck{
+			Command:  "curl -f http://localhost:8080/ || exit 1",
+			Interval: 10 * time.Second,
+			Timeout:  5 * time.Second,
+			Retries:  2,
+		},
+	}
+	wantedSidecar := &dockerengine.RunOptions{
+		ImageURI:      "nginx",
+		ContainerName: "phonetool-test-frontend-nginx",
+		Network:       "phonetool-test-frontend",
+		NetworkAlias:  "nginx",
+		Ports: map[string]string{
+			"80": "80",
+		},
+		EnvVars: map[string]string{
+			"NGINX_PORT": "80",
+		},
+		Detach: true,
+	}
+
+	testCases := map[string]struct {
+		inManifest string
+		inSSM      fakeParameterStore
+		setupMocks func(m *runLocalSvcMocks)
+		wantedErr  error
+	}{
+		"should error if a secret is not in the parameter store": {
+			inManifest: mockManifest,
+			inSSM:      fakeParameterStore{},
+			setupMocks: func(m *runLocalSvcMocks) {
+				m.docker.EXPECT().Build(gomock.Any()).Return(nil)
+			},
+			wantedErr: errors.New("read secrets of service frontend: get value of secret DB_PASSWORD: parameter /copilot/phonetool/test/secrets/db-password not found"),
+		},
+		"should error if the manifest type cannot run locally": {
+			inManifest: `name: frontend
+type: Request-Driven Web Service
+image:
+  build: frontend/Dockerfile
+  port: 8080
+`,
+			inSSM:      fakeSSM,
+			setupMocks: func(m *runLocalSvcMocks) {},
+			wantedErr:  errors.New("running manifest type *manifest.RequestDrivenWebService locally is not supported"),
+		},
+		"should clean up the sidecars and network after the service exits": {
+			inManifest: mockManifest,
+			inSSM:      fakeSSM,
+			setupMocks: func(m *runLocalSvcMocks) {
+				gomock.InOrder(
+					m.docker.EXPECT().Build(&dockerengine.BuildArguments{
+						URI:        "phonetool/frontend",
+						Dockerfile: "/ws/frontend/Dockerfile",
+						Context:    "/ws/frontend",
+					}).Return(nil),
+					m.docker.EXPECT().CreateNetwork("phonetool-test-frontend").Return(nil),
+					m.docker.EXPECT().Run(wantedSidecar).Return(nil),
+					m.docker.EXPECT().Run(wantedMain).Return(errors.New("some error")),
+					m.docker.EXPECT().RemoveContainer("phonetool-test-frontend-nginx").Return(nil),
+					m.docker.EXPECT().RemoveNetwork("phonetool-test-frontend").Return(nil),
+				)
+			},
+			wantedErr: errors.New("run service frontend: some error"),
+		},
+		"should run the service and its sidecars locally": {
+			inManifest: mockManifest,
+			inSSM:      fakeSSM,
+			setupMocks: func(m *runLocalSvcMocks) {
+				m.docker.EXPECT().Build(gomock.Any()).Return(nil)
+				m.docker.EXPECT().CreateNetwork("phonetool-test-frontend").Return(nil)
+				m.docker.EXPECT().Run(wantedSidecar).Return(nil)
+				m.docker.EXPECT().Run(wantedMain).Return(nil)
+				m.docker.EXPECT().RemoveContainer("phonetool-test-frontend-nginx").Return(nil)
+				m.docker.EXPECT().RemoveNetwork("phonetool-test-frontend").Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newRunLocalSvcMocks(ctrl)
+			m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+				App:    "phonetool",
+				Name:   "test",
+				Region: "us-west-2",
+			}, nil)
+			m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(tc.inManifest), nil)
+			m.ws.EXPECT().Path().Return("/ws", nil).AnyTimes()
+			m.interpolator.EXPECT().Interpolate(tc.inManifest).Return(tc.inManifest, nil)
+			tc.setupMocks(m)
+			opts := &runLocalSvcOpts{
+				runLocalSvcVars: runLocalSvcVars{
+					appName: "phonetool",
+					name:    "frontend",
+					envName: "test",
+				},
+				store:     m.store,
+				ws:        m.ws,
+				unmarshal: manifest.UnmarshalWorkload,
+				newInterpolator: func(_, _ string) interpolator {
+					return m.interpolator
+				},
+				docker: m.docker,
+				newSSM: func(region string) (secretGetter, error) {
+					require.Equal(t, "us-west-2", region)
+					return tc.inSSM, nil
+				},
+				newSecretsManager: func(region string) (secretGetter, error) {
+					require.Equal(t, "us-west-2", region)
+					return fakeSecretsManager, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRunLocalSvcOpts_secretValue(t *testing.T) {
+	ssm := fakeParameterStore{
+		"/phonetool/db-password":   "latest",
+		"/phonetool/db-password:3": "pinned",
+	}
+	secretsManager := fakeParameterStore{
+		"demo/api": `{"key": "abc123", "port": 5432}`,
+		"arn:aws:secretsmanager:us-west-2:111122223333:secret:demo/api-AbCdEf": `{"key": "abc123"}`,
+	}
+	testCases := map[string]struct {
+		in string
+
+		wanted    string
+		wantedErr error
+	}{
+		"ssm parameter": {
+			in:     `/phonetool/db-password`,
+			wanted: "latest",
+		},
+		"ssm parameter pinned to a version": {
+			in: `
+from: /phonetool/db-password
+version: 3`,
+			wanted: "pinned",
+		},
+		"whole secrets manager secret": {
+			in:     `secretsmanager: demo/api`,
+			wanted: `{"key": "abc123", "port": 5432}`,
+		},
+		"secrets manager secret json key": {
+			in: `
+secretsmanager: demo/api
+key: port`,
+			wanted: "5432",
+		},
+		"secrets manager arn with json key": {
+			in:     `'arn:aws:secretsmanager:us-west-2:111122223333:secret:demo/api-AbCdEf:key::'`,
+			wanted: "abc123",
+		},
+		"should error if the json key is not in the secret": {
+			in: `
+secretsmanager: demo/api
+key: user`,
+			wantedErr: errors.New("key user not found in secret demo/api"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var secret manifest.Secret
+			require.NoError(t, yaml.Unmarshal([]byte(tc.in), &secret))
+			opts := &runLocalSvcOpts{
+				ssm:            ssm,
+				secretsManager: secretsManager,
+			}
+
+			got, err := opts.secretValue(secret)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSplitSecretsManagerRef(t *testing.T) {
+	testCases := map[string]struct {
+		in            string
+		wantedID      string
+		wantedJSONKey string
+	}{
+		"name": {
+			in:       "demo/api",
+			wantedID: "demo/api",
+		},
+		"name with json key": {
+			in:            "demo/api:key::",
+			wantedID:      "demo/api",
+			wantedJSONKey: "key",
+		},
+		"arn": {
+			in:       "arn:aws:secretsmanager:us-west-2:111122223333:secret:demo/api-AbCdEf",
+			wantedID: "arn:aws:secretsmanager:us-west-2:111122223333:secret:demo/api-AbCdEf",
+		},
+		"arn with json key": {
+			in:            "arn:aws:secretsmanager:us-west-2:111122223333:secret:demo/api-AbCdEf:key:AWSCURRENT:",
+			wantedID:      "arn:aws:secretsmanager:us-west-2:111122223333:secret:demo/api-AbCdEf",
+			wantedJSONKey: "key",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			id, key := splitSecretsManagerRef(tc.in)
+
+			require.Equal(t, tc.wantedID, id)
+			require.Equal(t, tc.wantedJSONKey, key)
+		})
+	}
+}
+
+type runLocalSvcMocks struct {
+	store        *mocks.Mockstore
+	ws           *mocks.MockwsWlDirReader
+	interpolator *mocks.Mockinterpolator
+	docker       *mocks.MocklocalContainerRunner
+}
+
+func newRunLocalSvcMocks(ctrl *gomock.Controller) *runLocalSvcMocks {
+	return &runLocalSvcMocks{
+		store:        mocks.NewMockstore(ctrl),
+		ws:           mocks.NewMockwsWlDirReader(ctrl),
+		interpolator: mocks.NewMockinterpolator(ctrl),
+		docker:       mocks.NewMocklocalContainerRunner(ctrl),
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam/catalog"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	svcValidateNamePrompt = "Which service's manifest would you like to validate?"
+	jobValidateNamePrompt = "Which job's manifest would you like to validate?"
+
+	wkldKindService = "service"
+	wkldKindJob     = "job"
+)
+
+// YAML syntax and type errors are reported as "yaml: line 3: msg" and "line 3: msg".
+var yamlErrLineRegexp = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+type validateWkldVars struct {
+	appName string
+	name    string
+}
+
+// validateWkldOpts validates the manifest of a service or a job against the environments of its application.
+type validateWkldOpts struct {
+	validateWkldVars
+
+	kind string // Either "service" or "job".
+
+	store           environmentLister
+	ws              wsWlDirReader
+	prompt          prompter
+	unmarshal       func([]byte) (manifest.WorkloadManifest, error)
+	newInterpolator func(app, env string) interpolator
+	actions         manifest.IAMActionCatalog
+	w               io.Writer
+}
+
+// iamActionsChecker is implemented by the manifests of workloads with a task role.
+type iamActionsChecker interface {
+	CheckIAMActions(catalog manifest.IAMActionCatalog) []error
+}
+
+func newValidateWkldOpts(kind string, vars validateWkldVars) (*validateWkldOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras(kind + " validate")).Default()
+	if err != nil {
+		return nil, err
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	actions, err := catalog.New()
+	if err != nil {
+		return nil, err
+	}
+	return &validateWkldOpts{
+		validateWkldVars: vars,
+
+		kind:            kind,
+		store:           config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region)),
+		ws:              ws,
+		prompt:          prompt.New(),
+		unmarshal:       manifest.UnmarshalWorkload,
+		newInterpolator: newManifestInterpolator,
+		actions:         actions,
+		w:               os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *validateWkldOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name == "" {
+		return nil
+	}
+	names, err := o.listWorkloads()
+	if err != nil {
+		return err
+	}
+	if !contains(o.name, names) {
+		return fmt.Errorf("%s %s not found in the workspace", o.kind, color.HighlightUserInput(o.name))
+	}
+	return nil
+}
+
+// Ask prompts for the workload to validate if there is more than one in the workspace.
+func (o *validateWkldOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	names, err := o.listWorkloads()
+	if err != nil {
+		return err
+	}
+	switch len(names) {
+	case 0:
+		return fmt.Errorf("no %ss found in the workspace", o.kind)
+	case 1:
+		log.Infof("Found only one %s, defaulting to: %s\n", o.kind, color.HighlightUserInput(names[0]))
+		o.name = names[0]
+		return nil
+	}
+	msg := svcValidateNamePrompt
+	if o.kind == wkldKindJob {
+		msg = jobValidateNamePrompt
+	}
+	name, err := o.prompt.SelectOne(msg, "", names, prompt.WithFinalMessage(fmt.Sprintf("%s:", strings.Title(o.kind))))
+	if err != nil {
+		return fmt.Errorf("select %s: %w", o.kind, err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute validates the manifest with the overrides of each environment of the application applied,
+// and writes every problem found along with its position in the manifest.
+func (o *validateWkldOpts) Execute() error {
+	raw, err := o.ws.ReadWorkloadManifest(o.name)
+	if err != nil {
+		return fmt.Errorf("read manifest file for %s: %w", o.name, err)
+	}
+	path := filepath.Join(workspace.CopilotDirName, o.name, "manifest.yml")
+	var errs int
+	for _, p := range o.problems(raw, o.environments()) {
+		fmt.Fprintf(o.w, "%s%s\n", path, p)
+		if !p.warning {
+			errs++
+		}
+	}
+	if errs == 0 {
+		log.Successf("Manifest for %s %s is valid.\n", o.kind, color.HighlightUserInput(o.name))
+		return nil
+	}
+	return fmt.Errorf("manifest for %s %s has %d %s", o.kind, o.name, errs, english.PluralWord(errs, "problem", "problems"))
+}
+
+// RecommendActions is a no-op.
+func (o *validateWkldOpts) RecommendActions() error {
+	return nil
+}
+
+func (o *validateWkldOpts) listWorkloads() ([]string, error) {
+	if o.kind == wkldKindJob {
+		names, err := o.ws.ListJobs()
+		if err != nil {
+			return nil, fmt.Errorf("list jobs in the workspace: %w", err)
+		}
+		return names, nil
+	}
+	names, err := o.ws.ListServices()
+	if err != nil {
+		return nil, fmt.Errorf("list services in the workspace: %w", err)
+	}
+	return names, nil
+}
+
+// environments returns the names of the environments of the application.
+// If they can't be listed, the manifest is only validated against the environments it overrides.
+func (o *validateWkldOpts) environments() []string {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		log.Warningf("Couldn't list the environments of application %s, validating the manifest against the environments it overrides only: %v\n", o.appName, err)
+		return nil
+	}
+	names := make([]string, len(envs))
+	for i, env := range envs {
+		names[i] = env.Name
+	}
+	return names
+}
+
+// problems validates the manifest against each of the environments and each environment it overrides,
+// or once without any override if there are none, and returns the unique problems found ordered by their position.
+func (o *validateWkldOpts) problems(raw []byte, appEnvs []string) []*manifestProblem {
+	locator, err := manifest.NewLocator(raw)
+	if err != nil {
+		return []*manifestProblem{newYAMLProblem(err.Error())}
+	}
+	envs := appEnvs
+	for _, env := range locator.Environments() {
+		if !contains(env, envs) {
+			envs = append(envs, env)
+		}
+	}
+	if len(envs) == 0 {
+		envs = []string{""}
+	}
+	var problems manifestProblems
+	for _, env := range envs {
+		for _, p := range o.envProblems(locator, raw, env) {
+			problems.add(p, env)
+		}
+	}
+	for _, p := range problems {
+		if len(p.envs) == len(envs) {
+			p.envs = nil // Don't single out environments if the problem occurs in all of them.
+		}
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].pos.Line != problems[j].pos.Line {
+			return problems[i].pos.Line < problems[j].pos.Line
+		}
+		return problems[i].pos.Column < problems[j].pos.Column
+	})
+	return problems
+}
+
+func (o *validateWkldOpts) envProblems(locator *manifest.Locator, raw []byte, env string) []*manifestProblem {
+	interpolated, err := o.newInterpolator(o.appName, env).Interpolate(string(raw))
+	if err != nil {
+		return []*manifestProblem{{msg: fmt.Sprintf("interpolate environment variables: %v", err)}}
+	}
+	mft, err := o.unmarshal([]byte(interpolated))
+	if err != nil {
+		var typeErr *yaml.TypeError
+		if !errors.As(err, &typeErr) {
+			return []*manifestProblem{{msg: err.Error()}}
+		}
+		var problems []*manifestProblem
+		for _, msg := range typeErr.Errors {
+			p := newYAMLProblem(msg)
+			if p.pos.Line != 0 {
+				// The interpolated manifest is re-encoded, so its lines don't necessarily match the original file.
+				if pos, ok := locator.Translate([]byte(interpolated), p.pos.Line); ok {
+					p.pos = pos
+				}
+			}
+			problems = append(problems, p)
+		}
+		return problems
+	}
+	envMft, err := mft.ApplyEnv(env)
+	if err != nil {
+		return []*manifestProblem{{msg: fmt.Sprintf("apply environment %s override: %v", env, err)}}
+	}
+	var problems []*manifestProblem
+	for _, err := range manifest.FieldErrors(envMft.Validate()) {
+		pos, _ := locator.ValidationError(env, err)
+		problems = append(problems, &manifestProblem{pos: pos, msg: err.Error()})
+	}
+	checker, ok := envMft.(iamActionsChecker)
+	if !ok {
+		return problems
+	}
+	for _, err := range checker.CheckIAMActions(o.actions) {
+		pos, _ := locator.ValidationError(env, err)
+		problems = append(problems, &manifestProblem{pos: pos, msg: err.Error(), warning: isUnverifiedIAMAction(err)})
+	}
+	return problems
+}
+
+// isUnverifiedIAMAction returns true if the action can't be checked because the catalog doesn't list the actions of its service.
+// The bundled catalog doesn't cover every service, so these actions are reported without failing the validation.
+func isUnverifiedIAMAction(err error) bool {
+	var unverified *catalog.ErrUnverifiedService
+	var unknownSvc *catalog.ErrUnknownService
+	return errors.As(err, &unverified) || errors.As(err, &unknownSvc)
+}
+
+// manifestProblem is an error found in a manifest.
+type manifestProblem struct {
+	pos     manifest.Position // Zero if the problem can't be located.
+	msg     string
+	warning bool     // True if the problem doesn't fail the validation, such as an IAM action that can't be verified.
+	envs    []string // Environments the problem occurs in, empty if it occurs in all of them.
+}
+
+func newYAMLProblem(msg string) *manifestProblem {
+	parts := yamlErrLineRegexp.FindStringSubmatch(msg)
+	if parts == nil {
+		return &manifestProblem{msg: msg}
+	}
+	line, _ := strconv.Atoi(parts[1])
+	return &manifestProblem{
+		pos: manifest.Position{Line: line},
+		msg: parts[2],
+	}
+}
+
+// String returns the problem in the format ":line:column: [warning: ]msg (environment "env")".
+func (p *manifestProblem) String() string {
+	var b strings.Builder
+	if p.pos.Line != 0 {
+		fmt.Fprintf(&b, ":%d", p.pos.Line)
+	}
+	if p.pos.Column != 0 {
+		fmt.Fprintf(&b, ":%d", p.pos.Column)
+	}
+	if p.warning {
+		b.WriteString(": warning")
+	}
+	fmt.Fprintf(&b, ": %s", p.msg)
+	if len(p.envs) != 0 {
+		quoted := make([]string, len(p.envs))
+		for i, env := range p.envs {
+			quoted[i] = strconv.Quote(env)
+		}
+		fmt.Fprintf(&b, " (%s %s)", english.PluralWord(len(p.envs), "environment", "environments"), english.WordSeries(quoted, "and"))
+	}
+	return b.String()
+}
+
+type manifestProblems []*manifestProblem
+
+// add records the problem found in the environment, unless the same problem was already found in another environment.
+func (ps *manifestProblems) add(p *manifestProblem, env string) {
+	for _, prev := range *ps {
+		if prev.pos == p.pos && prev.msg == p.msg {
+			prev.envs = append(prev.envs, env)
+			return
+		}
+	}
+	p.envs = []string{env}
+	*ps = append(*ps, p)
+}
+
+// buildSvcValidateCmd builds the command for validating a service's manifest.
+func buildSvcValidateCmd() *cobra.Command {
+	vars := validateWkldVars{}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates the manifest of a service.",
+		Long: `Validates the manifest of a service against each environment of the application, without deploying it.
+Every problem found is printed with its line and column in the manifest.
+IAM actions in "permissions" are checked against a catalog bundled with Copilot.
+Actions of services whose actions are not in the catalog are reported as warnings.`,
+		Example: `
+  Validate the manifest of the "frontend" service.
+  /code $ copilot svc validate -n frontend`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newValidateWkldOpts(wkldKindService, vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
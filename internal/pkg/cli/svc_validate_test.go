@@ -0,0 +1,389 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam/catalog"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type validateWkldMocks struct {
+	ws     *mocks.MockwsWlDirReader
+	prompt *mocks.Mockprompter
+}
+
+func TestValidateWkldOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inKind     string
+		inVars     validateWkldVars
+		setupMocks func(m *validateWkldMocks)
+		wantedErr  error
+	}{
+		"should error if not in a workspace": {
+			inKind:     wkldKindService,
+			setupMocks: func(m *validateWkldMocks) {},
+			wantedErr:  errNoAppInWorkspace,
+		},
+		"should error if the service is not in the workspace": {
+			inKind: wkldKindService,
+			inVars: validateWkldVars{
+				appName: "phonetool",
+				name:    "frontend",
+			},
+			setupMocks: func(m *validateWkldMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"backend"}, nil)
+			},
+			wantedErr: errors.New("service frontend not found in the workspace"),
+		},
+		"should wrap the error if jobs can't be listed": {
+			inKind: wkldKindJob,
+			inVars: validateWkldVars{
+				appName: "phonetool",
+				name:    "report",
+			},
+			setupMocks: func(m *validateWkldMocks) {
+				m.ws.EXPECT().ListJobs().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list jobs in the workspace: some error"),
+		},
+		"should succeed if the job is in the workspace": {
+			inKind: wkldKindJob,
+			inVars: validateWkldVars{
+				appName: "phonetool",
+				name:    "report",
+			},
+			setupMocks: func(m *validateWkldMocks) {
+				m.ws.EXPECT().ListJobs().Return([]string{"report"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &validateWkldMocks{
+				ws: mocks.NewMockwsWlDirReader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateWkldOpts{
+				validateWkldVars: tc.inVars,
+				kind:             tc.inKind,
+				ws:               m.ws,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateWkldOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inKind     string
+		inName     string
+		setupMocks func(m *validateWkldMocks)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"should not prompt if the name is provided": {
+			inKind:     wkldKindService,
+			inName:     "frontend",
+			setupMocks: func(m *validateWkldMocks) {},
+			wantedName: "frontend",
+		},
+		"should error if there are no services in the workspace": {
+			inKind: wkldKindService,
+			setupMocks: func(m *validateWkldMocks) {
+				m.ws.EXPECT().ListServices().Return(nil, nil)
+			},
+			wantedErr: errors.New("no services found in the workspace"),
+		},
+		"should default to the only job in the workspace": {
+			inKind: wkldKindJob,
+			setupMocks: func(m *validateWkldMocks) {
+				m.ws.EXPECT().ListJobs().Return([]string{"report"}, nil)
+			},
+			wantedName: "report",
+		},
+		"should prompt for the service if there are several": {
+			inKind: wkldKindService,
+			setupMocks: func(m *validateWkldMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"frontend", "backend"}, nil)
+				m.prompt.EXPECT().SelectOne(svcValidateNamePrompt, "", []string{"frontend", "backend"}, gomock.Any()).Return("backend", nil)
+			},
+			wantedName: "backend",
+		},
+		"should wrap the prompt error": {
+			inKind: wkldKindJob,
+			setupMocks: func(m *validateWkldMocks) {
+				m.ws.EXPECT().ListJobs().Return([]string{"report", "cleanup"}, nil)
+				m.prompt.EXPECT().SelectOne(jobValidateNamePrompt, "", []string{"report", "cleanup"}, gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select job: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &validateWkldMocks{
+				ws:     mocks.NewMockwsWlDirReader(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateWkldOpts{
+				validateWkldVars: validateWkldVars{
+					appName: "phonetool",
+					name:    tc.inName,
+				},
+				kind:   tc.inKind,
+				ws:     m.ws,
+				prompt: m.prompt,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestValidateWkldOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+		inEnvs     []string
+		inEnvsErr  error
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"valid manifest": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+image:
+  build: ./Dockerfile
+  port: 8080
+http:
+  path: '/'
+environments:
+  test:
+    count: 2
+`,
+		},
+		"syntax error": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+image:
+  build: ./Dockerfile
+ port: 8080
+`,
+			wantedOutput: "copilot/frontend/manifest.yml:4: did not find expected key\n",
+			wantedErr:    errors.New("manifest for service frontend has 1 problem"),
+		},
+		"type errors are reported at their position in the original manifest": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+
+image:
+  build: ./Dockerfile
+  port: 8080
+
+http:
+  path: '/'
+
+memory: lots
+`,
+			wantedOutput: "copilot/frontend/manifest.yml:11:9: cannot unmarshal !!str `lots` into int\n",
+			wantedErr:    errors.New("manifest for service frontend has 1 problem"),
+		},
+		"problems that only occur in some environments": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+image:
+  build: ./Dockerfile
+  port: 8080
+http:
+  path: '/'
+taskdef_overrides:
+  - path: Family
+    value: frontend
+environments:
+  test:
+    count: 2
+  prod:
+    taskdef_overrides:
+      - path: ContainerDefinitions[0].Name
+        value: nginx
+`,
+			inEnvs: []string{"test", "staging", "prod"},
+			wantedOutput: `copilot/frontend/manifest.yml:9:5: validate "taskdef_overrides[0]": "Family" cannot be overridden with a custom value (environments "test" and "staging")
+copilot/frontend/manifest.yml:16:9: validate "taskdef_overrides[0]": "ContainerDefinitions\[\d+\].Name" cannot be overridden with a custom value (environment "prod")
+`,
+			wantedErr: errors.New("manifest for service frontend has 2 problems"),
+		},
+		"actions that are not in the IAM action catalog": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+image:
+  build: ./Dockerfile
+  port: 8080
+http:
+  path: '/'
+permissions:
+  statements:
+    - actions:
+        - s3:GetObject
+        - s3:GetObjekt
+      resources:
+        - arn:aws:s3:::assets/*
+    - actions: [sqs:SendMessageBatch, dynamo:Query]
+      resources: ['*']
+`,
+			wantedOutput: `copilot/frontend/manifest.yml:12:11: validate "permissions": validate "statements[0]": validate "actions[1]": action "s3:GetObjekt" does not match any IAM action in the catalog
+copilot/frontend/manifest.yml:15:17: validate "permissions": validate "statements[1]": validate "actions[0]": action "sqs:SendMessageBatch" does not match any IAM action in the catalog
+copilot/frontend/manifest.yml:15:39: warning: validate "permissions": validate "statements[1]": validate "actions[1]": service prefix "dynamo" is not in the IAM action catalog
+`,
+			wantedErr: errors.New("manifest for service frontend has 2 problems"),
+		},
+		"actions of services without bundled actions are reported as warnings": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+image:
+  build: ./Dockerfile
+  port: 8080
+http:
+  path: '/'
+permissions:
+  statements:
+    - actions: [ecs:RunTask, ecs:*, s3:GetObject]
+      resources: ['*']
+`,
+			wantedOutput: `copilot/frontend/manifest.yml:10:17: warning: validate "permissions": validate "statements[0]": validate "actions[0]": action "ecs:RunTask" can't be verified since the actions of its service are not in the IAM action catalog
+`,
+		},
+		"invalid fields don't hide unknown IAM actions": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+image:
+  build: ./Dockerfile
+  port: 8080
+http:
+  path: '/'
+taskdef_overrides:
+  - path: Family
+    value: frontend
+permissions:
+  statements:
+    - actions: [s3:GetObjekt]
+      resources: ['*']
+`,
+			wantedOutput: `copilot/frontend/manifest.yml:9:5: validate "taskdef_overrides[0]": "Family" cannot be overridden with a custom value
+copilot/frontend/manifest.yml:13:17: validate "permissions": validate "statements[0]": validate "actions[0]": action "s3:GetObjekt" does not match any IAM action in the catalog
+`,
+			wantedErr: errors.New("manifest for service frontend has 2 problems"),
+		},
+		"every invalid field is reported": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+image:
+  build: ./Dockerfile
+  port: 8080
+http:
+  path: '/'
+  version: quic
+storage:
+  ephemeral: 10
+taskdef_overrides:
+  - path: Family
+    value: frontend
+`,
+			inEnvs: []string{"test"},
+			wantedOutput: `copilot/frontend/manifest.yml:6:1: validate "http": "version" field value 'quic' must be one of GRPC, HTTP1 or HTTP2
+copilot/frontend/manifest.yml:10:3: validate "storage": validate "ephemeral": ephemeral storage must be between 20 GiB and 200 GiB
+copilot/frontend/manifest.yml:12:5: validate "taskdef_overrides[0]": "Family" cannot be overridden with a custom value
+`,
+			wantedErr: errors.New("manifest for service frontend has 3 problems"),
+		},
+		"validates against the overridden environments if the environments of the application can't be listed": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+image:
+  build: ./Dockerfile
+  port: 8080
+http:
+  path: '/'
+environments:
+  test:
+    taskdef_overrides:
+      - path: Family
+        value: frontend
+`,
+			inEnvsErr: errors.New("some error"),
+			wantedOutput: `copilot/frontend/manifest.yml:11:9: validate "taskdef_overrides[0]": "Family" cannot be overridden with a custom value
+`,
+			wantedErr: errors.New("manifest for service frontend has 1 problem"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsWlDirReader(ctrl)
+			ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(tc.inManifest), nil)
+			store := mocks.NewMockenvironmentLister(ctrl)
+			var envs []*config.Environment
+			for _, env := range tc.inEnvs {
+				envs = append(envs, &config.Environment{App: "phonetool", Name: env})
+			}
+			store.EXPECT().ListEnvironments("phonetool").Return(envs, tc.inEnvsErr)
+			out := &strings.Builder{}
+			actions, err := catalog.New()
+			require.NoError(t, err)
+			opts := &validateWkldOpts{
+				validateWkldVars: validateWkldVars{
+					appName: "phonetool",
+					name:    "frontend",
+				},
+				kind:            wkldKindService,
+				store:           store,
+				ws:              ws,
+				unmarshal:       manifest.UnmarshalWorkload,
+				newInterpolator: newManifestInterpolator,
+				actions:         actions,
+				w:               out,
+			}
+
+			err = opts.Execute()
+
+			require.Equal(t, tc.wantedOutput, out.String())
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -123,6 +124,8 @@ type runTaskVars struct {
 
 	os   string
 	arch string
+
+	outputFormat string
 }
 
 type runTaskOpts struct {
@@ -334,6 +337,10 @@ func (o *runTaskOpts) Validate() error {
 		return errors.New("cannot specify both `--image` and `--build-context`")
 	}
 
+	if o.follow && o.outputFormat != "" {
+		return errors.New("cannot specify both `--follow` and `--output`")
+	}
+
 	if o.isDockerfileSet {
 		if _, err := o.fs.Stat(o.dockerfilePath); err != nil {
 			return fmt.Errorf("invalid `--dockerfile` path: %w", err)
@@ -692,6 +699,15 @@ Did you tag your secrets with the "copilot-application" and "copilot-environment
 	}
 
 	o.showPublicIPs(tasks)
+	taskARNs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskARNs[i] = t.TaskARN
+	}
+	event.Publish(event.Output{
+		Application: o.appName,
+		Environment: o.env,
+		TaskARNs:    taskARNs,
+	})
 
 	if o.follow {
 		o.configureEventsWriter(tasks)
@@ -1042,15 +1058,17 @@ func BuildTaskRunCmd() *cobra.Command {
   Run a task with a command.
   /code $ copilot task run --command "python migrate-script.py"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newTaskRunOpts(vars)
-			if err != nil {
-				return err
-			}
-			opts.nFlag = cmd.Flags().NFlag()
-			if cmd.Flags().Changed(dockerFileFlag) {
-				opts.isDockerfileSet = true
-			}
-			return run(opts)
+			return runWithEvents(vars.outputFormat, func() error {
+				opts, err := newTaskRunOpts(vars)
+				if err != nil {
+					return err
+				}
+				opts.nFlag = cmd.Flags().NFlag()
+				if cmd.Flags().Changed(dockerFileFlag) {
+					opts.isDockerfileSet = true
+				}
+				return run(opts)
+			})
 		}),
 	}
 
@@ -1085,6 +1103,7 @@ func BuildTaskRunCmd() *cobra.Command {
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
 	cmd.Flags().StringVar(&vars.generateCommandTarget, generateCommandFlag, "", generateCommandFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFormatFlag, "", outputFormatFlagDescription)
 
 	// group flags.
 	nameFlags := pflag.NewFlagSet("Name", pflag.ContinueOnError)
@@ -1122,6 +1141,7 @@ func BuildTaskRunCmd() *cobra.Command {
 	utilityFlags := pflag.NewFlagSet("Utility", pflag.ContinueOnError)
 	utilityFlags.AddFlag(cmd.Flags().Lookup(followFlag))
 	utilityFlags.AddFlag(cmd.Flags().Lookup(generateCommandFlag))
+	utilityFlags.AddFlag(cmd.Flags().Lookup(outputFormatFlag))
 
 	// prettify help menu.
 	cmd.Annotations = map[string]string{
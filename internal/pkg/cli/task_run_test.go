@@ -70,6 +70,8 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 		inDefault               bool
 		inGenerateCommandTarget string
+		inFollow                bool
+		inOutputFormat          string
 
 		appName         string
 		isDockerfileSet bool
@@ -218,6 +220,14 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("cannot specify both `--image` and `--build-context`"),
 		},
+		"both follow and output specified": {
+			basicOpts: defaultOpts,
+
+			inFollow:       true,
+			inOutputFormat: "json",
+
+			wantedError: errors.New("cannot specify both `--follow` and `--output`"),
+		},
 		"both dockerfile and image name specified": {
 			basicOpts: defaultOpts,
 
@@ -411,6 +421,8 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					generateCommandTarget:       tc.inGenerateCommandTarget,
 					os:                          tc.inOS,
 					arch:                        tc.inArch,
+					follow:                      tc.inFollow,
+					outputFormat:                tc.inOutputFormat,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
 				nFlag:           2,
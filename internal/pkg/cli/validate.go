@@ -59,11 +59,19 @@ var (
 	errTooManyLSIKeys                     = errors.New("number of specified LSI sort keys must be 5 or less")
 
 	// Aurora-Serverless-specific errors.
-	errInvalidRDSNameCharacters    = errors.New("value must start with a letter")
-	errRDWSNotConnectedToVPC       = fmt.Errorf("%s requires a VPC connection", manifest.RequestDrivenWebServiceType)
-	fmtErrInvalidEngineType        = "invalid engine type %s: must be one of %s"
-	fmtErrInvalidDBNameCharacters  = "invalid database name %s: must contain only alphanumeric characters and underscore; should start with a letter"
-	errInvalidSecretNameCharacters = errors.New("value must contain only letters, numbers, periods, hyphens and underscores")
+	errInvalidRDSNameCharacters = errors.New("value must start with a letter")
+	errRDWSNotConnectedToVPC    = fmt.Errorf("%s requires a VPC connection", manifest.RequestDrivenWebServiceType)
+
+	// Redis, OpenSearch and SQS errors.
+	errStorageTypeNotSupportedByRDWS = fmt.Errorf("not supported for a %s", manifest.RequestDrivenWebServiceType)
+	errInvalidStorageNameCharacters  = errors.New("value must start with a letter and contain only alphanumeric characters and -")
+	fmtErrInvalidRedisReplicas       = "invalid number of replicas %d: must be between 0 and 5"
+	fmtErrInvalidOpenSearchNodeCount = "invalid number of data nodes %d: must be 1 or an even number"
+	fmtErrInvalidOpenSearchVolume    = "invalid volume size %d: must be between 10 and 16384 GiB"
+	fmtErrInvalidSQSMaxReceiveCount  = "invalid maximum receive count %d: must be between 1 and 1000"
+	fmtErrInvalidEngineType          = "invalid engine type %s: must be one of %s"
+	fmtErrInvalidDBNameCharacters    = "invalid database name %s: must contain only alphanumeric characters and underscore; should start with a letter"
+	errInvalidSecretNameCharacters   = errors.New("value must contain only letters, numbers, periods, hyphens and underscores")
 
 	// Topic subscription errors.
 	errMissingPublishTopicField = errors.New("field `publish.topics[].name` cannot be empty")
@@ -142,6 +150,8 @@ var (
 		`[a-zA-Z0-9\-\.\_]*` + // Followed by alphanumeric, ._-. Refers to POSIX portable file name character set.
 		"$", // End of string.
 	)
+
+	storageLogicalIDNameRegExp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9\-]*$`)
 )
 
 // SSM secret parameter name validation expression.
@@ -292,8 +302,31 @@ func validateStorageType(val interface{}, opts validateStorageTypeOpts) error {
 		return fmt.Errorf(fmtErrInvalidStorageType, storageType, prettify(storageTypes))
 	}
 
-	if storageType == rdsStorageType {
+	switch storageType {
+	case rdsStorageType:
 		return validateAuroraStorageType(opts.ws, opts.workloadName)
+	case redisStorageType, openSearchStorageType:
+		return validateECSOnlyStorageType(opts.ws, opts.workloadName, storageType)
+	}
+	return nil
+}
+
+// validateECSOnlyStorageType returns an error if the storage type requires the workload to run in the environment's VPC,
+// and the workload is a Request-Driven Web Service.
+func validateECSOnlyStorageType(ws manifestReader, workloadName, storageType string) error {
+	if workloadName == "" {
+		return nil // Workload not yet selected while validating storage type flag.
+	}
+	mft, err := ws.ReadWorkloadManifest(workloadName)
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read manifest file for %s: %w", storageType, workloadName, err)
+	}
+	mftType, err := mft.WorkloadType()
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read type of workload from manifest file for %s: %w", storageType, workloadName, err)
+	}
+	if mftType == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("invalid storage type %s: %w", storageType, errStorageTypeNotSupportedByRDWS)
 	}
 	return nil
 }
@@ -626,6 +659,50 @@ func rdsNameValidation(val interface{}) error {
 	return nil
 }
 
+// Redis replication groups, OpenSearch domains and SQS queues are named by CloudFormation after their logical ID,
+// which is derived from the storage name.
+func storageLogicalIDNameValidation(val interface{}) error {
+	const minNameLength = 1
+	const maxNameLength = 40
+
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if len(s) < minNameLength || len(s) > maxNameLength {
+		return fmt.Errorf(fmtErrValueBadSize, minNameLength, maxNameLength)
+	}
+	if !storageLogicalIDNameRegExp.MatchString(s) {
+		return errInvalidStorageNameCharacters
+	}
+	return nil
+}
+
+func validateRedisReplicas(replicas int) error {
+	if replicas < 0 || replicas > 5 {
+		return fmt.Errorf(fmtErrInvalidRedisReplicas, replicas)
+	}
+	return nil
+}
+
+func validateOpenSearchNodes(count, volumeSize int) error {
+	// Data nodes beyond the first are spread evenly across two Availability Zones.
+	if count < 1 || (count > 1 && count%2 != 0) {
+		return fmt.Errorf(fmtErrInvalidOpenSearchNodeCount, count)
+	}
+	if volumeSize < 10 || volumeSize > 16384 {
+		return fmt.Errorf(fmtErrInvalidOpenSearchVolume, volumeSize)
+	}
+	return nil
+}
+
+func validateSQSMaxReceiveCount(count int) error {
+	if count < 1 || count > 1000 {
+		return fmt.Errorf(fmtErrInvalidSQSMaxReceiveCount, count)
+	}
+	return nil
+}
+
 func validateKey(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
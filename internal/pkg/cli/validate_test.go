@@ -377,6 +377,34 @@ type: Request-Driven Web Service
 			},
 			want: errors.New("invalid storage type Aurora: Request-Driven Web Service requires a VPC connection"),
 		},
+		"should return an error if OpenSearch is selected for a RDWS": {
+			input: "OpenSearch",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Request-Driven Web Service
+network:
+  vpc:
+    placement: private
+`),
+				},
+				workloadName: "api",
+			},
+			want: errors.New("invalid storage type OpenSearch: not supported for a Request-Driven Web Service"),
+		},
+		"should allow Redis for a Backend Service": {
+			input: "Redis",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Backend Service
+`),
+				},
+				workloadName: "api",
+			},
+		},
 		"should succeed if Aurora is selected and RDWS is connected to a VPC": {
 			input: "Aurora",
 			optionals: validateStorageTypeOpts{
@@ -25,6 +25,7 @@ type Environment struct {
 	ManagerRoleARN   string        `json:"managerRoleARN"`         // ARN for the manager role assumed to manipulate the environment and its services.
 	CustomConfig     *CustomizeEnv `json:"customConfig,omitempty"` // Custom environment configuration by users.
 	Telemetry        *Telemetry    `json:"telemetry,omitempty"`    // Optional environment telemetry features.
+	Addons           *EnvAddons    `json:"addons,omitempty"`       // Optional addons shared by the workloads in the environment.
 }
 
 // CustomizeEnv represents the custom environment config.
@@ -64,6 +65,13 @@ type Telemetry struct {
 	EnableContainerInsights bool `json:"containerInsights"`
 }
 
+// EnvAddons holds the configuration of the addons nested stack shared by all the workloads in an environment.
+type EnvAddons struct {
+	TemplateURL string   `json:"templateURL"`          // S3 object URL of the merged addons template.
+	Parameters  string   `json:"parameters,omitempty"` // Additional user defined Parameters for the addons stack.
+	Outputs     []string `json:"outputs,omitempty"`    // Logical IDs of the addons outputs exported by the environment stack.
+}
+
 // CreateEnvironment instantiates a new environment within an existing App. Skip if
 // the environment already exists in the App.
 func (s *Store) CreateEnvironment(environment *Environment) error {
@@ -95,6 +103,26 @@ func (s *Store) CreateEnvironment(environment *Environment) error {
 	return nil
 }
 
+// UpdateEnvironment overwrites the configuration of an existing environment.
+func (s *Store) UpdateEnvironment(environment *Environment) error {
+	environmentPath := fmt.Sprintf(fmtEnvParamPath, environment.App, environment.Name)
+	data, err := marshal(environment)
+	if err != nil {
+		return fmt.Errorf("serializing environment %s: %w", environment.Name, err)
+	}
+
+	if _, err = s.ssm.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(environmentPath),
+		Description: aws.String(fmt.Sprintf("The %s deployment stage", environment.Name)),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(data),
+		Overwrite:   aws.Bool(true),
+	}); err != nil {
+		return fmt.Errorf("update environment %s in application %s: %w", environment.Name, environment.App, err)
+	}
+	return nil
+}
+
 // GetEnvironment gets an environment belonging to a particular application by name. If no environment is found
 // it returns ErrNoSuchEnvironment.
 func (s *Store) GetEnvironment(appName string, environmentName string) (*Environment, error) {
@@ -221,6 +221,62 @@ func TestStore_GetEnvironment(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inEnvironment *Environment
+
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"success": {
+			inEnvironment: &Environment{
+				App:  "phonetool",
+				Name: "test",
+				Telemetry: &Telemetry{
+					EnableContainerInsights: true,
+				},
+			},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, fmt.Sprintf(fmtEnvParamPath, "phonetool", "test"), *param.Name)
+				require.Equal(t, `{"app":"phonetool","name":"test","region":"","accountID":"","prod":false,"registryURL":"","executionRoleARN":"","managerRoleARN":"","telemetry":{"containerInsights":true}}`, *param.Value)
+				require.True(t, aws.BoolValue(param.Overwrite))
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"with SSM error": {
+			inEnvironment: &Environment{App: "phonetool", Name: "test"},
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("update environment test in application phonetool: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssm: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+				},
+			}
+
+			// WHEN
+			err := store.UpdateEnvironment(tc.inEnvironment)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestStore_CreateEnvironment(t *testing.T) {
 	testApplication := Application{Name: "chicken", Version: "1.0"}
 	testApplicationString, err := marshal(testApplication)
@@ -24,6 +24,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -38,6 +39,11 @@ const (
 	// CloudFormation resource types.
 	ecsServiceResourceType    = "AWS::ECS::Service"
 	envControllerResourceType = "Custom::EnvControllerFunction"
+
+	// Logical IDs of the resources that shift traffic between revisions of a service.
+	alternateTargetGroupLogicalID = "AlternateTargetGroup"
+	httpListenerRuleLogicalID     = "HTTPListenerRule"
+	httpsListenerRuleLogicalID    = "HTTPSListenerRule"
 )
 
 // StackConfiguration represents the set of methods needed to deploy a cloudformation stack.
@@ -53,6 +59,10 @@ type ecsClient interface {
 	stream.ECSServiceDescriber
 }
 
+type elbv2Client interface {
+	stream.ListenerRuleDescriber
+}
+
 type cfnClient interface {
 	// Methods augmented by the aws wrapper struct.
 	Create(*cloudformation.Stack) (string, error)
@@ -60,6 +70,7 @@ type cfnClient interface {
 	WaitForCreate(ctx context.Context, stackName string) error
 	Update(*cloudformation.Stack) (string, error)
 	UpdateAndWait(*cloudformation.Stack) error
+	PreviewUpdate(*cloudformation.Stack) (*cloudformation.ChangeSetDescription, error)
 	WaitForUpdate(ctx context.Context, stackName string) error
 	Delete(stackName string) error
 	DeleteAndWait(stackName string) error
@@ -72,6 +83,7 @@ type cfnClient interface {
 	ListStacksWithTags(tags map[string]string) ([]cloudformation.StackDescription, error)
 	ErrorEvents(stackName string) ([]cloudformation.StackEvent, error)
 	Outputs(stack *cloudformation.Stack) (map[string]string, error)
+	StackResources(name string) ([]*cloudformation.StackResource, error)
 
 	// Methods vended by the aws sdk struct.
 	DescribeStackEvents(*sdkcloudformation.DescribeStackEventsInput) (*sdkcloudformation.DescribeStackEventsOutput, error)
@@ -105,6 +117,7 @@ type CloudFormation struct {
 	codeStarClient codeStarClient
 	cpClient       codePipelineClient
 	ecsClient      ecsClient
+	elbv2Client    elbv2Client
 	regionalClient func(region string) cfnClient
 	appStackSet    stackSetClient
 	s3Client       s3Client
@@ -118,6 +131,7 @@ func New(sess *session.Session) CloudFormation {
 		codeStarClient: codestar.New(sess),
 		cpClient:       codepipeline.New(sess),
 		ecsClient:      ecs.New(sess),
+		elbv2Client:    elbv2.New(sess),
 		regionalClient: func(region string) cfnClient {
 			return cloudformation.New(sess.Copy(&aws.Config{
 				Region: aws.String(region),
@@ -291,11 +305,21 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 			}
 			renderer = r
 		case aws.StringValue(change.ResourceChange.ResourceType) == ecsServiceResourceType:
-			renderer = progress.ListeningECSServiceResourceRenderer(in.stackStreamer, cf.ecsClient, logicalID, description, progress.ECSServiceRendererOpts{
+			opts := progress.ECSServiceRendererOpts{
 				Group:      in.g,
 				Ctx:        in.ctx,
 				RenderOpts: in.opts,
-			})
+			}
+			if _, ok := in.descriptions[alternateTargetGroupLogicalID]; ok {
+				// The service shifts traffic to its new revision through the listener rule.
+				ruleARN, err := cf.listenerRuleARN(in.stackName)
+				if err != nil {
+					return nil, err
+				}
+				opts.RuleDescriber = cf.elbv2Client
+				opts.ListenerRuleARN = ruleARN
+			}
+			renderer = progress.ListeningECSServiceResourceRenderer(in.stackStreamer, cf.ecsClient, logicalID, description, opts)
 		case change.ResourceChange.ChangeSetId != nil:
 			// The resource change is a nested stack.
 			changeSetID := aws.StringValue(change.ResourceChange.ChangeSetId)
@@ -316,6 +340,22 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 	return resources, nil
 }
 
+// listenerRuleARN returns the ARN of the listener rule that forwards traffic to the service of the stack,
+// or an empty string if the stack doesn't have one yet.
+func (cf CloudFormation) listenerRuleARN(stackName string) (string, error) {
+	resources, err := cf.cfnClient.StackResources(stackName)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range resources {
+		switch aws.StringValue(r.LogicalResourceId) {
+		case httpListenerRuleLogicalID, httpsListenerRuleLogicalID:
+			return aws.StringValue(r.PhysicalResourceId), nil
+		}
+	}
+	return "", nil
+}
+
 type envControllerRendererInput struct {
 	g                 *errgroup.Group
 	ctx               context.Context
@@ -728,3 +728,61 @@ Resources:
 	require.Contains(t, buf.String(), "An Addons CloudFormation Stack for your additional AWS resources")
 	require.Contains(t, buf.String(), "A DynamoDB table to store data")
 }
+
+func TestCloudFormation_listenerRuleARN(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockcfnClient)
+
+		wanted    string
+		wantedErr error
+	}{
+		"should return the error if the stack resources can't be described": {
+			setupMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().StackResources("phonetool-test-frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"should return an empty ARN if the stack has no listener rule yet": {
+			setupMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().StackResources("phonetool-test-frontend").Return(nil, nil)
+			},
+		},
+		"should return the physical ID of the listener rule": {
+			setupMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().StackResources("phonetool-test-frontend").Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("TargetGroup"),
+						PhysicalResourceId: aws.String("tg-arn"),
+					},
+					{
+						LogicalResourceId:  aws.String("HTTPSListenerRule"),
+						PhysicalResourceId: aws.String("rule-arn"),
+					},
+				}, nil)
+			},
+			wanted: "rule-arn",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCFN := mocks.NewMockcfnClient(ctrl)
+			tc.setupMocks(mockCFN)
+			cf := CloudFormation{cfnClient: mockCFN}
+
+			// WHEN
+			got, err := cf.listenerRuleARN("phonetool-test-frontend")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -150,6 +151,44 @@ func (mr *MockecsClientMockRecorder) Service(clusterName, serviceName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsClient)(nil).Service), clusterName, serviceName)
 }
 
+// Mockelbv2Client is a mock of elbv2Client interface.
+type Mockelbv2Client struct {
+	ctrl     *gomock.Controller
+	recorder *Mockelbv2ClientMockRecorder
+}
+
+// Mockelbv2ClientMockRecorder is the mock recorder for Mockelbv2Client.
+type Mockelbv2ClientMockRecorder struct {
+	mock *Mockelbv2Client
+}
+
+// NewMockelbv2Client creates a new mock instance.
+func NewMockelbv2Client(ctrl *gomock.Controller) *Mockelbv2Client {
+	mock := &Mockelbv2Client{ctrl: ctrl}
+	mock.recorder = &Mockelbv2ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockelbv2Client) EXPECT() *Mockelbv2ClientMockRecorder {
+	return m.recorder
+}
+
+// ListenerRuleWeights mocks base method.
+func (m *Mockelbv2Client) ListenerRuleWeights(ruleARN string) ([]elbv2.TargetGroupWeight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenerRuleWeights", ruleARN)
+	ret0, _ := ret[0].([]elbv2.TargetGroupWeight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListenerRuleWeights indicates an expected call of ListenerRuleWeights.
+func (mr *Mockelbv2ClientMockRecorder) ListenerRuleWeights(ruleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRuleWeights", reflect.TypeOf((*Mockelbv2Client)(nil).ListenerRuleWeights), ruleARN)
+}
+
 // MockcfnClient is a mock of cfnClient interface.
 type MockcfnClient struct {
 	ctrl     *gomock.Controller
@@ -349,6 +388,36 @@ func (mr *MockcfnClientMockRecorder) Outputs(stack interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockcfnClient)(nil).Outputs), stack)
 }
 
+// PreviewUpdate mocks base method.
+func (m *MockcfnClient) PreviewUpdate(arg0 *cloudformation0.Stack) (*cloudformation0.ChangeSetDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewUpdate", arg0)
+	ret0, _ := ret[0].(*cloudformation0.ChangeSetDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewUpdate indicates an expected call of PreviewUpdate.
+func (mr *MockcfnClientMockRecorder) PreviewUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewUpdate", reflect.TypeOf((*MockcfnClient)(nil).PreviewUpdate), arg0)
+}
+
+// StackResources mocks base method.
+func (m *MockcfnClient) StackResources(name string) ([]*cloudformation0.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources", name)
+	ret0, _ := ret[0].([]*cloudformation0.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockcfnClientMockRecorder) StackResources(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockcfnClient)(nil).StackResources), name)
+}
+
 // TemplateBody mocks base method.
 func (m *MockcfnClient) TemplateBody(stackName string) (string, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,109 @@
+//go:build integration || localintegration
+// +build integration localintegration
+
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+// TestActions_Pipeline_Template ensures that the CloudFormation template generated for a pipeline with pre and post deployment actions matches our pre-defined template.
+func TestActions_Pipeline_Template(t *testing.T) {
+	ps := stack.NewPipelineStackConfig(&deploy.CreatePipelineInput{
+		AppName: "phonetool",
+		Name:    "phonetool-pipeline",
+		Source: &deploy.CodeCommitSource{
+			ProviderName:         manifest.CodeCommitProviderName,
+			RepositoryURL:        "https://us-west-2.console.aws.amazon.com/codesuite/codecommit/repositories/aws-sample/browse",
+			Branch:               "main",
+			OutputArtifactFormat: "CODEBUILD_CLONE_REF",
+		},
+		Build: deploy.PipelineBuildFromManifest(nil),
+		Stages: []deploy.PipelineStage{
+			{
+				AssociatedEnvironment: &deploy.AssociatedEnvironment{
+					Name:      "test",
+					Region:    "us-west-2",
+					AccountID: "1111",
+				},
+				LocalWorkloads: []string{"frontend", "api"},
+				TestCommands:   []string{`echo "test"`},
+				PreDeployments: deploy.PipelineActionsFromManifest([]manifest.PipelineAction{
+					{
+						Name: "migrate-db",
+						Build: &manifest.ActionBuild{
+							Image:     "aws/codebuild/standard:5.0",
+							Buildspec: "copilot/pipelines/migrate.yml",
+						},
+					},
+				}),
+				PostDeployments: deploy.PipelineActionsFromManifest([]manifest.PipelineAction{
+					{
+						Name: "smoke-test",
+						Build: &manifest.ActionBuild{
+							Commands: []string{"make smoke-test"},
+						},
+					},
+					{
+						Name: "report",
+						Job:  "report-generator",
+					},
+				}),
+			},
+			{
+				AssociatedEnvironment: &deploy.AssociatedEnvironment{
+					Name:      "prod",
+					Region:    "us-east-1",
+					AccountID: "2222",
+				},
+				LocalWorkloads: []string{"frontend"},
+				PreDeployments: deploy.PipelineActionsFromManifest([]manifest.PipelineAction{
+					{
+						Name: "approve",
+						Approval: &manifest.ActionApproval{
+							Topic:   "arn:aws:sns:us-west-2:1111:release-managers",
+							Message: "Ready to release: \"frontend\"?",
+						},
+					},
+				}),
+			},
+		},
+		ArtifactBuckets: []deploy.ArtifactBucket{
+			{
+				BucketName: "fancy-bucket",
+				KeyArn:     "arn:aws:kms:us-west-2:1111:key/abcd",
+			},
+			{
+				BucketName: "fancier-bucket",
+				KeyArn:     "arn:aws:kms:us-east-1:1111:key/efgh",
+			},
+		},
+		AdditionalTags: nil,
+	})
+
+	actual, err := ps.Template()
+	require.NoError(t, err, "template should have rendered successfully")
+	actualInBytes := []byte(actual)
+	m1 := make(map[interface{}]interface{})
+	require.NoError(t, yaml.Unmarshal(actualInBytes, m1))
+
+	wanted, err := ioutil.ReadFile(filepath.Join("testdata", "pipeline", "actions_template.yaml"))
+	require.NoError(t, err, "should be able to read expected template file")
+	wantedInBytes := []byte(wanted)
+	m2 := make(map[interface{}]interface{})
+	require.NoError(t, yaml.Unmarshal(wantedInBytes, m2))
+
+	require.Equal(t, m2, m1)
+}
@@ -120,11 +120,14 @@ func (s *BackendService) Template() (string, error) {
 		Secrets:                  convertSecrets(s.manifest.BackendServiceConfig.Secrets),
 		NestedStack:              addonsOutputs,
 		AddonsExtraParams:        addonsParams,
+		ImportedEnvAddons:        convertImportedEnvAddons(s.manifest.BackendServiceConfig.EnvAddons),
+		Permissions:              convertPermissions(s.manifest.BackendServiceConfig.Permissions),
 		Sidecars:                 sidecars,
 		Autoscaling:              autoscaling,
 		CapacityProviders:        capacityProviders,
 		DesiredCountOnSpot:       desiredCountOnSpot,
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
+		ServiceConnect:           convertServiceConnect(s.manifest.Network.Connect, aws.StringValue(s.manifest.Name), s.manifest.ImageConfig.Port != nil),
 		WorkloadType:             manifest.BackendServiceType,
 		HealthCheck:              convertContainerHealthCheck(s.manifest.BackendServiceConfig.ImageConfig.HealthCheck),
 		LogConfig:                convertLogging(s.manifest.Logging),
@@ -148,7 +151,11 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("apply task definition overrides: %w", err)
 	}
-	return string(overridenTpl), nil
+	patchedTpl, err := override.PatchTemplate(convertPatches(s.manifest.Patches), overridenTpl)
+	if err != nil {
+		return "", fmt.Errorf("apply patches: %w", err)
+	}
+	return string(patchedTpl), nil
 }
 
 // Parameters returns the list of CloudFormation parameters used by the template.
@@ -18,6 +18,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 // Test settings for container healthchecks in the backend service manifest.
@@ -162,6 +163,75 @@ Outputs:
 			},
 			wantedErr: fmt.Errorf("parse backend service template: %w", errors.New("some error")),
 		},
+		"failed applying patches": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.Patches = []manifest.PatchOperation{
+					{
+						Operation: "remove",
+						Path:      "/Resources/DiscoveryService",
+					},
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("Resources:\n  Service:\n    Type: AWS::ECS::Service\n")}, nil)
+				svc.parser = m
+				svc.addons = mockAddons{
+					tpl: `
+Resources:
+  AdditionalResourcesPolicy:
+    Type: AWS::IAM::ManagedPolicy
+Outputs:
+  AdditionalResourcesPolicyArn:
+    Value: hello`,
+				}
+			},
+			wantedErr: fmt.Errorf(`apply patches: patch 0: remove operation at "/Resources/DiscoveryService": key "DiscoveryService" not found at "/Resources"`),
+		},
+		"render template with patches": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.Patches = []manifest.PatchOperation{
+					{
+						Operation: "add",
+						Path:      "/Resources/Service/Properties",
+						Value: yaml.Node{
+							Kind: yaml.MappingNode,
+							Tag:  "!!map",
+							Content: []*yaml.Node{
+								{Kind: yaml.ScalarNode, Tag: "!!str", Value: "PlatformVersion"},
+								{Kind: yaml.ScalarNode, Tag: "!!str", Value: "1.4.0"},
+							},
+						},
+					},
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("Resources:\n  Service:\n    Type: AWS::ECS::Service\n")}, nil)
+				svc.parser = m
+				svc.addons = mockAddons{
+					tpl: `
+Resources:
+  AdditionalResourcesPolicy:
+    Type: AWS::IAM::ManagedPolicy
+Outputs:
+  AdditionalResourcesPolicyArn:
+    Value: hello`,
+				}
+			},
+			wantedTemplate: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      PlatformVersion: 1.4.0
+`,
+		},
 		"render template": {
 			setUpManifest: func(svc *BackendService) {
 				svc.manifest = manifest.NewBackendService(manifest.BackendServiceProps{
@@ -41,6 +41,8 @@ const (
 	EnvOutputVPCID                   = "VpcId"
 	EnvOutputPublicSubnets           = "PublicSubnets"
 	EnvOutputPrivateSubnets          = "PrivateSubnets"
+	EnvOutputHTTPListenerARN         = "HTTPListenerArn"
+	EnvOutputHTTPSListenerARN        = "HTTPSListenerArn"
 	envOutputCFNExecutionRoleARN     = "CFNExecutionRoleARN"
 	envOutputManagerRoleKey          = "EnvironmentManagerRoleARN"
 	EnvParamServiceDiscoveryEndpoint = "ServiceDiscoveryEndpoint"
@@ -100,6 +102,7 @@ func (e *EnvStackConfig) Template() (string, error) {
 		VPCConfig:              vpcConf,
 		Version:                e.in.Version,
 		Telemetry:              e.in.Telemetry,
+		Addons:                 e.in.Addons,
 		LatestVersion:          deploy.LatestEnvTemplateVersion,
 	}, template.WithFuncs(map[string]interface{}{
 		"inc": template.IncFunc,
@@ -140,11 +143,10 @@ func (e *EnvStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 	}, nil
 }
 
-// SerializedParameters returns the CloudFormation stack's parameters serialized
-// to a YAML document annotated with comments for readability to users.
-func (s *EnvStackConfig) SerializedParameters() (string, error) {
-	// No-op for now.
-	return "", nil
+// SerializedParameters returns the CloudFormation stack's parameters and tags serialized
+// to a JSON document in the template configuration file format.
+func (e *EnvStackConfig) SerializedParameters() (string, error) {
+	return serializeTemplateConfig(e.parser, e)
 }
 
 // Tags returns the tags that should be applied to the environment CloudFormation stack.
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestEnv_Template(t *testing.T) {
@@ -72,6 +73,44 @@ func TestEnv_Template(t *testing.T) {
 	}
 }
 
+func TestEnv_Template_Addons(t *testing.T) {
+	// GIVEN
+	in := mockDeployEnvironmentInput()
+	in.Version = deploy.LatestEnvTemplateVersion
+	in.Addons = &config.EnvAddons{
+		TemplateURL: "https://mockbucket.s3-us-west-2.amazonaws.com/environments/addons.stack.yml",
+		Parameters:  "VpcId: !Ref VPC\n",
+		Outputs:     []string{"ClusterEndpoint", "ClusterSecret"},
+	}
+	envStack := NewEnvStackConfig(in)
+
+	// WHEN
+	tpl, err := envStack.Template()
+
+	// THEN
+	require.NoError(t, err)
+	require.Contains(t, tpl, `  AddonsStack:
+    Metadata:
+      'aws:copilot:description': 'An Addons CloudFormation Stack for the resources shared by the workloads in your environment'
+    Type: AWS::CloudFormation::Stack
+    Properties:
+      Parameters:
+        App: !Ref AppName
+        Env: !Ref EnvironmentName
+        VpcId: !Ref VPC
+`)
+	require.Contains(t, tpl, `      TemplateURL: https://mockbucket.s3-us-west-2.amazonaws.com/environments/addons.stack.yml
+`)
+	require.Contains(t, tpl, `  AddonsClusterSecret:
+    Value: !GetAtt AddonsStack.Outputs.ClusterSecret
+    Description: An output of the environment addons stack that workloads can import.
+    Export:
+      Name: !Sub ${AWS::StackName}-Addons-ClusterSecret
+`)
+	var parsed map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed), "rendered template should be valid YAML")
+}
+
 func TestEnv_Parameters(t *testing.T) {
 	deploymentInput := mockDeployEnvironmentInput()
 	deploymentInputWithDNS := mockDeployEnvironmentInput()
@@ -151,6 +190,31 @@ func TestEnv_Parameters(t *testing.T) {
 	}
 }
 
+func TestEnv_SerializedParameters(t *testing.T) {
+	env := &EnvStackConfig{
+		in:     mockDeployEnvironmentInput(),
+		parser: template.New(),
+	}
+
+	params, err := env.SerializedParameters()
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+  "Parameters": {
+    "AppName": "project",
+    "EnvironmentName": "env",
+    "ToolsAccountPrincipalARN": "arn:aws:iam::000000000:root",
+    "AppDNSName": "",
+    "AppDNSDelegationRole": "",
+    "ServiceDiscoveryEndpoint": "env.project.local"
+  },
+  "Tags": {
+    "copilot-application": "project",
+    "copilot-environment": "env"
+  }
+}`, params)
+}
+
 func TestEnv_Tags(t *testing.T) {
 	env := &EnvStackConfig{
 		in: &deploy.CreateEnvironmentInput{
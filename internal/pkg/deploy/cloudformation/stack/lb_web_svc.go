@@ -204,6 +204,8 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		Aliases:                        aliases,
 		NestedStack:                    addonsOutputs,
 		AddonsExtraParams:              addonsParams,
+		ImportedEnvAddons:              convertImportedEnvAddons(s.manifest.TaskConfig.EnvAddons),
+		Permissions:                    convertPermissions(s.manifest.TaskConfig.Permissions),
 		Sidecars:                       sidecars,
 		LogConfig:                      convertLogging(s.manifest.Logging),
 		DockerLabels:                   s.manifest.ImageConfig.Image.DockerLabels,
@@ -211,11 +213,15 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		CapacityProviders:              capacityProviders,
 		DesiredCountOnSpot:             desiredCountOnSpot,
 		ExecuteCommand:                 convertExecuteCommand(&s.manifest.ExecuteCommand),
+		ServiceConnect:                 convertServiceConnect(s.manifest.Network.Connect, aws.StringValue(s.manifest.Name), true),
 		WorkloadType:                   manifest.LoadBalancedWebServiceType,
 		HealthCheck:                    convertContainerHealthCheck(s.manifest.ImageConfig.HealthCheck),
 		HTTPHealthCheck:                convertHTTPHealthCheck(&s.manifest.RoutingRule.HealthCheck),
 		DeregistrationDelay:            deregistrationDelay,
 		AllowedSourceIps:               allowedSourceIPs,
+		HTTPRulePriority:               s.manifest.RoutingRule.Priority,
+		AdditionalRules:                convertAdditionalRoutingRules(s.manifest.RoutingRule.AdditionalRules),
+		Deployment:                     convertDeploymentConfig(s.manifest.Deployment),
 		RulePriorityLambda:             rulePriorityLambda.String(),
 		DesiredCountLambda:             desiredCountLambda.String(),
 		EnvControllerLambda:            envControllerLambda.String(),
@@ -243,7 +249,11 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("apply task definition overrides: %w", err)
 	}
-	return string(overridenTpl), nil
+	patchedTpl, err := override.PatchTemplate(convertPatches(s.manifest.Patches), overridenTpl)
+	if err != nil {
+		return "", fmt.Errorf("apply patches: %w", err)
+	}
+	return string(patchedTpl), nil
 }
 
 func (s *LoadBalancedWebService) httpLoadBalancerTarget() (targetContainer *string, targetPort *string) {
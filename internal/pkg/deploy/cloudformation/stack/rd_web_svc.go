@@ -12,6 +12,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/override"
 )
 
 var awsSDKLayerForRegion = map[string]*string{
@@ -148,7 +149,11 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return content.String(), nil
+	patchedTpl, err := override.PatchTemplate(convertPatches(s.manifest.Patches), content.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("apply patches: %w", err)
+	}
+	return string(patchedTpl), nil
 }
 
 // SerializedParameters returns the CloudFormation stack's parameters serialized
@@ -4,9 +4,12 @@
 package stack
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
@@ -25,6 +28,14 @@ const (
 	ScheduledJobScheduleParamKey = "Schedule"
 )
 
+// Output keys for a scheduled job.
+const (
+	ScheduledJobOutputWorkflowStateMachineARN = "WorkflowStateMachineArn"
+)
+
+// jobPayloadDefaultVariable is the environment variable that holds the event payload if none is specified.
+const jobPayloadDefaultVariable = "COPILOT_JOB_PAYLOAD"
+
 type scheduledJobReadParser interface {
 	template.ReadParser
 	ParseScheduledJob(template.WorkloadOpts) (*template.Content, error)
@@ -143,6 +154,14 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert retry/timeout config for job %s: %w", j.name, err)
 	}
+	jobTrigger, err := j.jobTriggerOpts()
+	if err != nil {
+		return "", fmt.Errorf(`convert "on" field for job %s: %w`, j.name, err)
+	}
+	workflow, err := j.workflowOpts()
+	if err != nil {
+		return "", fmt.Errorf(`convert "workflow" field for job %s: %w`, j.name, err)
+	}
 	envControllerLambda, err := j.parser.Read(envControllerPath)
 	if err != nil {
 		return "", fmt.Errorf("read env controller lambda: %w", err)
@@ -156,22 +175,47 @@ func (j *ScheduledJob) Template() (string, error) {
 		return "", err
 	}
 
+	storage := convertStorageOpts(j.manifest.Name, j.manifest.Storage)
+	dependsOn := convertDependsOn(j.manifest.ImageConfig.Image.DependsOn)
+	if payloadPath := aws.StringValue(j.manifest.On.Payload.Path); payloadPath != "" {
+		// The payload container writes the file to a volume shared with the main container before it starts.
+		if storage == nil {
+			storage = &template.StorageOpts{}
+		}
+		storage.Volumes = append(storage.Volumes, &template.Volume{
+			Name: aws.String(template.JobPayloadVolumeName),
+		})
+		storage.MountPoints = append(storage.MountPoints, &template.MountPoint{
+			ContainerPath: aws.String(path.Dir(payloadPath)),
+			ReadOnly:      aws.Bool(true),
+			SourceVolume:  aws.String(template.JobPayloadVolumeName),
+		})
+		if dependsOn == nil {
+			dependsOn = make(map[string]string)
+		}
+		dependsOn[template.JobPayloadContainerName] = "SUCCESS"
+	}
+
 	content, err := j.parser.ParseScheduledJob(template.WorkloadOpts{
 		Variables:                j.manifest.Variables,
 		Secrets:                  convertSecrets(j.manifest.Secrets),
 		NestedStack:              addonsOutputs,
 		AddonsExtraParams:        addonsParams,
+		ImportedEnvAddons:        convertImportedEnvAddons(j.manifest.EnvAddons),
+		Permissions:              convertPermissions(j.manifest.Permissions),
 		Sidecars:                 sidecars,
 		ScheduleExpression:       schedule,
 		StateMachine:             stateMachine,
+		JobTrigger:               jobTrigger,
+		Workflow:                 workflow,
 		HealthCheck:              convertContainerHealthCheck(j.manifest.ImageConfig.HealthCheck),
 		LogConfig:                convertLogging(j.manifest.Logging),
 		DockerLabels:             j.manifest.ImageConfig.Image.DockerLabels,
-		Storage:                  convertStorageOpts(j.manifest.Name, j.manifest.Storage),
+		Storage:                  storage,
 		Network:                  convertNetworkConfig(j.manifest.Network),
 		EntryPoint:               entrypoint,
 		Command:                  command,
-		DependsOn:                convertDependsOn(j.manifest.ImageConfig.Image.DependsOn),
+		DependsOn:                dependsOn,
 		CredentialsParameter:     aws.StringValue(j.manifest.ImageConfig.Image.Credentials),
 		ServiceDiscoveryEndpoint: j.rc.ServiceDiscoveryEndpoint,
 		Publish:                  publishers,
@@ -186,7 +230,11 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("apply task definition overrides: %w", err)
 	}
-	return string(overridenTpl), nil
+	patchedTpl, err := override.PatchTemplate(convertPatches(j.manifest.Patches), overridenTpl)
+	if err != nil {
+		return "", fmt.Errorf("apply patches: %w", err)
+	}
+	return string(patchedTpl), nil
 }
 
 // Parameters returns the list of CloudFormation parameters used by the template.
@@ -227,6 +275,9 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 // Exception is made for strings of the form "rate( )" or "cron( )". These are accepted as-is and
 // validated server-side by CloudFormation.
 func (j *ScheduledJob) awsSchedule() (string, error) {
+	if j.manifest.On.IsUnscheduled() {
+		return "", nil
+	}
 	schedule := aws.StringValue(j.manifest.On.Schedule)
 	if schedule == "" {
 		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
@@ -381,8 +432,50 @@ func toAWSCron(schedule string) (string, error) {
 // StateMachine converts the Timeout and Retries fields to an instance of template.StateMachineOpts
 // It also performs basic validations to provide a fast feedback loop to the customer.
 func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
+	return convertJobFailureHandler(j.manifest.JobFailureHandlerConfig)
+}
+
+// workflowOpts converts the steps of the job's workflow to an instance of template.WorkflowOpts.
+// The steps are validated by the manifest not to depend on each other in a cycle.
+// It returns nil if the job doesn't have a workflow.
+func (j *ScheduledJob) workflowOpts() (*template.WorkflowOpts, error) {
+	workflow := j.manifest.Workflow
+	if len(workflow) == 0 {
+		return nil, nil
+	}
+	var names []string
+	for name := range workflow {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	steps := make([]template.WorkflowStepOpts, len(names))
+	for i, name := range names {
+		var failureHandler manifest.JobFailureHandlerConfig
+		var dependsOn []string
+		if step := workflow[name]; step != nil {
+			failureHandler = step.JobFailureHandlerConfig
+			dependsOn = append(dependsOn, step.DependsOn...)
+			sort.Strings(dependsOn)
+		}
+		opts, err := convertJobFailureHandler(failureHandler)
+		if err != nil {
+			return nil, fmt.Errorf("convert retry/timeout config for step %s: %w", name, err)
+		}
+		steps[i] = template.WorkflowStepOpts{
+			Name:             name,
+			DependsOn:        dependsOn,
+			StateMachineOpts: *opts,
+		}
+	}
+	return &template.WorkflowOpts{
+		Steps: steps,
+	}, nil
+}
+
+// convertJobFailureHandler converts the Timeout and Retries fields to an instance of template.StateMachineOpts.
+func convertJobFailureHandler(config manifest.JobFailureHandlerConfig) (*template.StateMachineOpts, error) {
 	var timeoutSeconds *int
-	if inTimeout := aws.StringValue(j.manifest.Timeout); inTimeout != "" {
+	if inTimeout := aws.StringValue(config.Timeout); inTimeout != "" {
 		parsedTimeout, err := time.ParseDuration(inTimeout)
 		if err != nil {
 			return nil, errDurationInvalid{reason: err}
@@ -397,7 +490,7 @@ func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
 	}
 
 	var retries *int
-	if inRetries := aws.IntValue(j.manifest.Retries); inRetries != 0 {
+	if inRetries := aws.IntValue(config.Retries); inRetries != 0 {
 		if inRetries < 0 {
 			return nil, errors.New("number of retries cannot be negative")
 		}
@@ -408,3 +501,63 @@ func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
 		Retries: retries,
 	}, nil
 }
+
+// jobTriggerOpts converts the event, S3, SNS and manual triggers of the job to an instance of template.JobTriggerOpts.
+// It returns nil if the job runs on a schedule.
+func (j *ScheduledJob) jobTriggerOpts() (*template.JobTriggerOpts, error) {
+	on := j.manifest.On
+	if !on.IsUnscheduled() {
+		return nil, nil
+	}
+	opts := &template.JobTriggerOpts{
+		Payload: template.JobPayloadOpts{
+			Variable: jobPayloadDefaultVariable,
+		},
+	}
+	if on.Payload.Variable != nil {
+		opts.Payload.Variable = aws.StringValue(on.Payload.Variable)
+	}
+	if on.Payload.Path != nil {
+		opts.Payload.FileName = path.Base(aws.StringValue(on.Payload.Path))
+	}
+	switch {
+	case !on.Event.IsEmpty():
+		pattern, err := json.Marshal(on.Event.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf(`convert "event.pattern" to a JSON string: %w`, err)
+		}
+		opts.EventPattern = string(pattern)
+		opts.EventBusName = aws.StringValue(on.Event.Bus)
+	case !on.S3.IsEmpty():
+		key := map[string]interface{}{
+			"prefix": aws.StringValue(on.S3.Prefix),
+		}
+		pattern, err := json.Marshal(map[string]interface{}{
+			"source":      []string{"aws.s3"},
+			"detail-type": []string{"Object Created"},
+			"detail": map[string]interface{}{
+				"bucket": map[string]interface{}{
+					"name": []string{aws.StringValue(on.S3.Bucket)},
+				},
+				"object": map[string]interface{}{
+					"key": []interface{}{key},
+				},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf(`convert "s3" to an event pattern: %w`, err)
+		}
+		opts.EventPattern = string(pattern)
+	case !on.SNS.IsEmpty():
+		topic, err := convertTopicSubscription(manifest.TopicSubscription{
+			Name:         on.SNS.Name,
+			Service:      on.SNS.Service,
+			FilterPolicy: on.SNS.FilterPolicy,
+		})
+		if err != nil {
+			return nil, err
+		}
+		opts.Topic = topic
+	}
+	return opts, nil
+}
@@ -200,6 +200,7 @@ DiscoveryServiceArn: !GetAtt DiscoveryService.Arn`,
 func TestScheduledJob_awsSchedule(t *testing.T) {
 	testCases := map[string]struct {
 		inputSchedule   string
+		inputTrigger    manifest.JobTriggerConfig
 		wantedSchedule  string
 		wantedError     error
 		wantedErrorType interface{}
@@ -212,6 +213,12 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 			inputSchedule: "",
 			wantedError:   errors.New(`missing required field "schedule" in manifest for job mailer`),
 		},
+		"unscheduled job": {
+			inputTrigger: manifest.JobTriggerConfig{
+				Manual: aws.Bool(true),
+			},
+			wantedSchedule: "",
+		},
 		"one minute rate": {
 			inputSchedule:  "@every 1m",
 			wantedSchedule: "rate(1 minute)",
@@ -326,6 +333,9 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 					},
 				},
 			}
+			if tc.inputTrigger.IsUnscheduled() {
+				job.manifest.On = tc.inputTrigger
+			}
 			// WHEN
 			parsedSchedule, err := job.awsSchedule()
 
@@ -426,6 +436,200 @@ func TestScheduledJob_stateMachine(t *testing.T) {
 	}
 }
 
+func TestScheduledJob_jobTriggerOpts(t *testing.T) {
+	testCases := map[string]struct {
+		inTrigger manifest.JobTriggerConfig
+
+		wantedOpts *template.JobTriggerOpts
+	}{
+		"nil for a schedule": {
+			inTrigger: manifest.JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+			},
+		},
+		"manual trigger with the default payload variable": {
+			inTrigger: manifest.JobTriggerConfig{
+				Manual: aws.Bool(true),
+			},
+			wantedOpts: &template.JobTriggerOpts{
+				Payload: template.JobPayloadOpts{
+					Variable: "COPILOT_JOB_PAYLOAD",
+				},
+			},
+		},
+		"event trigger with a custom bus and payload variable": {
+			inTrigger: manifest.JobTriggerConfig{
+				Event: manifest.JobEventTrigger{
+					Pattern: map[string]interface{}{
+						"source": []interface{}{"aws.ecr"},
+					},
+					Bus: aws.String("orders"),
+				},
+				Payload: manifest.JobPayloadConfig{
+					Variable: aws.String("EVENT"),
+				},
+			},
+			wantedOpts: &template.JobTriggerOpts{
+				EventPattern: `{"source":["aws.ecr"]}`,
+				EventBusName: "orders",
+				Payload: template.JobPayloadOpts{
+					Variable: "EVENT",
+				},
+			},
+		},
+		"s3 trigger with a payload file": {
+			inTrigger: manifest.JobTriggerConfig{
+				S3: manifest.JobS3Trigger{
+					Bucket: aws.String("uploads"),
+					Prefix: aws.String("images/"),
+				},
+				Payload: manifest.JobPayloadConfig{
+					Path: aws.String("/copilot/event.json"),
+				},
+			},
+			wantedOpts: &template.JobTriggerOpts{
+				EventPattern: `{"detail":{"bucket":{"name":["uploads"]},"object":{"key":[{"prefix":"images/"}]}},"detail-type":["Object Created"],"source":["aws.s3"]}`,
+				Payload: template.JobPayloadOpts{
+					Variable: "COPILOT_JOB_PAYLOAD",
+					FileName: "event.json",
+				},
+			},
+		},
+		"sns trigger": {
+			inTrigger: manifest.JobTriggerConfig{
+				SNS: manifest.JobSNSTrigger{
+					Name:    aws.String("orders"),
+					Service: aws.String("api"),
+					FilterPolicy: map[string]interface{}{
+						"store": []interface{}{"example_corp"},
+					},
+				},
+			},
+			wantedOpts: &template.JobTriggerOpts{
+				Topic: &template.TopicSubscription{
+					Name:         aws.String("orders"),
+					Service:      aws.String("api"),
+					FilterPolicy: aws.String(`{"store":["example_corp"]}`),
+				},
+				Payload: template.JobPayloadOpts{
+					Variable: "COPILOT_JOB_PAYLOAD",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			job := &ScheduledJob{
+				ecsWkld: &ecsWkld{
+					wkld: &wkld{
+						name: "mailer",
+					},
+				},
+				manifest: &manifest.ScheduledJob{
+					ScheduledJobConfig: manifest.ScheduledJobConfig{
+						On: tc.inTrigger,
+					},
+				},
+			}
+
+			// WHEN
+			opts, err := job.jobTriggerOpts()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOpts, opts)
+		})
+	}
+}
+
+func TestScheduledJob_workflowOpts(t *testing.T) {
+	testCases := map[string]struct {
+		inWorkflow manifest.JobWorkflow
+
+		wantedOpts  *template.WorkflowOpts
+		wantedError error
+	}{
+		"nil without a workflow": {},
+		"steps with their sorted dependencies": {
+			inWorkflow: manifest.JobWorkflow{
+				"extract": nil,
+				"orders": {
+					DependsOn: []string{"extract"},
+				},
+				"customers": {
+					DependsOn: []string{"extract"},
+					JobFailureHandlerConfig: manifest.JobFailureHandlerConfig{
+						Retries: aws.Int(3),
+					},
+				},
+				"load": {
+					DependsOn: []string{"orders", "customers", "extract"},
+					JobFailureHandlerConfig: manifest.JobFailureHandlerConfig{
+						Timeout: aws.String("1h"),
+					},
+				},
+			},
+			wantedOpts: &template.WorkflowOpts{
+				Steps: []template.WorkflowStepOpts{
+					{
+						Name:      "customers",
+						DependsOn: []string{"extract"},
+						StateMachineOpts: template.StateMachineOpts{
+							Retries: aws.Int(3),
+						},
+					},
+					{Name: "extract"},
+					{
+						Name:      "load",
+						DependsOn: []string{"customers", "extract", "orders"},
+						StateMachineOpts: template.StateMachineOpts{
+							Timeout: aws.Int(3600),
+						},
+					},
+					{
+						Name:      "orders",
+						DependsOn: []string{"extract"},
+					},
+				},
+			},
+		},
+		"error if a step has an invalid timeout": {
+			inWorkflow: manifest.JobWorkflow{
+				"extract": {
+					JobFailureHandlerConfig: manifest.JobFailureHandlerConfig{
+						Timeout: aws.String("500ms"),
+					},
+				},
+			},
+			wantedError: errors.New("convert retry/timeout config for step extract: timeout must be greater than or equal to 1 second"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			job := &ScheduledJob{
+				manifest: &manifest.ScheduledJob{
+					ScheduledJobConfig: manifest.ScheduledJobConfig{
+						Workflow: tc.inWorkflow,
+					},
+				},
+			}
+
+			// WHEN
+			opts, err := job.workflowOpts()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOpts, opts)
+		})
+	}
+}
+
 func TestScheduledJob_Parameters(t *testing.T) {
 	baseProps := &manifest.ScheduledJobProps{
 		WorkloadProps: &manifest.WorkloadProps{
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/crc32"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -351,6 +353,117 @@ func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandO
 	return &template.ExecuteCommandOpts{}
 }
 
+// convertServiceConnect returns the Service Connect configuration of a service.
+// If canExposePort is false, or the service is client only, other services can't reach it with Service Connect.
+func convertServiceConnect(c manifest.ServiceConnectConfigOrBool, svcName string, canExposePort bool) *template.ServiceConnectOpts {
+	if !c.IsEnabled() {
+		return nil
+	}
+	opts := &template.ServiceConnectOpts{}
+	if !canExposePort || aws.BoolValue(c.ClientOnly) {
+		return opts
+	}
+	opts.Server = &template.ServiceConnectServerOpts{
+		Alias:    svcName,
+		PortName: svcName,
+	}
+	if c.Alias != nil {
+		opts.Server.Alias = aws.StringValue(c.Alias)
+	}
+	if c.PortName != nil {
+		opts.Server.PortName = aws.StringValue(c.PortName)
+	}
+	return opts
+}
+
+func convertImportedEnvAddons(in manifest.EnvAddonsImports) *template.ImportedEnvAddonsOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	return &template.ImportedEnvAddonsOpts{
+		Variables: in.Variables,
+		Secrets:   in.Secrets,
+		Policies:  in.Policies,
+	}
+}
+
+// convertPermissions returns the IAM permissions of the task role.
+// The resources are rendered with Fn::Sub, so user-provided values are escaped and grants use pseudo parameters.
+func convertPermissions(in manifest.Permissions) *template.PermissionsOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	opts := &template.PermissionsOpts{
+		ManagedPolicies: in.ManagedPolicies,
+	}
+	for _, stmt := range in.Statements {
+		effect := "Allow"
+		if stmt.Effect != nil {
+			effect = aws.StringValue(stmt.Effect)
+		}
+		resources := make([]string, len(stmt.Resources))
+		for i, resource := range stmt.Resources {
+			resources[i] = escapeSub(resource)
+		}
+		opts.Statements = append(opts.Statements, template.PolicyStatementOpts{
+			Effect:    effect,
+			Actions:   stmt.Actions,
+			Resources: resources,
+		})
+	}
+	if len(in.Grants.S3Read) > 0 {
+		var resources []string
+		for _, bucket := range in.Grants.S3Read {
+			resources = append(resources,
+				fmt.Sprintf("arn:${AWS::Partition}:s3:::%s", bucket),
+				fmt.Sprintf("arn:${AWS::Partition}:s3:::%s/*", bucket))
+		}
+		opts.Statements = append(opts.Statements, template.PolicyStatementOpts{
+			Effect:    "Allow",
+			Actions:   []string{"s3:GetObject", "s3:ListBucket"},
+			Resources: resources,
+		})
+	}
+	if len(in.Grants.SNSPublish) > 0 {
+		var resources []string
+		for _, topic := range in.Grants.SNSPublish {
+			if arn.IsARN(topic) {
+				resources = append(resources, escapeSub(topic))
+				continue
+			}
+			resources = append(resources, fmt.Sprintf("arn:${AWS::Partition}:sns:${AWS::Region}:${AWS::AccountId}:%s", topic))
+		}
+		opts.Statements = append(opts.Statements, template.PolicyStatementOpts{
+			Effect:    "Allow",
+			Actions:   []string{"sns:Publish"},
+			Resources: resources,
+		})
+	}
+	if len(in.Grants.SecretsRead) > 0 {
+		var params, secrets []string
+		for _, prefix := range in.Grants.SecretsRead {
+			// The ARN of the parameter "/myapp/token" is "arn:aws:ssm:region:account:parameter/myapp/token".
+			params = append(params, fmt.Sprintf("arn:${AWS::Partition}:ssm:${AWS::Region}:${AWS::AccountId}:parameter/%s*", strings.TrimPrefix(prefix, "/")))
+			secrets = append(secrets, fmt.Sprintf("arn:${AWS::Partition}:secretsmanager:${AWS::Region}:${AWS::AccountId}:secret:%s*", prefix))
+		}
+		opts.Statements = append(opts.Statements, template.PolicyStatementOpts{
+			Effect:    "Allow",
+			Actions:   []string{"ssm:GetParameter", "ssm:GetParameters", "ssm:GetParametersByPath"},
+			Resources: params,
+		}, template.PolicyStatementOpts{
+			Effect:    "Allow",
+			Actions:   []string{"secretsmanager:GetSecretValue"},
+			Resources: secrets,
+		})
+	}
+	return opts
+}
+
+// escapeSub escapes the variables in s so that Fn::Sub renders them literally.
+func escapeSub(s string) string {
+	return strings.ReplaceAll(s, "${", "${!")
+}
+
 func convertLogging(lc manifest.Logging) *template.LogConfigOpts {
 	if lc.IsEmpty() {
 		return nil
@@ -378,6 +491,19 @@ func convertTaskDefOverrideRules(inRules []manifest.OverrideRule) []override.Rul
 	return res
 }
 
+func convertPatches(in []manifest.PatchOperation) []override.Patch {
+	var res []override.Patch
+	for _, p := range in {
+		res = append(res, override.Patch{
+			Operation: p.Operation,
+			Path:      p.Path,
+			From:      p.From,
+			Value:     p.Value,
+		})
+	}
+	return res
+}
+
 // convertStorageOpts converts a manifest Storage field into template data structures which can be used
 // to execute CFN templates
 func convertStorageOpts(wlName *string, in manifest.Storage) *template.StorageOpts {
@@ -796,6 +922,96 @@ func convertHTTPVersion(protocolVersion *string) *string {
 	return &pv
 }
 
+// convertAdditionalRoutingRules converts the additional routing rules of a service into listener rules.
+// Header and query string conditions are sorted so that the rendered template is deterministic.
+func convertAdditionalRoutingRules(rules []manifest.AdditionalRoutingRule) []template.ListenerRuleOpts {
+	if len(rules) == 0 {
+		return nil
+	}
+	opts := make([]template.ListenerRuleOpts, len(rules))
+	for i, rule := range rules {
+		opts[i] = template.ListenerRuleOpts{
+			Priority: rule.Priority,
+			Hosts:    rule.Hosts,
+			Paths:    rule.Paths,
+		}
+		names := make([]string, 0, len(rule.Headers))
+		for name := range rule.Headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			opts[i].Headers = append(opts[i].Headers, template.HTTPHeaderConditionOpts{
+				Name:   name,
+				Values: rule.Headers[name],
+			})
+		}
+		keys := make([]string, 0, len(rule.QueryStrings))
+		for key := range rule.QueryStrings {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			opts[i].QueryStrings = append(opts[i].QueryStrings, template.QueryStringConditionOpts{
+				Key:   key,
+				Value: rule.QueryStrings[key],
+			})
+		}
+		for _, ipNet := range rule.AllowedSourceIps {
+			opts[i].AllowedSourceIps = append(opts[i].AllowedSourceIps, string(ipNet))
+		}
+		if rule.FixedResponse != nil {
+			opts[i].FixedResponse = &template.FixedResponseOpts{
+				StatusCode:  strconv.Itoa(aws.IntValue(rule.FixedResponse.StatusCode)),
+				ContentType: aws.StringValue(rule.FixedResponse.ContentType),
+				Body:        aws.StringValue(rule.FixedResponse.Body),
+			}
+		}
+		if rule.Redirect != nil {
+			statusCode := 301
+			if rule.Redirect.StatusCode != nil {
+				statusCode = aws.IntValue(rule.Redirect.StatusCode)
+			}
+			opts[i].Redirect = &template.RedirectOpts{
+				Protocol:   aws.StringValue(rule.Redirect.Protocol),
+				Host:       aws.StringValue(rule.Redirect.Host),
+				Port:       aws.StringValue(rule.Redirect.Port),
+				Path:       aws.StringValue(rule.Redirect.Path),
+				Query:      aws.StringValue(rule.Redirect.Query),
+				StatusCode: fmt.Sprintf("HTTP_%d", statusCode),
+			}
+		}
+	}
+	return opts
+}
+
+func convertDeploymentConfig(d manifest.DeploymentConfig) template.DeploymentConfigurationOpts {
+	opts := template.DeploymentConfigurationOpts{
+		RollbackAlarms: d.RollbackAlarms,
+	}
+	switch aws.StringValue(d.Strategy) {
+	case manifest.DeploymentStrategyBlueGreen:
+		opts.Strategy = template.DeploymentStrategyBlueGreen
+	case manifest.DeploymentStrategyCanary:
+		opts.Strategy = template.DeploymentStrategyCanary
+	case manifest.DeploymentStrategyLinear:
+		opts.Strategy = template.DeploymentStrategyLinear
+	}
+	if opts.ShiftsTraffic() {
+		opts.StepPercent = d.Percent
+		opts.StepBakeTimeInMinutes = convertMinutes(d.Interval)
+		opts.BakeTimeInMinutes = convertMinutes(d.BakeTime)
+	}
+	return opts
+}
+
+func convertMinutes(t *time.Duration) *int {
+	if t == nil {
+		return nil
+	}
+	return aws.Int(int(t.Minutes()))
+}
+
 func convertSecrets(secrets map[string]manifest.Secret) map[string]template.Secret {
 	if len(secrets) == 0 {
 		return nil
@@ -803,8 +1019,14 @@ func convertSecrets(secrets map[string]manifest.Secret) map[string]template.Secr
 	m := make(map[string]template.Secret)
 	for name, mftSecret := range secrets {
 		var tplSecret template.Secret = template.SecretFromSSMOrARN(mftSecret.Value())
+		if version := mftSecret.Version(); version != nil {
+			tplSecret = template.SecretFromSSMOrARN(fmt.Sprintf("%s:%d", mftSecret.Value(), aws.IntValue(version)))
+		}
 		if mftSecret.IsSecretsManagerName() {
 			tplSecret = template.SecretFromSecretsManager(mftSecret.Value())
+			if key := mftSecret.JSONKey(); key != "" {
+				tplSecret = template.SecretFromSecretsManagerJSONKey(mftSecret.Value(), key)
+			}
 		}
 		m[name] = tplSecret
 	}
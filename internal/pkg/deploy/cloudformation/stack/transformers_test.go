@@ -1163,6 +1163,164 @@ func Test_convertExecuteCommand(t *testing.T) {
 	}
 }
 
+func Test_convertServiceConnect(t *testing.T) {
+	testCases := map[string]struct {
+		inConfig        manifest.ServiceConnectConfigOrBool
+		inCanExposePort bool
+
+		wanted *template.ServiceConnectOpts
+	}{
+		"without service connect": {
+			inConfig:        manifest.ServiceConnectConfigOrBool{},
+			inCanExposePort: true,
+			wanted:          nil,
+		},
+		"service connect disabled": {
+			inConfig: manifest.ServiceConnectConfigOrBool{
+				Enabled: aws.Bool(false),
+			},
+			inCanExposePort: true,
+			wanted:          nil,
+		},
+		"defaults to the service name": {
+			inConfig: manifest.ServiceConnectConfigOrBool{
+				Enabled: aws.Bool(true),
+			},
+			inCanExposePort: true,
+			wanted: &template.ServiceConnectOpts{
+				Server: &template.ServiceConnectServerOpts{
+					Alias:    "api",
+					PortName: "api",
+				},
+			},
+		},
+		"with alias and port name": {
+			inConfig: manifest.ServiceConnectConfigOrBool{
+				ServiceConnectConfiguration: manifest.ServiceConnectConfiguration{
+					Alias:    aws.String("users"),
+					PortName: aws.String("http"),
+				},
+			},
+			inCanExposePort: true,
+			wanted: &template.ServiceConnectOpts{
+				Server: &template.ServiceConnectServerOpts{
+					Alias:    "users",
+					PortName: "http",
+				},
+			},
+		},
+		"client only": {
+			inConfig: manifest.ServiceConnectConfigOrBool{
+				ServiceConnectConfiguration: manifest.ServiceConnectConfiguration{
+					ClientOnly: aws.Bool(true),
+				},
+			},
+			inCanExposePort: true,
+			wanted:          &template.ServiceConnectOpts{},
+		},
+		"client only if the service has no port": {
+			inConfig: manifest.ServiceConnectConfigOrBool{
+				Enabled: aws.Bool(true),
+			},
+			wanted: &template.ServiceConnectOpts{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertServiceConnect(tc.inConfig, "api", tc.inCanExposePort)
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertPermissions(t *testing.T) {
+	testCases := map[string]struct {
+		in manifest.Permissions
+
+		wanted *template.PermissionsOpts
+	}{
+		"without permissions": {
+			in:     manifest.Permissions{},
+			wanted: nil,
+		},
+		"statements default to Allow and escape variables": {
+			in: manifest.Permissions{
+				ManagedPolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+				Statements: []manifest.PolicyStatement{
+					{
+						Actions:   []string{"dynamodb:GetItem"},
+						Resources: []string{"arn:aws:dynamodb:us-west-2:123456789012:table/orders"},
+					},
+					{
+						Effect:    aws.String("Deny"),
+						Actions:   []string{"s3:DeleteObject"},
+						Resources: []string{"arn:aws:s3:::assets/${aws:username}/*"},
+					},
+				},
+			},
+			wanted: &template.PermissionsOpts{
+				ManagedPolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+				Statements: []template.PolicyStatementOpts{
+					{
+						Effect:    "Allow",
+						Actions:   []string{"dynamodb:GetItem"},
+						Resources: []string{"arn:aws:dynamodb:us-west-2:123456789012:table/orders"},
+					},
+					{
+						Effect:    "Deny",
+						Actions:   []string{"s3:DeleteObject"},
+						Resources: []string{"arn:aws:s3:::assets/${!aws:username}/*"},
+					},
+				},
+			},
+		},
+		"grants are expanded into statements": {
+			in: manifest.Permissions{
+				Grants: manifest.PermissionGrants{
+					S3Read:      []string{"assets"},
+					SNSPublish:  []string{"orders", "arn:aws:sns:us-west-2:123456789012:payments"},
+					SecretsRead: []string{"/myapp/prod/"},
+				},
+			},
+			wanted: &template.PermissionsOpts{
+				Statements: []template.PolicyStatementOpts{
+					{
+						Effect:    "Allow",
+						Actions:   []string{"s3:GetObject", "s3:ListBucket"},
+						Resources: []string{"arn:${AWS::Partition}:s3:::assets", "arn:${AWS::Partition}:s3:::assets/*"},
+					},
+					{
+						Effect:  "Allow",
+						Actions: []string{"sns:Publish"},
+						Resources: []string{
+							"arn:${AWS::Partition}:sns:${AWS::Region}:${AWS::AccountId}:orders",
+							"arn:aws:sns:us-west-2:123456789012:payments",
+						},
+					},
+					{
+						Effect:    "Allow",
+						Actions:   []string{"ssm:GetParameter", "ssm:GetParameters", "ssm:GetParametersByPath"},
+						Resources: []string{"arn:${AWS::Partition}:ssm:${AWS::Region}:${AWS::AccountId}:parameter/myapp/prod/*"},
+					},
+					{
+						Effect:    "Allow",
+						Actions:   []string{"secretsmanager:GetSecretValue"},
+						Resources: []string{"arn:${AWS::Partition}:secretsmanager:${AWS::Region}:${AWS::AccountId}:secret:/myapp/prod/*"},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertPermissions(tc.in)
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func Test_convertSidecarMountPoints(t *testing.T) {
 	testCases := map[string]struct {
 		inMountPoints  []manifest.SidecarMountPoint
@@ -1462,3 +1620,170 @@ func Test_convertHTTPVersion(t *testing.T) {
 		})
 	}
 }
+
+func Test_convertAdditionalRoutingRules(t *testing.T) {
+	testCases := map[string]struct {
+		in     []manifest.AdditionalRoutingRule
+		wanted []template.ListenerRuleOpts
+	}{
+		"should return nil if there are no additional rules": {},
+		"should sort conditions and convert actions": {
+			in: []manifest.AdditionalRoutingRule{
+				{
+					Priority: aws.Int(5),
+					Hosts:    []string{"beta.example.com"},
+					Headers: map[string][]string{
+						"X-Env":    {"beta"},
+						"X-Canary": {"true", "1"},
+					},
+					QueryStrings: map[string]string{
+						"version": "2",
+						"debug":   "true",
+					},
+					AllowedSourceIps: []manifest.IPNet{"10.0.0.0/16"},
+				},
+				{
+					Paths: []string{"/maintenance"},
+					FixedResponse: &manifest.FixedResponse{
+						StatusCode: aws.Int(503),
+						Body:       aws.String("down for maintenance"),
+					},
+				},
+				{
+					Hosts: []string{"old.example.com"},
+					Redirect: &manifest.RedirectAction{
+						Host: aws.String("new.example.com"),
+					},
+				},
+			},
+			wanted: []template.ListenerRuleOpts{
+				{
+					Priority: aws.Int(5),
+					Hosts:    []string{"beta.example.com"},
+					Headers: []template.HTTPHeaderConditionOpts{
+						{Name: "X-Canary", Values: []string{"true", "1"}},
+						{Name: "X-Env", Values: []string{"beta"}},
+					},
+					QueryStrings: []template.QueryStringConditionOpts{
+						{Key: "debug", Value: "true"},
+						{Key: "version", Value: "2"},
+					},
+					AllowedSourceIps: []string{"10.0.0.0/16"},
+				},
+				{
+					Paths: []string{"/maintenance"},
+					FixedResponse: &template.FixedResponseOpts{
+						StatusCode: "503",
+						Body:       "down for maintenance",
+					},
+				},
+				{
+					Hosts: []string{"old.example.com"},
+					Redirect: &template.RedirectOpts{
+						Host:       "new.example.com",
+						StatusCode: "HTTP_301",
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertAdditionalRoutingRules(tc.in))
+		})
+	}
+}
+
+func Test_convertDeploymentConfig(t *testing.T) {
+	fiveMinutes := 5 * time.Minute
+	oneHour := time.Hour
+	testCases := map[string]struct {
+		in     manifest.DeploymentConfig
+		wanted template.DeploymentConfigurationOpts
+	}{
+		"should return a rolling update by default": {},
+		"should keep the rollback alarms of a rolling update": {
+			in: manifest.DeploymentConfig{
+				Strategy:       aws.String(manifest.DeploymentStrategyRolling),
+				RollbackAlarms: []string{"5xx"},
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				RollbackAlarms: []string{"5xx"},
+			},
+		},
+		"should convert a blue-green deployment": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String(manifest.DeploymentStrategyBlueGreen),
+				BakeTime: &oneHour,
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				Strategy:          template.DeploymentStrategyBlueGreen,
+				BakeTimeInMinutes: aws.Int(60),
+			},
+		},
+		"should convert a canary deployment": {
+			in: manifest.DeploymentConfig{
+				Strategy: aws.String(manifest.DeploymentStrategyCanary),
+				Percent:  aws.Int(10),
+				Interval: &fiveMinutes,
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				Strategy:              template.DeploymentStrategyCanary,
+				StepPercent:           aws.Int(10),
+				StepBakeTimeInMinutes: aws.Int(5),
+			},
+		},
+		"should convert a linear deployment": {
+			in: manifest.DeploymentConfig{
+				Strategy:       aws.String(manifest.DeploymentStrategyLinear),
+				Percent:        aws.Int(25),
+				RollbackAlarms: []string{"latency"},
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				Strategy:       template.DeploymentStrategyLinear,
+				StepPercent:    aws.Int(25),
+				RollbackAlarms: []string{"latency"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertDeploymentConfig(tc.in))
+		})
+	}
+}
+
+func Test_convertSecrets(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted map[string]template.Secret
+	}{
+		"should return nil if there are no secrets": {},
+		"should convert SSM parameters and SecretsManager names": {
+			in: `
+GITHUB_TOKEN: /github/token
+PINNED_TOKEN:
+  from: /github/token
+  version: 3
+DB:
+  secretsmanager: mysql
+DB_USER:
+  secretsmanager: mysql
+  key: username`,
+			wanted: map[string]template.Secret{
+				"GITHUB_TOKEN": template.SecretFromSSMOrARN("/github/token"),
+				"PINNED_TOKEN": template.SecretFromSSMOrARN("/github/token:3"),
+				"DB":           template.SecretFromSecretsManager("mysql"),
+				"DB_USER":      template.SecretFromSecretsManagerJSONKey("mysql", "username"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var secrets map[string]manifest.Secret
+			require.NoError(t, yaml.Unmarshal([]byte(tc.in), &secrets))
+
+			require.Equal(t, tc.wanted, convertSecrets(secrets))
+		})
+	}
+}
@@ -121,11 +121,14 @@ func (s *WorkerService) Template() (string, error) {
 		Secrets:                        convertSecrets(s.manifest.WorkerServiceConfig.Secrets),
 		NestedStack:                    addonsOutputs,
 		AddonsExtraParams:              addonsParams,
+		ImportedEnvAddons:              convertImportedEnvAddons(s.manifest.WorkerServiceConfig.EnvAddons),
+		Permissions:                    convertPermissions(s.manifest.WorkerServiceConfig.Permissions),
 		Sidecars:                       sidecars,
 		Autoscaling:                    autoscaling,
 		CapacityProviders:              capacityProviders,
 		DesiredCountOnSpot:             desiredCountOnSpot,
 		ExecuteCommand:                 convertExecuteCommand(&s.manifest.ExecuteCommand),
+		ServiceConnect:                 convertServiceConnect(s.manifest.Network.Connect, aws.StringValue(s.manifest.Name), false),
 		WorkloadType:                   manifest.WorkerServiceType,
 		HealthCheck:                    convertContainerHealthCheck(s.manifest.WorkerServiceConfig.ImageConfig.HealthCheck),
 		LogConfig:                      convertLogging(s.manifest.Logging),
@@ -151,7 +154,11 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("apply task definition overrides: %w", err)
 	}
-	return string(overridenTpl), nil
+	patchedTpl, err := override.PatchTemplate(convertPatches(s.manifest.Patches), overridenTpl)
+	if err != nil {
+		return "", fmt.Errorf("apply patches: %w", err)
+	}
+	return string(patchedTpl), nil
 }
 
 // Parameters returns the list of CloudFormation parameters used by the template.
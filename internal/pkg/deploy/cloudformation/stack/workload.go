@@ -170,11 +170,16 @@ type templateConfigurer interface {
 }
 
 func (w *wkld) templateConfiguration(tc templateConfigurer) (string, error) {
+	return serializeTemplateConfig(w.parser, tc)
+}
+
+// serializeTemplateConfig returns the parameters and tags of the stack in the template configuration file format.
+func serializeTemplateConfig(parser template.Parser, tc templateConfigurer) (string, error) {
 	params, err := tc.Parameters()
 	if err != nil {
 		return "", err
 	}
-	doc, err := w.parser.Parse(wkldParamsTemplatePath, struct {
+	doc, err := parser.Parse(wkldParamsTemplatePath, struct {
 		Parameters []*cloudformation.Parameter
 		Tags       []*cloudformation.Tag
 	}{
@@ -5,9 +5,12 @@ package cloudformation
 
 import (
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -33,6 +36,95 @@ func (cf CloudFormation) DeployService(out progress.FileWriter, conf StackConfig
 	return cf.renderStackChanges(cf.newRenderWorkloadInput(out, stack))
 }
 
+// StackPreview holds the deployed and the proposed configuration of a stack, and the changes
+// that CloudFormation would make to the stack's resources to go from one to the other.
+type StackPreview struct {
+	DeployedTemplate   string // Empty if the stack does not exist yet.
+	DeployedParameters map[string]string
+	Template           string
+	Parameters         map[string]string
+	Changes            []*sdkcloudformation.Change
+}
+
+// PreviewService returns what deploying the service stack would change without updating the stack.
+// If the service stack doesn't exist yet, then the preview has no deployed configuration nor resource changes.
+func (cf CloudFormation) PreviewService(conf StackConfiguration, bucketName string, opts ...cloudformation.StackOption) (*StackPreview, error) {
+	descr, err := cf.cfnClient.Describe(conf.StackName())
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if !errors.As(err, &errNotFound) {
+			return nil, err
+		}
+		return previewNewStack(conf, opts...)
+	}
+	deployedTpl, err := cf.cfnClient.TemplateBody(conf.StackName())
+	if err != nil {
+		return nil, fmt.Errorf("get template of stack %s: %w", conf.StackName(), err)
+	}
+	deployedParams := make(map[string]string)
+	for _, param := range descr.Parameters {
+		deployedParams[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+
+	templateURL, err := cf.pushWorkloadTemplateToS3Bucket(bucketName, conf)
+	if err != nil {
+		return nil, err
+	}
+	stack, err := toStackFromS3(conf, templateURL)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(stack)
+	}
+	changes, err := cf.cfnClient.PreviewUpdate(stack)
+	if err != nil {
+		return nil, fmt.Errorf("preview changes to stack %s: %w", conf.StackName(), err)
+	}
+	tpl, err := conf.Template()
+	if err != nil {
+		return nil, fmt.Errorf("generate template: %w", err)
+	}
+	params := make(map[string]string)
+	for _, param := range stack.Parameters {
+		key := aws.StringValue(param.ParameterKey)
+		if aws.BoolValue(param.UsePreviousValue) {
+			params[key] = deployedParams[key]
+			continue
+		}
+		params[key] = aws.StringValue(param.ParameterValue)
+	}
+	return &StackPreview{
+		DeployedTemplate:   deployedTpl,
+		DeployedParameters: deployedParams,
+		Template:           tpl,
+		Parameters:         params,
+		Changes:            changes.Changes,
+	}, nil
+}
+
+func previewNewStack(conf StackConfiguration, opts ...cloudformation.StackOption) (*StackPreview, error) {
+	tpl, err := conf.Template()
+	if err != nil {
+		return nil, fmt.Errorf("generate template: %w", err)
+	}
+	stack := cloudformation.NewStack(conf.StackName(), tpl)
+	if stack.Parameters, err = conf.Parameters(); err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(stack)
+	}
+	params := make(map[string]string)
+	for _, param := range stack.Parameters {
+		params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	return &StackPreview{
+		Template:   tpl,
+		Parameters: params,
+	}, nil
+}
+
 func (cf CloudFormation) pushWorkloadTemplateToS3Bucket(bucket string, config StackConfiguration) (string, error) {
 	template, err := config.Template()
 	if err != nil {
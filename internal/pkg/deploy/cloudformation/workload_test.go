@@ -4,10 +4,12 @@
 package cloudformation
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -104,6 +106,105 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	})
 }
 
+func TestCloudFormation_PreviewService(t *testing.T) {
+	serviceConfig := &mockStackConfig{
+		name:     "myapp-myenv-mysvc",
+		template: "template",
+		parameters: map[string]string{
+			"ContainerImage": "",
+		},
+	}
+	mockChange := &sdkcloudformation.Change{
+		ResourceChange: &sdkcloudformation.ResourceChange{
+			Action:            aws.String(sdkcloudformation.ChangeActionModify),
+			LogicalResourceId: aws.String("HTTPListenerRule"),
+			Replacement:       aws.String(sdkcloudformation.ReplacementTrue),
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(cfn *mocks.MockcfnClient, s3 *mocks.Mocks3Client)
+
+		wanted    *StackPreview
+		wantedErr error
+	}{
+		"return only the proposed configuration if the stack does not exist": {
+			setupMocks: func(cfn *mocks.MockcfnClient, s3 *mocks.Mocks3Client) {
+				cfn.EXPECT().Describe("myapp-myenv-mysvc").Return(nil, &cloudformation.ErrStackNotFound{})
+				s3.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wanted: &StackPreview{
+				Template: "template",
+				Parameters: map[string]string{
+					"ContainerImage": "",
+				},
+			},
+		},
+		"wrap error if the change set cannot be previewed": {
+			setupMocks: func(cfn *mocks.MockcfnClient, s3 *mocks.Mocks3Client) {
+				cfn.EXPECT().Describe("myapp-myenv-mysvc").Return(&cloudformation.StackDescription{}, nil)
+				cfn.EXPECT().TemplateBody("myapp-myenv-mysvc").Return("deployed", nil)
+				s3.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockURL", nil)
+				cfn.EXPECT().PreviewUpdate(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("preview changes to stack myapp-myenv-mysvc: some error"),
+		},
+		"reuse the deployed parameter values that are not known": {
+			setupMocks: func(cfn *mocks.MockcfnClient, s3 *mocks.Mocks3Client) {
+				cfn.EXPECT().Describe("myapp-myenv-mysvc").Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("ContainerImage"),
+							ParameterValue: aws.String("nginx"),
+						},
+					},
+				}, nil)
+				cfn.EXPECT().TemplateBody("myapp-myenv-mysvc").Return("deployed", nil)
+				s3.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockURL", nil)
+				cfn.EXPECT().PreviewUpdate(gomock.Any()).DoAndReturn(func(stack *cloudformation.Stack) (*cloudformation.ChangeSetDescription, error) {
+					require.Equal(t, "mockURL", stack.TemplateURL)
+					require.True(t, aws.BoolValue(stack.Parameters[0].UsePreviousValue))
+					return &cloudformation.ChangeSetDescription{
+						Changes: []*sdkcloudformation.Change{mockChange},
+					}, nil
+				})
+			},
+			wanted: &StackPreview{
+				DeployedTemplate: "deployed",
+				DeployedParameters: map[string]string{
+					"ContainerImage": "nginx",
+				},
+				Template: "template",
+				Parameters: map[string]string{
+					"ContainerImage": "nginx",
+				},
+				Changes: []*sdkcloudformation.Change{mockChange},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mCFN, mS3 := mocks.NewMockcfnClient(ctrl), mocks.NewMocks3Client(ctrl)
+			tc.setupMocks(mCFN, mS3)
+			cf := CloudFormation{
+				cfnClient: mCFN,
+				s3Client:  mS3,
+			}
+
+			got, err := cf.PreviewService(serviceConfig, "mockBucket", cloudformation.WithPreviousParameterValues("ContainerImage"))
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_DeleteWorkload(t *testing.T) {
 	testCases := map[string]struct {
 		in         deploy.DeleteWorkloadInput
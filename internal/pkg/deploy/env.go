@@ -13,7 +13,9 @@ const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.8.0"
+	LatestEnvTemplateVersion = "v1.9.0"
+	// EnvAddonsCfnTemplateNameFormat is the file name of the addons template shared by the workloads in an environment.
+	EnvAddonsCfnTemplateNameFormat = "environments/%s.addons.stack.yml"
 )
 
 // CreateEnvironmentInput holds the fields required to deploy an environment.
@@ -31,6 +33,7 @@ type CreateEnvironmentInput struct {
 	ImportVPCConfig      *config.ImportVPC // Optional configuration if users have an existing VPC.
 	AdjustVPCConfig      *config.AdjustVPC // Optional configuration if users want to override default VPC configuration.
 	Telemetry            *config.Telemetry // Optional observability and monitoring configuration.
+	Addons               *config.EnvAddons // Optional addons nested stack shared by the workloads in the environment.
 
 	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
 }
@@ -36,6 +36,8 @@ var (
 	ccRepoExp = regexp.MustCompile(`(https:\/\/(?P<region>.+).console.aws.amazon.com\/codesuite\/codecommit\/repositories\/(?P<repo>.+)(\/browse))`)
 	// Ex: https://bitbucket.org/repoOwner/repoName
 	bbRepoExp = regexp.MustCompile(`(https:\/\/bitbucket.org\/)(?P<owner>.+)\/(?P<repo>.+)`)
+	// Ex: https://gitlab.com/group/subgroup/repoName
+	glRepoExp = regexp.MustCompile(`(https:\/\/gitlab.com\/)(?P<owner>.+)\/(?P<repo>[^\/]+)`)
 )
 
 // CreatePipelineInput represents the fields required to deploy a pipeline.
@@ -134,6 +136,28 @@ type BitbucketSource struct {
 	OutputArtifactFormat string
 }
 
+// GitLabSource defines the (GL) source of the artifacts to be built and deployed.
+type GitLabSource struct {
+	ProviderName         string
+	Branch               string
+	RepositoryURL        string
+	ConnectionARN        string
+	OutputArtifactFormat string
+}
+
+// S3Source defines a source of the artifacts that are cloned from any Git repository, packaged,
+// and uploaded to an S3 object whenever the repository triggers the pipeline's webhook.
+type S3Source struct {
+	ProviderName  string
+	Branch        string
+	RepositoryURL string
+	// Name of the Secrets Manager secret with the "username:token" credentials to clone the repository, if any.
+	CredentialsSecretID string
+	// Location of the packaged source code. If empty, the pipeline's artifact bucket is used.
+	Bucket    string
+	ObjectKey string
+}
+
 func convertRequiredProperty(properties map[string]interface{}, key string) (string, error) {
 	v, ok := properties[key]
 	if !ok {
@@ -232,6 +256,41 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 		}
 		repo.ConnectionARN = connection.(string)
 		return repo, false, nil
+	case manifest.GitLabProviderName:
+		// If an existing CSC connection is being used, don't prompt to update connection from 'PENDING' to 'AVAILABLE'.
+		connection, ok := mfSource.Properties["connection_arn"]
+		repo := &GitLabSource{
+			ProviderName:         manifest.GitLabProviderName,
+			Branch:               branch,
+			RepositoryURL:        repository,
+			OutputArtifactFormat: outputFormat,
+		}
+		if !ok {
+			return repo, true, nil
+		}
+		repo.ConnectionARN = connection.(string)
+		return repo, false, nil
+	case manifest.S3ProviderName:
+		secret, err := convertOptionalProperty(mfSource.Properties, "credentials_secret", "")
+		if err != nil {
+			return nil, false, err
+		}
+		bucket, err := convertOptionalProperty(mfSource.Properties, "bucket", "")
+		if err != nil {
+			return nil, false, err
+		}
+		key, err := convertOptionalProperty(mfSource.Properties, "key", "")
+		if err != nil {
+			return nil, false, err
+		}
+		return &S3Source{
+			ProviderName:        manifest.S3ProviderName,
+			Branch:              branch,
+			RepositoryURL:       repository,
+			CredentialsSecretID: secret,
+			Bucket:              bucket,
+			ObjectKey:           key,
+		}, false, nil
 	default:
 		return nil, false, fmt.Errorf("invalid repo source provider: %s", mfSource.ProviderName)
 	}
@@ -253,6 +312,39 @@ func PipelineBuildFromManifest(mfBuild *manifest.Build) (build *Build) {
 	}
 }
 
+// PipelineActionsFromManifest processes manifest info about the actions to run before or after the deployments of a stage.
+func PipelineActionsFromManifest(mfActions []manifest.PipelineAction) []PipelineAction {
+	if len(mfActions) == 0 {
+		return nil
+	}
+	actions := make([]PipelineAction, len(mfActions))
+	for i, mfAction := range mfActions {
+		action := PipelineAction{
+			Name:    mfAction.Name,
+			JobName: mfAction.Job,
+		}
+		if mfAction.Build != nil {
+			build := PipelineBuildFromManifest(&manifest.Build{
+				Image: mfAction.Build.Image,
+			})
+			action.Build = &ActionBuild{
+				Image:           build.Image,
+				EnvironmentType: build.EnvironmentType,
+				Buildspec:       mfAction.Build.Buildspec,
+				Commands:        mfAction.Build.Commands,
+			}
+		}
+		if mfAction.Approval != nil {
+			action.Approval = &ActionApproval{
+				TopicARN: mfAction.Approval.Topic,
+				Message:  mfAction.Approval.Message,
+			}
+		}
+		actions[i] = action
+	}
+	return actions
+}
+
 // GitHubPersonalAccessTokenSecretID returns the ID of the secret in the
 // Secrets manager, which stores the GitHub Personal Access token if the
 // provider is "GitHubV1".
@@ -273,6 +365,11 @@ func (s *GitHubSource) Connection() string {
 	return s.ConnectionARN
 }
 
+// Connection returns the ARN correlated with a ConnectionName in the pipeline manifest.
+func (s *GitLabSource) Connection() string {
+	return s.ConnectionARN
+}
+
 // parse parses the owner and repo name from the GH repo URL, which was formatted and assigned in cli/pipeline_init.go.
 func (url GitHubURL) parse() (owner, repo string, err error) {
 	if url == "" {
@@ -334,6 +431,27 @@ func (s *BitbucketSource) parseOwnerAndRepo() (owner, repo string, err error) {
 	return matches["owner"], matches["repo"], nil
 }
 
+// parseOwnerAndRepo parses the owner and repo name from the GL repo URL, which was formatted and assigned in cli/pipeline_init.go.
+// The owner includes the subgroups of the repository, if any.
+func (s *GitLabSource) parseOwnerAndRepo() (owner, repo string, err error) {
+	if s.RepositoryURL == "" {
+		return "", "", fmt.Errorf("unable to locate the repository")
+	}
+
+	match := glRepoExp.FindStringSubmatch(s.RepositoryURL)
+	if len(match) == 0 {
+		return "", "", fmt.Errorf(fmtInvalidRepo, s.RepositoryURL)
+	}
+
+	matches := make(map[string]string)
+	for i, name := range glRepoExp.SubexpNames() {
+		if i != 0 && name != "" {
+			matches[name] = match[i]
+		}
+	}
+	return matches["owner"], matches["repo"], nil
+}
+
 // ConnectionName generates a string of maximum length 32 to be used as a CodeStar Connections ConnectionName.
 // If there is a duplicate ConnectionName generated by CFN, the previous one is replaced. (Duplicate names
 // generated by the aws cli don't have to be unique for some reason.)
@@ -353,6 +471,16 @@ func (s *BitbucketSource) ConnectionName() (string, error) {
 	return formatConnectionName(owner, repo), nil
 }
 
+// ConnectionName generates a recognizable string by which the connection may be identified.
+func (s *GitLabSource) ConnectionName() (string, error) {
+	owner, repo, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", fmt.Errorf("parse owner and repo to generate connection name: %w", err)
+	}
+	// Only keep the top-level group if the repository belongs to a subgroup.
+	return formatConnectionName(strings.Split(owner, "/")[0], repo), nil
+}
+
 // ConnectionName generates a recognizable string by which the connection may be identified.
 func (s *GitHubSource) ConnectionName() (string, error) {
 	owner, repo, err := s.RepositoryURL.parse()
@@ -392,6 +520,16 @@ func (s *BitbucketSource) Repository() (string, error) {
 	return fmt.Sprintf("%s/%s", owner, repo), nil
 }
 
+// Repository returns the repository portion. For CodeStar Connections,
+// this needs to be in the format "some-group/my-repo."
+func (s *GitLabSource) Repository() (string, error) {
+	owner, repo, err := s.parseOwnerAndRepo()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s", owner, repo), nil
+}
+
 // Repository returns the repository portion. For CodeStar Connections,
 // this needs to be in the format "some-user/my-repo."
 func (s *GitHubSource) Repository() (string, error) {
@@ -441,6 +579,71 @@ type PipelineStage struct {
 	LocalWorkloads   []string
 	RequiresApproval bool
 	TestCommands     []string
+	PreDeployments   []PipelineAction
+	PostDeployments  []PipelineAction
+}
+
+// DeploymentRunOrder returns the run order of the workload deployments of the stage.
+// The manual approval of the stage, if any, runs first and is followed by the pre-deployment actions.
+func (s *PipelineStage) DeploymentRunOrder() int {
+	return 2 + len(s.PreDeployments)
+}
+
+// TestCommandsRunOrder returns the run order of the test commands of the stage.
+func (s *PipelineStage) TestCommandsRunOrder() int {
+	return s.DeploymentRunOrder() + 1
+}
+
+// Actions returns the pre-deployment and post-deployment actions of the stage along with the order they run in.
+// Post-deployment actions run after the test commands of the stage.
+func (s *PipelineStage) Actions() []StageAction {
+	var actions []StageAction
+	for i, action := range s.PreDeployments {
+		actions = append(actions, StageAction{
+			PipelineAction: action,
+			RunOrder:       2 + i,
+		})
+	}
+	for i, action := range s.PostDeployments {
+		actions = append(actions, StageAction{
+			PipelineAction: action,
+			RunOrder:       s.TestCommandsRunOrder() + 1 + i,
+		})
+	}
+	return actions
+}
+
+// StageAction is an action of a pipeline stage along with the order it runs in.
+type StageAction struct {
+	PipelineAction
+	RunOrder int
+}
+
+// PipelineAction represents an action that runs before or after the workload deployments of a stage.
+// Exactly one of Build, Approval or JobName is set.
+type PipelineAction struct {
+	Name string
+
+	// Build runs commands with CodeBuild against the source code of the pipeline.
+	Build *ActionBuild
+	// Approval waits for the action to be manually approved.
+	Approval *ActionApproval
+	// JobName is the name of a scheduled job to run in the environment of the stage; the action waits for its completion.
+	JobName string
+}
+
+// ActionBuild represents a CodeBuild project that runs either a buildspec or commands.
+type ActionBuild struct {
+	Image           string
+	EnvironmentType string
+	Buildspec       string // Path to the buildspec in the source code.
+	Commands        []string
+}
+
+// ActionApproval represents a manual approval.
+type ActionApproval struct {
+	TopicARN string // SNS topic notified when the approval is pending.
+	Message  string
 }
 
 // WorkloadTemplatePath returns the full path to the workload CFN template
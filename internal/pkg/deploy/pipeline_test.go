@@ -161,6 +161,67 @@ func TestPipelineSourceFromManifest(t *testing.T) {
 			expectedShouldPrompt: false,
 			expectedErr:          nil,
 		},
+		"transforms GitLab source without existing connection": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitLabProviderName,
+				Properties: map[string]interface{}{
+					"repository": "https://gitlab.com/group/project",
+				},
+			},
+			expectedDeploySource: &GitLabSource{
+				ProviderName:  manifest.GitLabProviderName,
+				Branch:        "main",
+				RepositoryURL: "https://gitlab.com/group/project",
+			},
+			expectedShouldPrompt: true,
+		},
+		"transforms GitLab source with existing connection": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.GitLabProviderName,
+				Properties: map[string]interface{}{
+					"branch":         "test",
+					"repository":     "https://gitlab.com/group/project",
+					"connection_arn": "someConnectionARN",
+				},
+			},
+			expectedDeploySource: &GitLabSource{
+				ProviderName:  manifest.GitLabProviderName,
+				Branch:        "test",
+				RepositoryURL: "https://gitlab.com/group/project",
+				ConnectionARN: "someConnectionARN",
+			},
+			expectedShouldPrompt: false,
+		},
+		"transforms S3 source": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.S3ProviderName,
+				Properties: map[string]interface{}{
+					"repository":         "https://git.example.com/group/project.git",
+					"credentials_secret": "git-credentials",
+					"bucket":             "my-bucket",
+					"key":                "sources/project.zip",
+				},
+			},
+			expectedDeploySource: &S3Source{
+				ProviderName:        manifest.S3ProviderName,
+				Branch:              "main",
+				RepositoryURL:       "https://git.example.com/group/project.git",
+				CredentialsSecretID: "git-credentials",
+				Bucket:              "my-bucket",
+				ObjectKey:           "sources/project.zip",
+			},
+			expectedShouldPrompt: false,
+		},
+		"error out if the S3 bucket is not a string": {
+			mfSource: &manifest.Source{
+				ProviderName: manifest.S3ProviderName,
+				Properties: map[string]interface{}{
+					"repository": "https://git.example.com/group/project.git",
+					"bucket":     1,
+				},
+			},
+			expectedErr: errors.New("property `bucket` is not a string"),
+		},
 		"error out if repository is not configured": {
 			mfSource: &manifest.Source{
 				ProviderName: manifest.CodeCommitProviderName,
@@ -237,6 +298,93 @@ func TestPipelineBuildFromManifest(t *testing.T) {
 	}
 }
 
+func TestPipelineActionsFromManifest(t *testing.T) {
+	testCases := map[string]struct {
+		mfActions       []manifest.PipelineAction
+		expectedActions []PipelineAction
+	}{
+		"no actions": {},
+		"converts every kind of action": {
+			mfActions: []manifest.PipelineAction{
+				{
+					Name: "migrate",
+					Build: &manifest.ActionBuild{
+						Buildspec: "copilot/pipelines/migrate.yml",
+					},
+				},
+				{
+					Name: "smoke",
+					Build: &manifest.ActionBuild{
+						Image:    "aws/codebuild/amazonlinux2-aarch64-standard:2.0",
+						Commands: []string{"make smoke-test"},
+					},
+				},
+				{
+					Name: "approve",
+					Approval: &manifest.ActionApproval{
+						Topic:   "arn:aws:sns:us-west-2:123456789012:release-managers",
+						Message: "Ready to release?",
+					},
+				},
+				{
+					Name: "report",
+					Job:  "report-generator",
+				},
+			},
+			expectedActions: []PipelineAction{
+				{
+					Name: "migrate",
+					Build: &ActionBuild{
+						Image:           "aws/codebuild/amazonlinux2-x86_64-standard:3.0",
+						EnvironmentType: "LINUX_CONTAINER",
+						Buildspec:       "copilot/pipelines/migrate.yml",
+					},
+				},
+				{
+					Name: "smoke",
+					Build: &ActionBuild{
+						Image:           "aws/codebuild/amazonlinux2-aarch64-standard:2.0",
+						EnvironmentType: "ARM_CONTAINER",
+						Commands:        []string{"make smoke-test"},
+					},
+				},
+				{
+					Name: "approve",
+					Approval: &ActionApproval{
+						TopicARN: "arn:aws:sns:us-west-2:123456789012:release-managers",
+						Message:  "Ready to release?",
+					},
+				},
+				{
+					Name:    "report",
+					JobName: "report-generator",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actions := PipelineActionsFromManifest(tc.mfActions)
+			require.Equal(t, tc.expectedActions, actions)
+		})
+	}
+}
+
+func TestPipelineStage_Actions(t *testing.T) {
+	stage := PipelineStage{
+		PreDeployments:  []PipelineAction{{Name: "approve"}, {Name: "migrate"}},
+		PostDeployments: []PipelineAction{{Name: "smoke"}},
+	}
+
+	require.Equal(t, 4, stage.DeploymentRunOrder())
+	require.Equal(t, 5, stage.TestCommandsRunOrder())
+	require.Equal(t, []StageAction{
+		{PipelineAction: PipelineAction{Name: "approve"}, RunOrder: 2},
+		{PipelineAction: PipelineAction{Name: "migrate"}, RunOrder: 3},
+		{PipelineAction: PipelineAction{Name: "smoke"}, RunOrder: 6},
+	}, stage.Actions())
+}
+
 func TestParseOwnerAndRepo(t *testing.T) {
 	testCases := map[string]struct {
 		src            *GitHubSource
@@ -325,3 +473,55 @@ func TestParseRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestGitLabSource_Repository(t *testing.T) {
+	testCases := map[string]struct {
+		src *GitLabSource
+
+		wantedRepo           string
+		wantedConnectionName string
+		wantedErr            error
+	}{
+		"missing repository property": {
+			src:       &GitLabSource{},
+			wantedErr: errors.New("unable to locate the repository"),
+		},
+		"unable to parse repository URL": {
+			src: &GitLabSource{
+				RepositoryURL: "https://gitlab.company.com/group/project",
+			},
+			wantedErr: errors.New("unable to parse the repository from the URL https://gitlab.company.com/group/project"),
+		},
+		"repository of a group": {
+			src: &GitLabSource{
+				RepositoryURL: "https://gitlab.com/badgoose/chaOS",
+			},
+			wantedRepo:           "badgoose/chaOS",
+			wantedConnectionName: "copilot-badgo-chaOS",
+		},
+		"repository of a subgroup": {
+			src: &GitLabSource{
+				RepositoryURL: "https://gitlab.com/badgoose/infra/chaOS",
+			},
+			wantedRepo:           "badgoose/infra/chaOS",
+			wantedConnectionName: "copilot-badgo-chaOS",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			repo, err := tc.src.Repository()
+			connectionName, connErr := tc.src.ConnectionName()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				require.Error(t, connErr)
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, connErr)
+				require.Equal(t, tc.wantedRepo, repo)
+				require.Equal(t, tc.wantedConnectionName, connectionName)
+			}
+		})
+	}
+}
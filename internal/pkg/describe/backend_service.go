@@ -7,9 +7,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"io"
+	"strings"
 	"text/tabwriter"
 
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -21,6 +24,11 @@ const (
 	// that cannot be reached with Service Discovery.
 	BlankServiceDiscoveryURI = "-"
 	blankContainerPort       = "-"
+
+	svcOutputServiceConnectEnabled  = "ServiceConnectEnabled"
+	svcOutputServiceConnectEndpoint = "ServiceConnectEndpoint"
+
+	noServiceConnectClients = "-"
 )
 
 // BackendServiceDescriber retrieves information about a backend service.
@@ -33,6 +41,7 @@ type BackendServiceDescriber struct {
 	initClients          func(string) error
 	ecsServiceDescribers map[string]ecsDescriber
 	envStackDescriber    map[string]envDescriber
+	newSvcStackDescriber func(env, svc string) (workloadStackDescriber, error) // Describes the other services of an environment.
 }
 
 // NewBackendServiceDescriber instantiates a backend service describer.
@@ -70,6 +79,14 @@ func NewBackendServiceDescriber(opt NewServiceConfig) (*BackendServiceDescriber,
 		describer.envStackDescriber[env] = envDescr
 		return nil
 	}
+	describer.newSvcStackDescriber = func(env, svc string) (workloadStackDescriber, error) {
+		return NewECSServiceDescriber(NewServiceConfig{
+			App:         opt.App,
+			Env:         env,
+			Svc:         svc,
+			ConfigStore: opt.ConfigStore,
+		})
+	}
 	return describer, nil
 }
 
@@ -131,6 +148,10 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
 	}
+	serviceConnect, err := describeServiceConnect(d.app, d.svc, environments, d.store, d.ecsServiceDescribers, d.newSvcStackDescriber)
+	if err != nil {
+		return nil, err
+	}
 
 	resources := make(map[string][]*stack.Resource)
 	if d.enableResources {
@@ -153,6 +174,7 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 		App:              d.app,
 		Configurations:   configs,
 		ServiceDiscovery: services,
+		ServiceConnect:   serviceConnect,
 		Variables:        envVars,
 		Secrets:          secrets,
 		Resources:        resources,
@@ -161,6 +183,72 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 	}, nil
 }
 
+// describeServiceConnect returns, for each environment where other services can reach svc with Service Connect,
+// the endpoint of the service and the other services of the environment that can reach it.
+func describeServiceConnect(app, svc string, environments []string, store DeployedEnvServicesLister,
+	svcDescribers map[string]ecsDescriber, newSvcStackDescriber func(env, svc string) (workloadStackDescriber, error)) ([]*ServiceConnect, error) {
+	var out []*ServiceConnect
+	for _, env := range environments {
+		outputs, err := svcDescribers[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("get stack outputs for environment %s: %w", env, err)
+		}
+		endpoint, ok := outputs[svcOutputServiceConnectEndpoint]
+		if !ok {
+			continue
+		}
+		svcs, err := store.ListDeployedServices(app, env)
+		if err != nil {
+			return nil, fmt.Errorf("list deployed services in environment %s: %w", env, err)
+		}
+		clients := []string{}
+		for _, other := range svcs {
+			if other == svc {
+				continue
+			}
+			describer, err := newSvcStackDescriber(env, other)
+			if err != nil {
+				return nil, err
+			}
+			outputs, err := describer.Outputs()
+			if err != nil {
+				return nil, fmt.Errorf("get stack outputs of service %s in environment %s: %w", other, env, err)
+			}
+			if outputs[svcOutputServiceConnectEnabled] == "true" {
+				clients = append(clients, other)
+			}
+		}
+		out = append(out, &ServiceConnect{
+			Environment: env,
+			Endpoint:    endpoint,
+			Clients:     clients,
+		})
+	}
+	return out, nil
+}
+
+// ServiceConnect contains serialized Service Connect info for a service.
+type ServiceConnect struct {
+	Environment string   `json:"environment"`
+	Endpoint    string   `json:"endpoint"`
+	Clients     []string `json:"reachableFrom"`
+}
+
+type serviceConnects []*ServiceConnect
+
+func (s serviceConnects) humanString(w io.Writer) {
+	headers := []string{"Environment", "Endpoint", "Reachable From"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, sc := range s {
+		clients := noServiceConnectClients
+		if len(sc.Clients) != 0 {
+			clients = strings.Join(sc.Clients, ", ")
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", sc.Environment, sc.Endpoint, clients)
+	}
+}
+
 // backendSvcDesc contains serialized parameters for a backend service.
 type backendSvcDesc struct {
 	Service          string               `json:"service"`
@@ -168,6 +256,7 @@ type backendSvcDesc struct {
 	App              string               `json:"application"`
 	Configurations   ecsConfigurations    `json:"configurations"`
 	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
+	ServiceConnect   serviceConnects      `json:"serviceConnect,omitempty"`
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
 	Resources        deployedSvcResources `json:"resources,omitempty"`
@@ -199,6 +288,11 @@ func (w *backendSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
+	if len(w.ServiceConnect) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nService Connect\n\n"))
+		writer.Flush()
+		w.ServiceConnect.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
@@ -205,6 +205,17 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 					}, nil),
 					m.ecsDescriber.EXPECT().Secrets().Return(
 						nil, nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						"ServiceConnectEnabled":  "true",
+						"ServiceConnectEndpoint": "jobs:5000",
+					}, nil),
+					m.storeSvc.EXPECT().ListDeployedServices(testApp, testEnv).Return([]string{"api", "jobs", "frontend"}, nil),
+					m.svcStackDescriber.EXPECT().Outputs().Return(map[string]string{
+						"ServiceConnectEnabled": "true",
+					}, nil),
+					m.svcStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::EC2::SecurityGroupIngress",
@@ -271,6 +282,13 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						Namespace:   "jobs.prod.phonetool.local:5000",
 					},
 				},
+				ServiceConnect: []*ServiceConnect{
+					{
+						Environment: "test",
+						Endpoint:    "jobs:5000",
+						Clients:     []string{"api"},
+					},
+				},
 				Variables: []*containerEnvVar{
 					{
 						envVar: &envVar{
@@ -344,10 +362,12 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
 			mockSvcDescriber := mocks.NewMockecsDescriber(ctrl)
 			mockEnvDescriber := mocks.NewMockenvDescriber(ctrl)
+			mockSvcStackDescriber := mocks.NewMockworkloadStackDescriber(ctrl)
 			mocks := lbWebSvcDescriberMocks{
-				storeSvc:     mockStore,
-				ecsDescriber: mockSvcDescriber,
-				envDescriber: mockEnvDescriber,
+				storeSvc:          mockStore,
+				ecsDescriber:      mockSvcDescriber,
+				envDescriber:      mockEnvDescriber,
+				svcStackDescriber: mockSvcStackDescriber,
 			}
 
 			tc.setupMocks(mocks)
@@ -369,6 +389,11 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 					"prod":    mockEnvDescriber,
 					"mockEnv": mockEnvDescriber,
 				},
+				newSvcStackDescriber: func(env, svc string) (workloadStackDescriber, error) {
+					require.Equal(t, testEnv, env)
+					require.NotEqual(t, testSvc, svc, "should not describe the service itself")
+					return mockSvcStackDescriber, nil
+				},
 			}
 
 			// WHEN
@@ -411,6 +436,13 @@ Service Discovery
   test         http://my-svc.test.my-app.local:5000
   prod         http://my-svc.prod.my-app.local:5000
 
+Service Connect
+
+  Environment  Endpoint     Reachable From
+  -----------  --------     --------------
+  test         my-svc:5000  api, worker
+  prod         my-svc:5000  -
+
 Variables
 
   Name                      Container  Environment  Value
@@ -433,7 +465,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"256\",\"memory\":\"512\",\"platform\":\"LINUX/X86_64\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"5000\",\"cpu\":\"512\",\"memory\":\"1024\",\"platform\":\"LINUX/ARM64\",\"tasks\":\"3\"}],\"serviceDiscovery\":[{\"environment\":[\"test\"],\"namespace\":\"http://my-svc.test.my-app.local:5000\"},{\"environment\":[\"prod\"],\"namespace\":\"http://my-svc.prod.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"container\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"container\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"container\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"container\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"256\",\"memory\":\"512\",\"platform\":\"LINUX/X86_64\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"5000\",\"cpu\":\"512\",\"memory\":\"1024\",\"platform\":\"LINUX/ARM64\",\"tasks\":\"3\"}],\"serviceDiscovery\":[{\"environment\":[\"test\"],\"namespace\":\"http://my-svc.test.my-app.local:5000\"},{\"environment\":[\"prod\"],\"namespace\":\"http://my-svc.prod.my-app.local:5000\"}],\"serviceConnect\":[{\"environment\":\"test\",\"endpoint\":\"my-svc:5000\",\"reachableFrom\":[\"api\",\"worker\"]},{\"environment\":\"prod\",\"endpoint\":\"my-svc:5000\",\"reachableFrom\":[]}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"container\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"container\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"container\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"container\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -503,6 +535,18 @@ Resources
 					Namespace:   "http://my-svc.prod.my-app.local:5000",
 				},
 			}
+			serviceConnect := []*ServiceConnect{
+				{
+					Environment: "test",
+					Endpoint:    "my-svc:5000",
+					Clients:     []string{"api", "worker"},
+				},
+				{
+					Environment: "prod",
+					Endpoint:    "my-svc:5000",
+					Clients:     []string{},
+				},
+			}
 			resources := map[string][]*stack.Resource{
 				"test": {
 					{
@@ -525,6 +569,7 @@ Resources
 				Variables:        envVars,
 				Secrets:          secrets,
 				ServiceDiscovery: sds,
+				ServiceConnect:   serviceConnect,
 				Resources:        resources,
 				environments:     []string{"test", "prod"},
 			}
@@ -7,21 +7,29 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net"
 	"sort"
 	"strings"
 	"text/tabwriter"
 
+	"github.com/dustin/go-humanize/english"
 	"gopkg.in/yaml.v3"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
+const (
+	listenerRuleResourceType = "AWS::ElasticLoadBalancingV2::ListenerRule"
+)
+
 var (
 	fmtLegacySvcDiscoveryEndpoint = "%s.local"
 )
@@ -30,6 +38,10 @@ type vpcSubnetLister interface {
 	ListVPCSubnets(vpcID string) (*ec2.VPCSubnets, error)
 }
 
+type listenerRuleLister interface {
+	ListenerRules(listenerARN string) ([]elbv2.ListenerRule, error)
+}
+
 // EnvDescription contains the information about an environment.
 type EnvDescription struct {
 	Environment    *config.Environment `json:"environment"`
@@ -38,6 +50,18 @@ type EnvDescription struct {
 	Tags           map[string]string   `json:"tags,omitempty"`
 	Resources      []*stack.Resource   `json:"resources,omitempty"`
 	EnvironmentVPC EnvironmentVPC      `json:"environmentVPC"`
+	ListenerRules  []*ListenerRule     `json:"listenerRules,omitempty"`
+}
+
+// ListenerRule is a rule of a listener of the environment's load balancer.
+type ListenerRule struct {
+	Listener   string   `json:"listener"` // Either "HTTP" or "HTTPS".
+	Priority   string   `json:"priority"`
+	Service    string   `json:"service,omitempty"` // Empty if the rule does not belong to a deployed service.
+	Conditions []string `json:"conditions"`
+	Action     string   `json:"action"`
+	// ConflictsWith holds the priorities of the other rules of the listener that can match the same requests.
+	ConflictsWith []string `json:"conflictsWith,omitempty"`
 }
 
 // EnvironmentVPC holds the ID of the environment's VPC configuration.
@@ -57,6 +81,8 @@ type EnvDescriber struct {
 	deployStore  DeployedEnvServicesLister
 	cfn          stackDescriber
 	subnetLister vpcSubnetLister
+	ruleLister   listenerRuleLister
+	svcStack     func(svc string) stackDescriber
 
 	// Cached values for reuse.
 	description *EnvDescription
@@ -90,6 +116,10 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 		deployStore:  opt.DeployStore,
 		cfn:          stack.NewStackDescriber(cfnstack.NameForEnv(opt.App, opt.Env), sess),
 		subnetLister: ec2.New(sess),
+		ruleLister:   elbv2.New(sess),
+		svcStack: func(svc string) stackDescriber {
+			return stack.NewStackDescriber(cfnstack.NameForService(opt.App, opt.Env, svc), sess)
+		},
 	}, nil
 }
 
@@ -108,7 +138,12 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 		return nil, err
 	}
 
-	tags, environmentVPC, err := d.loadStackInfo()
+	info, err := d.loadStackInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := d.listenerRules(info.listenerARNs, svcs)
 	if err != nil {
 		return nil, err
 	}
@@ -124,9 +159,10 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 		Environment:    d.env,
 		Services:       svcs,
 		Jobs:           jobs,
-		Tags:           tags,
+		Tags:           info.tags,
 		Resources:      stackResources,
-		EnvironmentVPC: environmentVPC,
+		EnvironmentVPC: info.environmentVPC,
+		ListenerRules:  rules,
 	}
 	return d.description, nil
 }
@@ -195,11 +231,11 @@ func (d *EnvDescriber) ServiceDiscoveryEndpoint() (string, error) {
 
 // PublicCIDRBlocks returns the public CIDR blocks of the public subnets in the environment VPC.
 func (d *EnvDescriber) PublicCIDRBlocks() ([]string, error) {
-	_, envVPC, err := d.loadStackInfo()
+	info, err := d.loadStackInfo()
 	if err != nil {
 		return nil, err
 	}
-	vpcID := envVPC.ID
+	vpcID := info.environmentVPC.ID
 	subnets, err := d.subnetLister.ListVPCSubnets(vpcID)
 	if err != nil {
 		return nil, fmt.Errorf("list subnets of vpc %s in environment %s: %w", vpcID, d.env.Name, err)
@@ -211,26 +247,184 @@ func (d *EnvDescriber) PublicCIDRBlocks() ([]string, error) {
 	return cidrBlocks, nil
 }
 
-func (d *EnvDescriber) loadStackInfo() (map[string]string, EnvironmentVPC, error) {
-	var environmentVPC EnvironmentVPC
+type envStackInfo struct {
+	tags           map[string]string
+	environmentVPC EnvironmentVPC
+	listenerARNs   map[string]string // Listener ARNs keyed by "HTTP" or "HTTPS".
+}
 
+func (d *EnvDescriber) loadStackInfo() (*envStackInfo, error) {
 	envStack, err := d.cfn.Describe()
 	if err != nil {
-		return nil, environmentVPC, fmt.Errorf("retrieve environment stack: %w", err)
+		return nil, fmt.Errorf("retrieve environment stack: %w", err)
 	}
 
+	info := &envStackInfo{
+		tags:         envStack.Tags,
+		listenerARNs: make(map[string]string),
+	}
 	for k, v := range envStack.Outputs {
 		switch k {
 		case cfnstack.EnvOutputVPCID:
-			environmentVPC.ID = v
+			info.environmentVPC.ID = v
 		case cfnstack.EnvOutputPublicSubnets:
-			environmentVPC.PublicSubnetIDs = strings.Split(v, ",")
+			info.environmentVPC.PublicSubnetIDs = strings.Split(v, ",")
 		case cfnstack.EnvOutputPrivateSubnets:
-			environmentVPC.PrivateSubnetIDs = strings.Split(v, ",")
+			info.environmentVPC.PrivateSubnetIDs = strings.Split(v, ",")
+		case cfnstack.EnvOutputHTTPListenerARN:
+			info.listenerARNs["HTTP"] = v
+		case cfnstack.EnvOutputHTTPSListenerARN:
+			info.listenerARNs["HTTPS"] = v
+		}
+	}
+	return info, nil
+}
+
+// ListenerRules returns the rules of the listeners of the environment's load balancer along with the service that owns each rule.
+func (d *EnvDescriber) ListenerRules() ([]*ListenerRule, error) {
+	svcs, err := d.filterDeployedSvcs()
+	if err != nil {
+		return nil, err
+	}
+	info, err := d.loadStackInfo()
+	if err != nil {
+		return nil, err
+	}
+	return d.listenerRules(info.listenerARNs, svcs)
+}
+
+// listenerRules returns the rules of every listener of the environment's load balancer, along with the
+// service that created each rule, so that rules of different services matching the same requests are visible.
+func (d *EnvDescriber) listenerRules(listenerARNs map[string]string, svcs []*config.Workload) ([]*ListenerRule, error) {
+	if len(listenerARNs) == 0 {
+		return nil, nil
+	}
+	ruleOwners := make(map[string]string)
+	for _, svc := range svcs {
+		if svc.Type != manifest.LoadBalancedWebServiceType {
+			continue
+		}
+		resources, err := d.svcStack(svc.Name).Resources()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve resources of service %s: %w", svc.Name, err)
+		}
+		for _, resource := range resources {
+			if resource.Type == listenerRuleResourceType {
+				ruleOwners[resource.PhysicalID] = svc.Name
+			}
+		}
+	}
+	var rules []*ListenerRule
+	for _, listener := range []string{"HTTPS", "HTTP"} {
+		arn, ok := listenerARNs[listener]
+		if !ok {
+			continue
+		}
+		listenerRules, err := d.ruleLister.ListenerRules(arn)
+		if err != nil {
+			return nil, fmt.Errorf("list rules of the %s listener: %w", listener, err)
+		}
+		converted := make([]*ListenerRule, len(listenerRules))
+		for i, r := range listenerRules {
+			converted[i] = &ListenerRule{
+				Listener:   listener,
+				Priority:   r.Priority,
+				Service:    ruleOwners[r.ARN],
+				Conditions: r.Conditions,
+				Action:     r.Action,
+			}
+		}
+		for i, r := range listenerRules {
+			if r.IsDefault() {
+				continue
+			}
+			for j, other := range listenerRules {
+				if i != j && !other.IsDefault() && conditionsOverlap(r.ConditionValues, other.ConditionValues) {
+					converted[i].ConflictsWith = append(converted[i].ConflictsWith, other.Priority)
+				}
+			}
+		}
+		rules = append(rules, converted...)
+	}
+	return rules, nil
+}
+
+// conditionsOverlap returns true if a request can match the conditions of both rules.
+// Conditions of different fields don't exclude each other, so the rules overlap unless
+// a field of both rules has values that no request can match at the same time.
+func conditionsOverlap(a, b map[string][]string) bool {
+	for field, values := range a {
+		others, ok := b[field]
+		if !ok {
+			continue
+		}
+		if !valuesOverlap(field, values, others) {
+			return false
 		}
 	}
+	return true
+}
 
-	return envStack.Tags, environmentVPC, nil
+func valuesOverlap(field string, values, others []string) bool {
+	if field == "query-string" {
+		// A request can have any number of query parameters, even the same key twice.
+		return true
+	}
+	for _, v := range values {
+		for _, o := range others {
+			switch field {
+			case "source-ip":
+				if cidrsOverlap(v, o) {
+					return true
+				}
+			case "path-pattern":
+				if patternsOverlap(v, o) {
+					return true
+				}
+			default:
+				// Hosts, headers and methods are case-insensitive.
+				if patternsOverlap(strings.ToLower(v), strings.ToLower(o)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func cidrsOverlap(a, b string) bool {
+	_, netA, errA := net.ParseCIDR(a)
+	_, netB, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP)
+}
+
+// patternsOverlap returns true if a string can match both patterns, where "*" matches any characters and "?" matches one character.
+func patternsOverlap(a, b string) bool {
+	seen := make(map[[2]int]bool)
+	var overlap func(i, j int) bool
+	overlap = func(i, j int) bool {
+		key := [2]int{i, j}
+		if result, ok := seen[key]; ok {
+			return result
+		}
+		var result bool
+		switch {
+		case i == len(a) && j == len(b):
+			result = true
+		case i < len(a) && a[i] == '*':
+			result = overlap(i+1, j) || (j < len(b) && overlap(i, j+1))
+		case j < len(b) && b[j] == '*':
+			result = overlap(i, j+1) || (i < len(a) && overlap(i+1, j))
+		case i < len(a) && j < len(b) && (a[i] == b[j] || a[i] == '?' || b[j] == '?'):
+			result = overlap(i+1, j+1)
+		}
+		seen[key] = result
+		return result
+	}
+	return overlap(0, 0)
 }
 
 func (d *EnvDescriber) filterDeployedSvcs() ([]*config.Workload, error) {
@@ -328,6 +522,36 @@ func (e *EnvDescription) HumanString() string {
 		}
 	}
 	writer.Flush()
+	if len(e.ListenerRules) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nListener Rules\n\n"))
+		writer.Flush()
+		headers := []string{"Listener", "Priority", "Service", "Action", "Conditions"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, rule := range e.ListenerRules {
+			svc := rule.Service
+			if svc == "" {
+				svc = "-"
+			}
+			conditions := rule.Conditions
+			if len(conditions) == 0 {
+				conditions = []string{"-"}
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", rule.Listener, rule.Priority, svc, rule.Action, conditions[0])
+			for _, condition := range conditions[1:] {
+				fmt.Fprintf(writer, "  \t\t\t\t%s\n", condition)
+			}
+		}
+		writer.Flush()
+		for _, rule := range e.ListenerRules {
+			if len(rule.ConflictsWith) == 0 {
+				continue
+			}
+			fmt.Fprint(writer, color.Yellow.Sprintf("\n  Rule %s of the %s listener can match the same requests as %s %s.\n",
+				rule.Priority, rule.Listener, english.PluralWord(len(rule.ConflictsWith), "rule", "rules"), english.WordSeries(rule.ConflictsWith, "and")))
+		}
+	}
+	writer.Flush()
 	if len(e.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n\n"))
 		writer.Flush()
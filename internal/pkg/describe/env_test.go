@@ -9,11 +9,13 @@ import (
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	cfstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -23,6 +25,7 @@ type envDescriberMocks struct {
 	deployStoreSvc *mocks.MockDeployedEnvServicesLister
 	stackDescriber *mocks.MockstackDescriber
 	subnetLister   *mocks.MockvpcSubnetLister
+	ruleLister     *mocks.MocklistenerRuleLister
 }
 
 var wantedResources = []*stack.Resource{
@@ -274,6 +277,201 @@ func TestEnvDescriber_Describe(t *testing.T) {
 	}
 }
 
+func TestEnvDescriber_Describe_ListenerRules(t *testing.T) {
+	testEnv := &config.Environment{
+		App:  "testApp",
+		Name: "testEnv",
+	}
+	frontend := &config.Workload{
+		App:  "testApp",
+		Name: "frontend",
+		Type: manifest.LoadBalancedWebServiceType,
+	}
+	api := &config.Workload{
+		App:  "testApp",
+		Name: "api",
+		Type: manifest.LoadBalancedWebServiceType,
+	}
+	worker := &config.Workload{
+		App:  "testApp",
+		Name: "worker",
+		Type: manifest.WorkerServiceType,
+	}
+	stackOutputs := map[string]string{
+		"HTTPSListenerArn": "https-listener",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m envDescriberMocks, svcStacks map[string]*mocks.MockstackDescriber)
+
+		wantedRules []*ListenerRule
+		wantedError error
+	}{
+		"error if fail to retrieve the resources of a service": {
+			setupMocks: func(m envDescriberMocks, svcStacks map[string]*mocks.MockstackDescriber) {
+				m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{Outputs: stackOutputs}, nil)
+				svcStacks["frontend"].EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("retrieve resources of service frontend: some error"),
+		},
+		"error if fail to list the rules of a listener": {
+			setupMocks: func(m envDescriberMocks, svcStacks map[string]*mocks.MockstackDescriber) {
+				m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{Outputs: stackOutputs}, nil)
+				svcStacks["frontend"].EXPECT().Resources().Return(nil, nil)
+				svcStacks["api"].EXPECT().Resources().Return(nil, nil)
+				m.ruleLister.EXPECT().ListenerRules("https-listener").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list rules of the HTTPS listener: some error"),
+		},
+		"success with overlapping rules": {
+			setupMocks: func(m envDescriberMocks, svcStacks map[string]*mocks.MockstackDescriber) {
+				m.stackDescriber.EXPECT().Describe().Return(stack.StackDescription{Outputs: stackOutputs}, nil)
+				svcStacks["frontend"].EXPECT().Resources().Return([]*stack.Resource{
+					{Type: "AWS::ElasticLoadBalancingV2::ListenerRule", PhysicalID: "rule-1"},
+					{Type: "AWS::ECS::Service", PhysicalID: "frontend-service"},
+				}, nil)
+				svcStacks["api"].EXPECT().Resources().Return([]*stack.Resource{
+					{Type: "AWS::ElasticLoadBalancingV2::ListenerRule", PhysicalID: "rule-2"},
+					{Type: "AWS::ElasticLoadBalancingV2::ListenerRule", PhysicalID: "rule-3"},
+				}, nil)
+				m.ruleLister.EXPECT().ListenerRules("https-listener").Return([]elbv2.ListenerRule{
+					{
+						ARN:             "rule-2",
+						Priority:        "1",
+						Conditions:      []string{"path-pattern: /api/*"},
+						Action:          "forward",
+						ConditionValues: map[string][]string{"path-pattern": {"/api/*"}},
+					},
+					{
+						ARN:             "rule-3",
+						Priority:        "2",
+						Conditions:      []string{"host-header: example.com", "path-pattern: /v?/*"},
+						Action:          "forward",
+						ConditionValues: map[string][]string{"host-header": {"example.com"}, "path-pattern": {"/v?/*"}},
+					},
+					{
+						ARN:             "rule-1",
+						Priority:        "3",
+						Conditions:      []string{"host-header: EXAMPLE.com"},
+						Action:          "forward",
+						ConditionValues: map[string][]string{"host-header": {"EXAMPLE.com"}},
+					},
+					{ARN: "rule-default", Priority: "default", Action: "fixed-response 404"},
+				}, nil)
+			},
+			wantedRules: []*ListenerRule{
+				{Listener: "HTTPS", Priority: "1", Service: "api", Conditions: []string{"path-pattern: /api/*"}, Action: "forward", ConflictsWith: []string{"3"}},
+				{Listener: "HTTPS", Priority: "2", Service: "api", Conditions: []string{"host-header: example.com", "path-pattern: /v?/*"}, Action: "forward", ConflictsWith: []string{"3"}},
+				{Listener: "HTTPS", Priority: "3", Service: "frontend", Conditions: []string{"host-header: EXAMPLE.com"}, Action: "forward", ConflictsWith: []string{"1", "2"}},
+				{Listener: "HTTPS", Priority: "default", Action: "fixed-response 404"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := envDescriberMocks{
+				configStoreSvc: mocks.NewMockConfigStoreSvc(ctrl),
+				deployStoreSvc: mocks.NewMockDeployedEnvServicesLister(ctrl),
+				stackDescriber: mocks.NewMockstackDescriber(ctrl),
+				ruleLister:     mocks.NewMocklistenerRuleLister(ctrl),
+			}
+			svcStacks := map[string]*mocks.MockstackDescriber{
+				"frontend": mocks.NewMockstackDescriber(ctrl),
+				"api":      mocks.NewMockstackDescriber(ctrl),
+			}
+			m.configStoreSvc.EXPECT().ListServices("testApp").Return([]*config.Workload{frontend, api, worker}, nil)
+			m.deployStoreSvc.EXPECT().ListDeployedServices("testApp", "testEnv").Return([]string{"frontend", "api", "worker"}, nil)
+			m.configStoreSvc.EXPECT().ListJobs("testApp").Return(nil, nil)
+			m.deployStoreSvc.EXPECT().ListDeployedJobs("testApp", "testEnv").Return(nil, nil)
+			tc.setupMocks(m, svcStacks)
+
+			d := &EnvDescriber{
+				env: testEnv,
+				app: "testApp",
+
+				configStore: m.configStoreSvc,
+				deployStore: m.deployStoreSvc,
+				cfn:         m.stackDescriber,
+				ruleLister:  m.ruleLister,
+				svcStack: func(svc string) stackDescriber {
+					return svcStacks[svc]
+				},
+			}
+
+			// WHEN
+			actual, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRules, actual.ListenerRules)
+			}
+		})
+	}
+}
+
+func TestConditionsOverlap(t *testing.T) {
+	testCases := map[string]struct {
+		inA map[string][]string
+		inB map[string][]string
+
+		wanted bool
+	}{
+		"different fields": {
+			inA:    map[string][]string{"host-header": {"example.com"}},
+			inB:    map[string][]string{"path-pattern": {"/api"}},
+			wanted: true,
+		},
+		"wildcard host": {
+			inA:    map[string][]string{"host-header": {"*.example.com"}},
+			inB:    map[string][]string{"host-header": {"API.example.com"}},
+			wanted: true,
+		},
+		"distinct hosts": {
+			inA: map[string][]string{"host-header": {"*.example.com"}},
+			inB: map[string][]string{"host-header": {"example.com"}},
+		},
+		"one of the paths overlaps": {
+			inA:    map[string][]string{"path-pattern": {"/api", "/api/v?/*"}},
+			inB:    map[string][]string{"path-pattern": {"/*/v1/users"}},
+			wanted: true,
+		},
+		"paths are case-sensitive": {
+			inA: map[string][]string{"path-pattern": {"/API/*"}},
+			inB: map[string][]string{"path-pattern": {"/api/*"}},
+		},
+		"nested source ip ranges": {
+			inA:    map[string][]string{"source-ip": {"10.0.0.0/16"}},
+			inB:    map[string][]string{"source-ip": {"10.0.1.0/24"}},
+			wanted: true,
+		},
+		"disjoint source ip ranges": {
+			inA: map[string][]string{"source-ip": {"10.0.0.0/24"}},
+			inB: map[string][]string{"source-ip": {"10.0.1.0/24"}},
+		},
+		"query strings": {
+			inA:    map[string][]string{"query-string": {"version=1"}},
+			inB:    map[string][]string{"query-string": {"version=2"}},
+			wanted: true,
+		},
+		"every common field must overlap": {
+			inA: map[string][]string{"host-header": {"example.com"}, "path-pattern": {"/api/*"}},
+			inB: map[string][]string{"host-header": {"example.com"}, "path-pattern": {"/web/*"}},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, conditionsOverlap(tc.inA, tc.inB))
+			require.Equal(t, tc.wanted, conditionsOverlap(tc.inB, tc.inA))
+		})
+	}
+}
+
 func TestEnvDescriber_Version(t *testing.T) {
 	testCases := map[string]struct {
 		given func(ctrl *gomock.Controller) *EnvDescriber
@@ -615,6 +813,20 @@ Tags
   key1    value1
   key2    value2
 
+Listener Rules
+
+  Listener  Priority  Service   Action              Conditions
+  --------  --------  -------   ------              ----------
+  HTTPS     1         testSvc1  forward             host-header: example.com
+                                                    path-pattern: /api/*
+  HTTPS     2         testSvc2  forward             host-header: example.com
+                                                    path-pattern: /api/*
+  HTTPS     default   -         fixed-response 404  -
+
+  Rule 1 of the HTTPS listener can match the same requests as rule 2.
+
+  Rule 2 of the HTTPS listener can match the same requests as rule 1.
+
 Resources
 
   AWS::IAM::Role           testApp-testEnv-CFNExecutionRole
@@ -630,6 +842,29 @@ Resources
 		Jobs:        allJobs,
 		Tags:        testApp.Tags,
 		Resources:   wantedResources,
+		ListenerRules: []*ListenerRule{
+			{
+				Listener:      "HTTPS",
+				Priority:      "1",
+				Service:       "testSvc1",
+				Conditions:    []string{"host-header: example.com", "path-pattern: /api/*"},
+				Action:        "forward",
+				ConflictsWith: []string{"2"},
+			},
+			{
+				Listener:      "HTTPS",
+				Priority:      "2",
+				Service:       "testSvc2",
+				Conditions:    []string{"host-header: example.com", "path-pattern: /api/*"},
+				Action:        "forward",
+				ConflictsWith: []string{"1"},
+			},
+			{
+				Listener: "HTTPS",
+				Priority: "default",
+				Action:   "fixed-response 404",
+			},
+		},
 	}
 
 	// WHEN
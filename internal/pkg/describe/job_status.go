@@ -0,0 +1,413 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	fmtJobLogGroupName  = "/copilot/%s-%s-%s"
+	fmtJobLogStreamName = "copilot/%s/%s"
+
+	// jobStatusMaxExecutions is the number of recent executions of a job to describe.
+	jobStatusMaxExecutions   = 10
+	shortExecutionNameLength = 8
+)
+
+var (
+	awsRateExpressionRegexp  = regexp.MustCompile(`^rate\((\d+) (minutes?|hours?|days?)\)$`)
+	awsCronExpressionRegexp  = regexp.MustCompile(`^cron\((.+)\)$`)
+	awsCronLastWeekdayRegexp = regexp.MustCompile(`\dL`) // For example, "6L" is the last Friday of the month.
+)
+
+type jobExecutionLister interface {
+	ListJobExecutions(app, env, job string, maxResults int) ([]*ecs.JobExecutionHistory, error)
+	ListWorkflowExecutions(stateMachineARN string, maxResults int) ([]*ecs.WorkflowExecutionHistory, error)
+}
+
+type jobStatusDescriber struct {
+	app string
+	env string
+	job string
+
+	stackDescriber   workloadStackDescriber
+	executionsLister jobExecutionLister
+	now              func() time.Time
+}
+
+// jobStatus contains the schedule and the recent executions of a job.
+type jobStatus struct {
+	Schedule     string                `json:"schedule,omitempty"`
+	NextRun      *time.Time            `json:"nextRun,omitempty"`
+	LogGroup     string                `json:"logGroup"`
+	Executions   []*jobExecutionStatus `json:"executions"`
+	WorkflowRuns []*workflowRunStatus  `json:"workflowRuns,omitempty"` // Nil if the job doesn't have a workflow.
+}
+
+// jobExecutionStatus contains the history of an execution of a job.
+type jobExecutionStatus struct {
+	ARN       string     `json:"arn"`
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	StartedAt time.Time  `json:"startedAt"`
+	StoppedAt *time.Time `json:"stoppedAt,omitempty"`
+	Retries   int        `json:"retries"`
+	Timeouts  int        `json:"timeouts"`
+	ExitCode  *int       `json:"exitCode,omitempty"`
+	LogStream string     `json:"logStream,omitempty"`
+}
+
+// workflowRunStatus contains the history of an execution of a job's workflow.
+type workflowRunStatus struct {
+	ARN       string                `json:"arn"`
+	Name      string                `json:"name"`
+	Status    string                `json:"status"`
+	StartedAt time.Time             `json:"startedAt"`
+	StoppedAt *time.Time            `json:"stoppedAt,omitempty"`
+	Steps     []*workflowStepStatus `json:"steps"`
+}
+
+// workflowStepStatus contains the status of a job that ran as a step of a workflow.
+type workflowStepStatus struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+}
+
+// NewJobStatusDescriber instantiates a new jobStatusDescriber struct.
+func NewJobStatusDescriber(opt *NewServiceStatusConfig) (*jobStatusDescriber, error) {
+	stackDescriber, err := newServiceStackDescriber(NewServiceConfig{
+		App:         opt.App,
+		Env:         opt.Env,
+		Svc:         opt.Svc,
+		ConfigStore: opt.ConfigStore,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &jobStatusDescriber{
+		app:              opt.App,
+		env:              opt.Env,
+		job:              opt.Svc,
+		stackDescriber:   stackDescriber,
+		executionsLister: ecs.New(stackDescriber.sess),
+		now:              time.Now,
+	}, nil
+}
+
+// Describe returns the schedule and the recent executions of a job.
+func (d *jobStatusDescriber) Describe() (HumanJSONStringer, error) {
+	params, err := d.stackDescriber.Params()
+	if err != nil {
+		return nil, fmt.Errorf("get stack parameters of job %s: %w", d.job, err)
+	}
+	histories, err := d.executionsLister.ListJobExecutions(d.app, d.env, d.job, jobStatusMaxExecutions)
+	if err != nil {
+		return nil, fmt.Errorf("list executions of job %s: %w", d.job, err)
+	}
+	executions := make([]*jobExecutionStatus, len(histories))
+	for i, history := range histories {
+		execution := &jobExecutionStatus{
+			ARN:       history.ARN,
+			Name:      executionName(history.ARN),
+			Status:    history.Status,
+			StartedAt: history.StartDate,
+			StoppedAt: history.StopDate,
+			Retries:   history.Retries,
+			Timeouts:  history.TimedOut,
+			ExitCode:  history.ExitCode,
+		}
+		if taskID, err := awsecs.TaskID(history.TaskARN); err == nil {
+			execution.LogStream = fmt.Sprintf(fmtJobLogStreamName, d.job, taskID)
+		}
+		executions[i] = execution
+	}
+	outputs, err := d.stackDescriber.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("get stack outputs of job %s: %w", d.job, err)
+	}
+	var workflowRuns []*workflowRunStatus
+	if workflowARN, ok := outputs[cfnstack.ScheduledJobOutputWorkflowStateMachineARN]; ok {
+		workflowRuns, err = d.workflowRuns(workflowARN)
+		if err != nil {
+			return nil, err
+		}
+	}
+	schedule := params[cfnstack.ScheduledJobScheduleParamKey]
+	var lastRun *time.Time
+	switch {
+	case workflowRuns != nil:
+		// The schedule starts the workflow instead of the job.
+		if len(workflowRuns) > 0 {
+			lastRun = &workflowRuns[0].StartedAt
+		}
+	case len(histories) > 0:
+		lastRun = &histories[0].StartDate
+	}
+	return &jobStatus{
+		Schedule:     schedule,
+		NextRun:      nextScheduledRun(schedule, d.now(), lastRun),
+		LogGroup:     fmt.Sprintf(fmtJobLogGroupName, d.app, d.env, d.job),
+		Executions:   executions,
+		WorkflowRuns: workflowRuns,
+	}, nil
+}
+
+func (d *jobStatusDescriber) workflowRuns(stateMachineARN string) ([]*workflowRunStatus, error) {
+	histories, err := d.executionsLister.ListWorkflowExecutions(stateMachineARN, jobStatusMaxExecutions)
+	if err != nil {
+		return nil, fmt.Errorf("list workflow executions of job %s: %w", d.job, err)
+	}
+	runs := make([]*workflowRunStatus, len(histories))
+	for i, history := range histories {
+		steps := make([]*workflowStepStatus, len(history.Steps))
+		for j, step := range history.Steps {
+			status := step.Status
+			if status == sfn.ExecutionStatusRunning && !history.IsRunning() {
+				// The step was stopped because another step of the workflow failed.
+				status = sfn.ExecutionStatusAborted
+			}
+			steps[j] = &workflowStepStatus{
+				Name:     step.Name,
+				Status:   status,
+				Attempts: step.Attempts,
+			}
+		}
+		runs[i] = &workflowRunStatus{
+			ARN:       history.ARN,
+			Name:      executionName(history.ARN),
+			Status:    history.Status,
+			StartedAt: history.StartDate,
+			StoppedAt: history.StopDate,
+			Steps:     steps,
+		}
+	}
+	return runs, nil
+}
+
+// JSONString returns the stringified jobStatus struct with json format.
+func (s *jobStatus) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal job status: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified jobStatus struct with human readable format.
+func (s *jobStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Schedule\n\n"))
+	writer.Flush()
+	schedule, nextRun := "None", "-"
+	if s.Schedule != "" {
+		schedule = s.Schedule
+	}
+	if s.NextRun != nil {
+		nextRun = humanizeTime(*s.NextRun)
+	}
+	fmt.Fprintf(writer, "  %s\t%s\n", "Expression", schedule)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Next Run", nextRun)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Log Group", s.LogGroup)
+	writer.Flush()
+
+	fmt.Fprint(writer, color.Bold.Sprint("\nRecent Executions\n\n"))
+	writer.Flush()
+	if len(s.Executions) == 0 {
+		fmt.Fprintln(writer, "  No executions found.")
+	} else {
+		s.writeExecutions(writer)
+	}
+	writer.Flush()
+	if s.WorkflowRuns != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nRecent Workflow Runs\n\n"))
+		writer.Flush()
+		if len(s.WorkflowRuns) == 0 {
+			fmt.Fprintln(writer, "  No workflow runs found.")
+		} else {
+			s.writeWorkflowRuns(writer)
+		}
+		writer.Flush()
+	}
+	return b.String()
+}
+
+func (s *jobStatus) writeExecutions(writer io.Writer) {
+	headers := []string{"Name", "Status", "Started", "Stopped", "Retries", "Timeouts", "Exit Code", "Log Stream"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, execution := range s.Executions {
+		stopped, exitCode, logStream := "-", "-", "-"
+		if execution.StoppedAt != nil {
+			stopped = humanizeTime(*execution.StoppedAt)
+		}
+		if execution.ExitCode != nil {
+			exitCode = strconv.Itoa(*execution.ExitCode)
+		}
+		if execution.LogStream != "" {
+			logStream = execution.LogStream
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%d\t%d\t%s\t%s\n", shortExecutionName(execution.Name), jobExecutionStatusColor(execution.Status),
+			humanizeTime(execution.StartedAt), stopped, execution.Retries, execution.Timeouts, exitCode, logStream)
+	}
+}
+
+func (s *jobStatus) writeWorkflowRuns(writer io.Writer) {
+	headers := []string{"Name", "Status", "Started", "Stopped", "Step", "Step Status", "Attempts"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, run := range s.WorkflowRuns {
+		stopped := "-"
+		if run.StoppedAt != nil {
+			stopped = humanizeTime(*run.StoppedAt)
+		}
+		runColumns := fmt.Sprintf("%s\t%s\t%s\t%s", shortExecutionName(run.Name), jobExecutionStatusColor(run.Status),
+			humanizeTime(run.StartedAt), stopped)
+		if len(run.Steps) == 0 {
+			fmt.Fprintf(writer, "  %s\t-\t-\t-\n", runColumns)
+			continue
+		}
+		for i, step := range run.Steps {
+			if i > 0 {
+				// Only the first step of a run shows the run's columns.
+				runColumns = "\t\t\t"
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%d\n", runColumns, step.Name, jobExecutionStatusColor(step.Status), step.Attempts)
+		}
+	}
+}
+
+// executionName returns the name of an execution from its ARN, such as "arn:aws:states:us-west-2:123456789012:execution:stateMachine:name".
+func executionName(executionARN string) string {
+	parsed, err := arn.Parse(executionARN)
+	if err != nil {
+		return executionARN
+	}
+	parts := strings.Split(parsed.Resource, ":")
+	return parts[len(parts)-1]
+}
+
+func shortExecutionName(name string) string {
+	if len(name) >= shortExecutionNameLength {
+		return name[:shortExecutionNameLength]
+	}
+	return name
+}
+
+func jobExecutionStatusColor(status string) string {
+	switch status {
+	case "SUCCEEDED":
+		return color.Green.Sprint(status)
+	case "RUNNING":
+		return color.Yellow.Sprint(status)
+	default:
+		return color.Red.Sprint(status)
+	}
+}
+
+// nextScheduledRun returns the next time after now that a schedule expression triggers the job, or nil if it's unknown.
+// Rate expressions are relative to when the schedule was created, so the next run is estimated from the last run.
+func nextScheduledRun(expression string, now time.Time, lastRun *time.Time) *time.Time {
+	if match := awsRateExpressionRegexp.FindStringSubmatch(expression); match != nil {
+		if lastRun == nil {
+			return nil
+		}
+		value, err := strconv.Atoi(match[1])
+		if err != nil || value <= 0 {
+			return nil
+		}
+		var unit time.Duration
+		switch strings.TrimSuffix(match[2], "s") {
+		case "minute":
+			unit = time.Minute
+		case "hour":
+			unit = time.Hour
+		case "day":
+			unit = 24 * time.Hour
+		}
+		interval := time.Duration(value) * unit
+		next := lastRun.Add(interval)
+		if next.Before(now) {
+			next = next.Add((now.Sub(next)/interval + 1) * interval)
+		}
+		return &next
+	}
+	if match := awsCronExpressionRegexp.FindStringSubmatch(expression); match != nil {
+		standard, ok := standardCron(match[1])
+		if !ok {
+			return nil
+		}
+		// Schedule expressions of EventBridge rules are in UTC.
+		schedule, err := cron.ParseStandard("CRON_TZ=UTC " + standard)
+		if err != nil {
+			return nil
+		}
+		next := schedule.Next(now)
+		if next.IsZero() {
+			return nil
+		}
+		return &next
+	}
+	return nil
+}
+
+// standardCron converts the fields of an AWS cron expression, such as "0 9 ? * 2-6 *",
+// to a standard 5-field cron expression, such as "0 9 * * 1-5".
+// It returns false if the expression uses features that standard cron expressions don't support,
+// such as a specific year, or the "L", "W" and "#" wildcards.
+func standardCron(fields string) (string, bool) {
+	const (
+		DOM = 2
+		DOW = 4
+		YEA = 5
+	)
+	sched := strings.Fields(fields)
+	if len(sched) != 6 || sched[YEA] != "*" {
+		return "", false
+	}
+	if strings.ContainsAny(sched[DOM], "LW") || strings.Contains(sched[DOW], "#") || awsCronLastWeekdayRegexp.MatchString(sched[DOW]) {
+		return "", false
+	}
+	for _, i := range []int{DOM, DOW} {
+		if sched[i] == "?" {
+			sched[i] = "*"
+		}
+	}
+	// Day-of-week numbers are one-indexed in AWS but zero-indexed in standard cron expressions.
+	// Only the days are shifted, not the increment after "/".
+	values := strings.Split(sched[DOW], ",")
+	for i, value := range values {
+		days, step := value, ""
+		if idx := strings.Index(value, "/"); idx != -1 {
+			days, step = value[:idx], value[idx:]
+		}
+		bounds := strings.Split(days, "-")
+		for j, bound := range bounds {
+			if day, err := strconv.Atoi(bound); err == nil {
+				bounds[j] = strconv.Itoa(day - 1)
+			}
+		}
+		values[i] = strings.Join(bounds, "-") + step
+	}
+	sched[DOW] = strings.Join(values, ",")
+	return strings.Join(sched[:YEA], " "), true
+}
@@ -0,0 +1,392 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type jobStatusDescriberMocks struct {
+	stackDescriber   *mocks.MockworkloadStackDescriber
+	executionsLister *mocks.MockjobExecutionLister
+}
+
+func TestJobStatusDescriber_Describe(t *testing.T) {
+	const (
+		mockExecutionARN         = "arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:3f2a9c4e-1b2d-4c3e-9f8a-7b6c5d4e3f2a"
+		mockWorkflowARN          = "arn:aws:states:us-west-2:123456789012:stateMachine:phonetool-test-report-workflow"
+		mockWorkflowExecutionARN = "arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report-workflow:8c1d2e3f"
+	)
+	now := time.Date(2022, time.March, 2, 12, 0, 0, 0, time.UTC)
+	startDate := time.Date(2022, time.March, 2, 9, 0, 0, 0, time.UTC)
+	stopDate := time.Date(2022, time.March, 2, 9, 5, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m jobStatusDescriberMocks)
+
+		wantedStatus *jobStatus
+		wantedError  error
+	}{
+		"error if fail to get the stack parameters": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().Params().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get stack parameters of job report: some error"),
+		},
+		"error if fail to list the executions": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().Params().Return(map[string]string{"Schedule": "cron(0 9 ? * 2-6 *)"}, nil)
+				m.executionsLister.EXPECT().ListJobExecutions("phonetool", "test", "report", jobStatusMaxExecutions).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of job report: some error"),
+		},
+		"error if fail to get the stack outputs": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().Params().Return(map[string]string{"Schedule": "cron(0 9 ? * 2-6 *)"}, nil)
+				m.executionsLister.EXPECT().ListJobExecutions("phonetool", "test", "report", jobStatusMaxExecutions).Return(nil, nil)
+				m.stackDescriber.EXPECT().Outputs().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get stack outputs of job report: some error"),
+		},
+		"error if fail to list the workflow executions": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().Params().Return(map[string]string{"Schedule": "cron(0 9 ? * 2-6 *)"}, nil)
+				m.executionsLister.EXPECT().ListJobExecutions("phonetool", "test", "report", jobStatusMaxExecutions).Return(nil, nil)
+				m.stackDescriber.EXPECT().Outputs().Return(map[string]string{"WorkflowStateMachineArn": mockWorkflowARN}, nil)
+				m.executionsLister.EXPECT().ListWorkflowExecutions(mockWorkflowARN, jobStatusMaxExecutions).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list workflow executions of job report: some error"),
+		},
+		"success with a workflow": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().Params().Return(map[string]string{"Schedule": "rate(1 hour)"}, nil)
+				m.executionsLister.EXPECT().ListJobExecutions("phonetool", "test", "report", jobStatusMaxExecutions).Return(nil, nil)
+				m.stackDescriber.EXPECT().Outputs().Return(map[string]string{"WorkflowStateMachineArn": mockWorkflowARN}, nil)
+				m.executionsLister.EXPECT().ListWorkflowExecutions(mockWorkflowARN, jobStatusMaxExecutions).Return([]*ecs.WorkflowExecutionHistory{
+					{
+						Execution: &stepfunctions.Execution{
+							ARN:       mockWorkflowExecutionARN,
+							Status:    "FAILED",
+							StartDate: startDate,
+							StopDate:  aws.Time(stopDate),
+						},
+						Steps: []*stepfunctions.TaskState{
+							{
+								Name:     "extract",
+								Attempts: 1,
+								Status:   "SUCCEEDED",
+							},
+							{
+								Name:     "orders",
+								Attempts: 3,
+								Status:   "FAILED",
+							},
+							{
+								Name:     "customers",
+								Attempts: 1,
+								Status:   "RUNNING",
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedStatus: &jobStatus{
+				Schedule:   "rate(1 hour)",
+				NextRun:    aws.Time(time.Date(2022, time.March, 2, 13, 0, 0, 0, time.UTC)),
+				LogGroup:   "/copilot/phonetool-test-report",
+				Executions: []*jobExecutionStatus{},
+				WorkflowRuns: []*workflowRunStatus{
+					{
+						ARN:       mockWorkflowExecutionARN,
+						Name:      "8c1d2e3f",
+						Status:    "FAILED",
+						StartedAt: startDate,
+						StoppedAt: aws.Time(stopDate),
+						Steps: []*workflowStepStatus{
+							{
+								Name:     "extract",
+								Status:   "SUCCEEDED",
+								Attempts: 1,
+							},
+							{
+								Name:     "orders",
+								Status:   "FAILED",
+								Attempts: 3,
+							},
+							{
+								Name:     "customers",
+								Status:   "ABORTED",
+								Attempts: 1,
+							},
+						},
+					},
+				},
+			},
+		},
+		"success": {
+			setupMocks: func(m jobStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().Params().Return(map[string]string{"Schedule": "cron(0 9 ? * 2-6 *)"}, nil)
+				m.stackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil)
+				m.executionsLister.EXPECT().ListJobExecutions("phonetool", "test", "report", jobStatusMaxExecutions).Return([]*ecs.JobExecutionHistory{
+					{
+						Execution: &stepfunctions.Execution{
+							ARN:       mockExecutionARN,
+							Status:    "FAILED",
+							StartDate: startDate,
+							StopDate:  aws.Time(stopDate),
+						},
+						Retries:  2,
+						TimedOut: 1,
+						TaskARN:  "arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/4082490ee6c245e09d2145010aa1ba8d",
+						ExitCode: aws.Int(137),
+					},
+				}, nil)
+			},
+			wantedStatus: &jobStatus{
+				Schedule: "cron(0 9 ? * 2-6 *)",
+				NextRun:  aws.Time(time.Date(2022, time.March, 3, 9, 0, 0, 0, time.UTC)),
+				LogGroup: "/copilot/phonetool-test-report",
+				Executions: []*jobExecutionStatus{
+					{
+						ARN:       mockExecutionARN,
+						Name:      "3f2a9c4e-1b2d-4c3e-9f8a-7b6c5d4e3f2a",
+						Status:    "FAILED",
+						StartedAt: startDate,
+						StoppedAt: aws.Time(stopDate),
+						Retries:   2,
+						Timeouts:  1,
+						ExitCode:  aws.Int(137),
+						LogStream: "copilot/report/4082490ee6c245e09d2145010aa1ba8d",
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobStatusDescriberMocks{
+				stackDescriber:   mocks.NewMockworkloadStackDescriber(ctrl),
+				executionsLister: mocks.NewMockjobExecutionLister(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &jobStatusDescriber{
+				app:              "phonetool",
+				env:              "test",
+				job:              "report",
+				stackDescriber:   m.stackDescriber,
+				executionsLister: m.executionsLister,
+				now: func() time.Time {
+					return now
+				},
+			}
+
+			got, err := d.Describe()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStatus, got)
+		})
+	}
+}
+
+func TestJobStatus_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2022-03-02T12:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	testCases := map[string]struct {
+		status *jobStatus
+
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"unscheduled job without executions": {
+			status: &jobStatus{
+				LogGroup:   "/copilot/phonetool-test-report",
+				Executions: []*jobExecutionStatus{},
+			},
+			wantedHumanString: `Schedule
+
+  Expression  None
+  Next Run    -
+  Log Group   /copilot/phonetool-test-report
+
+Recent Executions
+
+  No executions found.
+`,
+			wantedJSONString: "{\"logGroup\":\"/copilot/phonetool-test-report\",\"executions\":[]}\n",
+		},
+		"scheduled job with executions": {
+			status: &jobStatus{
+				Schedule: "cron(0 9 ? * 2-6 *)",
+				NextRun:  aws.Time(time.Date(2022, time.March, 3, 9, 0, 0, 0, time.UTC)),
+				LogGroup: "/copilot/phonetool-test-report",
+				Executions: []*jobExecutionStatus{
+					{
+						ARN:       "arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:6a1b2c3d",
+						Name:      "6a1b2c3d",
+						Status:    "RUNNING",
+						StartedAt: time.Date(2022, time.March, 2, 11, 0, 0, 0, time.UTC),
+					},
+					{
+						ARN:       "arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:3f2a9c4e-1b2d",
+						Name:      "3f2a9c4e-1b2d",
+						Status:    "FAILED",
+						StartedAt: time.Date(2022, time.March, 2, 9, 0, 0, 0, time.UTC),
+						StoppedAt: aws.Time(time.Date(2022, time.March, 2, 9, 5, 0, 0, time.UTC)),
+						Retries:   2,
+						Timeouts:  1,
+						ExitCode:  aws.Int(137),
+						LogStream: "copilot/report/4082490e",
+					},
+				},
+			},
+			wantedHumanString: `Schedule
+
+  Expression  cron(0 9 ? * 2-6 *)
+  Next Run    21 hours from now
+  Log Group   /copilot/phonetool-test-report
+
+Recent Executions
+
+  Name      Status      Started      Stopped      Retries     Timeouts    Exit Code   Log Stream
+  ----      ------      -------      -------      -------     --------    ---------   ----------
+  6a1b2c3d  RUNNING     1 hour ago   -            0           0           -           -
+  3f2a9c4e  FAILED      3 hours ago  2 hours ago  2           1           137         copilot/report/4082490e
+`,
+			wantedJSONString: "{\"schedule\":\"cron(0 9 ? * 2-6 *)\",\"nextRun\":\"2022-03-03T09:00:00Z\",\"logGroup\":\"/copilot/phonetool-test-report\",\"executions\":[{\"arn\":\"arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:6a1b2c3d\",\"name\":\"6a1b2c3d\",\"status\":\"RUNNING\",\"startedAt\":\"2022-03-02T11:00:00Z\",\"retries\":0,\"timeouts\":0},{\"arn\":\"arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report:3f2a9c4e-1b2d\",\"name\":\"3f2a9c4e-1b2d\",\"status\":\"FAILED\",\"startedAt\":\"2022-03-02T09:00:00Z\",\"stoppedAt\":\"2022-03-02T09:05:00Z\",\"retries\":2,\"timeouts\":1,\"exitCode\":137,\"logStream\":\"copilot/report/4082490e\"}]}\n",
+		},
+		"job with workflow runs": {
+			status: &jobStatus{
+				Schedule:   "rate(1 hour)",
+				LogGroup:   "/copilot/phonetool-test-report",
+				Executions: []*jobExecutionStatus{},
+				WorkflowRuns: []*workflowRunStatus{
+					{
+						ARN:       "arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report-workflow:8c1d2e3f",
+						Name:      "8c1d2e3f",
+						Status:    "FAILED",
+						StartedAt: time.Date(2022, time.March, 2, 9, 0, 0, 0, time.UTC),
+						StoppedAt: aws.Time(time.Date(2022, time.March, 2, 10, 0, 0, 0, time.UTC)),
+						Steps: []*workflowStepStatus{
+							{
+								Name:     "extract",
+								Status:   "SUCCEEDED",
+								Attempts: 1,
+							},
+							{
+								Name:     "orders",
+								Status:   "FAILED",
+								Attempts: 3,
+							},
+						},
+					},
+					{
+						ARN:       "arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report-workflow:1a2b3c4d",
+						Name:      "1a2b3c4d",
+						Status:    "RUNNING",
+						StartedAt: time.Date(2022, time.March, 2, 11, 0, 0, 0, time.UTC),
+						Steps:     []*workflowStepStatus{},
+					},
+				},
+			},
+			wantedHumanString: `Schedule
+
+  Expression  rate(1 hour)
+  Next Run    -
+  Log Group   /copilot/phonetool-test-report
+
+Recent Executions
+
+  No executions found.
+
+Recent Workflow Runs
+
+  Name      Status      Started      Stopped      Step        Step Status  Attempts
+  ----      ------      -------      -------      ----        -----------  --------
+  8c1d2e3f  FAILED      3 hours ago  2 hours ago  extract     SUCCEEDED    1
+                                                  orders      FAILED       3
+  1a2b3c4d  RUNNING     1 hour ago   -            -           -            -
+`,
+			wantedJSONString: "{\"schedule\":\"rate(1 hour)\",\"logGroup\":\"/copilot/phonetool-test-report\",\"executions\":[],\"workflowRuns\":[{\"arn\":\"arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report-workflow:8c1d2e3f\",\"name\":\"8c1d2e3f\",\"status\":\"FAILED\",\"startedAt\":\"2022-03-02T09:00:00Z\",\"stoppedAt\":\"2022-03-02T10:00:00Z\",\"steps\":[{\"name\":\"extract\",\"status\":\"SUCCEEDED\",\"attempts\":1},{\"name\":\"orders\",\"status\":\"FAILED\",\"attempts\":3}]},{\"arn\":\"arn:aws:states:us-west-2:123456789012:execution:phonetool-test-report-workflow:1a2b3c4d\",\"name\":\"1a2b3c4d\",\"status\":\"RUNNING\",\"startedAt\":\"2022-03-02T11:00:00Z\",\"steps\":[]}]}\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.status.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHumanString, tc.status.HumanString())
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
+
+func TestNextScheduledRun(t *testing.T) {
+	now := time.Date(2022, time.March, 2, 12, 0, 0, 0, time.UTC) // A Wednesday.
+	testCases := map[string]struct {
+		inExpression string
+		inLastRun    *time.Time
+
+		wanted *time.Time
+	}{
+		"unscheduled job": {
+			inExpression: "",
+		},
+		"cron expression on weekdays": {
+			inExpression: "cron(0 9 ? * 2-6 *)",
+			wanted:       aws.Time(time.Date(2022, time.March, 3, 9, 0, 0, 0, time.UTC)),
+		},
+		"cron expression on sundays": {
+			inExpression: "cron(30 0 ? * 1 *)",
+			wanted:       aws.Time(time.Date(2022, time.March, 6, 0, 30, 0, 0, time.UTC)),
+		},
+		"cron expression on every other day of the week from monday": {
+			inExpression: "cron(0 9 ? * 2/2 *)",
+			wanted:       aws.Time(time.Date(2022, time.March, 4, 9, 0, 0, 0, time.UTC)),
+		},
+		"cron expression on a day of the month": {
+			inExpression: "cron(0 0 1 * ? *)",
+			wanted:       aws.Time(time.Date(2022, time.April, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		"cron expression in a specific year": {
+			inExpression: "cron(0 0 1 * ? 2023)",
+		},
+		"cron expression on the last friday of the month": {
+			inExpression: "cron(0 0 ? * 6L *)",
+		},
+		"rate expression without a previous run": {
+			inExpression: "rate(1 hour)",
+		},
+		"rate expression after the last run": {
+			inExpression: "rate(90 minutes)",
+			inLastRun:    aws.Time(time.Date(2022, time.March, 2, 8, 0, 0, 0, time.UTC)),
+			wanted:       aws.Time(time.Date(2022, time.March, 2, 12, 30, 0, 0, time.UTC)),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, nextScheduledRun(tc.inExpression, now, tc.inLastRun))
+		})
+	}
+}
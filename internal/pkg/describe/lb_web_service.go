@@ -50,6 +50,7 @@ type LBWebServiceDescriber struct {
 	initClients          func(string) error
 	ecsServiceDescribers map[string]ecsDescriber
 	envDescriber         map[string]envDescriber
+	newSvcStackDescriber func(env, svc string) (workloadStackDescriber, error) // Describes the other services of an environment.
 
 	// cache only last svc paramerters
 	svcParams map[string]string
@@ -90,6 +91,14 @@ func NewLBWebServiceDescriber(opt NewServiceConfig) (*LBWebServiceDescriber, err
 		describer.envDescriber[env] = envDescr
 		return nil
 	}
+	describer.newSvcStackDescriber = func(env, svc string) (workloadStackDescriber, error) {
+		return NewECSServiceDescriber(NewServiceConfig{
+			App:         opt.App,
+			Env:         env,
+			Svc:         svc,
+			ConfigStore: opt.ConfigStore,
+		})
+	}
 	return describer, nil
 }
 
@@ -152,6 +161,11 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		}
 		secrets = append(secrets, flattenSecrets(env, webSvcSecrets)...)
 	}
+	serviceConnect, err := describeServiceConnect(d.app, d.svc, environments, d.store, d.ecsServiceDescribers, d.newSvcStackDescriber)
+	if err != nil {
+		return nil, err
+	}
+
 	resources := make(map[string][]*stack.Resource)
 	if d.enableResources {
 		for _, env := range environments {
@@ -174,6 +188,7 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		Configurations:   configs,
 		Routes:           routes,
 		ServiceDiscovery: serviceDiscoveries,
+		ServiceConnect:   serviceConnect,
 		Variables:        envVars,
 		Secrets:          secrets,
 		Resources:        resources,
@@ -269,6 +284,7 @@ type webSvcDesc struct {
 	Configurations   ecsConfigurations    `json:"configurations"`
 	Routes           []*WebServiceRoute   `json:"routes"`
 	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
+	ServiceConnect   serviceConnects      `json:"serviceConnect,omitempty"`
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
 	Resources        deployedSvcResources `json:"resources,omitempty"`
@@ -308,6 +324,11 @@ func (w *webSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
+	if len(w.ServiceConnect) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nService Connect\n\n"))
+		writer.Flush()
+		w.ServiceConnect.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
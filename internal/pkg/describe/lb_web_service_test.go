@@ -17,9 +17,10 @@ import (
 )
 
 type lbWebSvcDescriberMocks struct {
-	storeSvc     *mocks.MockDeployedEnvServicesLister
-	ecsDescriber *mocks.MockecsDescriber
-	envDescriber *mocks.MockenvDescriber
+	storeSvc          *mocks.MockDeployedEnvServicesLister
+	ecsDescriber      *mocks.MockecsDescriber
+	envDescriber      *mocks.MockenvDescriber
+	svcStackDescriber *mocks.MockworkloadStackDescriber
 }
 
 func TestLBWebServiceDescriber_Describe(t *testing.T) {
@@ -236,11 +237,44 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "SHHHHHHHH",
 						},
 					}, nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return(nil, mockErr),
 				)
 			},
 			wantedError: fmt.Errorf("retrieve service resources: some error"),
 		},
+		"return error if fail to retrieve the services that can reach the service with Service Connect": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							LogicalID: svcStackResourceALBTargetGroupLogicalID,
+						},
+					}, nil),
+					m.envDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+						cfnstack.LBWebServiceContainerPortParamKey: "80",
+						cfnstack.LBWebServiceRulePathParamKey:      testSvcPath,
+					}, nil),
+					m.ecsDescriber.EXPECT().Platform().Return(&ecs.ContainerPlatform{
+						OperatingSystem: "LINUX",
+						Architecture:    "X86_64",
+					}, nil),
+					m.ecsDescriber.EXPECT().EnvVars().Return(nil, nil),
+					m.envDescriber.EXPECT().ServiceDiscoveryEndpoint().Return("test.phonetool.local", nil),
+					m.ecsDescriber.EXPECT().Secrets().Return(nil, nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						"ServiceConnectEndpoint": "jobs:80",
+					}, nil),
+					m.storeSvc.EXPECT().ListDeployedServices(testApp, testEnv).Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("list deployed services in environment test: some error"),
+		},
 		"success for ALB service": {
 			shouldOutputResources: true,
 			setupMocks: func(m lbWebSvcDescriberMocks) {
@@ -316,6 +350,16 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 							ValueFrom: "SHHHHHHHH",
 						},
 					}, nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{
+						"ServiceConnectEnabled":  "true",
+						"ServiceConnectEndpoint": "jobs:5000",
+					}, nil),
+					m.storeSvc.EXPECT().ListDeployedServices(testApp, testEnv).Return([]string{"api", "jobs", "worker"}, nil),
+					m.svcStackDescriber.EXPECT().Outputs().Return(map[string]string{
+						"ServiceConnectEnabled": "true",
+					}, nil),
+					m.svcStackDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
+					m.ecsDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.ecsDescriber.EXPECT().ServiceStackResources().Return([]*stack.Resource{
 						{
 							Type:       "AWS::EC2::SecurityGroupIngress",
@@ -376,6 +420,13 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 						Namespace:   "jobs.prod.phonetool.local:5000",
 					},
 				},
+				ServiceConnect: []*ServiceConnect{
+					{
+						Environment: "test",
+						Endpoint:    "jobs:5000",
+						Clients:     []string{"api"},
+					},
+				},
 				Variables: []*containerEnvVar{
 					{
 						envVar: &envVar{
@@ -433,12 +484,14 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
-			mockSvcStackDescriber := mocks.NewMockecsDescriber(ctrl)
+			mockSvcDescriber := mocks.NewMockecsDescriber(ctrl)
 			mockEnvDescriber := mocks.NewMockenvDescriber(ctrl)
+			mockSvcStackDescriber := mocks.NewMockworkloadStackDescriber(ctrl)
 			mocks := lbWebSvcDescriberMocks{
-				storeSvc:     mockStore,
-				ecsDescriber: mockSvcStackDescriber,
-				envDescriber: mockEnvDescriber,
+				storeSvc:          mockStore,
+				ecsDescriber:      mockSvcDescriber,
+				envDescriber:      mockEnvDescriber,
+				svcStackDescriber: mockSvcStackDescriber,
 			}
 
 			tc.setupMocks(mocks)
@@ -451,13 +504,16 @@ func TestLBWebServiceDescriber_Describe(t *testing.T) {
 				initClients:     func(string) error { return nil },
 
 				ecsServiceDescribers: map[string]ecsDescriber{
-					"test": mockSvcStackDescriber,
-					"prod": mockSvcStackDescriber,
+					"test": mockSvcDescriber,
+					"prod": mockSvcDescriber,
 				},
 				envDescriber: map[string]envDescriber{
 					"test": mockEnvDescriber,
 					"prod": mockEnvDescriber,
 				},
+				newSvcStackDescriber: func(_, _ string) (workloadStackDescriber, error) {
+					return mockSvcStackDescriber, nil
+				},
 			}
 
 			// WHEN
@@ -507,6 +563,12 @@ Service Discovery
   test         http://my-svc.test.my-app.local:5000
   prod         http://my-svc.prod.my-app.local:5000
 
+Service Connect
+
+  Environment  Endpoint     Reachable From
+  -----------  --------     --------------
+  test         my-svc:5000  api, worker
+
 Variables
 
   Name                      Container   Environment  Value
@@ -530,7 +592,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"256\",\"memory\":\"512\",\"platform\":\"LINUX/X86_64\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"5000\",\"cpu\":\"512\",\"memory\":\"1024\",\"platform\":\"LINUX/ARM64\",\"tasks\":\"3\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\"],\"namespace\":\"http://my-svc.test.my-app.local:5000\"},{\"environment\":[\"prod\"],\"namespace\":\"http://my-svc.prod.my-app.local:5000\"}],\"variables\":[{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"containerA\"},{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"containerB\"},{\"environment\":\"prod\",\"name\":\"DIFFERENT_ENV_VAR\",\"value\":\"prod\",\"container\":\"containerB\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"containerA\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"containerB\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"cpu\":\"256\",\"memory\":\"512\",\"platform\":\"LINUX/X86_64\",\"tasks\":\"1\"},{\"environment\":\"prod\",\"port\":\"5000\",\"cpu\":\"512\",\"memory\":\"1024\",\"platform\":\"LINUX/ARM64\",\"tasks\":\"3\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\"],\"namespace\":\"http://my-svc.test.my-app.local:5000\"},{\"environment\":[\"prod\"],\"namespace\":\"http://my-svc.prod.my-app.local:5000\"}],\"serviceConnect\":[{\"environment\":\"test\",\"endpoint\":\"my-svc:5000\",\"reachableFrom\":[\"api\",\"worker\"]}],\"variables\":[{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\",\"container\":\"containerA\"},{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\",\"container\":\"containerB\"},{\"environment\":\"prod\",\"name\":\"DIFFERENT_ENV_VAR\",\"value\":\"prod\",\"container\":\"containerB\"}],\"secrets\":[{\"name\":\"GITHUB_WEBHOOK_SECRET\",\"container\":\"containerA\",\"environment\":\"test\",\"valueFrom\":\"GH_WEBHOOK_SECRET\"},{\"name\":\"SOME_OTHER_SECRET\",\"container\":\"containerB\",\"environment\":\"prod\",\"valueFrom\":\"SHHHHH\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -618,6 +680,13 @@ Resources
 					Namespace:   "http://my-svc.prod.my-app.local:5000",
 				},
 			}
+			serviceConnect := []*ServiceConnect{
+				{
+					Environment: "test",
+					Endpoint:    "my-svc:5000",
+					Clients:     []string{"api", "worker"},
+				},
+			}
 			resources := map[string][]*stack.Resource{
 				"test": {
 					{
@@ -641,6 +710,7 @@ Resources
 				Secrets:          secrets,
 				Routes:           routes,
 				ServiceDiscovery: sds,
+				ServiceConnect:   serviceConnect,
 				Resources:        resources,
 				environments:     []string{"test", "prod"},
 			}
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -48,3 +49,41 @@ func (mr *MockvpcSubnetListerMockRecorder) ListVPCSubnets(vpcID interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCSubnets", reflect.TypeOf((*MockvpcSubnetLister)(nil).ListVPCSubnets), vpcID)
 }
+
+// MocklistenerRuleLister is a mock of listenerRuleLister interface.
+type MocklistenerRuleLister struct {
+	ctrl     *gomock.Controller
+	recorder *MocklistenerRuleListerMockRecorder
+}
+
+// MocklistenerRuleListerMockRecorder is the mock recorder for MocklistenerRuleLister.
+type MocklistenerRuleListerMockRecorder struct {
+	mock *MocklistenerRuleLister
+}
+
+// NewMocklistenerRuleLister creates a new mock instance.
+func NewMocklistenerRuleLister(ctrl *gomock.Controller) *MocklistenerRuleLister {
+	mock := &MocklistenerRuleLister{ctrl: ctrl}
+	mock.recorder = &MocklistenerRuleListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklistenerRuleLister) EXPECT() *MocklistenerRuleListerMockRecorder {
+	return m.recorder
+}
+
+// ListenerRules mocks base method.
+func (m *MocklistenerRuleLister) ListenerRules(listenerARN string) ([]elbv2.ListenerRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenerRules", listenerARN)
+	ret0, _ := ret[0].([]elbv2.ListenerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListenerRules indicates an expected call of ListenerRules.
+func (mr *MocklistenerRuleListerMockRecorder) ListenerRules(listenerARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRules", reflect.TypeOf((*MocklistenerRuleLister)(nil).ListenerRules), listenerARN)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/job_status.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	ecs "github.com/aws/copilot-cli/internal/pkg/ecs"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockjobExecutionLister is a mock of jobExecutionLister interface.
+type MockjobExecutionLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobExecutionListerMockRecorder
+}
+
+// MockjobExecutionListerMockRecorder is the mock recorder for MockjobExecutionLister.
+type MockjobExecutionListerMockRecorder struct {
+	mock *MockjobExecutionLister
+}
+
+// NewMockjobExecutionLister creates a new mock instance.
+func NewMockjobExecutionLister(ctrl *gomock.Controller) *MockjobExecutionLister {
+	mock := &MockjobExecutionLister{ctrl: ctrl}
+	mock.recorder = &MockjobExecutionListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobExecutionLister) EXPECT() *MockjobExecutionListerMockRecorder {
+	return m.recorder
+}
+
+// ListJobExecutions mocks base method.
+func (m *MockjobExecutionLister) ListJobExecutions(app, env, job string, maxResults int) ([]*ecs.JobExecutionHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobExecutions", app, env, job, maxResults)
+	ret0, _ := ret[0].([]*ecs.JobExecutionHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobExecutions indicates an expected call of ListJobExecutions.
+func (mr *MockjobExecutionListerMockRecorder) ListJobExecutions(app, env, job, maxResults interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobExecutions", reflect.TypeOf((*MockjobExecutionLister)(nil).ListJobExecutions), app, env, job, maxResults)
+}
+
+// ListWorkflowExecutions mocks base method.
+func (m *MockjobExecutionLister) ListWorkflowExecutions(stateMachineARN string, maxResults int) ([]*ecs.WorkflowExecutionHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowExecutions", stateMachineARN, maxResults)
+	ret0, _ := ret[0].([]*ecs.WorkflowExecutionHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowExecutions indicates an expected call of ListWorkflowExecutions.
+func (mr *MockjobExecutionListerMockRecorder) ListWorkflowExecutions(stateMachineARN, maxResults interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowExecutions", reflect.TypeOf((*MockjobExecutionLister)(nil).ListWorkflowExecutions), stateMachineARN, maxResults)
+}
@@ -0,0 +1,140 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// SecretParameter contains serialized metadata of the SSM parameter of a secret in an environment.
+// The value of the secret is never included.
+type SecretParameter struct {
+	Environment      string    `json:"environment"`
+	Name             string    `json:"parameter"`
+	Version          int64     `json:"version"`
+	LastModifiedDate time.Time `json:"lastModifiedDate"`
+	LastModifiedUser string    `json:"lastModifiedUser"`
+}
+
+// SecretConsumer is a local workload whose manifest refers to a secret in an environment.
+type SecretConsumer struct {
+	Environment   string `json:"environment"`
+	Workload      string `json:"workload"`
+	PinnedVersion *int   `json:"pinnedVersion,omitempty"` // Nil if the workload uses the latest version.
+}
+
+// Secret contains serialized metadata of a secret across the environments of an application.
+type Secret struct {
+	Name       string             `json:"name"`
+	App        string             `json:"application"`
+	Parameters []*SecretParameter `json:"parameters"`
+	Consumers  []*SecretConsumer  `json:"consumers"`
+}
+
+// JSONString returns the stringified Secret struct with json format.
+func (s *Secret) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal secret: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified Secret struct with human readable format.
+func (s *Secret) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", s.Name)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Application", s.App)
+	writer.Flush()
+	fmt.Fprint(writer, color.Bold.Sprint("\nEnvironments\n\n"))
+	writer.Flush()
+	headers := []string{"Environment", "Parameter", "Version", "Last Modified", "Last Modified By"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, param := range s.Parameters {
+		fmt.Fprintf(writer, "  %s\t%s\t%d\t%s\t%s\n", param.Environment, param.Name, param.Version,
+			humanizeTime(param.LastModifiedDate), param.LastModifiedUser)
+	}
+	writer.Flush()
+	fmt.Fprint(writer, color.Bold.Sprint("\nConsumers\n\n"))
+	writer.Flush()
+	if len(s.Consumers) == 0 {
+		fmt.Fprintf(writer, "  %s\n", "No workload in the workspace refers to this secret.")
+		writer.Flush()
+		return b.String()
+	}
+	headers = []string{"Environment", "Workload", "Version"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, consumer := range s.Consumers {
+		version := "latest"
+		if consumer.PinnedVersion != nil {
+			version = strconv.Itoa(*consumer.PinnedVersion)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", consumer.Environment, consumer.Workload, version)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// SecretList contains serialized metadata of the secrets of an application.
+type SecretList struct {
+	App     string    `json:"application"`
+	Secrets []*Secret `json:"secrets"`
+}
+
+// JSONString returns the stringified SecretList struct with json format.
+func (l *SecretList) JSONString() (string, error) {
+	type secret struct {
+		Name       string             `json:"name"`
+		Parameters []*SecretParameter `json:"parameters"`
+	}
+	out := struct {
+		App     string    `json:"application"`
+		Secrets []*secret `json:"secrets"`
+	}{
+		App:     l.App,
+		Secrets: make([]*secret, len(l.Secrets)),
+	}
+	for i, s := range l.Secrets {
+		out.Secrets[i] = &secret{
+			Name:       s.Name,
+			Parameters: s.Parameters,
+		}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return "", fmt.Errorf("marshal secrets: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified SecretList struct with human readable format.
+func (l *SecretList) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Name", "Environment", "Version", "Last Modified"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underline(headers), "\t"))
+	for _, s := range l.Secrets {
+		name := s.Name
+		for _, param := range s.Parameters {
+			fmt.Fprintf(writer, "%s\t%s\t%d\t%s\n", name, param.Environment, param.Version, humanizeTime(param.LastModifiedDate))
+			name = dittoSymbol
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
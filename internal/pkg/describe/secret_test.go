@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecret_HumanString(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		return "2 days ago"
+	}
+	defer func() { humanizeTime = oldHumanize }()
+
+	testCases := map[string]struct {
+		in     *Secret
+		wanted string
+	}{
+		"without consumers": {
+			in: &Secret{
+				Name: "db-password",
+				App:  "phonetool",
+				Parameters: []*SecretParameter{
+					{
+						Environment:      "test",
+						Name:             "/copilot/phonetool/test/secrets/db-password",
+						Version:          2,
+						LastModifiedUser: "alice",
+					},
+				},
+			},
+			wanted: `About
+
+  Name         db-password
+  Application  phonetool
+
+Environments
+
+  Environment  Parameter                                    Version   Last Modified  Last Modified By
+  -----------  ---------                                    -------   -------------  ----------------
+  test         /copilot/phonetool/test/secrets/db-password  2         2 days ago     alice
+
+Consumers
+
+  No workload in the workspace refers to this secret.
+`,
+		},
+		"with consumers": {
+			in: &Secret{
+				Name: "db-password",
+				App:  "phonetool",
+				Parameters: []*SecretParameter{
+					{
+						Environment:      "test",
+						Name:             "/copilot/phonetool/test/secrets/db-password",
+						Version:          2,
+						LastModifiedUser: "alice",
+					},
+				},
+				Consumers: []*SecretConsumer{
+					{Environment: "test", Workload: "api"},
+					{Environment: "test", Workload: "worker", PinnedVersion: aws.Int(1)},
+				},
+			},
+			wanted: `About
+
+  Name         db-password
+  Application  phonetool
+
+Environments
+
+  Environment  Parameter                                    Version   Last Modified  Last Modified By
+  -----------  ---------                                    -------   -------------  ----------------
+  test         /copilot/phonetool/test/secrets/db-password  2         2 days ago     alice
+
+Consumers
+
+  Environment  Workload  Version
+  -----------  --------  -------
+  test         api       latest
+  test         worker    1
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HumanString())
+		})
+	}
+}
+
+func TestSecretList_HumanString(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		return "2 days ago"
+	}
+	defer func() { humanizeTime = oldHumanize }()
+
+	list := &SecretList{
+		App: "phonetool",
+		Secrets: []*Secret{
+			{
+				Name: "db-password",
+				Parameters: []*SecretParameter{
+					{Environment: "test", Version: 2},
+					{Environment: "prod", Version: 1},
+				},
+			},
+			{
+				Name: "token",
+				Parameters: []*SecretParameter{
+					{Environment: "prod", Version: 5},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, `Name         Environment  Version   Last Modified
+----         -----------  -------   -------------
+db-password  test         2         2 days ago
+  "          prod         1         2 days ago
+token        prod         5         2 days ago
+`, list.HumanString())
+}
+
+func TestSecretList_JSONString(t *testing.T) {
+	list := &SecretList{
+		App: "phonetool",
+		Secrets: []*Secret{
+			{
+				Name: "token",
+				Parameters: []*SecretParameter{
+					{
+						Environment:      "prod",
+						Name:             "/copilot/phonetool/prod/secrets/token",
+						Version:          5,
+						LastModifiedDate: time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC),
+						LastModifiedUser: "alice",
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := list.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"application":"phonetool","secrets":[{"name":"token","parameters":[{"environment":"prod","parameter":"/copilot/phonetool/prod/secrets/token","version":5,"lastModifiedDate":"2022-03-01T10:00:00Z","lastModifiedUser":"alice"}]}]}`+"\n", actual)
+}
@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -22,6 +23,7 @@ import (
 // Cmd is the interface implemented by external commands.
 type Cmd interface {
 	Run(name string, args []string, options ...exec.CmdOption) error
+	InteractiveRun(name string, args []string, options ...exec.CmdOption) error
 }
 
 // Operating systems and architectures supported by docker.
@@ -67,6 +69,31 @@ type BuildArguments struct {
 	Args       map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
 }
 
+// RunOptions holds the options to run a container with `docker run`.
+type RunOptions struct {
+	ImageURI      string            // Required. The image to run.
+	ContainerName string            // Optional. Name to assign to the container.
+	Network       string            // Optional. Network to connect the container to.
+	NetworkAlias  string            // Optional. Hostname of the container within the network.
+	Ports         map[string]string // Optional. Host ports mapped to container ports, for example "8080": "80/tcp".
+	EnvVars       map[string]string // Optional. Environment variables to set in the container.
+	Secrets       map[string]string // Optional. Environment variables whose values are kept out of the command line.
+	EnvFile       string            // Optional. Path to a file of environment variables to set in the container.
+	EntryPoint    []string          // Optional. Overrides the default entrypoint of the image.
+	Command       []string          // Optional. Overrides the default command of the image.
+	HealthCheck   *HealthCheck      // Optional. Healthcheck of the container.
+	Detach        bool              // Optional. Run the container in the background instead of streaming its output.
+}
+
+// HealthCheck holds the healthcheck configuration of a container.
+type HealthCheck struct {
+	Command     string
+	Interval    time.Duration
+	Timeout     time.Duration
+	StartPeriod time.Duration
+	Retries     int
+}
+
 type dockerConfig struct {
 	CredsStore  string            `json:"credsStore,omitempty"`
 	CredHelpers map[string]string `json:"credHelpers,omitempty"`
@@ -163,6 +190,95 @@ func (c CmdClient) Push(uri string, tags ...string) (digest string, err error) {
 	return parts[1], nil
 }
 
+// Run will run a `docker run` command with the given options. The container is removed once it stops.
+// Unless the container is detached, its output is streamed until it exits.
+func (c CmdClient) Run(in *RunOptions) error {
+	args := []string{"run", "--rm"}
+	if in.Detach {
+		args = append(args, "--detach")
+	}
+	if in.ContainerName != "" {
+		args = append(args, "--name", in.ContainerName)
+	}
+	if in.Network != "" {
+		args = append(args, "--network", in.Network)
+	}
+	if in.NetworkAlias != "" {
+		args = append(args, "--network-alias", in.NetworkAlias)
+	}
+	for _, host := range sortedKeys(in.Ports) {
+		args = append(args, "--publish", fmt.Sprintf("%s:%s", host, in.Ports[host]))
+	}
+	if in.EnvFile != "" {
+		args = append(args, "--env-file", in.EnvFile)
+	}
+	for _, k := range sortedKeys(in.EnvVars) {
+		args = append(args, "--env", fmt.Sprintf("%s=%s", k, in.EnvVars[k]))
+	}
+	// Secrets are set in the environment of the docker client, which passes them on to the container,
+	// so that their values don't show up in the process list or the shell history.
+	var secrets []string
+	for _, k := range sortedKeys(in.Secrets) {
+		args = append(args, "--env", k)
+		secrets = append(secrets, fmt.Sprintf("%s=%s", k, in.Secrets[k]))
+	}
+	if hc := in.HealthCheck; hc != nil {
+		args = append(args,
+			"--health-cmd", hc.Command,
+			"--health-interval", hc.Interval.String(),
+			"--health-timeout", hc.Timeout.String(),
+			"--health-start-period", hc.StartPeriod.String(),
+			"--health-retries", fmt.Sprintf("%d", hc.Retries))
+	}
+	// "--entrypoint" only accepts the executable, the rest of the entrypoint is prepended to the command.
+	var command []string
+	if len(in.EntryPoint) > 0 {
+		args = append(args, "--entrypoint", in.EntryPoint[0])
+		command = append(command, in.EntryPoint[1:]...)
+	}
+	args = append(args, in.ImageURI)
+	args = append(args, append(command, in.Command...)...)
+
+	var opts []exec.CmdOption
+	if len(secrets) > 0 {
+		opts = append(opts, exec.Env(secrets))
+	}
+	if in.Detach {
+		if err := c.runner.Run("docker", args, opts...); err != nil {
+			return fmt.Errorf("run container %s: %w", in.ImageURI, err)
+		}
+		return nil
+	}
+	if err := c.runner.InteractiveRun("docker", args, opts...); err != nil {
+		return fmt.Errorf("run container %s: %w", in.ImageURI, err)
+	}
+	return nil
+}
+
+// RemoveContainer will run a `docker rm` command to stop and remove the container.
+func (c CmdClient) RemoveContainer(name string) error {
+	if err := c.runner.Run("docker", []string{"rm", "--force", name}); err != nil {
+		return fmt.Errorf("remove container %s: %w", name, err)
+	}
+	return nil
+}
+
+// CreateNetwork will run a `docker network create` command to create a bridge network.
+func (c CmdClient) CreateNetwork(name string) error {
+	if err := c.runner.Run("docker", []string{"network", "create", name}); err != nil {
+		return fmt.Errorf("create network %s: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveNetwork will run a `docker network rm` command to remove the network.
+func (c CmdClient) RemoveNetwork(name string) error {
+	if err := c.runner.Run("docker", []string{"network", "rm", name}); err != nil {
+		return fmt.Errorf("remove network %s: %w", name, err)
+	}
+	return nil
+}
+
 // CheckDockerEngineRunning will run `docker info` command to check if the docker engine is running.
 func (c CmdClient) CheckDockerEngineRunning() error {
 	if _, err := osexec.LookPath("docker"); err != nil {
@@ -215,6 +331,16 @@ func (c CmdClient) GetPlatform() (os, arch string, err error) {
 	return platform.OS, platform.Arch, nil
 }
 
+func sortedKeys(m map[string]string) []string {
+	// Sort the keys so that the arguments are stable.
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func imageName(uri, tag string) string {
 	if tag == "" {
 		return uri // If no tag is specified build with latest.
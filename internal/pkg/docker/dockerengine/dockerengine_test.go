@@ -10,6 +10,7 @@ import (
 	osexec "os/exec"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 
@@ -297,6 +298,94 @@ func TestDockerCommand_Push(t *testing.T) {
 	})
 }
 
+func TestDockerCommand_Run(t *testing.T) {
+	t.Run("runs a detached container with all options", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", []string{"run", "--rm", "--detach",
+			"--name", "phonetool-test-frontend-nginx",
+			"--network", "phonetool-test-frontend",
+			"--network-alias", "nginx",
+			"--publish", "80:80/tcp",
+			"--env-file", "/ws/frontend.env",
+			"--env", "LOG_LEVEL=info",
+			"--env", "PORT=80",
+			"--env", "DB_PASSWORD",
+			"--health-cmd", "curl -f http://localhost/ || exit 1",
+			"--health-interval", "10s",
+			"--health-timeout", "5s",
+			"--health-start-period", "0s",
+			"--health-retries", "2",
+			"--entrypoint", "/bin/sh",
+			"nginx", "-c", "nginx -g 'daemon off;'"}, gomock.Any()).Return(nil)
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		err := cmd.Run(&RunOptions{
+			ImageURI:      "nginx",
+			ContainerName: "phonetool-test-frontend-nginx",
+			Network:       "phonetool-test-frontend",
+			NetworkAlias:  "nginx",
+			Ports:         map[string]string{"80": "80/tcp"},
+			EnvVars:       map[string]string{"PORT": "80", "LOG_LEVEL": "info"},
+			Secrets:       map[string]string{"DB_PASSWORD": "hunter2"},
+			EnvFile:       "/ws/frontend.env",
+			EntryPoint:    []string{"/bin/sh", "-c"},
+			Command:       []string{"nginx -g 'daemon off;'"},
+			HealthCheck: &HealthCheck{
+				Command:  "curl -f http://localhost/ || exit 1",
+				Interval: 10 * time.Second,
+				Timeout:  5 * time.Second,
+				Retries:  2,
+			},
+			Detach: true,
+		})
+
+		// THEN
+		require.NoError(t, err)
+	})
+	t.Run("streams the output of a container in the foreground", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().InteractiveRun("docker", []string{"run", "--rm", "--name", "frontend", "--publish", "8080:8080", "frontend"}).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		err := cmd.Run(&RunOptions{
+			ImageURI:      "frontend",
+			ContainerName: "frontend",
+			Ports:         map[string]string{"8080": "8080"},
+		})
+
+		// THEN
+		require.EqualError(t, err, "run container frontend: some error")
+	})
+}
+
+func TestDockerCommand_Networks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := NewMockCmd(ctrl)
+	m.EXPECT().Run("docker", []string{"network", "create", "phonetool-test-frontend"}).Return(nil)
+	m.EXPECT().Run("docker", []string{"rm", "--force", "phonetool-test-frontend-nginx"}).Return(errors.New("some error"))
+	m.EXPECT().Run("docker", []string{"network", "rm", "phonetool-test-frontend"}).Return(nil)
+	cmd := CmdClient{
+		runner: m,
+	}
+
+	require.NoError(t, cmd.CreateNetwork("phonetool-test-frontend"))
+	require.EqualError(t, cmd.RemoveContainer("phonetool-test-frontend-nginx"), "remove container phonetool-test-frontend-nginx: some error")
+	require.NoError(t, cmd.RemoveNetwork("phonetool-test-frontend"))
+}
+
 func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 	mockError := errors.New("some error")
 	var mockCmd *MockCmd
@@ -34,6 +34,25 @@ func (m *MockCmd) EXPECT() *MockCmdMockRecorder {
 	return m.recorder
 }
 
+// InteractiveRun mocks base method.
+func (m *MockCmd) InteractiveRun(name string, args []string, options ...exec.CmdOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name, args}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InteractiveRun", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InteractiveRun indicates an expected call of InteractiveRun.
+func (mr *MockCmdMockRecorder) InteractiveRun(name, args interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name, args}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InteractiveRun", reflect.TypeOf((*MockCmd)(nil).InteractiveRun), varargs...)
+}
+
 // Run mocks base method.
 func (m *MockCmd) Run(name string, args []string, options ...exec.CmdOption) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,436 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/spf13/afero"
+)
+
+const (
+	// Markers delimiting the output of the scripts run in the container,
+	// so that the messages of the session manager plugin are ignored.
+	cpBeginMarker            = "COPILOT_CP_BEGIN"
+	cpEndMarker              = "COPILOT_CP_END"
+	cpChecksumMismatchMarker = "COPILOT_CP_CHECKSUM_MISMATCH"
+	cpTooLargeMarker         = "COPILOT_CP_TOO_LARGE"
+
+	// Number of base64 characters sent to the container with each command.
+	// Each command starts a new session, so chunks are as large as possible while keeping the command
+	// below the 128 KiB limit of a single argument on Linux.
+	cpChunkSize = 96 * 1024
+	// Maximum size of the tar archive copied in either direction, so that an upload takes at most about 70 sessions.
+	cpMaxArchiveSize = 5 << 20
+
+	fmtCpTempFile        = "/tmp/copilot-cp-%d"
+	cpRemotePathBadChars = "'\"`$\\\n"
+)
+
+var (
+	errCpMissingTools    = errors.New(`make sure the path exists and the container has "tar", "base64" and "sha256sum" installed`)
+	errCpArchiveTooLarge = fmt.Errorf("exceeds the size limit of %d MiB", cpMaxArchiveSize>>20)
+)
+
+type commandOutputExecutor interface {
+	ExecuteCommandWithOutput(in ecs.ExecuteCommandInput, w io.Writer) error
+}
+
+// FileCopier copies files and directories between the local machine and a running container.
+// Files are archived with tar and sent base64 encoded over ECS Exec sessions,
+// and the checksum of the archive is verified on the receiving end.
+// Archives are limited to 5 MiB: uploads are sent in chunks of about 72 KiB with one session per chunk,
+// and downloads are streamed from a single session.
+type FileCopier struct {
+	cluster   string
+	task      string
+	container string
+
+	fs       afero.Fs
+	exec     commandOutputExecutor
+	progress io.Writer
+	tempFile func() string
+}
+
+// CopyResult holds the archive that was copied.
+type CopyResult struct {
+	Files    int    // Number of files and directories copied.
+	Bytes    int    // Size of the tar archive.
+	Checksum string // SHA-256 checksum of the tar archive.
+}
+
+// NewFileCopier returns a FileCopier between fs and the container of the task. The progress of copies is written to progress.
+func NewFileCopier(s *session.Session, fs afero.Fs, cluster, task, container string, progress io.Writer) *FileCopier {
+	return &FileCopier{
+		cluster:   cluster,
+		task:      task,
+		container: container,
+		fs:        fs,
+		exec:      ecs.New(s),
+		progress:  progress,
+		tempFile: func() string {
+			return fmt.Sprintf(fmtCpTempFile, time.Now().UnixNano())
+		},
+	}
+}
+
+// Upload copies the local file or directory to the container.
+// If remotePath ends with a slash, the file or directory is copied under it, otherwise it is copied to remotePath.
+func (c *FileCopier) Upload(localPath, remotePath string) (*CopyResult, error) {
+	if err := validateRemotePath(remotePath); err != nil {
+		return nil, err
+	}
+	destDir, name := path.Dir(remotePath), path.Base(remotePath)
+	if strings.HasSuffix(remotePath, "/") {
+		destDir, name = path.Clean(remotePath), filepath.Base(localPath)
+	}
+	archive, files, err := tarPath(c.fs, localPath, name)
+	if err != nil {
+		return nil, fmt.Errorf("archive %s: %w", localPath, err)
+	}
+	checksum := sha256Hex(archive)
+	encoded := base64.StdEncoding.EncodeToString(archive)
+	tmp := c.tempFile()
+
+	for start := 0; start < len(encoded); start += cpChunkSize {
+		end := start + cpChunkSize
+		if end > len(encoded) {
+			end = len(encoded)
+		}
+		redirect := ">>"
+		if start == 0 {
+			redirect = ">"
+		}
+		if _, err := c.run(fmt.Sprintf("printf %%s %s %s %s.b64", encoded[start:end], redirect, tmp)); err != nil {
+			return nil, fmt.Errorf("upload archive to container %s: %w", c.container, err)
+		}
+		fmt.Fprintf(c.progress, "\rUploading %s: %d%%", localPath, end*100/len(encoded))
+	}
+	fmt.Fprintln(c.progress)
+
+	out, err := c.run(fmt.Sprintf(`base64 -d %[1]s.b64 > %[1]s.tar && if echo "%[2]s  %[1]s.tar" | sha256sum -c - >/dev/null 2>&1; then mkdir -p "%[3]s" && tar xf %[1]s.tar -C "%[3]s" && echo %[4]s; else echo %[5]s; fi; rm -f %[1]s.b64 %[1]s.tar`,
+		tmp, checksum, destDir, cpEndMarker, cpChecksumMismatchMarker))
+	if err != nil {
+		return nil, fmt.Errorf("extract archive in container %s: %w", c.container, err)
+	}
+	switch {
+	case strings.Contains(out, cpChecksumMismatchMarker):
+		return nil, fmt.Errorf("checksum of the archive uploaded to container %s does not match %s", c.container, checksum)
+	case !strings.Contains(out, cpEndMarker):
+		return nil, fmt.Errorf("extract archive into %s in container %s: %w", destDir, c.container, errCpMissingTools)
+	}
+	return &CopyResult{
+		Files:    files,
+		Bytes:    len(archive),
+		Checksum: checksum,
+	}, nil
+}
+
+// Download copies the file or directory in the container to the local machine.
+// If localPath is an existing directory or ends with a path separator, the file or directory is copied under it,
+// otherwise it is copied to localPath.
+func (c *FileCopier) Download(remotePath, localPath string) (*CopyResult, error) {
+	if err := validateRemotePath(remotePath); err != nil {
+		return nil, err
+	}
+	srcDir, name := path.Dir(path.Clean(remotePath)), path.Base(path.Clean(remotePath))
+	root := localPath
+	if info, err := c.fs.Stat(localPath); (err == nil && info.IsDir()) || strings.HasSuffix(localPath, string(filepath.Separator)) {
+		root = filepath.Join(localPath, name)
+	}
+	tmp := c.tempFile()
+	fmt.Fprintf(c.progress, "Downloading %s from container %s\n", remotePath, c.container)
+
+	// The archive is extracted while it is streamed from the session.
+	pr, pw := io.Pipe()
+	stream := &archiveStream{w: pw}
+	sessErr := make(chan error, 1)
+	go func() {
+		err := c.stream(fmt.Sprintf(`cd "%[2]s" && tar cf %[1]s.tar "%[3]s" && if [ $(($(wc -c < %[1]s.tar))) -le %[6]d ]; then echo %[4]s && sha256sum %[1]s.tar && base64 %[1]s.tar && echo %[5]s; else echo %[7]s; fi; rm -f %[1]s.tar`,
+			tmp, srcDir, name, cpBeginMarker, cpEndMarker, cpMaxArchiveSize, cpTooLargeMarker), stream)
+		if closeErr := stream.Close(); err == nil {
+			err = closeErr
+		}
+		pw.CloseWithError(err)
+		sessErr <- err
+	}()
+	hash := sha256.New()
+	archive := &limitedReader{r: io.TeeReader(base64.NewDecoder(base64.StdEncoding, pr), hash), n: cpMaxArchiveSize}
+	files, err := untar(c.fs, archive, name, root)
+	if err == nil {
+		// Read the padding after the end of the archive to compute its checksum.
+		_, err = io.Copy(io.Discard, archive)
+	}
+	pr.CloseWithError(err) // Stop the session early if the archive can't be extracted.
+	if sErr := <-sessErr; sErr != nil && (err == nil || errors.Is(err, sErr)) {
+		var streamErr *errCpArchiveStream
+		if errors.As(sErr, &streamErr) {
+			return nil, fmt.Errorf("archive %s in container %s: %w", remotePath, c.container, streamErr.err)
+		}
+		return nil, fmt.Errorf("download archive from container %s: %w", c.container, sErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("extract archive to %s: %w", localPath, err)
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); actual != stream.checksum {
+		return nil, fmt.Errorf("checksum %s of the archive downloaded from container %s does not match %s", actual, c.container, stream.checksum)
+	}
+	return &CopyResult{
+		Files:    files,
+		Bytes:    int(cpMaxArchiveSize - archive.n),
+		Checksum: stream.checksum,
+	}, nil
+}
+
+// run runs the script with a shell in the container and returns its output.
+func (c *FileCopier) run(script string) (string, error) {
+	buf := new(bytes.Buffer)
+	err := c.stream(script, buf)
+	// The session manager plugin may end lines with a carriage return.
+	return strings.ReplaceAll(buf.String(), "\r\n", "\n"), err
+}
+
+// stream runs the script with a shell in the container and writes its output to w as it is received.
+func (c *FileCopier) stream(script string, w io.Writer) error {
+	return c.exec.ExecuteCommandWithOutput(ecs.ExecuteCommandInput{
+		Cluster:   c.cluster,
+		Command:   fmt.Sprintf("/bin/sh -c '%s'", script),
+		Task:      c.task,
+		Container: c.container,
+	}, w)
+}
+
+// errCpArchiveStream is returned when the output of a session doesn't contain the archive.
+type errCpArchiveStream struct {
+	err error
+}
+
+func (e *errCpArchiveStream) Error() string {
+	return e.err.Error()
+}
+
+// archiveStream is the output of a session that archives a path. It writes the base64 encoded archive
+// between the markers to w, and ignores the messages of the session manager plugin around them.
+type archiveStream struct {
+	w        io.Writer
+	checksum string // Checksum printed before the archive.
+
+	line     []byte // Incomplete last line.
+	begun    bool
+	ended    bool
+	tooLarge bool
+}
+
+// Write implements io.Writer.
+func (s *archiveStream) Write(p []byte) (int, error) {
+	s.line = append(s.line, p...)
+	for {
+		i := bytes.IndexByte(s.line, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		line := strings.TrimSpace(string(s.line[:i]))
+		s.line = s.line[i+1:]
+		if err := s.writeLine(line); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (s *archiveStream) writeLine(line string) error {
+	switch {
+	case s.ended:
+		return nil
+	case line == cpTooLargeMarker:
+		s.tooLarge = true
+	case line == cpBeginMarker:
+		s.begun = true
+	case !s.begun || line == "":
+		return nil
+	case line == cpEndMarker:
+		s.ended = true
+	case s.checksum == "":
+		s.checksum = strings.Fields(line)[0]
+	default:
+		_, err := io.WriteString(s.w, line)
+		return err
+	}
+	return nil
+}
+
+// Close writes the last line if it doesn't end with a new line,
+// and returns an error if the archive was not entirely written.
+func (s *archiveStream) Close() error {
+	if len(s.line) > 0 {
+		line := strings.TrimSpace(string(s.line))
+		s.line = nil
+		if err := s.writeLine(line); err != nil {
+			return err
+		}
+	}
+	switch {
+	case s.tooLarge:
+		return &errCpArchiveStream{err: errCpArchiveTooLarge}
+	case !s.ended || s.checksum == "":
+		return &errCpArchiveStream{err: errCpMissingTools}
+	}
+	return nil
+}
+
+// limitedReader reads from r until n bytes are left, and errors if r has more.
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+// Read implements io.Reader.
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return n, errCpArchiveTooLarge
+	}
+	return n, err
+}
+
+// limitedWriter writes to w until n bytes are left, and errors instead of writing more.
+type limitedWriter struct {
+	w io.Writer
+	n int64
+}
+
+// Write implements io.Writer.
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > l.n {
+		return 0, errCpArchiveTooLarge
+	}
+	l.n -= int64(len(p))
+	return l.w.Write(p)
+}
+
+// tarPath archives the file or directory at localPath with its entries rooted at name.
+func tarPath(fs afero.Fs, localPath, name string) ([]byte, int, error) {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(&limitedWriter{w: buf, n: cpMaxArchiveSize})
+	files := 0
+	err := afero.Walk(fs, localPath, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return nil // Skip symlinks, devices and sockets.
+		}
+		rel, err := filepath.Rel(localPath, p)
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = path.Join(name, filepath.ToSlash(rel))
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		files++
+		if info.IsDir() {
+			return nil
+		}
+		f, err := fs.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, 0, err
+	}
+	return buf.Bytes(), files, nil
+}
+
+// untar extracts the entries of the archive rooted at name under root.
+func untar(fs afero.Fs, archive io.Reader, name, root string) (int, error) {
+	tr := tar.NewReader(archive)
+	files := 0
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return files, err
+		}
+		entry := path.Clean(hdr.Name)
+		if entry != name && !strings.HasPrefix(entry, name+"/") {
+			return files, fmt.Errorf("entry %s is not under %s", hdr.Name, name)
+		}
+		target := filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(entry, name)))
+		if target != filepath.Clean(root) && !strings.HasPrefix(target, filepath.Clean(root)+string(filepath.Separator)) {
+			return files, fmt.Errorf("entry %s is outside of %s", hdr.Name, root)
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := fs.MkdirAll(target, 0755); err != nil {
+				return files, err
+			}
+		case tar.TypeReg:
+			if err := writeFile(fs, target, tr, os.FileMode(hdr.Mode).Perm()); err != nil {
+				return files, err
+			}
+		default:
+			continue // Skip symlinks, devices and sockets.
+		}
+		files++
+	}
+}
+
+func writeFile(fs afero.Fs, target string, r io.Reader, perm os.FileMode) error {
+	if err := fs.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	f, err := fs.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, r)
+	return err
+}
+
+func validateRemotePath(p string) error {
+	if !path.IsAbs(p) {
+		return fmt.Errorf("path %s in the container must be absolute", p)
+	}
+	if strings.ContainsAny(p, cpRemotePathBadChars) {
+		return fmt.Errorf("path %s in the container cannot contain quotes, backslashes, dollar signs or new lines", p)
+	}
+	return nil
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
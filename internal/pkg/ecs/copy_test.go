@@ -0,0 +1,288 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCommandExecutor records the scripts run in the container and writes canned outputs.
+type fakeCommandExecutor struct {
+	scripts []string
+	output  func(script string) string
+	err     error
+}
+
+func (e *fakeCommandExecutor) ExecuteCommandWithOutput(in ecs.ExecuteCommandInput, w io.Writer) error {
+	script := strings.TrimSuffix(strings.TrimPrefix(in.Command, "/bin/sh -c '"), "'")
+	e.scripts = append(e.scripts, script)
+	if e.err != nil {
+		return e.err
+	}
+	if e.output != nil {
+		fmt.Fprint(w, e.output(script))
+	}
+	return nil
+}
+
+func TestFileCopier_Upload(t *testing.T) {
+	local := filepath.Join("/", "home", "me")
+	fs := afero.NewMemMapFs()
+	require.NoError(t, fs.MkdirAll(filepath.Join(local, "seed", "tables"), 0755))
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(local, "seed", "tables", "users.sql"), []byte(strings.Repeat("INSERT INTO users;\n", 5000)), 0644))
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(local, "large.bin"), make([]byte, cpMaxArchiveSize+1), 0644))
+
+	testCases := map[string]struct {
+		inLocalPath  string
+		inRemotePath string
+		output       string
+		execErr      error
+
+		wantedDestDir string
+		wantedEntries []string
+		wantedError   error
+	}{
+		"error if the archive exceeds the size limit": {
+			inLocalPath:  filepath.Join(local, "large.bin"),
+			inRemotePath: "/tmp/",
+			wantedError:  fmt.Errorf("archive %s: exceeds the size limit of 5 MiB", filepath.Join(local, "large.bin")),
+		},
+		"wrap error if a command fails": {
+			inRemotePath: "/tmp/",
+			execErr:      errors.New("some error"),
+			wantedError:  errors.New("upload archive to container api: some error"),
+		},
+		"error if the checksum doesn't match in the container": {
+			inRemotePath: "/tmp/",
+			output:       "Starting session with SessionId: ecs-execute-command-123\r\n" + cpChecksumMismatchMarker + "\r\n",
+			wantedError:  errors.New("checksum of the archive uploaded to container api does not match"),
+		},
+		"error if the archive isn't extracted": {
+			inRemotePath: "/tmp/",
+			output:       "/bin/sh: tar: not found\r\n",
+			wantedError:  errors.New(`extract archive into /tmp in container api: make sure the path exists and the container has "tar", "base64" and "sha256sum" installed`),
+		},
+		"copy the directory under the remote directory": {
+			inRemotePath:  "/var/lib/",
+			output:        cpEndMarker + "\r\n",
+			wantedDestDir: "/var/lib",
+			wantedEntries: []string{"seed/", "seed/tables/", "seed/tables/users.sql"},
+		},
+		"copy the directory to the remote path": {
+			inRemotePath:  "/var/lib/fixtures",
+			output:        cpEndMarker + "\r\n",
+			wantedDestDir: "/var/lib",
+			wantedEntries: []string{"fixtures/", "fixtures/tables/", "fixtures/tables/users.sql"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fake := &fakeCommandExecutor{
+				err: tc.execErr,
+				output: func(script string) string {
+					if strings.HasPrefix(script, "printf") {
+						return ""
+					}
+					return tc.output
+				},
+			}
+			copier := &FileCopier{
+				cluster:   "cluster",
+				task:      "task",
+				container: "api",
+				fs:        fs,
+				exec:      fake,
+				progress:  io.Discard,
+				tempFile:  func() string { return "/tmp/copilot-cp-1" },
+			}
+			localPath := filepath.Join(local, "seed")
+			if tc.inLocalPath != "" {
+				localPath = tc.inLocalPath
+			}
+
+			got, err := copier.Upload(localPath, tc.inRemotePath)
+
+			if tc.wantedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 3, got.Files)
+
+			// The archive is sent in chunks followed by a command verifying and extracting it.
+			require.Greater(t, len(fake.scripts), 2, "archive should be sent in multiple chunks")
+			var encoded strings.Builder
+			chunk := regexp.MustCompile(`^printf %s (\S+) >>? /tmp/copilot-cp-1\.b64$`)
+			for _, script := range fake.scripts[:len(fake.scripts)-1] {
+				matches := chunk.FindStringSubmatch(script)
+				require.NotNil(t, matches, "unexpected script %s", script)
+				encoded.WriteString(matches[1])
+			}
+			require.True(t, strings.HasPrefix(fake.scripts[0], "printf %s ") && strings.Contains(fake.scripts[0], " > "), "first chunk should truncate the file")
+			archive, err := base64.StdEncoding.DecodeString(encoded.String())
+			require.NoError(t, err)
+			require.Equal(t, got.Checksum, sha256Hex(archive))
+			require.Equal(t, tarEntries(t, archive), tc.wantedEntries)
+
+			extract := fake.scripts[len(fake.scripts)-1]
+			require.Contains(t, extract, fmt.Sprintf(`echo "%s  /tmp/copilot-cp-1.tar" | sha256sum -c -`, got.Checksum))
+			require.Contains(t, extract, fmt.Sprintf(`tar xf /tmp/copilot-cp-1.tar -C "%s"`, tc.wantedDestDir))
+		})
+	}
+}
+
+func TestFileCopier_Download(t *testing.T) {
+	src := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(src, filepath.Join("/", "logs", "app.log"), []byte("hello\n"), 0644))
+	archive, _, err := tarPath(src, filepath.Join("/", "logs"), "logs")
+	require.NoError(t, err)
+	large := make([]byte, cpMaxArchiveSize+1)
+	// base64 wraps lines at 76 characters.
+	encoded := base64.StdEncoding.EncodeToString(archive)
+	var wrapped []string
+	for len(encoded) > 76 {
+		wrapped = append(wrapped, encoded[:76])
+		encoded = encoded[76:]
+	}
+	wrapped = append(wrapped, encoded)
+	output := func(checksum string, lines ...string) string {
+		return strings.Join(append(append([]string{
+			"",
+			"Starting session with SessionId: ecs-execute-command-123",
+			cpBeginMarker,
+			checksum + "  /tmp/copilot-cp-1.tar",
+		}, lines...), cpEndMarker, "", "Exiting session with sessionId: ecs-execute-command-123."), "\r\n")
+	}
+
+	testCases := map[string]struct {
+		inLocalPath func(dir string) string
+		output      string
+		execErr     error
+
+		wantedFile  func(dir string) string
+		wantedError error
+	}{
+		"error if the archive is not in the output": {
+			inLocalPath: func(dir string) string { return dir },
+			output:      "tar: logs: No such file or directory\r\n",
+			wantedError: errors.New(`archive /var/log/logs in container api: make sure the path exists and the container has "tar", "base64" and "sha256sum" installed`),
+		},
+		"error if the archive exceeds the size limit in the container": {
+			inLocalPath: func(dir string) string { return dir },
+			output:      "Starting session with SessionId: ecs-execute-command-123\r\n" + cpTooLargeMarker + "\r\n",
+			wantedError: errors.New("archive /var/log/logs in container api: exceeds the size limit of 5 MiB"),
+		},
+		"error if the streamed archive exceeds the size limit": {
+			inLocalPath: func(dir string) string { return dir },
+			output:      output(sha256Hex(large), base64.StdEncoding.EncodeToString(large)),
+			wantedError: errors.New("extract archive to /home/me: exceeds the size limit of 5 MiB"),
+		},
+		"wrap error if the session fails": {
+			inLocalPath: func(dir string) string { return dir },
+			execErr:     errors.New("some error"),
+			wantedError: errors.New("download archive from container api: some error"),
+		},
+		"error if the checksum doesn't match": {
+			inLocalPath: func(dir string) string { return dir },
+			output:      output("0000", wrapped...),
+			wantedError: fmt.Errorf("checksum %s of the archive downloaded from container api does not match 0000", sha256Hex(archive)),
+		},
+		"copy the directory under an existing local directory": {
+			inLocalPath: func(dir string) string { return dir },
+			output:      output(sha256Hex(archive), wrapped...),
+			wantedFile:  func(dir string) string { return filepath.Join(dir, "logs", "app.log") },
+		},
+		"copy the directory to the local path": {
+			inLocalPath: func(dir string) string { return filepath.Join(dir, "api-logs") },
+			output:      output(sha256Hex(archive), wrapped...),
+			wantedFile:  func(dir string) string { return filepath.Join(dir, "api-logs", "app.log") },
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dst := filepath.Join("/", "home", "me")
+			fs := afero.NewMemMapFs()
+			require.NoError(t, fs.MkdirAll(dst, 0755))
+			fake := &fakeCommandExecutor{
+				err:    tc.execErr,
+				output: func(string) string { return tc.output },
+			}
+			copier := &FileCopier{
+				cluster:   "cluster",
+				task:      "task",
+				container: "api",
+				fs:        fs,
+				exec:      fake,
+				progress:  io.Discard,
+				tempFile:  func() string { return "/tmp/copilot-cp-1" },
+			}
+
+			got, err := copier.Download("/var/log/logs", tc.inLocalPath(dst))
+
+			require.Equal(t, []string{
+				`cd "/var/log" && tar cf /tmp/copilot-cp-1.tar "logs" && if [ $(($(wc -c < /tmp/copilot-cp-1.tar))) -le 5242880 ]; then echo COPILOT_CP_BEGIN && sha256sum /tmp/copilot-cp-1.tar && base64 /tmp/copilot-cp-1.tar && echo COPILOT_CP_END; else echo COPILOT_CP_TOO_LARGE; fi; rm -f /tmp/copilot-cp-1.tar`,
+			}, fake.scripts)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 2, got.Files)
+			content, err := afero.ReadFile(fs, tc.wantedFile(dst))
+			require.NoError(t, err)
+			require.Equal(t, "hello\n", string(content))
+		})
+	}
+}
+
+func TestFileCopier_InvalidRemotePath(t *testing.T) {
+	copier := &FileCopier{container: "api", exec: &fakeCommandExecutor{}, progress: io.Discard}
+
+	_, err := copier.Download("logs", "/home/me")
+	require.EqualError(t, err, "path logs in the container must be absolute")
+
+	_, err = copier.Upload("/home/me", "/tmp/$(reboot)")
+	require.EqualError(t, err, "path /tmp/$(reboot) in the container cannot contain quotes, backslashes, dollar signs or new lines")
+}
+
+func TestUntar_RejectsEntriesOutsideOfRoot(t *testing.T) {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "logs/../../etc/passwd", Typeflag: tar.TypeReg, Mode: 0644}))
+	require.NoError(t, tw.Close())
+
+	_, err := untar(afero.NewMemMapFs(), buf, "logs", "/home/me")
+
+	require.EqualError(t, err, "entry logs/../../etc/passwd is not under logs")
+}
+
+func tarEntries(t *testing.T, archive []byte) []string {
+	tr := tar.NewReader(bytes.NewReader(archive))
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+	}
+	sort.Strings(names)
+	return names
+}
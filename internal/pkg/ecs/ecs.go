@@ -42,12 +42,19 @@ type ecsClient interface {
 	ServiceRunningTasks(clusterName, serviceName string) ([]*ecs.Task, error)
 	StoppedServiceTasks(cluster, service string) ([]*ecs.Task, error)
 	StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error
+	DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error)
 	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
 	UpdateService(clusterName, serviceName string, opts ...ecs.UpdateServiceOpts) error
 }
 
 type stepFunctionsClient interface {
 	StateMachineDefinition(stateMachineARN string) (string, error)
+	StartExecution(stateMachineARN, input string) (string, error)
+	DescribeExecution(executionARN string) (*stepfunctions.Execution, error)
+	TaskSubmittedOutputs(executionARN string) ([]string, error)
+	ListExecutions(stateMachineARN string, maxResults int) ([]*stepfunctions.Execution, error)
+	TaskHistory(executionARN string) (*stepfunctions.TaskHistory, error)
+	TaskStates(executionARN string) ([]*stepfunctions.TaskState, error)
 }
 
 // ServiceDesc contains the description of an ECS service.
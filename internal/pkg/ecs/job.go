@@ -0,0 +1,261 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+)
+
+// workflowStepResourcePrefix is the prefix of the resource of the task states that run the steps of a workflow.
+const workflowStepResourcePrefix = "states:startExecution"
+
+// JobOverrides holds the overrides of the main container for an execution of a job.
+type JobOverrides struct {
+	Command []string
+	EnvVars map[string]string
+}
+
+// JobExecution holds the status of an execution of a job and the last task that it started.
+type JobExecution struct {
+	*stepfunctions.Execution
+	Task *ecs.Task // Nil if the execution hasn't started a task yet.
+}
+
+// JobExecutionHistory holds the history of an execution of a job.
+type JobExecutionHistory struct {
+	*stepfunctions.Execution
+	Retries  int    // Number of times the execution retried the task.
+	TimedOut int    // Number of tasks that timed out.
+	TaskARN  string // ARN of the last task started by the execution, empty if it didn't start a task.
+	ExitCode *int   // Exit code of the job's main container in the last task, nil if unknown.
+}
+
+// WorkflowExecutionHistory holds the history of an execution of a workflow and of its steps.
+type WorkflowExecutionHistory struct {
+	*stepfunctions.Execution
+	Steps []*stepfunctions.TaskState // Steps that the execution started, in the order they were started.
+}
+
+// jobExecutionInput is the input of a job's state machine, the state machine passes "CopilotOverrides" to the RunTask API.
+type jobExecutionInput struct {
+	CopilotOverrides jobTaskOverrides `json:"CopilotOverrides"`
+}
+
+type jobTaskOverrides struct {
+	ContainerOverrides []jobContainerOverrides `json:"ContainerOverrides"`
+}
+
+type jobContainerOverrides struct {
+	Name        string      `json:"Name"`
+	Command     []string    `json:"Command,omitempty"`
+	Environment []jobEnvVar `json:"Environment,omitempty"`
+}
+
+type jobEnvVar struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// submittedTasks is the part of the ECS RunTask API response submitted by a job's state machine that holds the tasks.
+type submittedTasks struct {
+	Tasks []struct {
+		TaskArn    string `json:"TaskArn"`
+		ClusterArn string `json:"ClusterArn"`
+	} `json:"Tasks"`
+}
+
+// stoppedTask is the part of an ECS task that a job's state machine returns once the task stops.
+type stoppedTask struct {
+	Containers []struct {
+		Name     string `json:"Name"`
+		ExitCode *int   `json:"ExitCode"`
+	} `json:"Containers"`
+}
+
+// jobStateMachineDefinition is the part of a job's state machine definition that holds the retries of its states.
+type jobStateMachineDefinition struct {
+	States map[string]struct {
+		Retry []struct {
+			MaxAttempts int `json:"MaxAttempts"`
+		} `json:"Retry"`
+	} `json:"States"`
+}
+
+// StartJob starts an execution of a job with the overrides, and returns the ARN of the execution.
+func (c Client) StartJob(app, env, job string, overrides JobOverrides) (string, error) {
+	stateMachineARN, err := c.stateMachineARN(app, env, job)
+	if err != nil {
+		return "", err
+	}
+	// The main container is named after the job.
+	container := jobContainerOverrides{
+		Name:    job,
+		Command: overrides.Command,
+	}
+	names := make([]string, 0, len(overrides.EnvVars))
+	for name := range overrides.EnvVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		container.Environment = append(container.Environment, jobEnvVar{
+			Name:  name,
+			Value: overrides.EnvVars[name],
+		})
+	}
+	input, err := json.Marshal(jobExecutionInput{
+		CopilotOverrides: jobTaskOverrides{
+			ContainerOverrides: []jobContainerOverrides{container},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal overrides of job %s: %w", job, err)
+	}
+	return c.StepFuncClient.StartExecution(stateMachineARN, string(input))
+}
+
+// DescribeJobExecution returns the status of an execution of a job and the last task that it started.
+func (c Client) DescribeJobExecution(executionARN string) (*JobExecution, error) {
+	execution, err := c.StepFuncClient.DescribeExecution(executionARN)
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := c.StepFuncClient.TaskSubmittedOutputs(executionARN)
+	if err != nil {
+		return nil, err
+	}
+	if len(outputs) == 0 {
+		return &JobExecution{
+			Execution: execution,
+		}, nil
+	}
+	var submitted submittedTasks
+	if err := json.Unmarshal([]byte(outputs[len(outputs)-1]), &submitted); err != nil {
+		return nil, fmt.Errorf("unmarshal tasks submitted by execution %s: %w", executionARN, err)
+	}
+	if len(submitted.Tasks) == 0 {
+		return &JobExecution{
+			Execution: execution,
+		}, nil
+	}
+	submittedTask := submitted.Tasks[0]
+	tasks, err := c.ecsClient.DescribeTasks(submittedTask.ClusterArn, []string{submittedTask.TaskArn})
+	if err != nil {
+		return nil, fmt.Errorf("describe task %s: %w", submittedTask.TaskArn, err)
+	}
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("task %s started by execution %s not found", submittedTask.TaskArn, executionARN)
+	}
+	return &JobExecution{
+		Execution: execution,
+		Task:      tasks[0],
+	}, nil
+}
+
+// ListJobExecutions returns the history of up to maxResults of the most recent executions of a job, the most recent first.
+func (c Client) ListJobExecutions(app, env, job string, maxResults int) ([]*JobExecutionHistory, error) {
+	stateMachineARN, err := c.stateMachineARN(app, env, job)
+	if err != nil {
+		return nil, err
+	}
+	executions, err := c.StepFuncClient.ListExecutions(stateMachineARN, maxResults)
+	if err != nil {
+		return nil, err
+	}
+	histories := make([]*JobExecutionHistory, len(executions))
+	for i, execution := range executions {
+		taskHistory, err := c.StepFuncClient.TaskHistory(execution.ARN)
+		if err != nil {
+			return nil, err
+		}
+		history := &JobExecutionHistory{
+			Execution: execution,
+			TimedOut:  taskHistory.TimedOut,
+		}
+		if submitted := len(taskHistory.SubmittedOutputs); submitted > 0 {
+			history.Retries = submitted - 1
+			var tasks submittedTasks
+			if err := json.Unmarshal([]byte(taskHistory.SubmittedOutputs[submitted-1]), &tasks); err != nil {
+				return nil, fmt.Errorf("unmarshal tasks submitted by execution %s: %w", execution.ARN, err)
+			}
+			if len(tasks.Tasks) > 0 {
+				history.TaskARN = tasks.Tasks[0].TaskArn
+			}
+		}
+		history.ExitCode = mainContainerExitCode(taskHistory.LastResult, job)
+		histories[i] = history
+	}
+	return histories, nil
+}
+
+// JobRetries returns the number of times the state machine of a job retries the job, zero if it doesn't retry it.
+func (c Client) JobRetries(app, env, job string) (int, error) {
+	stateMachineARN, err := c.stateMachineARN(app, env, job)
+	if err != nil {
+		return 0, err
+	}
+	raw, err := c.StepFuncClient.StateMachineDefinition(stateMachineARN)
+	if err != nil {
+		return 0, fmt.Errorf("get state machine definition for job %s: %w", job, err)
+	}
+	var definition jobStateMachineDefinition
+	if err := json.Unmarshal([]byte(raw), &definition); err != nil {
+		return 0, fmt.Errorf("unmarshal state machine definition for job %s: %w", job, err)
+	}
+	var retries int
+	for _, state := range definition.States {
+		for _, retry := range state.Retry {
+			retries += retry.MaxAttempts
+		}
+	}
+	return retries, nil
+}
+
+// ListWorkflowExecutions returns the history of up to maxResults of the most recent executions of a workflow's state machine, the most recent first.
+func (c Client) ListWorkflowExecutions(stateMachineARN string, maxResults int) ([]*WorkflowExecutionHistory, error) {
+	executions, err := c.StepFuncClient.ListExecutions(stateMachineARN, maxResults)
+	if err != nil {
+		return nil, err
+	}
+	histories := make([]*WorkflowExecutionHistory, len(executions))
+	for i, execution := range executions {
+		states, err := c.StepFuncClient.TaskStates(execution.ARN)
+		if err != nil {
+			return nil, err
+		}
+		// Steps start an execution of their job's state machine, the other tasks wait for the steps they depend on.
+		var steps []*stepfunctions.TaskState
+		for _, state := range states {
+			if strings.HasPrefix(state.Resource, workflowStepResourcePrefix) {
+				steps = append(steps, state)
+			}
+		}
+		histories[i] = &WorkflowExecutionHistory{
+			Execution: execution,
+			Steps:     steps,
+		}
+	}
+	return histories, nil
+}
+
+// mainContainerExitCode returns the exit code of the job's main container in the result of a task state.
+// The result isn't a stopped task when the task state times out, then the exit code is unknown.
+func mainContainerExitCode(result, job string) *int {
+	var task stoppedTask
+	if err := json.Unmarshal([]byte(result), &task); err != nil {
+		return nil
+	}
+	for _, container := range task.Containers {
+		if container.Name == job {
+			return container.ExitCode
+		}
+	}
+	return nil
+}
@@ -0,0 +1,447 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_StartJob(t *testing.T) {
+	const (
+		testApp = "testApp"
+		testEnv = "testEnv"
+		testJob = "testJob"
+		testARN = "arn:aws:states:us-east-1:1234456789012:stateMachine:testApp-testEnv-testJob"
+	)
+	testCases := map[string]struct {
+		inOverrides JobOverrides
+		setupMocks  func(m clientMocks)
+
+		wantedARN   string
+		wantedError error
+	}{
+		"fail to find the state machine": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get state machine resource by tags for job testJob: some error"),
+		},
+		"start an execution with the overrides of the main container": {
+			inOverrides: JobOverrides{
+				Command: []string{"./migrate", "--up"},
+				EnvVars: map[string]string{
+					"LOG_LEVEL": "debug",
+					"DRY_RUN":   "false",
+				},
+			},
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, map[string]string{
+					deploy.AppTagKey:     testApp,
+					deploy.EnvTagKey:     testEnv,
+					deploy.ServiceTagKey: testJob,
+				}).Return([]*resourcegroups.Resource{{ARN: testARN}}, nil)
+				m.StepFuncClient.EXPECT().StartExecution(testARN, `{"CopilotOverrides":{"ContainerOverrides":[{"Name":"testJob","Command":["./migrate","--up"],"Environment":[{"Name":"DRY_RUN","Value":"false"},{"Name":"LOG_LEVEL","Value":"debug"}]}]}}`).
+					Return("mockExecution", nil)
+			},
+			wantedARN: "mockExecution",
+		},
+		"start an execution without overrides": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, gomock.Any()).
+					Return([]*resourcegroups.Resource{{ARN: testARN}}, nil)
+				m.StepFuncClient.EXPECT().StartExecution(testARN, `{"CopilotOverrides":{"ContainerOverrides":[{"Name":"testJob"}]}}`).
+					Return("mockExecution", nil)
+			},
+			wantedARN: "mockExecution",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := clientMocks{
+				StepFuncClient: mocks.NewMockstepFunctionsClient(ctrl),
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			client := Client{
+				rgGetter:       m.resourceGetter,
+				StepFuncClient: m.StepFuncClient,
+			}
+
+			// WHEN
+			got, err := client.StartJob(testApp, testEnv, testJob, tc.inOverrides)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedARN, got)
+			}
+		})
+	}
+}
+
+func TestClient_DescribeJobExecution(t *testing.T) {
+	const (
+		testExecution = "mockExecution"
+		testCluster   = "arn:aws:ecs:us-east-1:1234456789012:cluster/mockCluster"
+		testTask      = "arn:aws:ecs:us-east-1:1234456789012:task/mockCluster/1234"
+	)
+	execution := &stepfunctions.Execution{
+		ARN:    testExecution,
+		Status: "RUNNING",
+	}
+	task := &ecs.Task{
+		TaskArn:    aws.String(testTask),
+		LastStatus: aws.String("RUNNING"),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wanted      *JobExecution
+		wantedError error
+	}{
+		"fail to describe the execution": {
+			setupMocks: func(m clientMocks) {
+				m.StepFuncClient.EXPECT().DescribeExecution(testExecution).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"no task submitted yet": {
+			setupMocks: func(m clientMocks) {
+				m.StepFuncClient.EXPECT().DescribeExecution(testExecution).Return(execution, nil)
+				m.StepFuncClient.EXPECT().TaskSubmittedOutputs(testExecution).Return(nil, nil)
+			},
+			wanted: &JobExecution{
+				Execution: execution,
+			},
+		},
+		"fail to describe the submitted task": {
+			setupMocks: func(m clientMocks) {
+				m.StepFuncClient.EXPECT().DescribeExecution(testExecution).Return(execution, nil)
+				m.StepFuncClient.EXPECT().TaskSubmittedOutputs(testExecution).Return([]string{
+					`{"Tasks":[{"TaskArn":"` + testTask + `","ClusterArn":"` + testCluster + `"}]}`,
+				}, nil)
+				m.ecsClient.EXPECT().DescribeTasks(testCluster, []string{testTask}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe task " + testTask + ": some error"),
+		},
+		"return the last submitted task": {
+			setupMocks: func(m clientMocks) {
+				m.StepFuncClient.EXPECT().DescribeExecution(testExecution).Return(execution, nil)
+				m.StepFuncClient.EXPECT().TaskSubmittedOutputs(testExecution).Return([]string{
+					`{"Tasks":[{"TaskArn":"previousTask","ClusterArn":"` + testCluster + `"}]}`,
+					`{"Tasks":[{"TaskArn":"` + testTask + `","ClusterArn":"` + testCluster + `"}]}`,
+				}, nil)
+				m.ecsClient.EXPECT().DescribeTasks(testCluster, []string{testTask}).Return([]*ecs.Task{task}, nil)
+			},
+			wanted: &JobExecution{
+				Execution: execution,
+				Task:      task,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := clientMocks{
+				StepFuncClient: mocks.NewMockstepFunctionsClient(ctrl),
+				ecsClient:      mocks.NewMockecsClient(ctrl),
+			}
+			tc.setupMocks(m)
+			client := Client{
+				ecsClient:      m.ecsClient,
+				StepFuncClient: m.StepFuncClient,
+			}
+
+			// WHEN
+			got, err := client.DescribeJobExecution(testExecution)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestClient_ListJobExecutions(t *testing.T) {
+	const (
+		testApp = "testApp"
+		testEnv = "testEnv"
+		testJob = "testJob"
+		testARN = "arn:aws:states:us-east-1:1234456789012:stateMachine:testApp-testEnv-testJob"
+	)
+	succeeded := &stepfunctions.Execution{
+		ARN:    "succeededExecution",
+		Status: "SUCCEEDED",
+	}
+	timedOut := &stepfunctions.Execution{
+		ARN:    "timedOutExecution",
+		Status: "FAILED",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wanted      []*JobExecutionHistory
+		wantedError error
+	}{
+		"fail to list executions": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, gomock.Any()).
+					Return([]*resourcegroups.Resource{{ARN: testARN}}, nil)
+				m.StepFuncClient.EXPECT().ListExecutions(testARN, 5).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"fail to get the task history of an execution": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, gomock.Any()).
+					Return([]*resourcegroups.Resource{{ARN: testARN}}, nil)
+				m.StepFuncClient.EXPECT().ListExecutions(testARN, 5).Return([]*stepfunctions.Execution{succeeded}, nil)
+				m.StepFuncClient.EXPECT().TaskHistory("succeededExecution").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"return the retries, timeouts and exit code of each execution": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, gomock.Any()).
+					Return([]*resourcegroups.Resource{{ARN: testARN}}, nil)
+				m.StepFuncClient.EXPECT().ListExecutions(testARN, 5).Return([]*stepfunctions.Execution{succeeded, timedOut}, nil)
+				m.StepFuncClient.EXPECT().TaskHistory("succeededExecution").Return(&stepfunctions.TaskHistory{
+					SubmittedOutputs: []string{
+						`{"Tasks":[{"TaskArn":"firstTask"}]}`,
+						`{"Tasks":[{"TaskArn":"secondTask"}]}`,
+					},
+					LastResult: `{"Containers":[{"Name":"firelens_log_router","ExitCode":1},{"Name":"testJob","ExitCode":0}]}`,
+				}, nil)
+				m.StepFuncClient.EXPECT().TaskHistory("timedOutExecution").Return(&stepfunctions.TaskHistory{
+					SubmittedOutputs: []string{`{"Tasks":[{"TaskArn":"timedOutTask"}]}`},
+					TimedOut:         1,
+				}, nil)
+			},
+			wanted: []*JobExecutionHistory{
+				{
+					Execution: succeeded,
+					Retries:   1,
+					TaskARN:   "secondTask",
+					ExitCode:  aws.Int(0),
+				},
+				{
+					Execution: timedOut,
+					TimedOut:  1,
+					TaskARN:   "timedOutTask",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := clientMocks{
+				StepFuncClient: mocks.NewMockstepFunctionsClient(ctrl),
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			client := Client{
+				rgGetter:       m.resourceGetter,
+				StepFuncClient: m.StepFuncClient,
+			}
+
+			// WHEN
+			got, err := client.ListJobExecutions(testApp, testEnv, testJob, 5)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestClient_JobRetries(t *testing.T) {
+	const (
+		testApp = "testApp"
+		testEnv = "testEnv"
+		testJob = "testJob"
+		testARN = "arn:aws:states:us-east-1:1234456789012:stateMachine:testApp-testEnv-testJob"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wanted      int
+		wantedError error
+	}{
+		"fail to get the state machine definition": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, gomock.Any()).
+					Return([]*resourcegroups.Resource{{ARN: testARN}}, nil)
+				m.StepFuncClient.EXPECT().StateMachineDefinition(testARN).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get state machine definition for job testJob: some error"),
+		},
+		"zero if the job doesn't retry": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, gomock.Any()).
+					Return([]*resourcegroups.Resource{{ARN: testARN}}, nil)
+				m.StepFuncClient.EXPECT().StateMachineDefinition(testARN).Return(`{"States":{"Run Fargate Task":{"Type":"Task","End":true}}}`, nil)
+			},
+		},
+		"return the retries of the job": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, gomock.Any()).
+					Return([]*resourcegroups.Resource{{ARN: testARN}}, nil)
+				m.StepFuncClient.EXPECT().StateMachineDefinition(testARN).Return(`{"States":{"Run Fargate Task":{"Type":"Task","Retry":[{"ErrorEquals":["States.ALL"],"MaxAttempts":3}],"End":true}}}`, nil)
+			},
+			wanted: 3,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := clientMocks{
+				StepFuncClient: mocks.NewMockstepFunctionsClient(ctrl),
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			client := Client{
+				rgGetter:       m.resourceGetter,
+				StepFuncClient: m.StepFuncClient,
+			}
+
+			// WHEN
+			got, err := client.JobRetries(testApp, testEnv, testJob)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestClient_ListWorkflowExecutions(t *testing.T) {
+	const testARN = "arn:aws:states:us-east-1:1234456789012:stateMachine:testApp-testEnv-testJob-workflow"
+	running := &stepfunctions.Execution{
+		ARN:    "runningExecution",
+		Status: "RUNNING",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wanted      []*WorkflowExecutionHistory
+		wantedError error
+	}{
+		"fail to list executions": {
+			setupMocks: func(m clientMocks) {
+				m.StepFuncClient.EXPECT().ListExecutions(testARN, 5).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"fail to get the task states of an execution": {
+			setupMocks: func(m clientMocks) {
+				m.StepFuncClient.EXPECT().ListExecutions(testARN, 5).Return([]*stepfunctions.Execution{running}, nil)
+				m.StepFuncClient.EXPECT().TaskStates("runningExecution").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"return only the steps of each execution": {
+			setupMocks: func(m clientMocks) {
+				m.StepFuncClient.EXPECT().ListExecutions(testARN, 5).Return([]*stepfunctions.Execution{running}, nil)
+				m.StepFuncClient.EXPECT().TaskStates("runningExecution").Return([]*stepfunctions.TaskState{
+					{
+						Name:     "extract",
+						Resource: "states:startExecution.sync:2",
+						Attempts: 1,
+						Status:   "SUCCEEDED",
+					},
+					{
+						Name:     "Get status of extract before transform",
+						Resource: "aws-sdk:sfn:describeExecution",
+						Attempts: 1,
+						Status:   "SUCCEEDED",
+					},
+					{
+						Name:     "transform",
+						Resource: "states:startExecution.sync:2",
+						Attempts: 2,
+						Status:   "RUNNING",
+					},
+				}, nil)
+			},
+			wanted: []*WorkflowExecutionHistory{
+				{
+					Execution: running,
+					Steps: []*stepfunctions.TaskState{
+						{
+							Name:     "extract",
+							Resource: "states:startExecution.sync:2",
+							Attempts: 1,
+							Status:   "SUCCEEDED",
+						},
+						{
+							Name:     "transform",
+							Resource: "states:startExecution.sync:2",
+							Attempts: 2,
+							Status:   "RUNNING",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := clientMocks{
+				StepFuncClient: mocks.NewMockstepFunctionsClient(ctrl),
+			}
+			tc.setupMocks(m)
+			client := Client{
+				StepFuncClient: m.StepFuncClient,
+			}
+
+			// WHEN
+			got, err := client.ListWorkflowExecutions(testARN, 5)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	stepfunctions "github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -88,6 +89,21 @@ func (mr *MockecsClientMockRecorder) DefaultCluster() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultCluster", reflect.TypeOf((*MockecsClient)(nil).DefaultCluster))
 }
 
+// DescribeTasks mocks base method.
+func (m *MockecsClient) DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTasks", cluster, taskARNs)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTasks indicates an expected call of DescribeTasks.
+func (mr *MockecsClientMockRecorder) DescribeTasks(cluster, taskARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTasks", reflect.TypeOf((*MockecsClient)(nil).DescribeTasks), cluster, taskARNs)
+}
+
 // NetworkConfiguration mocks base method.
 func (m *MockecsClient) NetworkConfiguration(cluster, serviceName string) (*ecs.NetworkConfiguration, error) {
 	m.ctrl.T.Helper()
@@ -254,6 +270,51 @@ func (m *MockstepFunctionsClient) EXPECT() *MockstepFunctionsClientMockRecorder
 	return m.recorder
 }
 
+// DescribeExecution mocks base method.
+func (m *MockstepFunctionsClient) DescribeExecution(executionARN string) (*stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeExecution", executionARN)
+	ret0, _ := ret[0].(*stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeExecution indicates an expected call of DescribeExecution.
+func (mr *MockstepFunctionsClientMockRecorder) DescribeExecution(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeExecution", reflect.TypeOf((*MockstepFunctionsClient)(nil).DescribeExecution), executionARN)
+}
+
+// ListExecutions mocks base method.
+func (m *MockstepFunctionsClient) ListExecutions(stateMachineARN string, maxResults int) ([]*stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExecutions", stateMachineARN, maxResults)
+	ret0, _ := ret[0].([]*stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExecutions indicates an expected call of ListExecutions.
+func (mr *MockstepFunctionsClientMockRecorder) ListExecutions(stateMachineARN, maxResults interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExecutions", reflect.TypeOf((*MockstepFunctionsClient)(nil).ListExecutions), stateMachineARN, maxResults)
+}
+
+// StartExecution mocks base method.
+func (m *MockstepFunctionsClient) StartExecution(stateMachineARN, input string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartExecution", stateMachineARN, input)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartExecution indicates an expected call of StartExecution.
+func (mr *MockstepFunctionsClientMockRecorder) StartExecution(stateMachineARN, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartExecution", reflect.TypeOf((*MockstepFunctionsClient)(nil).StartExecution), stateMachineARN, input)
+}
+
 // StateMachineDefinition mocks base method.
 func (m *MockstepFunctionsClient) StateMachineDefinition(stateMachineARN string) (string, error) {
 	m.ctrl.T.Helper()
@@ -268,3 +329,48 @@ func (mr *MockstepFunctionsClientMockRecorder) StateMachineDefinition(stateMachi
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMachineDefinition", reflect.TypeOf((*MockstepFunctionsClient)(nil).StateMachineDefinition), stateMachineARN)
 }
+
+// TaskHistory mocks base method.
+func (m *MockstepFunctionsClient) TaskHistory(executionARN string) (*stepfunctions.TaskHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskHistory", executionARN)
+	ret0, _ := ret[0].(*stepfunctions.TaskHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskHistory indicates an expected call of TaskHistory.
+func (mr *MockstepFunctionsClientMockRecorder) TaskHistory(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskHistory", reflect.TypeOf((*MockstepFunctionsClient)(nil).TaskHistory), executionARN)
+}
+
+// TaskStates mocks base method.
+func (m *MockstepFunctionsClient) TaskStates(executionARN string) ([]*stepfunctions.TaskState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskStates", executionARN)
+	ret0, _ := ret[0].([]*stepfunctions.TaskState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskStates indicates an expected call of TaskStates.
+func (mr *MockstepFunctionsClientMockRecorder) TaskStates(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskStates", reflect.TypeOf((*MockstepFunctionsClient)(nil).TaskStates), executionARN)
+}
+
+// TaskSubmittedOutputs mocks base method.
+func (m *MockstepFunctionsClient) TaskSubmittedOutputs(executionARN string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskSubmittedOutputs", executionARN)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskSubmittedOutputs indicates an expected call of TaskSubmittedOutputs.
+func (mr *MockstepFunctionsClientMockRecorder) TaskSubmittedOutputs(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskSubmittedOutputs", reflect.TypeOf((*MockstepFunctionsClient)(nil).TaskSubmittedOutputs), executionARN)
+}
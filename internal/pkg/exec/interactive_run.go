@@ -12,10 +12,10 @@ import (
 )
 
 // InteractiveRun runs the input command that starts a child process.
-func (c *Cmd) InteractiveRun(name string, args []string) error {
+func (c *Cmd) InteractiveRun(name string, args []string, opts ...CmdOption) error {
 	// Ignore interrupt signal otherwise the program exits.
 	signal.Ignore(os.Interrupt)
 	defer signal.Reset(os.Interrupt)
-	cmd := c.command(name, args, Stdout(os.Stdout), Stdin(os.Stdin), Stderr(os.Stderr))
+	cmd := c.command(name, args, append([]CmdOption{Stdout(os.Stdout), Stdin(os.Stdin), Stderr(os.Stderr)}, opts...)...)
 	return cmd.Run()
 }
@@ -37,6 +37,31 @@ func TestCmd_InteractiveRun(t *testing.T) {
 		// WHEN
 		err := cmd.InteractiveRun("hello", nil)
 
+		// THEN
+		require.NoError(t, err)
+	})
+	t.Run("should apply additional options after the defaults", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cmd := &Cmd{
+			command: func(name string, args []string, opts ...CmdOption) cmdRunner {
+				cmd := &exec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				require.Equal(t, os.Stdin, cmd.Stdin)
+				require.Contains(t, cmd.Env, "SECRET=hunter2")
+
+				m := NewMockcmdRunner(ctrl)
+				m.EXPECT().Run().Return(nil)
+				return m
+			},
+		}
+
+		// WHEN
+		err := cmd.InteractiveRun("hello", nil, Env([]string{"SECRET=hunter2"}))
+
 		// THEN
 		require.NoError(t, err)
 	})
@@ -9,11 +9,11 @@ import (
 )
 
 // InteractiveRun runs the input command that starts a child process.
-func (c *Cmd) InteractiveRun(name string, args []string) error {
+func (c *Cmd) InteractiveRun(name string, args []string, opts ...CmdOption) error {
 	sig := make(chan os.Signal, 1)
 	// See https://golang.org/pkg/os/signal/#hdr-Windows
 	signal.Notify(sig, os.Interrupt)
 	defer signal.Reset(os.Interrupt)
-	cmd := c.command(name, args, Stdout(os.Stdout), Stdin(os.Stdin), Stderr(os.Stderr))
+	cmd := c.command(name, args, append([]CmdOption{Stdout(os.Stdout), Stdin(os.Stdin), Stderr(os.Stderr)}, opts...)...)
 	return cmd.Run()
 }
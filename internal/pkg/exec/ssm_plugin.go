@@ -13,8 +13,10 @@ import (
 	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 const (
@@ -59,6 +61,44 @@ func (s SSMPluginCommand) StartSession(ssmSess *ecs.Session) error {
 	return nil
 }
 
+// StartSessionWithOutput starts a session using the ssm plugin without attaching the terminal,
+// and writes the output of the session to w.
+func (s SSMPluginCommand) StartSessionWithOutput(ssmSess *ecs.Session, w io.Writer) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	if err := s.runner.Run(ssmPluginBinaryName,
+		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction}, Stdout(w), Stderr(os.Stderr)); err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	return nil
+}
+
+// StartSSMSession starts a session created with the SSM StartSession API using the ssm plugin.
+// Unlike sessions created by ECS, the plugin needs the request to read the parameters of the session document,
+// for example the local port of a port forwarding session.
+func (s SSMPluginCommand) StartSSMSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	request, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("marshal session request: %w", err)
+	}
+	region := aws.StringValue(s.sess.Config.Region)
+	endpoint, err := endpoints.DefaultResolver().EndpointFor(ssm.EndpointsID, region)
+	if err != nil {
+		return fmt.Errorf("resolve ssm endpoint in region %s: %w", region, err)
+	}
+	if err := s.runner.InteractiveRun(ssmPluginBinaryName,
+		[]string{string(response), region, startSessionAction, "", string(request), endpoint.URL}); err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	return nil
+}
+
 func download(client httpClient, filepath string, url string) error {
 	resp, err := client.Get(url)
 	if err != nil {
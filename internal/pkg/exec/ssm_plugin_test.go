@@ -4,6 +4,7 @@
 package exec
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -83,3 +85,103 @@ func TestSSMPluginCommand_StartSession(t *testing.T) {
 		})
 	}
 }
+
+func TestSSMPluginCommand_StartSSMSession(t *testing.T) {
+	mockResponse := &ssm.StartSessionOutput{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	mockRequest := &ssm.StartSessionInput{
+		DocumentName: aws.String("AWS-StartPortForwardingSession"),
+		Parameters: map[string][]*string{
+			"portNumber":      aws.StringSlice([]string{"80"}),
+			"localPortNumber": aws.StringSlice([]string{"8080"}),
+		},
+		Target: aws.String("ecs:cluster_task_runtime"),
+	}
+	wantedArgs := []string{
+		`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`,
+		"us-west-2",
+		"StartSession",
+		"",
+		`{"DocumentName":"AWS-StartPortForwardingSession","Parameters":{"localPortNumber":["8080"],"portNumber":["80"]},"Reason":null,"Target":"ecs:cluster_task_runtime"}`,
+		"https://ssm.us-west-2.amazonaws.com",
+	}
+	tests := map[string]struct {
+		runErr      error
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			runErr:      errors.New("some error"),
+			wantedError: fmt.Errorf("start session: some error"),
+		},
+		"success": {},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRunner := NewMockrunner(ctrl)
+			mockRunner.EXPECT().InteractiveRun(ssmPluginBinaryName, wantedArgs).Return(tc.runErr)
+			s := SSMPluginCommand{
+				runner: mockRunner,
+				sess: &session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				},
+			}
+
+			err := s.StartSSMSession(mockResponse, mockRequest)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSSMPluginCommand_StartSessionWithOutput(t *testing.T) {
+	mockSession := &ecs.Session{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	tests := map[string]struct {
+		runErr      error
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			runErr:      errors.New("some error"),
+			wantedError: fmt.Errorf("start session: some error"),
+		},
+		"success": {},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRunner := NewMockrunner(ctrl)
+			mockRunner.EXPECT().Run(ssmPluginBinaryName,
+				[]string{`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`, "us-west-2", "StartSession"},
+				gomock.Any(), gomock.Any()).Return(tc.runErr)
+			s := SSMPluginCommand{
+				runner: mockRunner,
+				sess: &session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				},
+			}
+
+			err := s.StartSessionWithOutput(mockSession, &bytes.Buffer{})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/spf13/afero"
+)
+
+// fmtExportFileName is the name of the file holding the events of a log stream, for example "copilot/api/4f8243e8.ndjson.gz".
+const fmtExportFileName = "%s.ndjson.gz"
+
+// ExportLogEventsOpts wraps the parameters to call ExportLogEvents.
+type ExportLogEventsOpts struct {
+	Dir        string
+	StartTime  *int64
+	EndTime    *int64
+	TaskIDs    []string
+	Containers []string
+}
+
+// ExportLogEvents writes the events of each log stream of the service to a gzipped file of newline-delimited JSON under the directory.
+// It returns the paths of the files written, log streams without events in the time range don't have a file.
+func (s *ServiceClient) ExportLogEvents(opts ExportLogEventsOpts) ([]string, error) {
+	prefixes, err := s.logStreamPrefixes(opts.TaskIDs, opts.Containers)
+	if err != nil {
+		return nil, err
+	}
+	logStreams, err := s.exporter.LogStreams(s.logGroupName, prefixes...)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, logStream := range logStreams {
+		path := filepath.Join(opts.Dir, fmt.Sprintf(fmtExportFileName, filepath.FromSlash(logStream)))
+		written, err := s.exportLogStream(logStream, path, opts)
+		if err != nil {
+			return nil, err
+		}
+		if written {
+			files = append(files, path)
+		}
+	}
+	return files, nil
+}
+
+// ExportLogEvents writes the events of the log streams of each workload under a directory named after the workload.
+func (c *WorkloadsClient) ExportLogEvents(opts ExportLogEventsOpts) ([]string, error) {
+	var files []string
+	for _, wkld := range c.workloads {
+		wkldOpts := opts
+		wkldOpts.Dir = filepath.Join(opts.Dir, wkld.name)
+		wkldFiles, err := wkld.client.ExportLogEvents(wkldOpts)
+		if err != nil {
+			return nil, fmt.Errorf("export logs of %s: %w", wkld.name, err)
+		}
+		files = append(files, wkldFiles...)
+	}
+	return files, nil
+}
+
+// exportLogStream writes the events of the log stream to a file, the file is only created if there are events.
+func (s *ServiceClient) exportLogStream(logStream, path string, opts ExportLogEventsOpts) (written bool, err error) {
+	var f afero.File
+	var gz *gzip.Writer
+	defer func() {
+		if gz == nil {
+			return
+		}
+		if closeErr := gz.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("compress %s: %w", path, closeErr)
+		}
+		if closeErr := f.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close %s: %w", path, closeErr)
+		}
+	}()
+	err = s.exporter.LogStreamEvents(s.logGroupName, logStream, opts.StartTime, opts.EndTime, func(events []*cloudwatchlogs.Event) error {
+		if gz == nil {
+			if err := s.fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return fmt.Errorf("create directory for %s: %w", path, err)
+			}
+			file, err := s.fs.Create(path)
+			if err != nil {
+				return fmt.Errorf("create %s: %w", path, err)
+			}
+			f, gz = file, gzip.NewWriter(file)
+		}
+		for _, event := range events {
+			data, err := event.JSONString()
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(gz, data); err != nil {
+				return fmt.Errorf("write to %s: %w", path, err)
+			}
+		}
+		return nil
+	})
+	return gz != nil, err
+}
@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/logging/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceClient_ExportLogEvents(t *testing.T) {
+	testCases := map[string]struct {
+		taskIDs    []string
+		containers []string
+		setupMocks func(m *mocks.MocklogStreamsExporter)
+
+		wantedError error
+		wantedFiles map[string]string
+	}{
+		"should return the error if log streams can't be listed": {
+			setupMocks: func(m *mocks.MocklogStreamsExporter) {
+				m.EXPECT().LogStreams("/copilot/phonetool-test-api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"should return the error if the events of a log stream can't be retrieved": {
+			setupMocks: func(m *mocks.MocklogStreamsExporter) {
+				m.EXPECT().LogStreams("/copilot/phonetool-test-api").Return([]string{"copilot/api/1"}, nil)
+				m.EXPECT().LogStreamEvents("/copilot/phonetool-test-api", "copilot/api/1", nil, aws.Int64(2000), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"should write a file per log stream with events": {
+			taskIDs:    []string{"1", "2"},
+			containers: []string{"api"},
+			setupMocks: func(m *mocks.MocklogStreamsExporter) {
+				m.EXPECT().LogStreams("/copilot/phonetool-test-api", "copilot/api/1", "copilot/api/2").Return([]string{"copilot/api/1", "copilot/api/2"}, nil)
+				m.EXPECT().LogStreamEvents("/copilot/phonetool-test-api", "copilot/api/1", nil, aws.Int64(2000), gomock.Any()).
+					DoAndReturn(func(_, _ string, _, _ *int64, fn func([]*cloudwatchlogs.Event) error) error {
+						if err := fn([]*cloudwatchlogs.Event{{LogStreamName: "copilot/api/1", Message: "starting", Timestamp: 1}}); err != nil {
+							return err
+						}
+						return fn([]*cloudwatchlogs.Event{{LogStreamName: "copilot/api/1", Message: "GET /", Timestamp: 2}})
+					})
+				m.EXPECT().LogStreamEvents("/copilot/phonetool-test-api", "copilot/api/2", nil, aws.Int64(2000), gomock.Any()).Return(nil)
+			},
+			wantedFiles: map[string]string{
+				"logs/copilot/api/1.ndjson.gz": `{"logStreamName":"copilot/api/1","ingestionTime":0,"message":"starting","timestamp":1}
+{"logStreamName":"copilot/api/1","ingestionTime":0,"message":"GET /","timestamp":2}
+`,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocklogStreamsExporter(ctrl)
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			client := &ServiceClient{
+				logGroupName:        "/copilot/phonetool-test-api",
+				logStreamNamePrefix: "copilot/api",
+				exporter:            m,
+				fs:                  fs,
+			}
+
+			files, err := client.ExportLogEvents(ExportLogEventsOpts{
+				Dir:        "logs",
+				EndTime:    aws.Int64(2000),
+				TaskIDs:    tc.taskIDs,
+				Containers: tc.containers,
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, files, len(tc.wantedFiles))
+			for path, wanted := range tc.wantedFiles {
+				require.Contains(t, files, path)
+				f, err := fs.Open(path)
+				require.NoError(t, err)
+				gz, err := gzip.NewReader(f)
+				require.NoError(t, err)
+				content, err := io.ReadAll(gz)
+				require.NoError(t, err)
+				require.Equal(t, wanted, string(content))
+			}
+			exists, err := afero.Exists(fs, "logs/copilot/api/2.ndjson.gz")
+			require.NoError(t, err)
+			require.False(t, exists, "log streams without events should not have a file")
+		})
+	}
+}
+
+func TestWorkloadsClient_ExportLogEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMocklogStreamsExporter(ctrl)
+	m.EXPECT().LogStreams("/copilot/phonetool-test-frontend").Return([]string{"copilot/frontend/1"}, nil)
+	m.EXPECT().LogStreamEvents("/copilot/phonetool-test-frontend", "copilot/frontend/1", nil, nil, gomock.Any()).
+		DoAndReturn(func(_, _ string, _, _ *int64, fn func([]*cloudwatchlogs.Event) error) error {
+			return fn([]*cloudwatchlogs.Event{{LogStreamName: "copilot/frontend/1", Message: "GET /"}})
+		})
+	m.EXPECT().LogStreams("/copilot/phonetool-test-api").Return(nil, errors.New("some error"))
+	fs := afero.NewMemMapFs()
+	client := &WorkloadsClient{
+		workloads: []*workloadLogs{
+			{name: "frontend", client: &ServiceClient{logGroupName: "/copilot/phonetool-test-frontend", exporter: m, fs: fs}},
+			{name: "api", client: &ServiceClient{logGroupName: "/copilot/phonetool-test-api", exporter: m, fs: fs}},
+		},
+	}
+
+	_, err := client.ExportLogEvents(ExportLogEventsOpts{Dir: "logs"})
+
+	require.EqualError(t, err, "export logs of api: some error")
+	exists, err := afero.Exists(fs, "logs/frontend/copilot/frontend/1.ndjson.gz")
+	require.NoError(t, err)
+	require.True(t, exists)
+}
@@ -7,6 +7,7 @@ package logging
 import (
 	"fmt"
 	"io"
+	"regexp"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 )
@@ -37,6 +38,22 @@ func WriteHumanLogs(w io.Writer, logStringers []HumanJSONStringer) error {
 	return nil
 }
 
+// HighlightHumanLogs returns a handler that outputs CloudWatch logs in human-readable format,
+// with every match of the regular expression highlighted.
+func HighlightHumanLogs(re *regexp.Regexp) func(w io.Writer, logs []HumanJSONStringer) error {
+	type highlighter interface {
+		Highlight(re *regexp.Regexp)
+	}
+	return func(w io.Writer, logStringers []HumanJSONStringer) error {
+		for _, logStringer := range logStringers {
+			if h, ok := logStringer.(highlighter); ok {
+				h.Highlight(re)
+			}
+		}
+		return WriteHumanLogs(w, logStringers)
+	}
+}
+
 func cwEventsToHumanJSONStringers(events []*cloudwatchlogs.Event) []HumanJSONStringer {
 	// golang limitation: https://golang.org/doc/faq#convert_slice_of_interface
 	logStringers := make([]HumanJSONStringer, len(events))
@@ -48,3 +48,98 @@ func (mr *MocklogGetterMockRecorder) LogEvents(opts interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvents", reflect.TypeOf((*MocklogGetter)(nil).LogEvents), opts)
 }
+
+// MocklogQuerier is a mock of logQuerier interface.
+type MocklogQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogQuerierMockRecorder
+}
+
+// MocklogQuerierMockRecorder is the mock recorder for MocklogQuerier.
+type MocklogQuerierMockRecorder struct {
+	mock *MocklogQuerier
+}
+
+// NewMocklogQuerier creates a new mock instance.
+func NewMocklogQuerier(ctrl *gomock.Controller) *MocklogQuerier {
+	mock := &MocklogQuerier{ctrl: ctrl}
+	mock.recorder = &MocklogQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogQuerier) EXPECT() *MocklogQuerierMockRecorder {
+	return m.recorder
+}
+
+// Query mocks base method.
+func (m *MocklogQuerier) Query(opts cloudwatchlogs.QueryOpts) (*cloudwatchlogs.QueryResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", opts)
+	ret0, _ := ret[0].(*cloudwatchlogs.QueryResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MocklogQuerierMockRecorder) Query(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MocklogQuerier)(nil).Query), opts)
+}
+
+// MocklogStreamsExporter is a mock of logStreamsExporter interface.
+type MocklogStreamsExporter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogStreamsExporterMockRecorder
+}
+
+// MocklogStreamsExporterMockRecorder is the mock recorder for MocklogStreamsExporter.
+type MocklogStreamsExporterMockRecorder struct {
+	mock *MocklogStreamsExporter
+}
+
+// NewMocklogStreamsExporter creates a new mock instance.
+func NewMocklogStreamsExporter(ctrl *gomock.Controller) *MocklogStreamsExporter {
+	mock := &MocklogStreamsExporter{ctrl: ctrl}
+	mock.recorder = &MocklogStreamsExporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogStreamsExporter) EXPECT() *MocklogStreamsExporterMockRecorder {
+	return m.recorder
+}
+
+// LogStreamEvents mocks base method.
+func (m *MocklogStreamsExporter) LogStreamEvents(logGroup, logStream string, startTime, endTime *int64, fn func([]*cloudwatchlogs.Event) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogStreamEvents", logGroup, logStream, startTime, endTime, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogStreamEvents indicates an expected call of LogStreamEvents.
+func (mr *MocklogStreamsExporterMockRecorder) LogStreamEvents(logGroup, logStream, startTime, endTime, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogStreamEvents", reflect.TypeOf((*MocklogStreamsExporter)(nil).LogStreamEvents), logGroup, logStream, startTime, endTime, fn)
+}
+
+// LogStreams mocks base method.
+func (m *MocklogStreamsExporter) LogStreams(logGroup string, prefixes ...string) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{logGroup}
+	for _, a := range prefixes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LogStreams", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogStreams indicates an expected call of LogStreams.
+func (mr *MocklogStreamsExporterMockRecorder) LogStreams(logGroup interface{}, prefixes ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{logGroup}, prefixes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogStreams", reflect.TypeOf((*MocklogStreamsExporter)(nil).LogStreams), varargs...)
+}
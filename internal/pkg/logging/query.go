@@ -0,0 +1,73 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+)
+
+// defaultQueryWindow is how far back a query looks if it has no start time.
+const defaultQueryWindow = time.Hour
+
+// WriteQueryResultsOpts wraps the parameters to call WriteQueryResults.
+type WriteQueryResultsOpts struct {
+	// Query is a CloudWatch Logs Insights query.
+	// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/CWL_QuerySyntax.html
+	Query     string
+	Limit     *int64
+	StartTime *int64 // Defaults to an hour before the end time.
+	EndTime   *int64 // Defaults to now.
+	// OnResults is a handler that's invoked when the results of the query are retrieved.
+	OnResults func(w io.Writer, results []HumanJSONStringer) error
+}
+
+func (o WriteQueryResultsOpts) timeRange(now func() time.Time) (start, end int64) {
+	end = now().UnixMilli()
+	if o.EndTime != nil {
+		end = *o.EndTime
+	}
+	start = end - defaultQueryWindow.Milliseconds()
+	if o.StartTime != nil {
+		start = *o.StartTime
+	}
+	return start, end
+}
+
+// WriteQueryResults runs a Logs Insights query over the log group of the service and writes its results.
+func (s *ServiceClient) WriteQueryResults(opts WriteQueryResultsOpts) error {
+	return writeQueryResults(s.querier, []string{s.logGroupName}, s.w, s.now, opts)
+}
+
+// WriteQueryResults runs a Logs Insights query over the log groups of all the workloads and writes its results.
+func (c *WorkloadsClient) WriteQueryResults(opts WriteQueryResultsOpts) error {
+	if len(c.workloads) == 0 {
+		return nil
+	}
+	logGroups := make([]string, len(c.workloads))
+	for i, wkld := range c.workloads {
+		logGroups[i] = wkld.client.logGroupName
+	}
+	// All the workloads are in the same environment, so any of their clients can run the query.
+	client := c.workloads[0].client
+	return writeQueryResults(client.querier, logGroups, c.w, client.now, opts)
+}
+
+func writeQueryResults(querier logQuerier, logGroups []string, w io.Writer, now func() time.Time, opts WriteQueryResultsOpts) error {
+	start, end := opts.timeRange(now)
+	results, err := querier.Query(cloudwatchlogs.QueryOpts{
+		LogGroups:   logGroups,
+		QueryString: opts.Query,
+		StartTime:   start,
+		EndTime:     end,
+		Limit:       opts.Limit,
+	})
+	if err != nil {
+		return fmt.Errorf("query logs: %w", err)
+	}
+	return opts.OnResults(w, []HumanJSONStringer{results})
+}
@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/logging/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceClient_WriteQueryResults(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	results := &cloudwatchlogs.QueryResults{
+		Fields: []string{"@timestamp", "@message"},
+		Rows: []map[string]string{
+			{"@timestamp": "2023-11-14 22:13:20.000", "@message": "GET /"},
+		},
+	}
+	testCases := map[string]struct {
+		startTime  *int64
+		endTime    *int64
+		jsonOutput bool
+		setupMocks func(m *mocks.MocklogQuerier)
+
+		wantedError   error
+		wantedContent string
+	}{
+		"should wrap the error if the query fails": {
+			setupMocks: func(m *mocks.MocklogQuerier) {
+				m.EXPECT().Query(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("query logs: some error"),
+		},
+		"should query the last hour by default": {
+			setupMocks: func(m *mocks.MocklogQuerier) {
+				m.EXPECT().Query(cloudwatchlogs.QueryOpts{
+					LogGroups:   []string{"/copilot/phonetool-test-api"},
+					QueryString: "fields @timestamp, @message",
+					StartTime:   now.Add(-time.Hour).UnixMilli(),
+					EndTime:     now.UnixMilli(),
+				}).Return(results, nil)
+			},
+			wantedContent: "@timestamp               @message\n2023-11-14 22:13:20.000  GET /\n",
+		},
+		"should query the time range and write JSON": {
+			startTime:  aws.Int64(1000),
+			endTime:    aws.Int64(2000),
+			jsonOutput: true,
+			setupMocks: func(m *mocks.MocklogQuerier) {
+				m.EXPECT().Query(cloudwatchlogs.QueryOpts{
+					LogGroups:   []string{"/copilot/phonetool-test-api"},
+					QueryString: "fields @timestamp, @message",
+					StartTime:   1000,
+					EndTime:     2000,
+				}).Return(results, nil)
+			},
+			wantedContent: `{"@message":"GET /","@timestamp":"2023-11-14 22:13:20.000"}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocklogQuerier(ctrl)
+			tc.setupMocks(m)
+			b := &strings.Builder{}
+			client := &ServiceClient{
+				logGroupName: "/copilot/phonetool-test-api",
+				querier:      m,
+				w:            b,
+				now: func() time.Time {
+					return now
+				},
+			}
+			writer := WriteHumanLogs
+			if tc.jsonOutput {
+				writer = WriteJSONLogs
+			}
+
+			err := client.WriteQueryResults(WriteQueryResultsOpts{
+				Query:     "fields @timestamp, @message",
+				StartTime: tc.startTime,
+				EndTime:   tc.endTime,
+				OnResults: writer,
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func TestWorkloadsClient_WriteQueryResults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMocklogQuerier(ctrl)
+	m.EXPECT().Query(gomock.Any()).Do(func(in cloudwatchlogs.QueryOpts) {
+		require.Equal(t, []string{"/copilot/phonetool-test-frontend", "/copilot/phonetool-test-api"}, in.LogGroups)
+	}).Return(&cloudwatchlogs.QueryResults{}, nil)
+	b := &strings.Builder{}
+	now := func() time.Time { return time.Unix(1700000000, 0) }
+	client := &WorkloadsClient{
+		workloads: []*workloadLogs{
+			{name: "frontend", client: &ServiceClient{logGroupName: "/copilot/phonetool-test-frontend", querier: m, now: now}},
+			{name: "api", client: &ServiceClient{logGroupName: "/copilot/phonetool-test-api", querier: m, now: now}},
+		},
+		w: b,
+	}
+
+	err := client.WriteQueryResults(WriteQueryResultsOpts{
+		Query:     "stats count(*) by @logStream",
+		OnResults: WriteHumanLogs,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "No results.\n", b.String())
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
 )
 
 const (
@@ -30,11 +31,23 @@ type logGetter interface {
 	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
 }
 
+type logQuerier interface {
+	Query(opts cloudwatchlogs.QueryOpts) (*cloudwatchlogs.QueryResults, error)
+}
+
+type logStreamsExporter interface {
+	LogStreams(logGroup string, prefixes ...string) ([]string, error)
+	LogStreamEvents(logGroup, logStream string, startTime, endTime *int64, fn func(events []*cloudwatchlogs.Event) error) error
+}
+
 // ServiceClient retrieves the logs of an Amazon ECS or AppRunner service.
 type ServiceClient struct {
 	logGroupName        string
 	logStreamNamePrefix string
 	eventsGetter        logGetter
+	querier             logQuerier
+	exporter            logStreamsExporter
+	fs                  afero.Fs
 	w                   io.Writer
 
 	now func() time.Time
@@ -47,8 +60,15 @@ type WriteLogEventsOpts struct {
 	StartTime *int64
 	EndTime   *int64
 	TaskIDs   []string
+	// Containers are the names of the containers to retrieve logs from, for example the sidecars. If nil, retrieve logs from all containers.
+	Containers []string
+	// FilterPattern is a CloudWatch Logs filter pattern, only the log events matching the pattern are retrieved.
+	FilterPattern string
 	// OnEvents is a handler that's invoked when logs are retrieved from the service.
 	OnEvents func(w io.Writer, logs []HumanJSONStringer) error
+	// Stopped is checked while following logs, the logs stop being followed once it returns true.
+	// If nil, logs are followed until the user interrupts the command.
+	Stopped func() (bool, error)
 }
 
 // NewServiceLogsConfig contains fields that initiates ServiceClient struct.
@@ -100,10 +120,14 @@ func NewServiceClient(opts *NewServiceLogsConfig) (*ServiceClient, error) {
 	if opts.LogGroup != "" {
 		logGroup = opts.LogGroup
 	}
+	cwlogs := cloudwatchlogs.New(opts.Sess)
 	return &ServiceClient{
 		logGroupName:        logGroup,
 		logStreamNamePrefix: fmt.Sprintf(fmtSvcLogStreamPrefix, opts.Svc),
-		eventsGetter:        cloudwatchlogs.New(opts.Sess),
+		eventsGetter:        cwlogs,
+		querier:             cwlogs,
+		exporter:            cwlogs,
+		fs:                  afero.NewOsFs(),
 		w:                   log.OutputWriter,
 		now:                 time.Now,
 	}, nil
@@ -140,9 +164,13 @@ func newAppRunnerServiceClient(opts *NewServiceLogsConfig) (*ServiceClient, erro
 			return nil, fmt.Errorf("get log group name: %w", err)
 		}
 	}
+	cwlogs := cloudwatchlogs.New(opts.Sess)
 	return &ServiceClient{
 		logGroupName: logGroup,
-		eventsGetter: cloudwatchlogs.New(opts.Sess),
+		eventsGetter: cwlogs,
+		querier:      cwlogs,
+		exporter:     cwlogs,
+		fs:           afero.NewOsFs(),
 		w:            log.OutputWriter,
 		now:          time.Now,
 	}, nil
@@ -150,16 +178,18 @@ func newAppRunnerServiceClient(opts *NewServiceLogsConfig) (*ServiceClient, erro
 
 // WriteLogEvents writes service logs.
 func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
-	logEventsOpts := cloudwatchlogs.LogEventsOpts{
-		LogGroup:  s.logGroupName,
-		Limit:     opts.limit(),
-		EndTime:   opts.EndTime,
-		StartTime: opts.startTime(s.now),
-	}
-	if opts.TaskIDs != nil {
-		logEventsOpts.LogStreams = s.logStreams(opts.TaskIDs)
+	logEventsOpts, err := s.logEventsOpts(opts)
+	if err != nil {
+		return err
 	}
 	for {
+		var stopped bool
+		if opts.Follow && opts.Stopped != nil {
+			// Check before retrieving the logs so that the last round includes the final log events.
+			if stopped, err = opts.Stopped(); err != nil {
+				return err
+			}
+		}
 		logEventsOutput, err := s.eventsGetter.LogEvents(logEventsOpts)
 		if err != nil {
 			return fmt.Errorf("get task log events for log group %s: %w", s.logGroupName, err)
@@ -167,7 +197,7 @@ func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
 		if err := opts.OnEvents(s.w, cwEventsToHumanJSONStringers(logEventsOutput.Events)); err != nil {
 			return err
 		}
-		if !opts.Follow {
+		if !opts.Follow || stopped {
 			return nil
 		}
 		// for unit test.
@@ -175,13 +205,61 @@ func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
 			return nil
 		}
 		logEventsOpts.StreamLastEventTime = logEventsOutput.StreamLastEventTime
+		logEventsOpts.StreamSeenEventIDs = logEventsOutput.StreamSeenEventIDs
 		time.Sleep(cloudwatchlogs.SleepDuration)
 	}
 }
 
+func (s *ServiceClient) logEventsOpts(opts WriteLogEventsOpts) (cloudwatchlogs.LogEventsOpts, error) {
+	logEventsOpts := cloudwatchlogs.LogEventsOpts{
+		LogGroup:      s.logGroupName,
+		Limit:         opts.limit(),
+		EndTime:       opts.EndTime,
+		StartTime:     opts.startTime(s.now),
+		FilterPattern: opts.FilterPattern,
+	}
+	logStreams, err := s.logStreamPrefixes(opts.TaskIDs, opts.Containers)
+	if err != nil {
+		return cloudwatchlogs.LogEventsOpts{}, err
+	}
+	logEventsOpts.LogStreams = logStreams
+	return logEventsOpts, nil
+}
+
+// logStreamPrefixes returns the prefixes of the log streams of the tasks and containers.
+// If there are neither tasks nor containers, it returns nil to select all the log streams.
+func (s *ServiceClient) logStreamPrefixes(taskIDs, containers []string) ([]string, error) {
+	if containers != nil {
+		if s.logStreamNamePrefix == "" {
+			return nil, fmt.Errorf("cannot select containers for App Runner service logs")
+		}
+		return s.containerLogStreams(containers, taskIDs), nil
+	}
+	if taskIDs != nil {
+		return s.logStreams(taskIDs), nil
+	}
+	return nil, nil
+}
+
 func (s *ServiceClient) logStreams(taskIDs []string) (logStreamName []string) {
 	for _, taskID := range taskIDs {
 		logStreamName = append(logStreamName, fmt.Sprintf("%s/%s", s.logStreamNamePrefix, taskID))
 	}
 	return
 }
+
+// containerLogStreams returns the log stream name prefixes of the containers, restricted to the tasks if there are any.
+func (s *ServiceClient) containerLogStreams(containers, taskIDs []string) (logStreamName []string) {
+	for _, container := range containers {
+		prefix := fmt.Sprintf(fmtSvcLogStreamPrefix, container)
+		if len(taskIDs) == 0 {
+			// The trailing slash prevents a container name from matching the containers that it prefixes.
+			logStreamName = append(logStreamName, prefix+"/")
+			continue
+		}
+		for _, taskID := range taskIDs {
+			logStreamName = append(logStreamName, fmt.Sprintf("%s/%s", prefix, taskID))
+		}
+	}
+	return
+}
@@ -64,6 +64,9 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 		startTime  *int64
 		jsonOutput bool
 		taskIDs    []string
+		containers []string
+		filter     string
+		stopped    func() (bool, error)
 		setupMocks func(mocks serviceLogsMocks)
 
 		wantedError   error
@@ -141,6 +144,54 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -
 `,
 		},
+		"error if fail to check whether to stop following": {
+			follow: true,
+			stopped: func() (bool, error) {
+				return false, errors.New("some error")
+			},
+			setupMocks: func(m serviceLogsMocks) {},
+
+			wantedError: errors.New("some error"),
+		},
+		"stop following after retrieving the last log events": {
+			follow: true,
+			stopped: func() (bool, error) {
+				return true, nil
+			},
+			setupMocks: func(m serviceLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events:              moreLogEvents,
+						StreamLastEventTime: mockLastEventTime,
+					}, nil)
+			},
+
+			wantedContent: `firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -
+`,
+		},
+		"success with containers and filter pattern": {
+			taskIDs:    []string{"mockTaskID1"},
+			containers: []string{"api", "nginx"},
+			filter:     "ERROR",
+			setupMocks: func(m serviceLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, []string{"copilot/api/mockTaskID1", "copilot/nginx/mockTaskID1"}, param.LogStreams)
+						require.Equal(t, "ERROR", param.FilterPattern)
+					}).
+					Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+			},
+		},
+		"success with containers of all tasks": {
+			containers: []string{"api"},
+			setupMocks: func(m serviceLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, []string{"copilot/api/"}, param.LogStreams)
+					}).
+					Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+			},
+		},
 		"success with no filtering": {
 			taskIDs: []string{"mockTaskID1"},
 			setupMocks: func(m serviceLogsMocks) {
@@ -193,11 +244,14 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 				logWriter = WriteJSONLogs
 			}
 			err := svcLogs.WriteLogEvents(WriteLogEventsOpts{
-				Follow:    tc.follow,
-				TaskIDs:   tc.taskIDs,
-				Limit:     tc.limit,
-				StartTime: tc.startTime,
-				OnEvents:  logWriter,
+				Follow:        tc.follow,
+				TaskIDs:       tc.taskIDs,
+				Containers:    tc.containers,
+				FilterPattern: tc.filter,
+				Limit:         tc.limit,
+				StartTime:     tc.startTime,
+				OnEvents:      logWriter,
+				Stopped:       tc.stopped,
 			})
 
 			// THEN
@@ -78,6 +78,7 @@ func (t *TaskClient) WriteEventsUntilStopped() error {
 				return fmt.Errorf("write log event: %w", err)
 			}
 			in.StreamLastEventTime = logEventsOutput.StreamLastEventTime
+			in.StreamSeenEventIDs = logEventsOutput.StreamSeenEventIDs
 
 			t.sleep()
 		}
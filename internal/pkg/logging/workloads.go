@@ -0,0 +1,137 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	fcolor "github.com/fatih/color"
+)
+
+// Colors of the workload names prefixing the log events, assigned in order.
+var workloadColors = []*fcolor.Color{color.Cyan, color.Magenta, color.DullGreen, color.Blue, color.BoldFgYellow, color.HiCyan}
+
+// WorkloadsClient retrieves the logs of several services and jobs of an environment and interleaves them.
+type WorkloadsClient struct {
+	workloads []*workloadLogs
+	w         io.Writer
+
+	// Replaced in tests.
+	sleep func()
+}
+
+type workloadLogs struct {
+	name   string
+	label  string // Name of the workload padded to the length of the longest name.
+	color  *fcolor.Color
+	client *ServiceClient
+}
+
+// NewWorkloadsClient returns a WorkloadsClient for the workloads described by the configurations.
+func NewWorkloadsClient(configs []*NewServiceLogsConfig) (*WorkloadsClient, error) {
+	var width int
+	for _, cfg := range configs {
+		if len(cfg.Svc) > width {
+			width = len(cfg.Svc)
+		}
+	}
+	workloads := make([]*workloadLogs, len(configs))
+	for i, cfg := range configs {
+		client, err := NewServiceClient(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("new logs client for %s: %w", cfg.Svc, err)
+		}
+		workloads[i] = &workloadLogs{
+			name:   cfg.Svc,
+			label:  fmt.Sprintf("%-*s", width, cfg.Svc),
+			color:  workloadColors[i%len(workloadColors)],
+			client: client,
+		}
+	}
+	return &WorkloadsClient{
+		workloads: workloads,
+		w:         log.OutputWriter,
+		sleep: func() {
+			time.Sleep(cloudwatchlogs.SleepDuration)
+		},
+	}, nil
+}
+
+// WriteLogEvents writes the log events of all the workloads ordered by time, each prefixed with the name of its workload.
+func (c *WorkloadsClient) WriteLogEvents(opts WriteLogEventsOpts) error {
+	in := make([]cloudwatchlogs.LogEventsOpts, len(c.workloads))
+	for i, wkld := range c.workloads {
+		logEventsOpts, err := wkld.client.logEventsOpts(opts)
+		if err != nil {
+			return fmt.Errorf("workload %s: %w", wkld.name, err)
+		}
+		in[i] = logEventsOpts
+	}
+	for {
+		var events []*workloadEvent
+		for i, wkld := range c.workloads {
+			out, err := wkld.client.eventsGetter.LogEvents(in[i])
+			if err != nil {
+				return fmt.Errorf("get log events for log group %s: %w", wkld.client.logGroupName, err)
+			}
+			for _, event := range out.Events {
+				events = append(events, &workloadEvent{
+					Event:    event,
+					workload: wkld,
+				})
+			}
+			in[i].StreamLastEventTime = out.StreamLastEventTime
+			in[i].StreamSeenEventIDs = out.StreamSeenEventIDs
+		}
+		sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+		if limit := opts.limit(); limit != nil && len(events) > int(*limit) {
+			// Each workload returns up to limit events, only keep the latest ones across all workloads.
+			events = events[len(events)-int(*limit):]
+		}
+		logStringers := make([]HumanJSONStringer, len(events))
+		for i, event := range events {
+			logStringers[i] = event
+		}
+		if err := opts.OnEvents(c.w, logStringers); err != nil {
+			return err
+		}
+		if !opts.Follow {
+			return nil
+		}
+		c.sleep()
+	}
+}
+
+// workloadEvent is a log event of one of the workloads.
+type workloadEvent struct {
+	*cloudwatchlogs.Event
+	workload *workloadLogs
+}
+
+// HumanString returns the log event prefixed with the name of its workload.
+func (e *workloadEvent) HumanString() string {
+	return fmt.Sprintf("%s %s", e.workload.color.Sprint(e.workload.label), e.Event.HumanString())
+}
+
+// JSONString returns the log event in JSON format with the name of its workload.
+func (e *workloadEvent) JSONString() (string, error) {
+	b, err := json.Marshal(struct {
+		Workload string `json:"workload"`
+		*cloudwatchlogs.Event
+	}{
+		Workload: e.workload.name,
+		Event:    e.Event,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal a log event: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
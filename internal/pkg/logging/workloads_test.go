@@ -0,0 +1,163 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/logging/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	fcolor "github.com/fatih/color"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadsClient_WriteLogEvents(t *testing.T) {
+	testCases := map[string]struct {
+		follow     bool
+		limit      *int64
+		jsonOutput bool
+		setupMocks func(frontend, api *mocks.MocklogGetter)
+
+		wantedError   error
+		wantedContent string
+	}{
+		"should wrap the error if the logs of a workload can't be retrieved": {
+			setupMocks: func(frontend, api *mocks.MocklogGetter) {
+				frontend.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+				api.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get log events for log group /copilot/phonetool-test-api: some error"),
+		},
+		"should interleave the events of all workloads and keep the latest ones": {
+			limit: aws.Int64(3),
+			setupMocks: func(frontend, api *mocks.MocklogGetter) {
+				frontend.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{LogStreamName: "copilot/frontend/1", Message: "GET /", Timestamp: 1},
+						{LogStreamName: "copilot/frontend/1", Message: "GET /orders", Timestamp: 3},
+					},
+				}, nil)
+				api.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{LogStreamName: "copilot/api/1", Message: "listing orders", Timestamp: 4},
+						{LogStreamName: "copilot/api/1", Message: "starting", Timestamp: 2},
+					},
+				}, nil)
+			},
+			wantedContent: `api      copilot/api/1 starting
+frontend copilot/frontend/1 GET /orders
+api      copilot/api/1 listing orders
+`,
+		},
+		"should include the workload in JSON output": {
+			jsonOutput: true,
+			setupMocks: func(frontend, api *mocks.MocklogGetter) {
+				frontend.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{LogStreamName: "copilot/frontend/1", Message: "GET /", Timestamp: 1},
+					},
+				}, nil)
+				api.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+			},
+			wantedContent: `{"workload":"frontend","logStreamName":"copilot/frontend/1","ingestionTime":0,"message":"GET /","timestamp":1}
+`,
+		},
+		"should resume each workload from its last events when following": {
+			follow: true,
+			setupMocks: func(frontend, api *mocks.MocklogGetter) {
+				gomock.InOrder(
+					frontend.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{LogStreamName: "copilot/frontend/1", Message: "GET /", Timestamp: 1},
+						},
+						StreamLastEventTime: map[string]int64{"copilot/frontend/1": 1},
+					}, nil),
+					frontend.EXPECT().LogEvents(gomock.Any()).Do(func(in cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, map[string]int64{"copilot/frontend/1": 1}, in.StreamLastEventTime)
+					}).Return(nil, errors.New("some error")),
+				)
+				api.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+			},
+			wantedError: errors.New("get log events for log group /copilot/phonetool-test-frontend: some error"),
+			wantedContent: `frontend copilot/frontend/1 GET /
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			frontend, api := mocks.NewMocklogGetter(ctrl), mocks.NewMocklogGetter(ctrl)
+			tc.setupMocks(frontend, api)
+			b := new(strings.Builder)
+			client := &WorkloadsClient{
+				workloads: []*workloadLogs{
+					{
+						name:  "frontend",
+						label: "frontend",
+						color: color.Cyan,
+						client: &ServiceClient{
+							logGroupName: "/copilot/phonetool-test-frontend",
+							eventsGetter: frontend,
+						},
+					},
+					{
+						name:  "api",
+						label: "api     ",
+						color: color.Magenta,
+						client: &ServiceClient{
+							logGroupName: "/copilot/phonetool-test-api",
+							eventsGetter: api,
+						},
+					},
+				},
+				w:     b,
+				sleep: func() {},
+			}
+			for _, wkld := range client.workloads {
+				wkld.client.now = func() time.Time { return time.Time{} }
+			}
+			onEvents := WriteHumanLogs
+			if tc.jsonOutput {
+				onEvents = WriteJSONLogs
+			}
+
+			err := client.WriteLogEvents(WriteLogEventsOpts{
+				Follow:   tc.follow,
+				Limit:    tc.limit,
+				OnEvents: onEvents,
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
+
+func TestHighlightHumanLogs(t *testing.T) {
+	noColor := fcolor.NoColor
+	fcolor.NoColor = false
+	defer func() { fcolor.NoColor = noColor }()
+	b := new(strings.Builder)
+	events := []*cloudwatchlogs.Event{
+		{LogStreamName: "copilot/api/1", Message: "GET /orders/42"},
+	}
+
+	err := HighlightHumanLogs(regexp.MustCompile(`\d+`))(b, cwEventsToHumanJSONStringers(events))
+
+	require.NoError(t, err)
+	require.Equal(t, color.Grey.Sprint("copilot/api/1")+" GET /orders/\x1b[7m42\x1b[0m\n", b.String())
+}
@@ -644,6 +644,92 @@ func TestApplyEnv_StructSlice(t *testing.T) {
 	}
 }
 
+func TestApplyEnv_Patches(t *testing.T) {
+	testCases := map[string]struct {
+		inSvc  func(svc *LoadBalancedWebService)
+		wanted func(svc *LoadBalancedWebService)
+	}{
+		"patches overridden by the environment": {
+			inSvc: func(svc *LoadBalancedWebService) {
+				svc.Patches = []PatchOperation{
+					{
+						Operation: "remove",
+						Path:      "/Resources/LogGroup/Properties/RetentionInDays",
+					},
+				}
+				svc.Environments["test"].Patches = []PatchOperation{
+					{
+						Operation: "copy",
+						From:      "/Resources/Service/Properties/Tags",
+						Path:      "/Resources/TaskRole/Properties/Tags",
+					},
+				}
+			},
+			wanted: func(svc *LoadBalancedWebService) {
+				svc.Patches = []PatchOperation{
+					{
+						Operation: "copy",
+						From:      "/Resources/Service/Properties/Tags",
+						Path:      "/Resources/TaskRole/Properties/Tags",
+					},
+				}
+			},
+		},
+		"patches only set in the environment": {
+			inSvc: func(svc *LoadBalancedWebService) {
+				svc.Environments["test"].Patches = []PatchOperation{
+					{
+						Operation: "remove",
+						Path:      "/Resources/LogGroup/Properties/RetentionInDays",
+					},
+				}
+			},
+			wanted: func(svc *LoadBalancedWebService) {
+				svc.Patches = []PatchOperation{
+					{
+						Operation: "remove",
+						Path:      "/Resources/LogGroup/Properties/RetentionInDays",
+					},
+				}
+			},
+		},
+		"patches not overridden": {
+			inSvc: func(svc *LoadBalancedWebService) {
+				svc.Patches = []PatchOperation{
+					{
+						Operation: "remove",
+						Path:      "/Resources/LogGroup/Properties/RetentionInDays",
+					},
+				}
+			},
+			wanted: func(svc *LoadBalancedWebService) {
+				svc.Patches = []PatchOperation{
+					{
+						Operation: "remove",
+						Path:      "/Resources/LogGroup/Properties/RetentionInDays",
+					},
+				}
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var inSvc, wantedSvc LoadBalancedWebService
+			inSvc.Environments = map[string]*LoadBalancedWebServiceConfig{
+				"test": {},
+			}
+
+			tc.inSvc(&inSvc)
+			tc.wanted(&wantedSvc)
+
+			got, err := inSvc.ApplyEnv("test")
+
+			require.NoError(t, err)
+			require.Equal(t, &wantedSvc, got)
+		})
+	}
+}
+
 func TestApplyEnv_MapToString(t *testing.T) {
 	testCases := map[string]struct {
 		inSvc  func(svc *LoadBalancedWebService)
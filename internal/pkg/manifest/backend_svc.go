@@ -33,6 +33,7 @@ type BackendServiceConfig struct {
 	Network          NetworkConfig             `yaml:"network"`
 	PublishConfig    PublishConfig             `yaml:"publish"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	Patches          []PatchOperation          `yaml:"patches"`
 }
 
 // BackendServiceProps represents the configuration needed to create a backend service.
@@ -90,6 +91,11 @@ func (s *BackendService) Publish() []Topic {
 	return s.BackendServiceConfig.PublishConfig.Topics
 }
 
+// ContainerSecrets returns the secrets of the main container, the sidecars and the log router.
+func (s *BackendService) ContainerSecrets() []Secret {
+	return containerSecrets(s.BackendServiceConfig.TaskConfig, s.BackendServiceConfig.Logging, s.BackendServiceConfig.Sidecars)
+}
+
 // BuildRequired returns if the service requires building from the local Dockerfile.
 func (s *BackendService) BuildRequired() (bool, error) {
 	return requiresBuild(s.ImageConfig.Image)
@@ -0,0 +1,134 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvironmentManifestType identifies that the type of a manifest is an environment manifest.
+const EnvironmentManifestType = "Environment"
+
+// Environment is the manifest configuration for an environment.
+type Environment struct {
+	Name              *string `yaml:"name"`
+	Type              *string `yaml:"type"` // must be "Environment".
+	EnvironmentConfig `yaml:",inline"`
+}
+
+// EnvironmentConfig holds the configuration for an environment.
+type EnvironmentConfig struct {
+	Network       environmentNetworkConfig `yaml:"network,omitempty"`
+	Observability environmentObservability `yaml:"observability,omitempty"`
+}
+
+type environmentNetworkConfig struct {
+	VPC environmentVPCConfig `yaml:"vpc,omitempty"`
+}
+
+type environmentVPCConfig struct {
+	ID      *string              `yaml:"id"`   // ID of an existing VPC to import.
+	CIDR    *IPNet               `yaml:"cidr"` // CIDR range of the VPC managed by Copilot.
+	Subnets subnetsConfiguration `yaml:"subnets,omitempty"`
+}
+
+type subnetsConfiguration struct {
+	Public  []subnetConfiguration `yaml:"public,omitempty"`
+	Private []subnetConfiguration `yaml:"private,omitempty"`
+}
+
+func (s subnetsConfiguration) isEmpty() bool {
+	return len(s.Public) == 0 && len(s.Private) == 0
+}
+
+type subnetConfiguration struct {
+	SubnetID *string `yaml:"id"`   // ID of an existing subnet to import.
+	CIDR     *IPNet  `yaml:"cidr"` // CIDR range of the subnet managed by Copilot.
+	AZ       *string `yaml:"az"`   // Availability zone of the subnet managed by Copilot.
+}
+
+func (s subnetConfiguration) cidr() string {
+	if s.CIDR == nil {
+		return ""
+	}
+	return string(*s.CIDR)
+}
+
+type environmentObservability struct {
+	ContainerInsights *bool `yaml:"container_insights"`
+}
+
+// UnmarshalEnvironment deserializes the YAML input stream into an environment manifest object.
+// If the type in the manifest is not an environment, then returns an ErrInvalidWorkloadType.
+func UnmarshalEnvironment(in []byte) (*Environment, error) {
+	var env Environment
+	if err := yaml.Unmarshal(in, &env); err != nil {
+		return nil, fmt.Errorf("unmarshal environment manifest: %w", err)
+	}
+	if typ := aws.StringValue(env.Type); typ != EnvironmentManifestType {
+		return nil, &ErrInvalidWorkloadType{Type: typ}
+	}
+	return &env, nil
+}
+
+// ImportedVPC returns the configuration of an existing VPC to import.
+// If the manifest does not import a VPC, then returns nil.
+func (e *Environment) ImportedVPC() *config.ImportVPC {
+	vpc := e.Network.VPC
+	if vpc.ID == nil {
+		return nil
+	}
+	var publicSubnetIDs, privateSubnetIDs []string
+	for _, subnet := range vpc.Subnets.Public {
+		publicSubnetIDs = append(publicSubnetIDs, aws.StringValue(subnet.SubnetID))
+	}
+	for _, subnet := range vpc.Subnets.Private {
+		privateSubnetIDs = append(privateSubnetIDs, aws.StringValue(subnet.SubnetID))
+	}
+	return &config.ImportVPC{
+		ID:               aws.StringValue(vpc.ID),
+		PublicSubnetIDs:  publicSubnetIDs,
+		PrivateSubnetIDs: privateSubnetIDs,
+	}
+}
+
+// AdjustedVPC returns the configuration to override the default VPC managed by Copilot.
+// If the manifest does not adjust the VPC, then returns nil.
+func (e *Environment) AdjustedVPC() *config.AdjustVPC {
+	vpc := e.Network.VPC
+	if vpc.CIDR == nil {
+		return nil
+	}
+	var azs, publicSubnetCIDRs, privateSubnetCIDRs []string
+	for _, subnet := range vpc.Subnets.Public {
+		publicSubnetCIDRs = append(publicSubnetCIDRs, subnet.cidr())
+		if subnet.AZ != nil {
+			azs = append(azs, aws.StringValue(subnet.AZ))
+		}
+	}
+	for _, subnet := range vpc.Subnets.Private {
+		privateSubnetCIDRs = append(privateSubnetCIDRs, subnet.cidr())
+	}
+	return &config.AdjustVPC{
+		CIDR:               string(*vpc.CIDR),
+		AZs:                azs,
+		PublicSubnetCIDRs:  publicSubnetCIDRs,
+		PrivateSubnetCIDRs: privateSubnetCIDRs,
+	}
+}
+
+// TelemetryConfig returns the observability configuration of the environment.
+// If no observability feature is configured, then returns nil.
+func (e *Environment) TelemetryConfig() *config.Telemetry {
+	if e.Observability.ContainerInsights == nil {
+		return nil
+	}
+	return &config.Telemetry{
+		EnableContainerInsights: aws.BoolValue(e.Observability.ContainerInsights),
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedStruct *Environment
+		wantedErr    error
+	}{
+		"error if the manifest is not an environment": {
+			inContent: `name: test
+type: Backend Service`,
+			wantedErr: errors.New("invalid manifest type: Backend Service"),
+		},
+		"unmarshal an environment that imports a VPC": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-123
+    subnets:
+      public:
+        - id: subnet-1
+        - id: subnet-2
+      private:
+        - id: subnet-3
+        - id: subnet-4
+observability:
+  container_insights: true
+`,
+			wantedStruct: &Environment{
+				Name: aws.String("test"),
+				Type: aws.String(EnvironmentManifestType),
+				EnvironmentConfig: EnvironmentConfig{
+					Network: environmentNetworkConfig{
+						VPC: environmentVPCConfig{
+							ID: aws.String("vpc-123"),
+							Subnets: subnetsConfiguration{
+								Public: []subnetConfiguration{
+									{SubnetID: aws.String("subnet-1")},
+									{SubnetID: aws.String("subnet-2")},
+								},
+								Private: []subnetConfiguration{
+									{SubnetID: aws.String("subnet-3")},
+									{SubnetID: aws.String("subnet-4")},
+								},
+							},
+						},
+					},
+					Observability: environmentObservability{
+						ContainerInsights: aws.Bool(true),
+					},
+				},
+			},
+		},
+		"unmarshal an environment that adjusts the default VPC": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    cidr: 10.0.0.0/16
+    subnets:
+      public:
+        - cidr: 10.0.0.0/24
+          az: us-east-2a
+      private:
+        - cidr: 10.0.1.0/24
+          az: us-east-2a
+`,
+			wantedStruct: &Environment{
+				Name: aws.String("test"),
+				Type: aws.String(EnvironmentManifestType),
+				EnvironmentConfig: EnvironmentConfig{
+					Network: environmentNetworkConfig{
+						VPC: environmentVPCConfig{
+							CIDR: ipNetP("10.0.0.0/16"),
+							Subnets: subnetsConfiguration{
+								Public: []subnetConfiguration{
+									{CIDR: ipNetP("10.0.0.0/24"), AZ: aws.String("us-east-2a")},
+								},
+								Private: []subnetConfiguration{
+									{CIDR: ipNetP("10.0.1.0/24"), AZ: aws.String("us-east-2a")},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := UnmarshalEnvironment([]byte(tc.inContent))
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStruct, got)
+			}
+		})
+	}
+}
+
+func TestEnvironment_ImportedVPC(t *testing.T) {
+	testCases := map[string]struct {
+		inVPC  environmentVPCConfig
+		wanted *config.ImportVPC
+	}{
+		"nil if the VPC is not imported": {
+			inVPC: environmentVPCConfig{
+				CIDR: ipNetP("10.0.0.0/16"),
+			},
+		},
+		"imported VPC with subnets": {
+			inVPC: environmentVPCConfig{
+				ID: aws.String("vpc-123"),
+				Subnets: subnetsConfiguration{
+					Public:  []subnetConfiguration{{SubnetID: aws.String("subnet-1")}},
+					Private: []subnetConfiguration{{SubnetID: aws.String("subnet-2")}},
+				},
+			},
+			wanted: &config.ImportVPC{
+				ID:               "vpc-123",
+				PublicSubnetIDs:  []string{"subnet-1"},
+				PrivateSubnetIDs: []string{"subnet-2"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			env := &Environment{
+				EnvironmentConfig: EnvironmentConfig{
+					Network: environmentNetworkConfig{
+						VPC: tc.inVPC,
+					},
+				},
+			}
+			require.Equal(t, tc.wanted, env.ImportedVPC())
+		})
+	}
+}
+
+func TestEnvironment_AdjustedVPC(t *testing.T) {
+	testCases := map[string]struct {
+		inVPC  environmentVPCConfig
+		wanted *config.AdjustVPC
+	}{
+		"nil if the VPC is not adjusted": {
+			inVPC: environmentVPCConfig{
+				ID: aws.String("vpc-123"),
+			},
+		},
+		"adjusted VPC with availability zones": {
+			inVPC: environmentVPCConfig{
+				CIDR: ipNetP("10.0.0.0/16"),
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{CIDR: ipNetP("10.0.0.0/24"), AZ: aws.String("us-east-2a")},
+						{CIDR: ipNetP("10.0.1.0/24"), AZ: aws.String("us-east-2b")},
+					},
+					Private: []subnetConfiguration{
+						{CIDR: ipNetP("10.0.2.0/24"), AZ: aws.String("us-east-2a")},
+						{CIDR: ipNetP("10.0.3.0/24"), AZ: aws.String("us-east-2b")},
+					},
+				},
+			},
+			wanted: &config.AdjustVPC{
+				CIDR:               "10.0.0.0/16",
+				AZs:                []string{"us-east-2a", "us-east-2b"},
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+				PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			env := &Environment{
+				EnvironmentConfig: EnvironmentConfig{
+					Network: environmentNetworkConfig{
+						VPC: tc.inVPC,
+					},
+				},
+			}
+			require.Equal(t, tc.wanted, env.AdjustedVPC())
+		})
+	}
+}
+
+func TestEnvironment_TelemetryConfig(t *testing.T) {
+	require.Nil(t, (&Environment{}).TelemetryConfig())
+	require.Equal(t, &config.Telemetry{EnableContainerInsights: false}, (&Environment{
+		EnvironmentConfig: EnvironmentConfig{
+			Observability: environmentObservability{
+				ContainerInsights: aws.Bool(false),
+			},
+		},
+	}).TelemetryConfig())
+}
+
+func ipNetP(s string) *IPNet {
+	ip := IPNet(s)
+	return &ip
+}
@@ -4,8 +4,10 @@
 package manifest
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/dustin/go-humanize/english"
 )
@@ -106,3 +108,67 @@ func (e *errAtLeastOneFieldMustBeSpecified) Error() string {
 	}
 	return errMsg
 }
+
+// errFields holds the errors of every invalid field found while validating a manifest,
+// so that validation doesn't stop at the first invalid field.
+type errFields []error
+
+// add records err if it's not nil.
+func (e *errFields) add(err error) {
+	if err != nil {
+		*e = append(*e, err)
+	}
+}
+
+// err returns nil if no error was recorded, the only error recorded, or all of them.
+func (e errFields) err() error {
+	switch len(e) {
+	case 0:
+		return nil
+	case 1:
+		return e[0]
+	}
+	return e
+}
+
+func (e errFields) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the errors of the fields.
+func (e errFields) Unwrap() []error {
+	return e
+}
+
+// FieldErrors splits an error returned by Validate into the error of each invalid field.
+// The fields an error is nested under, such as `validate "http": `, are kept in the message of each error.
+func FieldErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if fields, ok := err.(errFields); ok {
+		var errs []error
+		for _, field := range fields {
+			errs = append(errs, FieldErrors(field)...)
+		}
+		return errs
+	}
+	inner := errors.Unwrap(err)
+	if inner == nil {
+		return []error{err}
+	}
+	nested := FieldErrors(inner)
+	prefix := strings.TrimSuffix(err.Error(), inner.Error())
+	if len(nested) == 1 || prefix == err.Error() {
+		return []error{err}
+	}
+	errs := make([]error, len(nested))
+	for i, n := range nested {
+		errs[i] = fmt.Errorf("%s%w", prefix, n)
+	}
+	return errs
+}
@@ -45,14 +45,92 @@ type ScheduledJobConfig struct {
 	Sidecars                map[string]*SidecarConfig `yaml:"sidecars"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
 	On                      JobTriggerConfig          `yaml:"on,flow"`
 	JobFailureHandlerConfig `yaml:",inline"`
-	Network                 NetworkConfig  `yaml:"network"`
-	PublishConfig           PublishConfig  `yaml:"publish"`
-	TaskDefOverrides        []OverrideRule `yaml:"taskdef_overrides"`
+	Workflow                JobWorkflow      `yaml:"workflow"`
+	Network                 NetworkConfig    `yaml:"network"`
+	PublishConfig           PublishConfig    `yaml:"publish"`
+	TaskDefOverrides        []OverrideRule   `yaml:"taskdef_overrides"`
+	Patches                 []PatchOperation `yaml:"patches"`
 }
 
 // JobTriggerConfig represents the configuration for the event that triggers the job.
 type JobTriggerConfig struct {
-	Schedule *string `yaml:"schedule"`
+	Schedule *string          `yaml:"schedule"`
+	Event    JobEventTrigger  `yaml:"event"`
+	S3       JobS3Trigger     `yaml:"s3"`
+	SNS      JobSNSTrigger    `yaml:"sns"`
+	Manual   *bool            `yaml:"manual"`
+	Payload  JobPayloadConfig `yaml:"payload"`
+}
+
+// IsUnscheduled returns true if the job is triggered by events or only run manually instead of on a schedule.
+func (c JobTriggerConfig) IsUnscheduled() bool {
+	return c.Schedule == nil && len(c.triggers()) > 0
+}
+
+// triggers returns the names of the trigger fields that are specified.
+func (c JobTriggerConfig) triggers() []string {
+	var names []string
+	if c.Schedule != nil {
+		names = append(names, "schedule")
+	}
+	if !c.Event.IsEmpty() {
+		names = append(names, "event")
+	}
+	if !c.S3.IsEmpty() {
+		names = append(names, "s3")
+	}
+	if !c.SNS.IsEmpty() {
+		names = append(names, "sns")
+	}
+	if c.Manual != nil {
+		names = append(names, "manual")
+	}
+	return names
+}
+
+// JobEventTrigger triggers the job on the EventBridge events that match a pattern.
+type JobEventTrigger struct {
+	Pattern map[string]interface{} `yaml:"pattern"`
+	Bus     *string                `yaml:"bus"` // Name or ARN of the event bus, defaults to the default event bus.
+}
+
+// IsEmpty returns true if no event pattern is specified.
+func (e JobEventTrigger) IsEmpty() bool {
+	return len(e.Pattern) == 0 && e.Bus == nil
+}
+
+// JobS3Trigger triggers the job when objects are created in an S3 bucket.
+type JobS3Trigger struct {
+	Bucket *string `yaml:"bucket"`
+	Prefix *string `yaml:"prefix"`
+}
+
+// IsEmpty returns true if no bucket is specified.
+func (s JobS3Trigger) IsEmpty() bool {
+	return s.Bucket == nil && s.Prefix == nil
+}
+
+// JobSNSTrigger triggers the job on the messages published to an SNS topic of another workload.
+type JobSNSTrigger struct {
+	Name         *string                `yaml:"name"`
+	Service      *string                `yaml:"service"`
+	FilterPolicy map[string]interface{} `yaml:"filter_policy"`
+}
+
+// IsEmpty returns true if no topic is specified.
+func (s JobSNSTrigger) IsEmpty() bool {
+	return s.Name == nil && s.Service == nil && len(s.FilterPolicy) == 0
+}
+
+// JobPayloadConfig represents how the payload of the event that triggered the job is passed to the container.
+type JobPayloadConfig struct {
+	Variable *string `yaml:"variable"` // Name of the environment variable with the payload.
+	Path     *string `yaml:"path"`     // Absolute path of a file to write the payload to instead.
+}
+
+// IsEmpty returns true if the payload isn't configured.
+func (p JobPayloadConfig) IsEmpty() bool {
+	return p.Variable == nil && p.Path == nil
 }
 
 // JobFailureHandlerConfig represents the error handling configuration for the job.
@@ -61,6 +139,16 @@ type JobFailureHandlerConfig struct {
 	Retries *int    `yaml:"retries"`
 }
 
+// JobWorkflow represents the jobs that run as the steps of a workflow when the job is triggered, keyed by job name.
+// NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
+type JobWorkflow map[string]*JobWorkflowStep
+
+// JobWorkflowStep represents the configuration for a job that runs as a step of a workflow.
+type JobWorkflowStep struct {
+	DependsOn               []string `yaml:"depends_on"`
+	JobFailureHandlerConfig `yaml:",inline"`
+}
+
 // ScheduledJobProps contains properties for creating a new scheduled job manifest.
 type ScheduledJobProps struct {
 	*WorkloadProps
@@ -123,11 +211,30 @@ func (j ScheduledJob) ApplyEnv(envName string) (WorkloadManifest, error) {
 	return &j, nil
 }
 
+// Subscriptions returns the SNS topic that triggers the job, if any.
+func (j *ScheduledJob) Subscriptions() []TopicSubscription {
+	if j.On.SNS.IsEmpty() {
+		return nil
+	}
+	return []TopicSubscription{
+		{
+			Name:         j.On.SNS.Name,
+			Service:      j.On.SNS.Service,
+			FilterPolicy: j.On.SNS.FilterPolicy,
+		},
+	}
+}
+
 // Publish returns the list of topics where notifications can be published.
 func (j *ScheduledJob) Publish() []Topic {
 	return j.ScheduledJobConfig.PublishConfig.Topics
 }
 
+// ContainerSecrets returns the secrets of the main container, the sidecars and the log router.
+func (j *ScheduledJob) ContainerSecrets() []Secret {
+	return containerSecrets(j.ScheduledJobConfig.TaskConfig, j.ScheduledJobConfig.Logging, j.ScheduledJobConfig.Sidecars)
+}
+
 // BuildArgs returns a docker.BuildArguments object for the job given a workspace root.
 func (j *ScheduledJob) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return j.ImageConfig.Image.BuildConfig(wsRoot)
@@ -29,6 +29,17 @@ const (
 	GRPCProtocol = "gRPC" // GRPCProtocol is the HTTP protocol version for gRPC.
 )
 
+// Deployment strategies for a load balanced web service.
+const (
+	DeploymentStrategyRolling   = "rolling"    // Replace tasks in batches behind the same target group.
+	DeploymentStrategyBlueGreen = "blue-green" // Shift all traffic to the new tasks at once.
+	DeploymentStrategyCanary    = "canary"     // Shift a percentage of traffic, then the rest after an interval.
+	DeploymentStrategyLinear    = "linear"     // Shift an equal percentage of traffic at every interval.
+)
+
+// DeploymentStrategies are the supported deployment strategies for a load balanced web service.
+var DeploymentStrategies = []string{DeploymentStrategyRolling, DeploymentStrategyBlueGreen, DeploymentStrategyCanary, DeploymentStrategyLinear}
+
 var (
 	errUnmarshalHealthCheckArgs = errors.New("can't unmarshal healthcheck field into string or compose-style map")
 )
@@ -61,7 +72,9 @@ type LoadBalancedWebServiceConfig struct {
 	Network          NetworkConfig                    `yaml:"network"`
 	PublishConfig    PublishConfig                    `yaml:"publish"`
 	TaskDefOverrides []OverrideRule                   `yaml:"taskdef_overrides"`
+	Patches          []PatchOperation                 `yaml:"patches"`
 	NLBConfig        NetworkLoadBalancerConfiguration `yaml:"nlb"`
+	Deployment       DeploymentConfig                 `yaml:"deployment"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -162,6 +175,11 @@ func (s *LoadBalancedWebService) Publish() []Topic {
 	return s.LoadBalancedWebServiceConfig.PublishConfig.Topics
 }
 
+// ContainerSecrets returns the secrets of the main container, the sidecars and the log router.
+func (s *LoadBalancedWebService) ContainerSecrets() []Secret {
+	return containerSecrets(s.LoadBalancedWebServiceConfig.TaskConfig, s.LoadBalancedWebServiceConfig.Logging, s.LoadBalancedWebServiceConfig.Sidecars)
+}
+
 // BuildRequired returns if the service requires building from the local Dockerfile.
 func (s *LoadBalancedWebService) BuildRequired() (bool, error) {
 	return requiresBuild(s.ImageConfig.Image)
@@ -256,6 +274,39 @@ type RoutingRuleConfiguration struct {
 	TargetContainer          *string `yaml:"target_container"`
 	TargetContainerCamelCase *string `yaml:"targetContainer"` // "targetContainerCamelCase" for backwards compatibility
 	AllowedSourceIps         []IPNet `yaml:"allowed_source_ips"`
+	// Priority is the priority of the listener rule that routes to the service.
+	Priority        *int                    `yaml:"priority"`
+	AdditionalRules []AdditionalRoutingRule `yaml:"additional_rules"`
+}
+
+// AdditionalRoutingRule holds the conditions and action of an extra listener rule for the service.
+type AdditionalRoutingRule struct {
+	Priority         *int                `yaml:"priority"`
+	Hosts            []string            `yaml:"hosts"`
+	Paths            []string            `yaml:"paths"`
+	Headers          map[string][]string `yaml:"headers"`
+	QueryStrings     map[string]string   `yaml:"query_strings"`
+	AllowedSourceIps []IPNet             `yaml:"allowed_source_ips"`
+	FixedResponse    *FixedResponse      `yaml:"fixed_response"`
+	Redirect         *RedirectAction     `yaml:"redirect"`
+}
+
+// FixedResponse holds the response returned by the load balancer when a rule matches.
+type FixedResponse struct {
+	StatusCode  *int    `yaml:"status_code"`
+	ContentType *string `yaml:"content_type"`
+	Body        *string `yaml:"body"`
+}
+
+// RedirectAction holds the URL components the load balancer redirects to when a rule matches.
+// Components that are not set keep their original value.
+type RedirectAction struct {
+	Protocol   *string `yaml:"protocol"`
+	Host       *string `yaml:"host"`
+	Port       *string `yaml:"port"`
+	Path       *string `yaml:"path"`
+	Query      *string `yaml:"query"`
+	StatusCode *int    `yaml:"status_code"`
 }
 
 func (r *RoutingRuleConfiguration) targetContainer() *string {
@@ -270,7 +321,8 @@ func (r *RoutingRuleConfiguration) targetContainer() *string {
 
 func (r *RoutingRuleConfiguration) isEmpty() bool {
 	return r.Path == nil && r.ProtocolVersion == nil && r.HealthCheck.IsEmpty() && r.Stickiness == nil && r.Alias.IsEmpty() &&
-		r.DeregistrationDelay == nil && r.TargetContainer == nil && r.TargetContainerCamelCase == nil && r.AllowedSourceIps == nil
+		r.DeregistrationDelay == nil && r.TargetContainer == nil && r.TargetContainerCamelCase == nil && r.AllowedSourceIps == nil &&
+		r.Priority == nil && r.AdditionalRules == nil
 }
 
 // NetworkLoadBalancerConfiguration holds options for a network load balancer
@@ -289,6 +341,26 @@ func (c *NetworkLoadBalancerConfiguration) IsEmpty() bool {
 		c.SSLPolicy == nil && c.Stickiness == nil && c.Aliases.IsEmpty()
 }
 
+// DeploymentConfig represents how new versions of a service are rolled out.
+type DeploymentConfig struct {
+	Strategy       *string        `yaml:"strategy"`
+	Percent        *int           `yaml:"percent"`   // Percentage of traffic shifted at each step of a canary or linear deployment.
+	Interval       *time.Duration `yaml:"interval"`  // Time to wait between steps of a canary or linear deployment.
+	BakeTime       *time.Duration `yaml:"bake_time"` // Time to keep the previous tasks after all traffic is shifted.
+	RollbackAlarms []string       `yaml:"rollback_alarms"`
+}
+
+// ShiftsTraffic returns true if the deployment strategy shifts traffic between two target groups
+// instead of replacing tasks behind the same target group.
+func (d *DeploymentConfig) ShiftsTraffic() bool {
+	switch aws.StringValue(d.Strategy) {
+	case DeploymentStrategyBlueGreen, DeploymentStrategyCanary, DeploymentStrategyLinear:
+		return true
+	default:
+		return false
+	}
+}
+
 // IPNet represents an IP network string. For example: 10.1.0.0/16
 type IPNet string
 
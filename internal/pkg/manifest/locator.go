@@ -0,0 +1,229 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// Validation errors wrap the error of a field with `validate "field"` or `validate "field[index]"`.
+	validatedFieldRegexp = regexp.MustCompile(`validate "([^"]+)"`)
+	fieldIndexRegexp     = regexp.MustCompile(`^(.+)\[([^\]]+)\]$`)
+)
+
+// Position is the location of a node in a YAML document.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// String returns the position in the format "line:column".
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Locator finds the position of fields in a manifest document.
+type Locator struct {
+	root *yaml.Node
+}
+
+// NewLocator parses the manifest document to locate its fields.
+func NewLocator(doc []byte) (*Locator, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(doc, &node); err != nil {
+		return nil, err
+	}
+	var root *yaml.Node
+	if len(node.Content) != 0 {
+		root = node.Content[0]
+	}
+	return &Locator{root: root}, nil
+}
+
+// Environments returns the names of the environments overridden in the manifest in the order they're defined.
+func (l *Locator) Environments() []string {
+	_, envs := mappingValue(l.root, "environments")
+	if envs == nil || envs.Kind != yaml.MappingNode {
+		return nil
+	}
+	var names []string
+	for i := 0; i < len(envs.Content); i += 2 {
+		names = append(names, envs.Content[i].Value)
+	}
+	return names
+}
+
+// Translate takes a document with the same structure as the manifest, such as the manifest with its
+// environment variables interpolated, and returns the position in the manifest of the node at line in the document.
+func (l *Locator) Translate(doc []byte, line int) (Position, bool) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(doc, &node); err != nil || len(node.Content) == 0 {
+		return Position{}, false
+	}
+	path, ok := pathAtLine(node.Content[0], line)
+	if !ok {
+		return Position{}, false
+	}
+	curr := l.root
+	for _, segment := range path {
+		next := child(curr, segment)
+		if next == nil {
+			return Position{}, false
+		}
+		curr = next
+	}
+	return Position{Line: curr.Line, Column: curr.Column}, true
+}
+
+// ValidationError returns the position of the field that failed the validation of the manifest
+// with the overrides of the environment applied.
+func (l *Locator) ValidationError(envName string, err error) (Position, bool) {
+	var path []string
+	for _, match := range validatedFieldRegexp.FindAllStringSubmatch(err.Error(), -1) {
+		if parts := fieldIndexRegexp.FindStringSubmatch(match[1]); parts != nil {
+			path = append(path, parts[1], parts[2])
+			continue
+		}
+		path = append(path, match[1])
+	}
+	if len(path) == 0 {
+		return Position{}, false
+	}
+	// Prefer the environment overrides as they take precedence over the rest of the manifest.
+	var env *yaml.Node
+	if _, envs := mappingValue(l.root, "environments"); envName != "" {
+		_, env = mappingValue(envs, envName)
+	}
+	key, depth := locate(withoutKey(l.root, "environments"), path)
+	if envKey, envDepth := locate(env, path); envKey != nil && envDepth >= depth {
+		key = envKey
+	}
+	if key == nil {
+		return Position{}, false
+	}
+	return Position{Line: key.Line, Column: key.Column}, true
+}
+
+// locate returns the node of the deepest field in path that exists under node, and the number of fields matched.
+// The fields in path don't have to be direct descendants of each other.
+func locate(node *yaml.Node, path []string) (*yaml.Node, int) {
+	if node == nil {
+		return nil, 0
+	}
+	var found *yaml.Node
+	curr := node
+	for depth, segment := range path {
+		if curr.Kind == yaml.SequenceNode {
+			item := child(curr, segment)
+			if item == nil {
+				return found, depth
+			}
+			found, curr = item, item
+			continue
+		}
+		key, value := findKey(curr, segment)
+		if key == nil {
+			return found, depth
+		}
+		found, curr = key, value
+	}
+	return found, len(path)
+}
+
+// findKey searches the descendants of node breadth-first for the key.
+func findKey(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	queue := []*yaml.Node{node}
+	for len(queue) != 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		switch curr.Kind {
+		case yaml.MappingNode:
+			if k, v := mappingValue(curr, key); k != nil {
+				return k, v
+			}
+			for i := 1; i < len(curr.Content); i += 2 {
+				queue = append(queue, curr.Content[i])
+			}
+		case yaml.SequenceNode:
+			queue = append(queue, curr.Content...)
+		}
+	}
+	return nil, nil
+}
+
+// pathAtLine returns the path to the first node that starts at line.
+func pathAtLine(node *yaml.Node, line int) ([]string, bool) {
+	if node.Line == line && node.Kind != yaml.MappingNode && node.Kind != yaml.SequenceNode {
+		return nil, true
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content); i += 2 {
+			if path, ok := pathAtLine(node.Content[i+1], line); ok {
+				return append([]string{node.Content[i].Value}, path...), true
+			}
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			if path, ok := pathAtLine(item, line); ok {
+				return append([]string{strconv.Itoa(i)}, path...), true
+			}
+		}
+	}
+	if node.Line == line {
+		return nil, true
+	}
+	return nil, false
+}
+
+// child returns the value of the key in a mapping, or the item at the index in a sequence.
+func child(node *yaml.Node, segment string) *yaml.Node {
+	if node == nil {
+		return nil
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		_, v := mappingValue(node, segment)
+		return v
+	case yaml.SequenceNode:
+		idx, err := strconv.Atoi(segment)
+		if err != nil || idx < 0 || idx >= len(node.Content) {
+			return nil
+		}
+		return node.Content[idx]
+	}
+	return nil
+}
+
+// withoutKey returns a copy of the mapping node without the key.
+func withoutKey(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return node
+	}
+	cp := *node
+	cp.Content = nil
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value != key {
+			cp.Content = append(cp.Content, node.Content[i], node.Content[i+1])
+		}
+	}
+	return &cp
+}
+
+func mappingValue(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+	return nil, nil
+}
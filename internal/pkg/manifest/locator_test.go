@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testLocatorManifest = `name: api
+type: Load Balanced Web Service
+
+image:
+  build: ./Dockerfile
+  port: 8080
+
+http:
+  path: '/'
+  healthcheck:
+    path: '/_healthcheck'
+
+sidecars:
+  nginx:
+    port: 80
+
+taskdef_overrides:
+  - path: Ulimits[-]
+    value: 1024
+  - path: Family
+    value: api
+
+environments:
+  test:
+    http:
+      healthcheck:
+        path: '/ping'
+  prod:
+    count: 3
+`
+
+func TestLocator_Environments(t *testing.T) {
+	l, err := NewLocator([]byte(testLocatorManifest))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"test", "prod"}, l.Environments())
+}
+
+func TestLocator_Translate(t *testing.T) {
+	interpolated := `name: api
+type: Load Balanced Web Service
+image:
+  build: ./Dockerfile
+  port: 8080
+http:
+  path: '/'
+  healthcheck:
+    path: '/_healthcheck'
+sidecars:
+  nginx:
+    port: 80
+`
+	l, err := NewLocator([]byte(testLocatorManifest))
+	require.NoError(t, err)
+
+	pos, ok := l.Translate([]byte(interpolated), 12)
+
+	require.True(t, ok)
+	require.Equal(t, Position{Line: 15, Column: 11}, pos)
+}
+
+func TestLocator_ValidationError(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv string
+		inErr error
+
+		wanted   Position
+		wantedOK bool
+	}{
+		"nested fields that are not direct descendants": {
+			inErr:    errors.New(`validate "http": validate "path": path must not be empty`),
+			wanted:   Position{Line: 9, Column: 3},
+			wantedOK: true,
+		},
+		"fields with an index": {
+			inErr:    errors.New(`validate "taskdef_overrides[1]": "Family" cannot be overridden with a custom value`),
+			wanted:   Position{Line: 20, Column: 5},
+			wantedOK: true,
+		},
+		"fields with a key": {
+			inErr:    errors.New(`validate "sidecars[nginx]": validate "port": some error`),
+			wanted:   Position{Line: 15, Column: 5},
+			wantedOK: true,
+		},
+		"environment overrides take precedence": {
+			inEnv:    "test",
+			inErr:    errors.New(`validate "http": validate "healthcheck": validate "path": some error`),
+			wanted:   Position{Line: 27, Column: 9},
+			wantedOK: true,
+		},
+		"fields of the manifest if the environment does not override them": {
+			inEnv:    "prod",
+			inErr:    errors.New(`validate "http": validate "healthcheck": validate "path": some error`),
+			wanted:   Position{Line: 11, Column: 5},
+			wantedOK: true,
+		},
+		"errors that are not about a field": {
+			inErr: errors.New("validate container dependencies: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			l, err := NewLocator([]byte(testLocatorManifest))
+			require.NoError(t, err)
+
+			pos, ok := l.ValidationError(tc.inEnv, tc.inErr)
+
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wanted, pos)
+		})
+	}
+}
@@ -17,6 +17,8 @@ const (
 	GithubV1ProviderName   = "GitHubV1"
 	CodeCommitProviderName = "CodeCommit"
 	BitbucketProviderName  = "Bitbucket"
+	GitLabProviderName     = "GitLab"
+	S3ProviderName         = "S3"
 
 	pipelineManifestPath = "cicd/pipeline.yml"
 )
@@ -26,6 +28,7 @@ var PipelineProviders = []string{
 	GithubProviderName,
 	CodeCommitProviderName,
 	BitbucketProviderName,
+	GitLabProviderName,
 }
 
 // Provider defines a source of the artifacts
@@ -92,6 +95,34 @@ func (p *bitbucketProvider) Properties() map[string]interface{} {
 	return structs.Map(p.properties)
 }
 
+type gitlabProvider struct {
+	properties *GitLabProperties
+}
+
+func (p *gitlabProvider) Name() string {
+	return GitLabProviderName
+}
+func (p *gitlabProvider) String() string {
+	return GitLabProviderName
+}
+func (p *gitlabProvider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
+type s3Provider struct {
+	properties *S3Properties
+}
+
+func (p *s3Provider) Name() string {
+	return S3ProviderName
+}
+func (p *s3Provider) String() string {
+	return S3ProviderName
+}
+func (p *s3Provider) Properties() map[string]interface{} {
+	return structs.Map(p.properties)
+}
+
 // GitHubV1Properties contain information for configuring a Githubv1
 // source provider.
 type GitHubV1Properties struct {
@@ -123,6 +154,20 @@ type CodeCommitProperties struct {
 	Branch        string `structs:"branch" yaml:"branch"`
 }
 
+// GitLabProperties contains information for configuring a GitLab
+// source provider.
+type GitLabProperties struct {
+	RepositoryURL string `structs:"repository" yaml:"repository"`
+	Branch        string `structs:"branch" yaml:"branch"`
+}
+
+// S3Properties contains information for configuring a source provider
+// for any Git repository, whose source code is packaged into S3 by a webhook.
+type S3Properties struct {
+	RepositoryURL string `structs:"repository" yaml:"repository"`
+	Branch        string `structs:"branch" yaml:"branch"`
+}
+
 // NewProvider creates a source provider based on the type of
 // the provided provider-specific configurations
 func NewProvider(configs interface{}) (Provider, error) {
@@ -143,6 +188,14 @@ func NewProvider(configs interface{}) (Provider, error) {
 		return &bitbucketProvider{
 			properties: props,
 		}, nil
+	case *GitLabProperties:
+		return &gitlabProvider{
+			properties: props,
+		}, nil
+	case *S3Properties:
+		return &s3Provider{
+			properties: props,
+		}, nil
 	default:
 		return nil, &ErrUnknownProvider{unknownProviderProperties: props}
 	}
@@ -183,9 +236,34 @@ type Build struct {
 
 // PipelineStage represents a stage in the pipeline manifest
 type PipelineStage struct {
-	Name             string   `yaml:"name"`
-	RequiresApproval bool     `yaml:"requires_approval,omitempty"`
-	TestCommands     []string `yaml:"test_commands,omitempty"`
+	Name             string           `yaml:"name"`
+	RequiresApproval bool             `yaml:"requires_approval,omitempty"`
+	TestCommands     []string         `yaml:"test_commands,omitempty"`
+	Deployments      []string         `yaml:"deployments,omitempty"`
+	PreDeployments   []PipelineAction `yaml:"pre_deployments,omitempty"`
+	PostDeployments  []PipelineAction `yaml:"post_deployments,omitempty"`
+}
+
+// PipelineAction represents an action that runs before or after the deployments of a stage.
+// Exactly one of Build, Approval or Job is set.
+type PipelineAction struct {
+	Name     string          `yaml:"name"`
+	Build    *ActionBuild    `yaml:"build,omitempty"`
+	Approval *ActionApproval `yaml:"approval,omitempty"`
+	Job      string          `yaml:"job,omitempty"`
+}
+
+// ActionBuild runs commands with CodeBuild against the source code of the pipeline.
+type ActionBuild struct {
+	Image     string   `yaml:"image,omitempty"`
+	Buildspec string   `yaml:"buildspec,omitempty"`
+	Commands  []string `yaml:"commands,omitempty"`
+}
+
+// ActionApproval pauses the stage until the action is manually approved.
+type ActionApproval struct {
+	Topic   string `yaml:"topic,omitempty"` // ARN of the SNS topic to notify when the approval is pending.
+	Message string `yaml:"message,omitempty"`
 }
 
 // NewPipeline returns a pipeline manifest object.
@@ -248,6 +326,8 @@ func (s Source) IsCodeStarConnection() bool {
 		return true
 	case BitbucketProviderName:
 		return true
+	case GitLabProviderName:
+		return true
 	default:
 		return false
 	}
@@ -39,6 +39,18 @@ func TestNewProvider(t *testing.T) {
 				Branch:        defaultCCBranch,
 			},
 		},
+		"successfully create GitLab provider": {
+			providerConfig: &GitLabProperties{
+				RepositoryURL: "https://gitlab.com/group/project",
+				Branch:        "main",
+			},
+		},
+		"successfully create S3 provider": {
+			providerConfig: &S3Properties{
+				RepositoryURL: "https://git.example.com/group/project.git",
+				Branch:        "main",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -303,6 +315,83 @@ stages:
 				},
 			},
 		},
+		"valid pipeline.yml with pre and post deployment actions": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: prod
+      deployments: [frontend, api]
+      pre_deployments:
+        - name: approve
+          approval:
+            topic: arn:aws:sns:us-west-2:123456789012:release-managers
+            message: Ready to release?
+        - name: migrate
+          build:
+            image: aws/codebuild/standard:5.0
+            buildspec: copilot/pipelines/migrate.yml
+      post_deployments:
+        - name: smoke
+          build:
+            commands: [make smoke-test]
+        - name: report
+          job: report-generator
+`,
+			expectedManifest: &Pipeline{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     defaultGHBranch,
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name:        "prod",
+						Deployments: []string{"frontend", "api"},
+						PreDeployments: []PipelineAction{
+							{
+								Name: "approve",
+								Approval: &ActionApproval{
+									Topic:   "arn:aws:sns:us-west-2:123456789012:release-managers",
+									Message: "Ready to release?",
+								},
+							},
+							{
+								Name: "migrate",
+								Build: &ActionBuild{
+									Image:     "aws/codebuild/standard:5.0",
+									Buildspec: "copilot/pipelines/migrate.yml",
+								},
+							},
+						},
+						PostDeployments: []PipelineAction{
+							{
+								Name: "smoke",
+								Build: &ActionBuild{
+									Commands: []string{"make smoke-test"},
+								},
+							},
+							{
+								Name: "report",
+								Job:  "report-generator",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,41 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Command gen writes the JSON Schemas of the manifest files to a directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest/schema"
+)
+
+func main() {
+	dir := flag.String("dir", filepath.Join("site", "content", "schemas"), "Directory to write the schemas to.")
+	flag.Parse()
+	if err := run(*dir); err != nil {
+		fmt.Fprintf(os.Stderr, "generate manifest schemas: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create directory %s: %w", dir, err)
+	}
+	for _, m := range schema.Manifests {
+		out, err := schema.Generate(m)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(dir, m.Name+".json")
+		if err := ioutil.WriteFile(path, out, 0644); err != nil {
+			return fmt.Errorf("write file %s: %w", path, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package schema generates JSON Schemas for manifest files so that editors can validate and auto-complete them.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	draft          = "http://json-schema.org/draft-07/schema#"
+	definitionsRef = "#/definitions/"
+)
+
+// JSON Schema types.
+const (
+	typeObject  = "object"
+	typeArray   = "array"
+	typeString  = "string"
+	typeInteger = "integer"
+	typeNumber  = "number"
+	typeBoolean = "boolean"
+)
+
+var (
+	unmarshalerType = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	durationType    = reflect.TypeOf(time.Duration(0))
+	nodeType        = reflect.TypeOf(yaml.Node{})
+)
+
+// Manifest describes a manifest file to generate a JSON Schema for.
+type Manifest struct {
+	Name     string      // Name of the schema file without extension, example: "lb-web-service".
+	Title    string      // Title of the schema.
+	Type     string      // Value of the "type" field of the manifest, empty if the manifest doesn't have one.
+	Required []string    // Required top-level fields.
+	Value    interface{} // Manifest struct to reflect over.
+}
+
+// Manifests are the manifest files that JSON Schemas are generated for.
+var Manifests = []Manifest{
+	workload(manifest.LoadBalancedWebServiceType, "lb-web-service", &manifest.LoadBalancedWebService{}),
+	workload(manifest.RequestDrivenWebServiceType, "rd-web-service", &manifest.RequestDrivenWebService{}),
+	workload(manifest.BackendServiceType, "backend-service", &manifest.BackendService{}),
+	workload(manifest.WorkerServiceType, "worker-service", &manifest.WorkerService{}),
+	workload(manifest.ScheduledJobType, "scheduled-job", &manifest.ScheduledJob{}),
+	{
+		Name:     "pipeline",
+		Title:    "Copilot Pipeline manifest",
+		Required: []string{"name", "version", "source", "stages"},
+		Value:    &manifest.Pipeline{},
+	},
+}
+
+func workload(typ, name string, value interface{}) Manifest {
+	return Manifest{
+		Name:     name,
+		Title:    fmt.Sprintf("Copilot %s manifest", typ),
+		Type:     typ,
+		Required: []string{"name", "type"},
+		Value:    value,
+	}
+}
+
+// Schema is a JSON Schema document or subschema.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Const                string             `json:"const,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"` // Either a *Schema or false.
+	Items                *Schema            `json:"items,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Definitions          map[string]*Schema `json:"definitions,omitempty"`
+}
+
+// Generate returns the JSON Schema of the manifest.
+func Generate(m Manifest) ([]byte, error) {
+	g := &generator{
+		definitions: make(map[string]*Schema),
+		types:       make(map[string]reflect.Type),
+	}
+	root, err := g.structSchema(indirect(reflect.TypeOf(m.Value)))
+	if err != nil {
+		return nil, fmt.Errorf("generate schema for %s manifest: %w", m.Name, err)
+	}
+	root.Schema = draft
+	root.Title = m.Title
+	root.Required = m.Required
+	if m.Type != "" {
+		root.Properties["type"] = &Schema{
+			Type:  typeString,
+			Const: m.Type,
+		}
+	}
+	if len(g.definitions) != 0 {
+		root.Definitions = g.definitions
+	}
+	out, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal schema for %s manifest: %w", m.Name, err)
+	}
+	return append(out, '\n'), nil
+}
+
+type generator struct {
+	definitions map[string]*Schema
+	types       map[string]reflect.Type // Types already registered under a definition name.
+}
+
+func (g *generator) schema(t reflect.Type) (*Schema, error) {
+	t = indirect(t)
+	switch {
+	case t == durationType:
+		return &Schema{Type: typeString}, nil
+	case t == nodeType || t.Kind() == reflect.Interface:
+		return &Schema{}, nil // Any value.
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: typeString}, nil
+	case reflect.Bool:
+		return &Schema{Type: typeBoolean}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: typeInteger}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: typeNumber}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := g.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: typeArray, Items: items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("map key of type %s is not supported", t.Key())
+		}
+		values, err := g.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: typeObject, AdditionalProperties: values}, nil
+	case reflect.Struct:
+		return g.ref(t)
+	default:
+		return nil, fmt.Errorf("type %s is not supported", t)
+	}
+}
+
+// ref registers the struct under the definitions and returns a reference to it.
+func (g *generator) ref(t reflect.Type) (*Schema, error) {
+	name := t.Name()
+	if prev, ok := g.types[name]; ok {
+		if prev != t {
+			return nil, fmt.Errorf("types %s and %s have the same name", prev, t)
+		}
+		return &Schema{Ref: definitionsRef + name}, nil
+	}
+	g.types[name] = t
+	var def *Schema
+	var err error
+	if isUnion(t) {
+		def, err = g.unionSchema(t)
+	} else {
+		def, err = g.structSchema(t)
+	}
+	if err != nil {
+		return nil, err
+	}
+	g.definitions[name] = def
+	return &Schema{Ref: definitionsRef + name}, nil
+}
+
+// structSchema returns the schema of an object whose properties are the fields of the struct.
+func (g *generator) structSchema(t reflect.Type) (*Schema, error) {
+	s := &Schema{
+		Type:                 typeObject,
+		Properties:           make(map[string]*Schema),
+		AdditionalProperties: false,
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts := parseTag(field)
+		if name == "-" || (field.PkgPath != "" && !field.Anonymous) { // Skip ignored and unexported fields.
+			continue
+		}
+		if opts.inline {
+			inlined, err := g.structSchema(indirect(field.Type))
+			if err != nil {
+				return nil, fmt.Errorf("inline field %s: %w", field.Name, err)
+			}
+			for k, v := range inlined.Properties {
+				s.Properties[k] = v
+			}
+			continue
+		}
+		prop, err := g.schema(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		s.Properties[name] = prop
+	}
+	return s, nil
+}
+
+// unionSchema returns the schema of a type that can be unmarshaled from any of its fields.
+// For example, the "http" field of a Load Balanced Web Service can either be a boolean or a map.
+func (g *generator) unionSchema(t reflect.Type) (*Schema, error) {
+	s := &Schema{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		var alt *Schema
+		var err error
+		if field.Anonymous && indirect(field.Type).Kind() == reflect.Struct {
+			alt, err = g.structSchema(indirect(field.Type))
+		} else {
+			alt, err = g.schema(field.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		s.AnyOf = append(s.AnyOf, alt)
+	}
+	return s, nil
+}
+
+// isUnion returns true if the struct implements a custom YAML unmarshaler and none of its fields are mapped to a key.
+func isUnion(t reflect.Type) bool {
+	if !reflect.PtrTo(t).Implements(unmarshalerType) {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if _, ok := t.Field(i).Tag.Lookup("yaml"); ok {
+			return false
+		}
+	}
+	return true
+}
+
+type tagOptions struct {
+	inline bool
+}
+
+// parseTag returns the key of the struct field in a YAML document following the rules of the yaml.v3 package.
+func parseTag(field reflect.StructField) (string, tagOptions) {
+	parts := strings.Split(field.Tag.Get("yaml"), ",")
+	var opts tagOptions
+	for _, opt := range parts[1:] {
+		if opt == "inline" {
+			opts.inline = true
+		}
+	}
+	name := parts[0]
+	if name == "" {
+		name = strings.ToLower(field.Name)
+	}
+	return name, opts
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
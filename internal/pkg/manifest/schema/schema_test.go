@@ -0,0 +1,147 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type mockConfigOrBool struct {
+	mockConfig
+	Enabled *bool
+}
+
+func (m *mockConfigOrBool) UnmarshalYAML(value *yaml.Node) error {
+	return nil
+}
+
+type mockConfig struct {
+	Path    *string        `yaml:"path"`
+	Timeout *time.Duration `yaml:"timeout"`
+}
+
+type mockInlined struct {
+	Tags map[string]string `yaml:"tags"`
+}
+
+type mockManifest struct {
+	Name         *string `yaml:"name"`
+	Type         *string `yaml:"type"`
+	mockInlined  `yaml:",inline"`
+	Count        *int                   `yaml:"count"`
+	HTTP         mockConfigOrBool       `yaml:"http,flow"`
+	Ports        []uint16               `yaml:"ports"`
+	Value        yaml.Node              `yaml:"value"`
+	Ignored      string                 `yaml:"-"`
+	Environments map[string]*mockConfig `yaml:",flow"`
+
+	parser interface{}
+}
+
+func TestGenerate(t *testing.T) {
+	wanted := `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "Copilot Mock manifest",
+  "type": "object",
+  "properties": {
+    "count": {
+      "type": "integer"
+    },
+    "environments": {
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/mockConfig"
+      }
+    },
+    "http": {
+      "$ref": "#/definitions/mockConfigOrBool"
+    },
+    "name": {
+      "type": "string"
+    },
+    "ports": {
+      "type": "array",
+      "items": {
+        "type": "integer"
+      }
+    },
+    "tags": {
+      "type": "object",
+      "additionalProperties": {
+        "type": "string"
+      }
+    },
+    "type": {
+      "type": "string",
+      "const": "Mock"
+    },
+    "value": {}
+  },
+  "additionalProperties": false,
+  "required": [
+    "name",
+    "type"
+  ],
+  "definitions": {
+    "mockConfig": {
+      "type": "object",
+      "properties": {
+        "path": {
+          "type": "string"
+        },
+        "timeout": {
+          "type": "string"
+        }
+      },
+      "additionalProperties": false
+    },
+    "mockConfigOrBool": {
+      "anyOf": [
+        {
+          "type": "object",
+          "properties": {
+            "path": {
+              "type": "string"
+            },
+            "timeout": {
+              "type": "string"
+            }
+          },
+          "additionalProperties": false
+        },
+        {
+          "type": "boolean"
+        }
+      ]
+    }
+  }
+}
+`
+	got, err := Generate(workload("Mock", "mock", &mockManifest{}))
+
+	require.NoError(t, err)
+	require.Equal(t, wanted, string(got))
+}
+
+// TestManifests_UpToDate ensures that the published schemas are regenerated whenever a manifest changes.
+func TestManifests_UpToDate(t *testing.T) {
+	for _, m := range Manifests {
+		t.Run(m.Name, func(t *testing.T) {
+			got, err := Generate(m)
+			require.NoError(t, err)
+			require.True(t, json.Valid(got))
+
+			published, err := ioutil.ReadFile(filepath.Join("..", "..", "..", "..", "site", "content", "schemas", m.Name+".json"))
+			require.NoError(t, err)
+			require.Equal(t, string(published), string(got), `the schema is out of date, run "make gen-manifest-schemas" to update it`)
+		})
+	}
+}
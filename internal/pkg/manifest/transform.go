@@ -28,6 +28,8 @@ var defaultTransformers = []mergo.Transformers{
 	efsVolumeConfigurationTransformer{},
 	sqsQueueOrBoolTransformer{},
 	routingRuleConfigOrBoolTransformer{},
+	serviceConnectConfigOrBoolTransformer{},
+	jobTriggerConfigTransformer{},
 	secretTransformer{},
 }
 
@@ -353,6 +355,66 @@ func (t routingRuleConfigOrBoolTransformer) Transformer(typ reflect.Type) func(d
 	}
 }
 
+type serviceConnectConfigOrBoolTransformer struct{}
+
+// Transformer returns custom merge logic for ServiceConnectConfigOrBool's fields.
+func (t serviceConnectConfigOrBoolTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(ServiceConnectConfigOrBool{}) {
+		return nil
+	}
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(ServiceConnectConfigOrBool), src.Interface().(ServiceConnectConfigOrBool)
+
+		if !srcStruct.ServiceConnectConfiguration.isEmpty() {
+			dstStruct.Enabled = nil
+		}
+
+		if srcStruct.Enabled != nil {
+			dstStruct.ServiceConnectConfiguration = ServiceConnectConfiguration{}
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(dstStruct))
+		}
+		return nil
+	}
+}
+
+type jobTriggerConfigTransformer struct{}
+
+// Transformer returns custom merge logic for JobTriggerConfig's fields.
+// A trigger specified in the override replaces the trigger of the original manifest.
+func (t jobTriggerConfigTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(JobTriggerConfig{}) {
+		return nil
+	}
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(JobTriggerConfig), src.Interface().(JobTriggerConfig)
+
+		if len(srcStruct.triggers()) != 0 {
+			payload := dstStruct.Payload
+			dstStruct = srcStruct
+			// A schedule doesn't send a payload, so the payload of the replaced trigger is dropped.
+			if srcStruct.Schedule == nil {
+				dstStruct.Payload = payload
+			}
+		}
+
+		if srcStruct.Payload.Variable != nil {
+			dstStruct.Payload.Path = nil
+		}
+
+		if srcStruct.Payload.Path != nil {
+			dstStruct.Payload.Variable = nil
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(dstStruct))
+		}
+		return nil
+	}
+}
+
 type secretTransformer struct{}
 
 // Transformer returns custom merge logic for Secret's fields.
@@ -365,10 +427,17 @@ func (t secretTransformer) Transformer(typ reflect.Type) func(dst, src reflect.V
 
 		if !srcStruct.fromSecretsManager.IsEmpty() {
 			dstStruct.from = nil
+			dstStruct.fromVersion = ssmParameterVersion{}
 		}
 
 		if srcStruct.from != nil {
 			dstStruct.fromSecretsManager = secretsManagerSecret{}
+			dstStruct.fromVersion = ssmParameterVersion{}
+		}
+
+		if !srcStruct.fromVersion.IsEmpty() {
+			dstStruct.from = nil
+			dstStruct.fromSecretsManager = secretsManagerSecret{}
 		}
 
 		if dst.CanSet() { // For extra safety to prevent panicking.
@@ -878,6 +878,178 @@ func TestRoutingRuleConfigOrBoolTransformer_Transformer(t *testing.T) {
 	}
 }
 
+func TestServiceConnectConfigOrBoolTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(c *ServiceConnectConfigOrBool)
+		override func(c *ServiceConnectConfigOrBool)
+		wanted   func(c *ServiceConnectConfigOrBool)
+	}{
+		"bool set to empty if config is not nil": {
+			original: func(c *ServiceConnectConfigOrBool) {
+				c.Enabled = aws.Bool(true)
+			},
+			override: func(c *ServiceConnectConfigOrBool) {
+				c.ServiceConnectConfiguration = ServiceConnectConfiguration{
+					Alias: aws.String("api"),
+				}
+			},
+			wanted: func(c *ServiceConnectConfigOrBool) {
+				c.ServiceConnectConfiguration = ServiceConnectConfiguration{
+					Alias: aws.String("api"),
+				}
+			},
+		},
+		"config set to empty if bool is not nil": {
+			original: func(c *ServiceConnectConfigOrBool) {
+				c.ServiceConnectConfiguration = ServiceConnectConfiguration{
+					ClientOnly: aws.Bool(true),
+				}
+			},
+			override: func(c *ServiceConnectConfigOrBool) {
+				c.Enabled = aws.Bool(false)
+			},
+			wanted: func(c *ServiceConnectConfigOrBool) {
+				c.Enabled = aws.Bool(false)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted ServiceConnectConfigOrBool
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use custom transformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(serviceConnectConfigOrBoolTransformer{}))
+			require.NoError(t, err)
+
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
+
+func TestJobTriggerConfigTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(c *JobTriggerConfig)
+		override func(c *JobTriggerConfig)
+		wanted   func(c *JobTriggerConfig)
+	}{
+		"schedule replaced by an event pattern": {
+			original: func(c *JobTriggerConfig) {
+				c.Schedule = aws.String("@daily")
+			},
+			override: func(c *JobTriggerConfig) {
+				c.Event = JobEventTrigger{
+					Pattern: map[string]interface{}{
+						"source": []interface{}{"aws.ecr"},
+					},
+				}
+			},
+			wanted: func(c *JobTriggerConfig) {
+				c.Event = JobEventTrigger{
+					Pattern: map[string]interface{}{
+						"source": []interface{}{"aws.ecr"},
+					},
+				}
+			},
+		},
+		"event pattern replaced instead of merged": {
+			original: func(c *JobTriggerConfig) {
+				c.Event = JobEventTrigger{
+					Pattern: map[string]interface{}{
+						"source":      []interface{}{"aws.ecr"},
+						"detail-type": []interface{}{"ECR Image Action"},
+					},
+				}
+				c.Payload = JobPayloadConfig{
+					Variable: aws.String("EVENT"),
+				}
+			},
+			override: func(c *JobTriggerConfig) {
+				c.Event = JobEventTrigger{
+					Pattern: map[string]interface{}{
+						"source": []interface{}{"aws.s3"},
+					},
+				}
+			},
+			wanted: func(c *JobTriggerConfig) {
+				c.Event = JobEventTrigger{
+					Pattern: map[string]interface{}{
+						"source": []interface{}{"aws.s3"},
+					},
+				}
+				c.Payload = JobPayloadConfig{
+					Variable: aws.String("EVENT"),
+				}
+			},
+		},
+		"payload dropped if the event pattern is replaced by a schedule": {
+			original: func(c *JobTriggerConfig) {
+				c.Event = JobEventTrigger{
+					Pattern: map[string]interface{}{
+						"source": []interface{}{"aws.ecr"},
+					},
+				}
+				c.Payload = JobPayloadConfig{
+					Variable: aws.String("EVENT"),
+				}
+			},
+			override: func(c *JobTriggerConfig) {
+				c.Schedule = aws.String("@daily")
+			},
+			wanted: func(c *JobTriggerConfig) {
+				c.Schedule = aws.String("@daily")
+			},
+		},
+		"trigger kept if the override only changes the payload": {
+			original: func(c *JobTriggerConfig) {
+				c.Manual = aws.Bool(true)
+				c.Payload = JobPayloadConfig{
+					Variable: aws.String("EVENT"),
+				}
+			},
+			override: func(c *JobTriggerConfig) {
+				c.Payload = JobPayloadConfig{
+					Path: aws.String("/copilot/event.json"),
+				}
+			},
+			wanted: func(c *JobTriggerConfig) {
+				c.Manual = aws.Bool(true)
+				c.Payload = JobPayloadConfig{
+					Path: aws.String("/copilot/event.json"),
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted JobTriggerConfig
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use custom transformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(jobTriggerConfigTransformer{}))
+			require.NoError(t, err)
+
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
+
 func TestSecretTransformer_Transformer(t *testing.T) {
 	testCases := map[string]struct {
 		original func(s *Secret)
@@ -912,6 +1084,55 @@ func TestSecretTransformer_Transformer(t *testing.T) {
 				s.from = aws.String("/github/token")
 			},
 		},
+		`"version" is dropped when overriding with a plain "from"`: {
+			original: func(s *Secret) {
+				s.fromVersion = ssmParameterVersion{
+					From:    aws.String("/github/token"),
+					Version: aws.Int(2),
+				}
+			},
+			override: func(s *Secret) {
+				s.from = aws.String("/github/test/token")
+			},
+			wanted: func(s *Secret) {
+				s.from = aws.String("/github/test/token")
+			},
+		},
+		`"version" is replaced when overriding "from" and "version"`: {
+			original: func(s *Secret) {
+				s.fromVersion = ssmParameterVersion{
+					From:    aws.String("/github/token"),
+					Version: aws.Int(2),
+				}
+			},
+			override: func(s *Secret) {
+				s.fromVersion = ssmParameterVersion{
+					From: aws.String("/github/test/token"),
+				}
+			},
+			wanted: func(s *Secret) {
+				s.fromVersion = ssmParameterVersion{
+					From: aws.String("/github/test/token"),
+				}
+			},
+		},
+		`"from" is discarded if "from" and "version" are overridden`: {
+			original: func(s *Secret) {
+				s.from = aws.String("/github/token")
+			},
+			override: func(s *Secret) {
+				s.fromVersion = ssmParameterVersion{
+					From:    aws.String("/github/test/token"),
+					Version: aws.Int(3),
+				}
+			},
+			wanted: func(s *Secret) {
+				s.fromVersion = ssmParameterVersion{
+					From:    aws.String("/github/test/token"),
+					Version: aws.Int(3),
+				}
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -7,14 +7,18 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
+	"github.com/aws/copilot-cli/internal/pkg/template/override"
 	"github.com/dustin/go-humanize/english"
 )
 
@@ -30,6 +34,14 @@ const (
 	ephemeralMaxValueGiB = 200
 
 	envFileExt = ".env"
+
+	// Min and Max values for traffic shifting deployments.
+	minDeploymentPercent  = 1
+	maxDeploymentPercent  = 99
+	maxDeploymentWaitTime = 24 * time.Hour
+
+	// Max number of values of the conditions of a listener rule.
+	maxListenerRuleConditionValues = 5
 )
 
 const (
@@ -49,6 +61,17 @@ var (
 	punctuationRegExp   = regexp.MustCompile(`[\.\-]{2,}`)         // Check for consecutive periods or dashes.
 	trailingPunctRegExp = regexp.MustCompile(`[\-\.]$`)            // Check for trailing dash or dot.
 
+	pipelineActionNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9-]+$`) // Action names are part of CloudFormation logical IDs.
+	envAddonsOutputRegexp    = regexp.MustCompile(`^[a-zA-Z0-9]+$`)  // Output names are CloudFormation logical IDs.
+
+	iamActionRegexp     = regexp.MustCompile(`^([a-z0-9-]+:[a-zA-Z0-9*?]+|\*)$`)   // IAM actions such as "s3:GetObject", "s3:Get*" or "*".
+	s3BucketNameRegexp  = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`) // S3 bucket names.
+	secretsPrefixRegexp = regexp.MustCompile(`^[a-zA-Z0-9/_.+=@-]+$`)              // Characters allowed in both SSM parameter and Secrets Manager secret names.
+	envVarNameRegexp    = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)           // Environment variable names.
+
+	serviceConnectPortNameRegexp = regexp.MustCompile(`^[a-z0-9_][a-z0-9_-]{0,63}$`)                                       // ECS port mapping names.
+	serviceConnectAliasRegexp    = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`) // DNS names.
+
 	essentialContainerDependsOnValidStatuses = []string{dependsOnStart, dependsOnHealthy}
 	dependsOnValidStatuses                   = []string{dependsOnStart, dependsOnComplete, dependsOnSuccess, dependsOnHealthy}
 	nlbValidProtocols                        = []string{TCP, tls}
@@ -56,31 +79,33 @@ var (
 
 	httpProtocolVersions = []string{"GRPC", "HTTP1", "HTTP2"}
 
+	fixedResponseContentTypes = []string{"text/plain", "text/css", "text/html", "application/javascript", "application/json"}
+	redirectProtocols         = []string{"HTTP", "HTTPS", "#{protocol}"}
+
+	iamPolicyEffects = []string{"Allow", "Deny"}
+
 	invalidTaskDefOverridePathRegexp = []string{`Family`, `ContainerDefinitions\[\d+\].Name`}
 )
 
 // Validate returns nil if LoadBalancedWebService is configured correctly.
 func (l LoadBalancedWebService) Validate() error {
 	var err error
-	if err = l.LoadBalancedWebServiceConfig.Validate(); err != nil {
-		return err
-	}
-	if err = l.Workload.Validate(); err != nil {
-		return err
-	}
+	var errs errFields
+	errs.add(l.LoadBalancedWebServiceConfig.Validate())
+	errs.add(l.Workload.Validate())
 	if err = validateTargetContainer(validateTargetContainerOpts{
 		mainContainerName: aws.StringValue(l.Name),
 		targetContainer:   l.RoutingRule.targetContainer(),
 		sidecarConfig:     l.Sidecars,
 	}); err != nil {
-		return fmt.Errorf("validate HTTP load balancer target: %w", err)
+		errs.add(fmt.Errorf("validate HTTP load balancer target: %w", err))
 	}
 	if err = validateTargetContainer(validateTargetContainerOpts{
 		mainContainerName: aws.StringValue(l.Name),
 		targetContainer:   l.NLBConfig.TargetContainer,
 		sidecarConfig:     l.Sidecars,
 	}); err != nil {
-		return fmt.Errorf("validate network load balancer target: %w", err)
+		errs.add(fmt.Errorf("validate network load balancer target: %w", err))
 	}
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     l.Sidecars,
@@ -88,51 +113,53 @@ func (l LoadBalancedWebService) Validate() error {
 		mainContainerName: aws.StringValue(l.Name),
 		logging:           l.Logging,
 	}); err != nil {
-		return fmt.Errorf("validate container dependencies: %w", err)
+		errs.add(fmt.Errorf("validate container dependencies: %w", err))
 	}
-	return nil
+	return errs.err()
 }
 
 // Validate returns nil if LoadBalancedWebServiceConfig is configured correctly.
 func (l LoadBalancedWebServiceConfig) Validate() error {
 	var err error
+	var errs errFields
 	if l.RoutingRule.Disabled() && l.NLBConfig.IsEmpty() {
-		return &errAtLeastOneFieldMustBeSpecified{
+		errs.add(&errAtLeastOneFieldMustBeSpecified{
 			missingFields: []string{"http", "nlb"},
-		}
+		})
 	}
 	if l.RoutingRule.Disabled() && (l.Count.AdvancedCount.Requests != nil || l.Count.AdvancedCount.ResponseTime != nil) {
-		return errors.New(`scaling based on "nlb" requests or response time is not supported`)
+		errs.add(errors.New(`scaling based on "nlb" requests or response time is not supported`))
 	}
 	if err = l.ImageConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "image": %w`, err)
-	}
-	if err = l.ImageOverride.Validate(); err != nil {
-		return err
+		errs.add(fmt.Errorf(`validate "image": %w`, err))
 	}
+	errs.add(l.ImageOverride.Validate())
 	if err = l.RoutingRule.Validate(); err != nil {
-		return fmt.Errorf(`validate "http": %w`, err)
-	}
-	if err = l.TaskConfig.Validate(); err != nil {
-		return err
+		errs.add(fmt.Errorf(`validate "http": %w`, err))
 	}
+	errs.add(l.TaskConfig.Validate())
 	if err = l.Logging.Validate(); err != nil {
-		return fmt.Errorf(`validate "logging": %w`, err)
+		errs.add(fmt.Errorf(`validate "logging": %w`, err))
 	}
 	for k, v := range l.Sidecars {
 		if err = v.Validate(); err != nil {
-			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
+			errs.add(fmt.Errorf(`validate "sidecars[%s]": %w`, k, err))
 		}
 	}
 	if err = l.Network.Validate(); err != nil {
-		return fmt.Errorf(`validate "network": %w`, err)
+		errs.add(fmt.Errorf(`validate "network": %w`, err))
 	}
 	if err = l.PublishConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "publish": %w`, err)
+		errs.add(fmt.Errorf(`validate "publish": %w`, err))
 	}
 	for ind, taskDefOverride := range l.TaskDefOverrides {
 		if err = taskDefOverride.Validate(); err != nil {
-			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
+			errs.add(fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err))
+		}
+	}
+	for ind, patch := range l.Patches {
+		if err = patch.Validate(); err != nil {
+			errs.add(fmt.Errorf(`validate "patches[%d]": %w`, ind, err))
 		}
 	}
 	if l.TaskConfig.IsWindows() {
@@ -140,7 +167,7 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 			execEnabled: aws.BoolValue(l.ExecuteCommand.Enable),
 			efsVolumes:  l.Storage.Volumes,
 		}); err != nil {
-			return fmt.Errorf("validate Windows: %w", err)
+			errs.add(fmt.Errorf("validate Windows: %w", err))
 		}
 	}
 	if l.TaskConfig.IsARM() {
@@ -148,64 +175,83 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 			Spot:     l.Count.AdvancedCount.Spot,
 			SpotFrom: l.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
 		}); err != nil {
-			return fmt.Errorf("validate ARM: %w", err)
+			errs.add(fmt.Errorf("validate ARM: %w", err))
 		}
 	}
 	if err = l.NLBConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "nlb": %w`, err)
+		errs.add(fmt.Errorf(`validate "nlb": %w`, err))
 	}
-	return nil
+	if err = l.Deployment.Validate(); err != nil {
+		errs.add(fmt.Errorf(`validate "deployment": %w`, err))
+	}
+	if l.Deployment.ShiftsTraffic() {
+		if l.RoutingRule.Disabled() {
+			errs.add(fmt.Errorf(`deployment strategy "%s" requires "http" to be enabled`, aws.StringValue(l.Deployment.Strategy)))
+		}
+		if !l.NLBConfig.IsEmpty() {
+			errs.add(fmt.Errorf(`deployment strategy "%s" is not supported with "nlb"`, aws.StringValue(l.Deployment.Strategy)))
+		}
+		// ECS only shifts the traffic of the listener rule for "path", so additional rules can't forward to the service.
+		for ind, rule := range l.RoutingRule.AdditionalRules {
+			if rule.FixedResponse == nil && rule.Redirect == nil {
+				errs.add(fmt.Errorf(`deployment strategy "%s" requires "http.additional_rules[%d]" to specify "fixed_response" or "redirect"`, aws.StringValue(l.Deployment.Strategy), ind))
+			}
+		}
+	}
+	return errs.err()
 }
 
 // Validate returns nil if BackendService is configured correctly.
 func (b BackendService) Validate() error {
 	var err error
-	if err = b.BackendServiceConfig.Validate(); err != nil {
-		return err
-	}
-	if err = b.Workload.Validate(); err != nil {
-		return err
-	}
+	var errs errFields
+	errs.add(b.BackendServiceConfig.Validate())
+	errs.add(b.Workload.Validate())
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     b.Sidecars,
 		imageConfig:       b.ImageConfig.Image,
 		mainContainerName: aws.StringValue(b.Name),
 		logging:           b.Logging,
 	}); err != nil {
-		return fmt.Errorf("validate container dependencies: %w", err)
+		errs.add(fmt.Errorf("validate container dependencies: %w", err))
 	}
-	return nil
+	return errs.err()
 }
 
 // Validate returns nil if BackendServiceConfig is configured correctly.
 func (b BackendServiceConfig) Validate() error {
 	var err error
+	var errs errFields
 	if err = b.ImageConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "image": %w`, err)
-	}
-	if err = b.ImageOverride.Validate(); err != nil {
-		return err
-	}
-	if err = b.TaskConfig.Validate(); err != nil {
-		return err
+		errs.add(fmt.Errorf(`validate "image": %w`, err))
 	}
+	errs.add(b.ImageOverride.Validate())
+	errs.add(b.TaskConfig.Validate())
 	if err = b.Logging.Validate(); err != nil {
-		return fmt.Errorf(`validate "logging": %w`, err)
+		errs.add(fmt.Errorf(`validate "logging": %w`, err))
 	}
 	for k, v := range b.Sidecars {
 		if err = v.Validate(); err != nil {
-			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
+			errs.add(fmt.Errorf(`validate "sidecars[%s]": %w`, k, err))
 		}
 	}
 	if err = b.Network.Validate(); err != nil {
-		return fmt.Errorf(`validate "network": %w`, err)
+		errs.add(fmt.Errorf(`validate "network": %w`, err))
+	}
+	if b.ImageConfig.Port == nil && b.Network.Connect.exposesPort() {
+		errs.add(errors.New(`validate "network": validate "connect": "image.port" must be specified if "alias" or "port_name" is specified`))
 	}
 	if err = b.PublishConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "publish": %w`, err)
+		errs.add(fmt.Errorf(`validate "publish": %w`, err))
 	}
 	for ind, taskDefOverride := range b.TaskDefOverrides {
 		if err = taskDefOverride.Validate(); err != nil {
-			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
+			errs.add(fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err))
+		}
+	}
+	for ind, patch := range b.Patches {
+		if err = patch.Validate(); err != nil {
+			errs.add(fmt.Errorf(`validate "patches[%d]": %w`, ind, err))
 		}
 	}
 	if b.TaskConfig.IsWindows() {
@@ -213,7 +259,7 @@ func (b BackendServiceConfig) Validate() error {
 			execEnabled: aws.BoolValue(b.ExecuteCommand.Enable),
 			efsVolumes:  b.Storage.Volumes,
 		}); err != nil {
-			return fmt.Errorf("validate Windows: %w", err)
+			errs.add(fmt.Errorf("validate Windows: %w", err))
 		}
 	}
 	if b.TaskConfig.IsARM() {
@@ -221,96 +267,102 @@ func (b BackendServiceConfig) Validate() error {
 			Spot:     b.Count.AdvancedCount.Spot,
 			SpotFrom: b.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
 		}); err != nil {
-			return fmt.Errorf("validate ARM: %w", err)
+			errs.add(fmt.Errorf("validate ARM: %w", err))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 // Validate returns nil if RequestDrivenWebService is configured correctly.
 func (r RequestDrivenWebService) Validate() error {
-	if err := r.RequestDrivenWebServiceConfig.Validate(); err != nil {
-		return err
-	}
-	return r.Workload.Validate()
+	var errs errFields
+	errs.add(r.RequestDrivenWebServiceConfig.Validate())
+	errs.add(r.Workload.Validate())
+	return errs.err()
 }
 
 // Validate returns nil if RequestDrivenWebServiceConfig is configured correctly.
 func (r RequestDrivenWebServiceConfig) Validate() error {
 	var err error
+	var errs errFields
 	if err = r.ImageConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "image": %w`, err)
-	}
-	if err = r.InstanceConfig.Validate(); err != nil {
-		return err
+		errs.add(fmt.Errorf(`validate "image": %w`, err))
 	}
+	errs.add(r.InstanceConfig.Validate())
 	if err = r.RequestDrivenWebServiceHttpConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "http": %w`, err)
+		errs.add(fmt.Errorf(`validate "http": %w`, err))
 	}
 	if err = r.PublishConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "publish": %w`, err)
+		errs.add(fmt.Errorf(`validate "publish": %w`, err))
 	}
 	if err = r.Network.Validate(); err != nil {
-		return fmt.Errorf(`validate "network": %w`, err)
+		errs.add(fmt.Errorf(`validate "network": %w`, err))
 	}
 	if err = r.Observability.Validate(); err != nil {
-		return fmt.Errorf(`validate "observability": %w`, err)
+		errs.add(fmt.Errorf(`validate "observability": %w`, err))
 	}
-	return nil
+	for ind, patch := range r.Patches {
+		if err = patch.Validate(); err != nil {
+			errs.add(fmt.Errorf(`validate "patches[%d]": %w`, ind, err))
+		}
+	}
+	return errs.err()
 }
 
 // Validate returns nil if WorkerService is configured correctly.
 func (w WorkerService) Validate() error {
 	var err error
-	if err = w.WorkerServiceConfig.Validate(); err != nil {
-		return err
-	}
-	if err = w.Workload.Validate(); err != nil {
-		return err
-	}
+	var errs errFields
+	errs.add(w.WorkerServiceConfig.Validate())
+	errs.add(w.Workload.Validate())
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     w.Sidecars,
 		imageConfig:       w.ImageConfig.Image,
 		mainContainerName: aws.StringValue(w.Name),
 		logging:           w.Logging,
 	}); err != nil {
-		return fmt.Errorf("validate container dependencies: %w", err)
+		errs.add(fmt.Errorf("validate container dependencies: %w", err))
 	}
-	return nil
+	return errs.err()
 }
 
 // Validate returns nil if WorkerServiceConfig is configured correctly.
 func (w WorkerServiceConfig) Validate() error {
 	var err error
+	var errs errFields
 	if err = w.ImageConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "image": %w`, err)
-	}
-	if err = w.ImageOverride.Validate(); err != nil {
-		return err
-	}
-	if err = w.TaskConfig.Validate(); err != nil {
-		return err
+		errs.add(fmt.Errorf(`validate "image": %w`, err))
 	}
+	errs.add(w.ImageOverride.Validate())
+	errs.add(w.TaskConfig.Validate())
 	if err = w.Logging.Validate(); err != nil {
-		return fmt.Errorf(`validate "logging": %w`, err)
+		errs.add(fmt.Errorf(`validate "logging": %w`, err))
 	}
 	for k, v := range w.Sidecars {
 		if err = v.Validate(); err != nil {
-			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
+			errs.add(fmt.Errorf(`validate "sidecars[%s]": %w`, k, err))
 		}
 	}
 	if err = w.Network.Validate(); err != nil {
-		return fmt.Errorf(`validate "network": %w`, err)
+		errs.add(fmt.Errorf(`validate "network": %w`, err))
+	}
+	if w.Network.Connect.exposesPort() {
+		errs.add(fmt.Errorf(`validate "network": validate "connect": "alias" and "port_name" are not supported for %s`, WorkerServiceType))
 	}
 	if err = w.Subscribe.Validate(); err != nil {
-		return fmt.Errorf(`validate "subscribe": %w`, err)
+		errs.add(fmt.Errorf(`validate "subscribe": %w`, err))
 	}
 	if err = w.PublishConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "publish": %w`, err)
+		errs.add(fmt.Errorf(`validate "publish": %w`, err))
 	}
 	for ind, taskDefOverride := range w.TaskDefOverrides {
 		if err = taskDefOverride.Validate(); err != nil {
-			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
+			errs.add(fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err))
+		}
+	}
+	for ind, patch := range w.Patches {
+		if err = patch.Validate(); err != nil {
+			errs.add(fmt.Errorf(`validate "patches[%d]": %w`, ind, err))
 		}
 	}
 	if w.TaskConfig.IsWindows() {
@@ -318,7 +370,7 @@ func (w WorkerServiceConfig) Validate() error {
 			execEnabled: aws.BoolValue(w.ExecuteCommand.Enable),
 			efsVolumes:  w.Storage.Volumes,
 		}); err != nil {
-			return fmt.Errorf(`validate Windows: %w`, err)
+			errs.add(fmt.Errorf(`validate Windows: %w`, err))
 		}
 	}
 	if w.TaskConfig.IsARM() {
@@ -326,75 +378,89 @@ func (w WorkerServiceConfig) Validate() error {
 			Spot:     w.Count.AdvancedCount.Spot,
 			SpotFrom: w.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
 		}); err != nil {
-			return fmt.Errorf("validate ARM: %w", err)
+			errs.add(fmt.Errorf("validate ARM: %w", err))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 // Validate returns nil if ScheduledJob is configured correctly.
 func (s ScheduledJob) Validate() error {
 	var err error
-	if err = s.ScheduledJobConfig.Validate(); err != nil {
-		return err
-	}
-	if err = s.Workload.Validate(); err != nil {
-		return err
-	}
+	var errs errFields
+	errs.add(s.ScheduledJobConfig.Validate())
+	errs.add(s.Workload.Validate())
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     s.Sidecars,
 		imageConfig:       s.ImageConfig.Image,
 		mainContainerName: aws.StringValue(s.Name),
 		logging:           s.Logging,
 	}); err != nil {
-		return fmt.Errorf("validate container dependencies: %w", err)
+		errs.add(fmt.Errorf("validate container dependencies: %w", err))
 	}
-	return nil
+	// Each attempt of the job's own step also retries the job, so only one of the two can be set.
+	if step := s.Workflow[aws.StringValue(s.Name)]; step != nil && step.Retries != nil && s.Retries != nil {
+		errs.add(&errFieldMutualExclusive{
+			firstField:  "retries",
+			secondField: fmt.Sprintf("workflow.%s.retries", aws.StringValue(s.Name)),
+		})
+	}
+	return errs.err()
 }
 
 // Validate returns nil if ScheduledJobConfig is configured correctly.
 func (s ScheduledJobConfig) Validate() error {
 	var err error
+	var errs errFields
 	if err = s.ImageConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "image": %w`, err)
-	}
-	if err = s.ImageOverride.Validate(); err != nil {
-		return err
-	}
-	if err = s.TaskConfig.Validate(); err != nil {
-		return err
+		errs.add(fmt.Errorf(`validate "image": %w`, err))
 	}
+	errs.add(s.ImageOverride.Validate())
+	errs.add(s.TaskConfig.Validate())
 	if err = s.Logging.Validate(); err != nil {
-		return fmt.Errorf(`validate "logging": %w`, err)
+		errs.add(fmt.Errorf(`validate "logging": %w`, err))
 	}
 	for k, v := range s.Sidecars {
 		if err = v.Validate(); err != nil {
-			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
+			errs.add(fmt.Errorf(`validate "sidecars[%s]": %w`, k, err))
 		}
 	}
 	if err = s.Network.Validate(); err != nil {
-		return fmt.Errorf(`validate "network": %w`, err)
+		errs.add(fmt.Errorf(`validate "network": %w`, err))
+	}
+	if !s.Network.Connect.IsEmpty() {
+		errs.add(fmt.Errorf(`validate "network": "connect" is not supported for %s`, ScheduledJobType))
 	}
 	if err = s.On.Validate(); err != nil {
-		return fmt.Errorf(`validate "on": %w`, err)
+		errs.add(fmt.Errorf(`validate "on": %w`, err))
 	}
-	if err = s.JobFailureHandlerConfig.Validate(); err != nil {
-		return err
+	errs.add(s.JobFailureHandlerConfig.Validate())
+	if err = s.Workflow.Validate(); err != nil {
+		errs.add(fmt.Errorf(`validate "workflow": %w`, err))
+	}
+	if len(s.Workflow) > 0 && !s.On.Payload.IsEmpty() {
+		errs.add(fmt.Errorf(`"payload" cannot be specified with "workflow"`))
 	}
 	if err = s.PublishConfig.Validate(); err != nil {
-		return fmt.Errorf(`validate "publish": %w`, err)
+		errs.add(fmt.Errorf(`validate "publish": %w`, err))
 	}
 	for ind, taskDefOverride := range s.TaskDefOverrides {
 		if err = taskDefOverride.Validate(); err != nil {
-			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
+			errs.add(fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err))
+		}
+	}
+	for ind, patch := range s.Patches {
+		if err = patch.Validate(); err != nil {
+			errs.add(fmt.Errorf(`validate "patches[%d]": %w`, ind, err))
 		}
 	}
 	if s.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
 			execEnabled: aws.BoolValue(s.ExecuteCommand.Enable),
 			efsVolumes:  s.Storage.Volumes,
+			payloadFile: s.On.Payload.Path != nil,
 		}); err != nil {
-			return fmt.Errorf(`validate Windows: %w`, err)
+			errs.add(fmt.Errorf(`validate Windows: %w`, err))
 		}
 	}
 	if s.TaskConfig.IsARM() {
@@ -402,10 +468,10 @@ func (s ScheduledJobConfig) Validate() error {
 			Spot:     s.Count.AdvancedCount.Spot,
 			SpotFrom: s.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
 		}); err != nil {
-			return fmt.Errorf("validate ARM: %w", err)
+			errs.add(fmt.Errorf("validate ARM: %w", err))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 // Validate returns nil if the pipeline manifest is configured correctly.
@@ -413,6 +479,274 @@ func (p Pipeline) Validate() error {
 	if len(p.Name) > 100 {
 		return fmt.Errorf(`pipeline name '%s' must be shorter than 100 characters`, p.Name)
 	}
+	for idx, stage := range p.Stages {
+		if err := stage.Validate(); err != nil {
+			return fmt.Errorf(`validate "stages[%d]": %w`, idx, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if PipelineStage is configured correctly.
+func (s PipelineStage) Validate() error {
+	names := make(map[string]bool)
+	for _, actions := range []struct {
+		field   string
+		actions []PipelineAction
+	}{
+		{field: "pre_deployments", actions: s.PreDeployments},
+		{field: "post_deployments", actions: s.PostDeployments},
+	} {
+		for idx, action := range actions.actions {
+			if err := action.Validate(); err != nil {
+				return fmt.Errorf(`validate "%s[%d]": %w`, actions.field, idx, err)
+			}
+			if names[action.Name] {
+				return fmt.Errorf(`validate "%s[%d]": action name "%s" must be unique within the stage`, actions.field, idx, action.Name)
+			}
+			names[action.Name] = true
+		}
+	}
+	seen := make(map[string]bool)
+	for _, name := range s.Deployments {
+		if seen[name] {
+			return fmt.Errorf(`validate "deployments": workload "%s" must not be deployed more than once`, name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// Validate returns nil if PipelineAction is configured correctly.
+func (a PipelineAction) Validate() error {
+	if a.Name == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if !pipelineActionNameRegexp.MatchString(a.Name) {
+		return fmt.Errorf(`"name" %s must contain only alphanumeric characters and dashes`, a.Name)
+	}
+	var specified []string
+	if a.Build != nil {
+		specified = append(specified, "build")
+	}
+	if a.Approval != nil {
+		specified = append(specified, "approval")
+	}
+	if a.Job != "" {
+		specified = append(specified, "job")
+	}
+	if len(specified) == 0 {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"build", "approval", "job"},
+		}
+	}
+	if len(specified) > 1 {
+		return &errFieldMutualExclusive{
+			firstField:  specified[0],
+			secondField: specified[1],
+			mustExist:   false,
+		}
+	}
+	if a.Build != nil {
+		if err := a.Build.Validate(); err != nil {
+			return fmt.Errorf(`validate "build": %w`, err)
+		}
+	}
+	if a.Approval != nil {
+		if err := a.Approval.Validate(); err != nil {
+			return fmt.Errorf(`validate "approval": %w`, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if ActionBuild is configured correctly.
+func (b ActionBuild) Validate() error {
+	if b.Buildspec == "" && len(b.Commands) == 0 {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"buildspec", "commands"},
+		}
+	}
+	if b.Buildspec != "" && len(b.Commands) != 0 {
+		return &errFieldMutualExclusive{
+			firstField:  "buildspec",
+			secondField: "commands",
+			mustExist:   false,
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if ActionApproval is configured correctly.
+func (a ActionApproval) Validate() error {
+	if a.Topic != "" && !arn.IsARN(a.Topic) {
+		return fmt.Errorf(`"topic" must be the ARN of an SNS topic`)
+	}
+	return nil
+}
+
+// Validate returns nil if the environment manifest is configured correctly.
+func (e Environment) Validate() error {
+	if e.Name == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	return e.EnvironmentConfig.Validate()
+}
+
+// Validate returns nil if EnvironmentConfig is configured correctly.
+func (e EnvironmentConfig) Validate() error {
+	if err := e.Network.Validate(); err != nil {
+		return fmt.Errorf(`validate "network": %w`, err)
+	}
+	if err := e.Observability.Validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if environmentNetworkConfig is configured correctly.
+func (n environmentNetworkConfig) Validate() error {
+	if err := n.VPC.Validate(); err != nil {
+		return fmt.Errorf(`validate "vpc": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if environmentVPCConfig is configured correctly.
+func (v environmentVPCConfig) Validate() error {
+	if v.ID != nil && v.CIDR != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "id",
+			secondField: "cidr",
+		}
+	}
+	if v.CIDR != nil {
+		if err := v.CIDR.Validate(); err != nil {
+			return fmt.Errorf(`validate "cidr": %w`, err)
+		}
+	}
+	if err := v.Subnets.Validate(); err != nil {
+		return fmt.Errorf(`validate "subnets": %w`, err)
+	}
+	switch {
+	case v.ID != nil:
+		return v.validateImportedSubnets()
+	case v.CIDR != nil:
+		return v.validateManagedSubnets()
+	case !v.Subnets.isEmpty():
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields:    []string{"id", "cidr"},
+			conditionalField: "subnets",
+		}
+	}
+	return nil
+}
+
+func (v environmentVPCConfig) validateImportedSubnets() error {
+	for _, subnet := range append(v.Subnets.Public, v.Subnets.Private...) {
+		if subnet.SubnetID == nil {
+			return fmt.Errorf(`validate "subnets": %w`, &errFieldMustBeSpecified{
+				missingField:      "id",
+				conditionalFields: []string{"vpc.id"},
+			})
+		}
+		if subnet.CIDR != nil || subnet.AZ != nil {
+			return fmt.Errorf(`validate "subnets": cannot specify "cidr" or "az" when importing subnet %s`, aws.StringValue(subnet.SubnetID))
+		}
+	}
+	if len(v.Subnets.Public) == 1 {
+		return errors.New("at least two public subnets must be imported to enable Load Balancing")
+	}
+	if len(v.Subnets.Private) == 1 {
+		return errors.New("at least two private subnets must be imported")
+	}
+	return nil
+}
+
+func (v environmentVPCConfig) validateManagedSubnets() error {
+	var publicAZs, privateAZs []string
+	for _, subnet := range v.Subnets.Public {
+		if subnet.CIDR == nil {
+			return fmt.Errorf(`validate "subnets": %w`, &errFieldMustBeSpecified{
+				missingField:      "cidr",
+				conditionalFields: []string{"vpc.cidr"},
+			})
+		}
+		if subnet.AZ != nil {
+			publicAZs = append(publicAZs, aws.StringValue(subnet.AZ))
+		}
+	}
+	for _, subnet := range v.Subnets.Private {
+		if subnet.CIDR == nil {
+			return fmt.Errorf(`validate "subnets": %w`, &errFieldMustBeSpecified{
+				missingField:      "cidr",
+				conditionalFields: []string{"vpc.cidr"},
+			})
+		}
+		if subnet.AZ != nil {
+			privateAZs = append(privateAZs, aws.StringValue(subnet.AZ))
+		}
+	}
+	if len(v.Subnets.Public) == 0 || len(v.Subnets.Private) == 0 {
+		return errors.New(`both "public" and "private" subnets must be specified when configuring the VPC CIDR`)
+	}
+	if len(v.Subnets.Public) != len(v.Subnets.Private) {
+		return errors.New(`the number of "public" and "private" subnets must be the same`)
+	}
+	if len(publicAZs) == 0 && len(privateAZs) == 0 {
+		return nil
+	}
+	if len(publicAZs) != len(v.Subnets.Public) || len(privateAZs) != len(v.Subnets.Private) {
+		return errors.New(`"az" must be specified for either all or none of the subnets`)
+	}
+	for i := range publicAZs {
+		if publicAZs[i] != privateAZs[i] {
+			return fmt.Errorf(`public subnet %d is in availability zone %s but private subnet %d is in %s: subnets must be listed in the same availability zone order`, i+1, publicAZs[i], i+1, privateAZs[i])
+		}
+	}
+	if len(publicAZs) < 2 {
+		return errors.New("at least two availability zones must be provided to enable Load Balancing")
+	}
+	return nil
+}
+
+// Validate returns nil if subnetsConfiguration is configured correctly.
+func (s subnetsConfiguration) Validate() error {
+	for ind, subnet := range s.Public {
+		if err := subnet.Validate(); err != nil {
+			return fmt.Errorf(`validate "public[%d]": %w`, ind, err)
+		}
+	}
+	for ind, subnet := range s.Private {
+		if err := subnet.Validate(); err != nil {
+			return fmt.Errorf(`validate "private[%d]": %w`, ind, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if subnetConfiguration is configured correctly.
+func (s subnetConfiguration) Validate() error {
+	if s.SubnetID != nil && s.CIDR != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "id",
+			secondField: "cidr",
+		}
+	}
+	if s.CIDR != nil {
+		if err := s.CIDR.Validate(); err != nil {
+			return fmt.Errorf(`validate "cidr": %w`, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if environmentObservability is configured correctly.
+func (o environmentObservability) Validate() error {
 	return nil
 }
 
@@ -578,36 +912,149 @@ func (r RoutingRuleConfigOrBool) Validate() error {
 // Validate returns nil if RoutingRuleConfiguration is configured correctly.
 func (r RoutingRuleConfiguration) Validate() error {
 	var err error
+	var errs errFields
 	if err = r.HealthCheck.Validate(); err != nil {
-		return fmt.Errorf(`validate "healthcheck": %w`, err)
+		errs.add(fmt.Errorf(`validate "healthcheck": %w`, err))
 	}
 	if err = r.Alias.Validate(); err != nil {
-		return fmt.Errorf(`validate "alias": %w`, err)
+		errs.add(fmt.Errorf(`validate "alias": %w`, err))
 	}
 	if r.TargetContainer != nil && r.TargetContainerCamelCase != nil {
-		return &errFieldMutualExclusive{
+		errs.add(&errFieldMutualExclusive{
 			firstField:  "target_container",
 			secondField: "targetContainer",
-		}
+		})
 	}
 	for ind, ip := range r.AllowedSourceIps {
 		if err = ip.Validate(); err != nil {
-			return fmt.Errorf(`validate "allowed_source_ips[%d]": %w`, ind, err)
+			errs.add(fmt.Errorf(`validate "allowed_source_ips[%d]": %w`, ind, err))
 		}
 	}
 	if r.ProtocolVersion != nil {
 		if !contains(strings.ToUpper(*r.ProtocolVersion), httpProtocolVersions) {
-			return fmt.Errorf(`"version" field value '%s' must be one of %s`, *r.ProtocolVersion, english.WordSeries(httpProtocolVersions, "or"))
+			errs.add(fmt.Errorf(`"version" field value '%s' must be one of %s`, *r.ProtocolVersion, english.WordSeries(httpProtocolVersions, "or")))
 		}
 	}
 	if r.Path == nil {
-		return &errFieldMustBeSpecified{
+		errs.add(&errFieldMustBeSpecified{
 			missingField: "path",
+		})
+	}
+	if r.Priority != nil {
+		if err = validateListenerRulePriority(aws.IntValue(r.Priority)); err != nil {
+			errs.add(fmt.Errorf(`validate "priority": %w`, err))
+		}
+	}
+	priorities := make(map[int]string)
+	if r.Priority != nil {
+		priorities[aws.IntValue(r.Priority)] = `"priority"`
+	}
+	for ind, rule := range r.AdditionalRules {
+		if err = rule.Validate(); err != nil {
+			errs.add(fmt.Errorf(`validate "additional_rules[%d]": %w`, ind, err))
+		}
+		if rule.Priority == nil {
+			continue
+		}
+		field := fmt.Sprintf(`"additional_rules[%d].priority"`, ind)
+		if other, ok := priorities[aws.IntValue(rule.Priority)]; ok {
+			errs.add(fmt.Errorf(`%s and %s cannot both be %d`, other, field, aws.IntValue(rule.Priority)))
+		}
+		priorities[aws.IntValue(rule.Priority)] = field
+	}
+	return errs.err()
+}
+
+// Validate returns nil if AdditionalRoutingRule is configured correctly.
+func (r AdditionalRoutingRule) Validate() error {
+	var err error
+	if r.Priority != nil {
+		if err = validateListenerRulePriority(aws.IntValue(r.Priority)); err != nil {
+			return fmt.Errorf(`validate "priority": %w`, err)
+		}
+	}
+	if len(r.Hosts) == 0 && len(r.Paths) == 0 && len(r.Headers) == 0 && len(r.QueryStrings) == 0 && len(r.AllowedSourceIps) == 0 {
+		return errors.New(`must specify at least one of "hosts", "paths", "headers", "query_strings" or "allowed_source_ips"`)
+	}
+	conditionValues := len(r.Hosts) + len(r.Paths) + len(r.QueryStrings) + len(r.AllowedSourceIps)
+	for name, values := range r.Headers {
+		if len(values) == 0 {
+			return fmt.Errorf(`header %s in "headers" must have at least one value`, name)
+		}
+		conditionValues += len(values)
+	}
+	if conditionValues > maxListenerRuleConditionValues {
+		return fmt.Errorf(`%d values in "hosts", "paths", "headers", "query_strings" and "allowed_source_ips" exceed the maximum of %d for a listener rule`, conditionValues, maxListenerRuleConditionValues)
+	}
+	for ind, ip := range r.AllowedSourceIps {
+		if err = ip.Validate(); err != nil {
+			return fmt.Errorf(`validate "allowed_source_ips[%d]": %w`, ind, err)
+		}
+	}
+	if r.FixedResponse != nil && r.Redirect != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "fixed_response",
+			secondField: "redirect",
+		}
+	}
+	if r.FixedResponse != nil {
+		if err = r.FixedResponse.Validate(); err != nil {
+			return fmt.Errorf(`validate "fixed_response": %w`, err)
+		}
+	}
+	if r.Redirect != nil {
+		if err = r.Redirect.Validate(); err != nil {
+			return fmt.Errorf(`validate "redirect": %w`, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if FixedResponse is configured correctly.
+func (f FixedResponse) Validate() error {
+	if f.StatusCode == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "status_code",
+		}
+	}
+	code := aws.IntValue(f.StatusCode)
+	if code < 200 || code > 599 || (code >= 300 && code < 400) {
+		return fmt.Errorf(`"status_code" %d must be a 2XX, 4XX or 5XX code`, code)
+	}
+	if f.ContentType != nil && !contains(aws.StringValue(f.ContentType), fixedResponseContentTypes) {
+		return fmt.Errorf(`"content_type" %s must be one of %s`, aws.StringValue(f.ContentType), english.WordSeries(fixedResponseContentTypes, "or"))
+	}
+	if len(aws.StringValue(f.Body)) > 1024 {
+		return errors.New(`"body" cannot be longer than 1024 characters`)
+	}
+	return nil
+}
+
+// Validate returns nil if RedirectAction is configured correctly.
+func (r RedirectAction) Validate() error {
+	if r.Protocol != nil && !contains(aws.StringValue(r.Protocol), redirectProtocols) {
+		return fmt.Errorf(`"protocol" %s must be one of %s`, aws.StringValue(r.Protocol), english.WordSeries(redirectProtocols, "or"))
+	}
+	if r.Path != nil && !strings.HasPrefix(aws.StringValue(r.Path), "/") {
+		return fmt.Errorf(`"path" %s must start with "/"`, aws.StringValue(r.Path))
+	}
+	if r.StatusCode != nil {
+		if code := aws.IntValue(r.StatusCode); code != 301 && code != 302 {
+			return fmt.Errorf(`"status_code" %d must be 301 or 302`, code)
 		}
 	}
 	return nil
 }
 
+// validateListenerRulePriority returns nil if the priority can be assigned to a listener rule.
+// Priority 50000 is reserved for the rule of services routing from the root path.
+func validateListenerRulePriority(priority int) error {
+	if priority < 1 || priority > 49999 {
+		return fmt.Errorf("priority %d must be between 1 and 49999", priority)
+	}
+	return nil
+}
+
 // Validate returns nil if HealthCheckArgsOrString is configured correctly.
 func (h HealthCheckArgsOrString) Validate() error {
 	if h.IsEmpty() {
@@ -692,27 +1139,141 @@ func validateNLBPort(port *string) error {
 // Validate returns nil if TaskConfig is configured correctly.
 func (t TaskConfig) Validate() error {
 	var err error
+	var errs errFields
 	if err = t.Platform.Validate(); err != nil {
-		return fmt.Errorf(`validate "platform": %w`, err)
+		errs.add(fmt.Errorf(`validate "platform": %w`, err))
 	}
 	if err = t.Count.Validate(); err != nil {
-		return fmt.Errorf(`validate "count": %w`, err)
+		errs.add(fmt.Errorf(`validate "count": %w`, err))
 	}
 	if err = t.ExecuteCommand.Validate(); err != nil {
-		return fmt.Errorf(`validate "exec": %w`, err)
+		errs.add(fmt.Errorf(`validate "exec": %w`, err))
 	}
 	if err = t.Storage.Validate(); err != nil {
-		return fmt.Errorf(`validate "storage": %w`, err)
+		errs.add(fmt.Errorf(`validate "storage": %w`, err))
+	}
+	if err = t.EnvAddons.Validate(); err != nil {
+		errs.add(fmt.Errorf(`validate "env_addons": %w`, err))
+	}
+	if err = t.Permissions.Validate(); err != nil {
+		errs.add(fmt.Errorf(`validate "permissions": %w`, err))
 	}
+	errs.add(validateSecrets(t.Secrets))
 	if t.EnvFile != nil {
 		envFile := aws.StringValue(t.EnvFile)
 		if filepath.Ext(envFile) != envFileExt {
-			return fmt.Errorf("environment file %s must have a %s file extension", envFile, envFileExt)
+			errs.add(fmt.Errorf("environment file %s must have a %s file extension", envFile, envFileExt))
+		}
+	}
+	return errs.err()
+}
+
+// Validate returns nil if EnvAddonsImports is configured correctly.
+func (e EnvAddonsImports) Validate() error {
+	for name, output := range e.Variables {
+		if !envAddonsOutputRegexp.MatchString(output) {
+			return fmt.Errorf(`validate "variables": output name %q of %s must only contain alphanumeric characters`, output, name)
+		}
+	}
+	for name, output := range e.Secrets {
+		if !envAddonsOutputRegexp.MatchString(output) {
+			return fmt.Errorf(`validate "secrets": output name %q of %s must only contain alphanumeric characters`, output, name)
+		}
+	}
+	for _, output := range e.Policies {
+		if !envAddonsOutputRegexp.MatchString(output) {
+			return fmt.Errorf(`validate "policies": output name %q must only contain alphanumeric characters`, output)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if Permissions is configured correctly.
+func (p Permissions) Validate() error {
+	for _, policy := range p.ManagedPolicies {
+		parsed, err := arn.Parse(policy)
+		if err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "policy/") {
+			return fmt.Errorf(`validate "managed_policies": %q is not the ARN of an IAM managed policy`, policy)
+		}
+	}
+	for i, stmt := range p.Statements {
+		if err := stmt.Validate(); err != nil {
+			return fmt.Errorf(`validate "statements[%d]": %w`, i, err)
+		}
+	}
+	if err := p.Grants.Validate(); err != nil {
+		return fmt.Errorf(`validate "grants": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if PolicyStatement is configured correctly.
+func (s PolicyStatement) Validate() error {
+	if s.Effect != nil && !contains(aws.StringValue(s.Effect), iamPolicyEffects) {
+		return fmt.Errorf(`validate "effect": effect %q must be one of %s`, aws.StringValue(s.Effect), english.WordSeries(iamPolicyEffects, "or"))
+	}
+	if len(s.Actions) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "actions",
+		}
+	}
+	for i, action := range s.Actions {
+		if !iamActionRegexp.MatchString(action) {
+			return fmt.Errorf(`validate "actions[%d]": action %q must be in the format "service:Action"`, i, action)
+		}
+	}
+	if len(s.Resources) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "resources",
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if PermissionGrants is configured correctly.
+func (g PermissionGrants) Validate() error {
+	for i, bucket := range g.S3Read {
+		if !s3BucketNameRegexp.MatchString(bucket) {
+			return fmt.Errorf(`validate "s3_read[%d]": %q is not a valid S3 bucket name`, i, bucket)
+		}
+	}
+	for i, topic := range g.SNSPublish {
+		if parsed, err := arn.Parse(topic); err == nil {
+			if parsed.Service != "sns" {
+				return fmt.Errorf(`validate "sns_publish[%d]": %q is not the ARN of an SNS topic`, i, topic)
+			}
+			continue
+		}
+		if topic == "" || !awsSNSTopicRegexp.MatchString(topic) {
+			return fmt.Errorf(`validate "sns_publish[%d]": topic name %q must only contain letters, numbers, underscores, and hyphens`, i, topic)
+		}
+	}
+	for i, prefix := range g.SecretsRead {
+		if !secretsPrefixRegexp.MatchString(prefix) {
+			return fmt.Errorf(`validate "secrets_read[%d]": prefix %q must only contain letters, numbers, and the characters /_.+=@-`, i, prefix)
 		}
 	}
 	return nil
 }
 
+// IAMActionCatalog checks IAM actions against a list of known actions.
+type IAMActionCatalog interface {
+	Check(action string) error
+}
+
+// CheckIAMActions returns an error for each action of the permission statements that isn't in the catalog.
+func (t TaskConfig) CheckIAMActions(catalog IAMActionCatalog) []error {
+	var errs []error
+	for i, stmt := range t.Permissions.Statements {
+		for j, action := range stmt.Actions {
+			if err := catalog.Check(action); err != nil {
+				errs = append(errs, fmt.Errorf(`validate "permissions": validate "statements[%d]": validate "actions[%d]": %w`, i, j, err))
+			}
+		}
+	}
+	return errs
+}
+
 // Validate returns nil if PlatformArgsOrString is configured correctly.
 func (p PlatformArgsOrString) Validate() error {
 	if p.IsEmpty() {
@@ -927,25 +1488,26 @@ func (s Storage) Validate() error {
 	if s.IsEmpty() {
 		return nil
 	}
+	var errs errFields
 	if s.Ephemeral != nil {
 		ephemeral := aws.IntValue(s.Ephemeral)
 		if ephemeral < ephemeralMinValueGiB || ephemeral > ephemeralMaxValueGiB {
-			return fmt.Errorf(`validate "ephemeral": ephemeral storage must be between 20 GiB and 200 GiB`)
+			errs.add(fmt.Errorf(`validate "ephemeral": ephemeral storage must be between 20 GiB and 200 GiB`))
 		}
 	}
 	var hasManagedVolume bool
 	for k, v := range s.Volumes {
 		if err := v.Validate(); err != nil {
-			return fmt.Errorf(`validate "volumes[%s]": %w`, k, err)
+			errs.add(fmt.Errorf(`validate "volumes[%s]": %w`, k, err))
 		}
 		if !v.EmptyVolume() && v.EFS.UseManagedFS() {
 			if hasManagedVolume {
-				return fmt.Errorf("cannot specify more than one managed volume per service")
+				errs.add(fmt.Errorf("cannot specify more than one managed volume per service"))
 			}
 			hasManagedVolume = true
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 // Validate returns nil if Volume is configured correctly.
@@ -1035,23 +1597,29 @@ func (l Logging) Validate() error {
 	if l.IsEmpty() {
 		return nil
 	}
-	return nil
+	if err := validateSecrets(l.SecretOptions); err != nil {
+		return fmt.Errorf(`validate "secretOptions": %w`, err)
+	}
+	return validateSecrets(l.Secrets)
 }
 
 // Validate returns nil if SidecarConfig is configured correctly.
 func (s SidecarConfig) Validate() error {
+	var errs errFields
 	for ind, mp := range s.MountPoints {
 		if err := mp.Validate(); err != nil {
-			return fmt.Errorf(`validate "mount_points[%d]": %w`, ind, err)
+			errs.add(fmt.Errorf(`validate "mount_points[%d]": %w`, ind, err))
 		}
 	}
 	if err := s.HealthCheck.Validate(); err != nil {
-		return fmt.Errorf(`validate "healthcheck": %w`, err)
+		errs.add(fmt.Errorf(`validate "healthcheck": %w`, err))
 	}
 	if err := s.DependsOn.Validate(); err != nil {
-		return fmt.Errorf(`validate "depends_on": %w`, err)
+		errs.add(fmt.Errorf(`validate "depends_on": %w`, err))
 	}
-	return s.ImageOverride.Validate()
+	errs.add(validateSecrets(s.Secrets))
+	errs.add(s.ImageOverride.Validate())
+	return errs.err()
 }
 
 // Validate returns nil if SidecarMountPoint is configured correctly.
@@ -1069,8 +1637,45 @@ func (n NetworkConfig) Validate() error {
 	if n.IsEmpty() {
 		return nil
 	}
+	var errs errFields
 	if err := n.VPC.Validate(); err != nil {
-		return fmt.Errorf(`validate "vpc": %w`, err)
+		errs.add(fmt.Errorf(`validate "vpc": %w`, err))
+	}
+	if err := n.Connect.Validate(); err != nil {
+		errs.add(fmt.Errorf(`validate "connect": %w`, err))
+	}
+	return errs.err()
+}
+
+// Validate returns nil if ServiceConnectConfigOrBool is configured correctly.
+func (c ServiceConnectConfigOrBool) Validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+	return c.ServiceConnectConfiguration.Validate()
+}
+
+// Validate returns nil if ServiceConnectConfiguration is configured correctly.
+func (c ServiceConnectConfiguration) Validate() error {
+	if aws.BoolValue(c.ClientOnly) {
+		if c.Alias != nil {
+			return &errFieldMutualExclusive{
+				firstField:  "client_only",
+				secondField: "alias",
+			}
+		}
+		if c.PortName != nil {
+			return &errFieldMutualExclusive{
+				firstField:  "client_only",
+				secondField: "port_name",
+			}
+		}
+	}
+	if c.Alias != nil && !serviceConnectAliasRegexp.MatchString(aws.StringValue(c.Alias)) {
+		return fmt.Errorf(`"alias" %s must be a DNS name made of lowercase letters, numbers, hyphens and periods`, aws.StringValue(c.Alias))
+	}
+	if c.PortName != nil && !serviceConnectPortNameRegexp.MatchString(aws.StringValue(c.PortName)) {
+		return fmt.Errorf(`"port_name" %s must be up to 64 lowercase letters, numbers, underscores and hyphens, and cannot start with a hyphen`, aws.StringValue(c.PortName))
 	}
 	return nil
 }
@@ -1177,9 +1782,100 @@ func (o Observability) Validate() error {
 
 // Validate returns nil if JobTriggerConfig is configured correctly.
 func (c JobTriggerConfig) Validate() error {
-	if c.Schedule == nil {
+	var errs errFields
+	triggers := c.triggers()
+	if len(triggers) == 0 {
+		errs.add(fmt.Errorf(`must specify one of "schedule", "event", "s3", "sns" or "manual"`))
+	}
+	if len(triggers) > 1 {
+		errs.add(&errFieldMutualExclusive{
+			firstField:  triggers[0],
+			secondField: triggers[1],
+		})
+	}
+	if c.Manual != nil && !aws.BoolValue(c.Manual) {
+		errs.add(errors.New(`"manual" must be true if specified`))
+	}
+	if err := c.Event.Validate(); err != nil {
+		errs.add(fmt.Errorf(`validate "event": %w`, err))
+	}
+	if err := c.S3.Validate(); err != nil {
+		errs.add(fmt.Errorf(`validate "s3": %w`, err))
+	}
+	if err := c.SNS.Validate(); err != nil {
+		errs.add(fmt.Errorf(`validate "sns": %w`, err))
+	}
+	if c.Schedule != nil && !c.Payload.IsEmpty() {
+		errs.add(fmt.Errorf(`"payload" cannot be specified with "schedule"`))
+	}
+	if err := c.Payload.Validate(); err != nil {
+		errs.add(fmt.Errorf(`validate "payload": %w`, err))
+	}
+	return errs.err()
+}
+
+// Validate returns nil if JobEventTrigger is configured correctly.
+func (e JobEventTrigger) Validate() error {
+	if e.IsEmpty() {
+		return nil
+	}
+	if len(e.Pattern) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "pattern",
+		}
+	}
+	if e.Bus != nil && aws.StringValue(e.Bus) == "" {
+		return errors.New(`"bus" cannot be empty`)
+	}
+	return nil
+}
+
+// Validate returns nil if JobS3Trigger is configured correctly.
+func (s JobS3Trigger) Validate() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	if s.Bucket == nil {
 		return &errFieldMustBeSpecified{
-			missingField: "schedule",
+			missingField: "bucket",
+		}
+	}
+	if bucket := aws.StringValue(s.Bucket); !s3BucketNameRegexp.MatchString(bucket) {
+		return fmt.Errorf(`"bucket" %q is not a valid S3 bucket name`, bucket)
+	}
+	return nil
+}
+
+// Validate returns nil if JobSNSTrigger is configured correctly.
+func (s JobSNSTrigger) Validate() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	return TopicSubscription{
+		Name:         s.Name,
+		Service:      s.Service,
+		FilterPolicy: s.FilterPolicy,
+	}.Validate()
+}
+
+// Validate returns nil if JobPayloadConfig is configured correctly.
+func (p JobPayloadConfig) Validate() error {
+	if p.Variable != nil && p.Path != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "variable",
+			secondField: "path",
+		}
+	}
+	if p.Variable != nil && !envVarNameRegexp.MatchString(aws.StringValue(p.Variable)) {
+		return fmt.Errorf(`"variable" %q must start with a letter or an underscore and only contain letters, numbers, and underscores`, aws.StringValue(p.Variable))
+	}
+	if p.Path != nil {
+		file := aws.StringValue(p.Path)
+		if !path.IsAbs(file) || strings.HasSuffix(file, "/") || path.Dir(file) == "/" {
+			return fmt.Errorf(`"path" %q must be an absolute path to a file outside of the root directory`, file)
+		}
+		if !volumesPathRegexp.MatchString(file) {
+			return fmt.Errorf(`"path" %q can only contain the following characters: a-zA-Z0-9.-_/`, file)
 		}
 	}
 	return nil
@@ -1190,6 +1886,24 @@ func (JobFailureHandlerConfig) Validate() error {
 	return nil
 }
 
+// Validate returns nil if JobWorkflow is configured correctly.
+func (w JobWorkflow) Validate() error {
+	for name, step := range w {
+		if step == nil {
+			continue
+		}
+		if err := step.Validate(); err != nil {
+			return fmt.Errorf(`validate "%s": %w`, name, err)
+		}
+	}
+	return validateNoCircularStepDependencies(w)
+}
+
+// Validate returns nil if JobWorkflowStep is configured correctly.
+func (s JobWorkflowStep) Validate() error {
+	return s.JobFailureHandlerConfig.Validate()
+}
+
 // Validate returns nil if PublishConfig is configured correctly.
 func (p PublishConfig) Validate() error {
 	for ind, topic := range p.Topics {
@@ -1279,8 +1993,98 @@ func (r OverrideRule) Validate() error {
 	return nil
 }
 
-// Validate is a no-op for Secrets.
+// Validate returns nil if DeploymentConfig is configured correctly.
+func (d DeploymentConfig) Validate() error {
+	strategy := aws.StringValue(d.Strategy)
+	if d.Strategy != nil && !contains(strategy, DeploymentStrategies) {
+		return fmt.Errorf(`invalid "strategy": must be one of %s`, english.WordSeries(DeploymentStrategies, "or"))
+	}
+	switch strategy {
+	case DeploymentStrategyCanary, DeploymentStrategyLinear:
+		if d.Percent == nil {
+			return fmt.Errorf(`"percent" must be specified for the "%s" strategy`, strategy)
+		}
+		if percent := aws.IntValue(d.Percent); percent < minDeploymentPercent || percent > maxDeploymentPercent {
+			return fmt.Errorf(`"percent" must be between %d and %d`, minDeploymentPercent, maxDeploymentPercent)
+		}
+	default:
+		if d.Percent != nil {
+			return fmt.Errorf(`"percent" can only be specified for the "%s" or "%s" strategies`, DeploymentStrategyCanary, DeploymentStrategyLinear)
+		}
+		if d.Interval != nil {
+			return fmt.Errorf(`"interval" can only be specified for the "%s" or "%s" strategies`, DeploymentStrategyCanary, DeploymentStrategyLinear)
+		}
+	}
+	if err := validateDeploymentMinutes(d.Interval); err != nil {
+		return fmt.Errorf(`validate "interval": %w`, err)
+	}
+	if err := validateDeploymentMinutes(d.BakeTime); err != nil {
+		return fmt.Errorf(`validate "bake_time": %w`, err)
+	}
+	return nil
+}
+
+func validateDeploymentMinutes(d *time.Duration) error {
+	if d == nil {
+		return nil
+	}
+	if *d%time.Minute != 0 {
+		return fmt.Errorf("%s must be a whole number of minutes", *d)
+	}
+	if *d < 0 || *d > maxDeploymentWaitTime {
+		return fmt.Errorf("%s must be between 0m and %s", *d, maxDeploymentWaitTime)
+	}
+	return nil
+}
+
+// Validate returns nil if PatchOperation is configured correctly.
+func (p PatchOperation) Validate() error {
+	if p.Operation == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "op",
+		}
+	}
+	if p.Path == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "path",
+		}
+	}
+	if (p.Operation == override.PatchOpMove || p.Operation == override.PatchOpCopy) && p.From == "" {
+		return fmt.Errorf(`"from" must be specified for the "%s" operation`, p.Operation)
+	}
+	return override.ValidatePatch(override.Patch{
+		Operation: p.Operation,
+		Path:      p.Path,
+		From:      p.From,
+		Value:     p.Value,
+	})
+}
+
+// Validate returns nil if Secret is configured correctly.
 func (s Secret) Validate() error {
+	if s.fromVersion.Version == nil {
+		return nil
+	}
+	if aws.IntValue(s.fromVersion.Version) < 1 {
+		return errors.New(`"version" must be a positive integer`)
+	}
+	if arn.IsARN(aws.StringValue(s.fromVersion.From)) {
+		return errors.New(`"version" cannot be specified with an ARN in "from"`)
+	}
+	return nil
+}
+
+func validateSecrets(secrets map[string]Secret) error {
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := secrets[name].Validate(); err != nil {
+			return fmt.Errorf(`validate secret "%s": %w`, name, err)
+		}
+	}
 	return nil
 }
 
@@ -1305,6 +2109,7 @@ type validateTargetContainerOpts struct {
 type validateWindowsOpts struct {
 	execEnabled bool
 	efsVolumes  map[string]*Volume
+	payloadFile bool
 }
 
 type validateARMOpts struct {
@@ -1391,6 +2196,34 @@ func validateNoCircularDependencies(deps map[string]containerDependency) error {
 	return fmt.Errorf("circular container dependency chain includes the following containers: %s", cycle)
 }
 
+func validateNoCircularStepDependencies(workflow JobWorkflow) error {
+	dependencies := graph.New()
+	for name, step := range workflow {
+		if step == nil {
+			continue
+		}
+		for _, dep := range step.DependsOn {
+			if _, ok := workflow[dep]; !ok {
+				return fmt.Errorf("step %s depends on %s which is not a step of the workflow", name, dep)
+			}
+			dependencies.Add(graph.Edge{
+				From: name,
+				To:   dep,
+			})
+		}
+	}
+	cycle, ok := dependencies.IsAcyclic()
+	if ok {
+		return nil
+	}
+	if len(cycle) == 1 {
+		return fmt.Errorf("step %s cannot depend on itself", cycle[0])
+	}
+	// Stablize unit tests.
+	sort.SliceStable(cycle, func(i, j int) bool { return cycle[i] < cycle[j] })
+	return fmt.Errorf("circular step dependency chain includes the following steps: %s", cycle)
+}
+
 func buildDependencyGraph(deps map[string]containerDependency) (*graph.Graph, error) {
 	dependencyGraph := graph.New()
 	for name, containerDep := range deps {
@@ -1457,6 +2290,9 @@ func validateWindows(opts validateWindowsOpts) error {
 			return errors.New(`'EFS' is not supported when deploying a Windows container`)
 		}
 	}
+	if opts.payloadFile {
+		return errors.New(`'on.payload.path' is not supported when deploying a Windows container`)
+	}
 	return nil
 }
 
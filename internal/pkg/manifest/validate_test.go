@@ -82,7 +82,7 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: (*Placement)(aws.String("")),
 						},
 					},
@@ -265,10 +265,15 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 					Name: aws.String("mockName"),
 				},
 				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
 					TaskConfig: TaskConfig{
 						Count: Count{
 							AdvancedCount: AdvancedCount{
 								Requests: aws.Int(3),
+								Range: Range{
+									Value: (*IntRangeBand)(stringP("1-10")),
+								},
+								workloadType: LoadBalancedWebServiceType,
 							},
 						},
 					},
@@ -288,10 +293,15 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 					Name: aws.String("mockName"),
 				},
 				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
 					TaskConfig: TaskConfig{
 						Count: Count{
 							AdvancedCount: AdvancedCount{
 								ResponseTime: durationp(10 * time.Second),
+								Range: Range{
+									Value: (*IntRangeBand)(stringP("1-10")),
+								},
+								workloadType: LoadBalancedWebServiceType,
 							},
 						},
 					},
@@ -305,6 +315,99 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 			},
 			wantedError: errors.New(`scaling based on "nlb" requests or response time is not supported`),
 		},
+		"error if fail to validate deployment": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+						},
+					},
+					Deployment: DeploymentConfig{
+						Strategy: aws.String("recreate"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "deployment": `,
+		},
+		"error if shifting traffic without http": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						Enabled: aws.Bool(false),
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Port: aws.String("80"),
+					},
+					Deployment: DeploymentConfig{
+						Strategy: aws.String("blue-green"),
+					},
+				},
+			},
+			wantedError: errors.New(`deployment strategy "blue-green" requires "http" to be enabled
+deployment strategy "blue-green" is not supported with "nlb"`),
+		},
+		"error if shifting traffic with nlb": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+						},
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Port: aws.String("80"),
+					},
+					Deployment: DeploymentConfig{
+						Strategy: aws.String("canary"),
+						Percent:  aws.Int(10),
+					},
+				},
+			},
+			wantedError: errors.New(`deployment strategy "canary" is not supported with "nlb"`),
+		},
+		"error if shifting traffic with an additional rule that forwards to the service": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+							AdditionalRules: []AdditionalRoutingRule{
+								{
+									Paths: []string{"/maintenance"},
+									FixedResponse: &FixedResponse{
+										StatusCode: aws.Int(503),
+									},
+								},
+								{
+									Hosts: []string{"beta.example.com"},
+								},
+							},
+						},
+					},
+					Deployment: DeploymentConfig{
+						Strategy: aws.String("blue-green"),
+					},
+				},
+			},
+			wantedError: errors.New(`deployment strategy "blue-green" requires "http.additional_rules[1]" to specify "fixed_response" or "redirect"`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -373,7 +476,7 @@ func TestBackendService_Validate(t *testing.T) {
 				BackendServiceConfig: BackendServiceConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: (*Placement)(aws.String("")),
 						},
 					},
@@ -381,6 +484,24 @@ func TestBackendService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "network": `,
 		},
+		"error if service connect alias is set without a port": {
+			config: BackendService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						Connect: ServiceConnectConfigOrBool{
+							ServiceConnectConfiguration: ServiceConnectConfiguration{
+								Alias: aws.String("api"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "network": validate "connect": "image.port" must be specified if "alias" or "port_name" is specified`),
+		},
 		"error if fail to validate publish config": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -626,6 +747,24 @@ func TestWorkerService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "image": `,
 		},
+		"error if service connect alias is set": {
+			config: WorkerService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				WorkerServiceConfig: WorkerServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						Connect: ServiceConnectConfigOrBool{
+							ServiceConnectConfiguration: ServiceConnectConfiguration{
+								PortName: aws.String("http"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "network": validate "connect": "alias" and "port_name" are not supported for Worker Service`),
+		},
 		"error if fail to validate sidecars": {
 			config: WorkerService{
 				WorkerServiceConfig: WorkerServiceConfig{
@@ -646,7 +785,7 @@ func TestWorkerService_Validate(t *testing.T) {
 				WorkerServiceConfig: WorkerServiceConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: (*Placement)(aws.String("")),
 						},
 					},
@@ -797,6 +936,25 @@ func TestScheduledJob_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "image": `,
 		},
+		"error if service connect is set": {
+			config: ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					On: JobTriggerConfig{
+						Schedule: aws.String("mockSchedule"),
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectConfigOrBool{
+							Enabled: aws.Bool(true),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "network": "connect" is not supported for Scheduled Job`),
+		},
 		"error if fail to validate sidecars": {
 			config: ScheduledJob{
 				ScheduledJobConfig: ScheduledJobConfig{
@@ -817,7 +975,7 @@ func TestScheduledJob_Validate(t *testing.T) {
 				ScheduledJobConfig: ScheduledJobConfig{
 					ImageConfig: testImageConfig,
 					Network: NetworkConfig{
-						vpcConfig{
+						VPC: vpcConfig{
 							Placement: (*Placement)(aws.String("")),
 						},
 					},
@@ -866,6 +1024,68 @@ func TestScheduledJob_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "taskdef_overrides[0]": `,
 		},
+		"error if fail to validate workflow": {
+			config: ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					On: JobTriggerConfig{
+						Schedule: aws.String("mockSchedule"),
+					},
+					Workflow: JobWorkflow{
+						"load": {
+							DependsOn: []string{"extract"},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "workflow": `,
+		},
+		"error if payload is set with a workflow": {
+			config: ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					On: JobTriggerConfig{
+						Manual: aws.Bool(true),
+						Payload: JobPayloadConfig{
+							Variable: aws.String("INPUT"),
+						},
+					},
+					Workflow: JobWorkflow{
+						"extract": {},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"payload" cannot be specified with "workflow"`),
+		},
+		"error if retries are set for both the job and its workflow step": {
+			config: ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("nightly-etl"),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					On: JobTriggerConfig{
+						Schedule: aws.String("mockSchedule"),
+					},
+					JobFailureHandlerConfig: JobFailureHandlerConfig{
+						Retries: aws.Int(3),
+					},
+					Workflow: JobWorkflow{
+						"extract": {},
+						"nightly-etl": {
+							DependsOn: []string{"extract"},
+							JobFailureHandlerConfig: JobFailureHandlerConfig{
+								Retries: aws.Int(2),
+							},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "retries" and "workflow.nightly-etl.retries"`),
+		},
 		"error if name is not set": {
 			config: ScheduledJob{
 				ScheduledJobConfig: ScheduledJobConfig{
@@ -932,6 +1152,132 @@ func TestScheduledJob_Validate(t *testing.T) {
 	}
 }
 
+func TestEnvironment_Validate(t *testing.T) {
+	withVPC := func(vpc environmentVPCConfig) Environment {
+		return Environment{
+			Name: aws.String("test"),
+			EnvironmentConfig: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: vpc,
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		in Environment
+
+		wantedErrorMsgPrefix string
+	}{
+		"error if name is not specified": {
+			in:                   Environment{},
+			wantedErrorMsgPrefix: `"name" must be specified`,
+		},
+		"valid default environment": {
+			in: Environment{Name: aws.String("test")},
+		},
+		"error if both id and cidr are specified": {
+			in: withVPC(environmentVPCConfig{
+				ID:   aws.String("vpc-123"),
+				CIDR: ipNetP("10.0.0.0/16"),
+			}),
+			wantedErrorMsgPrefix: `validate "network": validate "vpc": must specify one, not both, of "id" and "cidr"`,
+		},
+		"error if the cidr is malformed": {
+			in: withVPC(environmentVPCConfig{
+				CIDR: ipNetP("10.0.0.0"),
+			}),
+			wantedErrorMsgPrefix: `validate "network": validate "vpc": validate "cidr": parse IPNet 10.0.0.0: `,
+		},
+		"error if subnets are specified without id or cidr": {
+			in: withVPC(environmentVPCConfig{
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{{SubnetID: aws.String("subnet-1")}},
+				},
+			}),
+			wantedErrorMsgPrefix: `validate "network": validate "vpc": must specify at least one of "id" or "cidr" if "subnets" is specified`,
+		},
+		"error if an imported subnet has a cidr": {
+			in: withVPC(environmentVPCConfig{
+				ID: aws.String("vpc-123"),
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{SubnetID: aws.String("subnet-1")},
+						{CIDR: ipNetP("10.0.0.0/24")},
+					},
+				},
+			}),
+			wantedErrorMsgPrefix: `validate "network": validate "vpc": validate "subnets": "id" must be specified if "vpc.id" is specified`,
+		},
+		"error if only one public subnet is imported": {
+			in: withVPC(environmentVPCConfig{
+				ID: aws.String("vpc-123"),
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{{SubnetID: aws.String("subnet-1")}},
+				},
+			}),
+			wantedErrorMsgPrefix: `validate "network": validate "vpc": at least two public subnets must be imported to enable Load Balancing`,
+		},
+		"error if the number of managed public and private subnets differ": {
+			in: withVPC(environmentVPCConfig{
+				CIDR: ipNetP("10.0.0.0/16"),
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{CIDR: ipNetP("10.0.0.0/24")},
+						{CIDR: ipNetP("10.0.1.0/24")},
+					},
+					Private: []subnetConfiguration{
+						{CIDR: ipNetP("10.0.2.0/24")},
+					},
+				},
+			}),
+			wantedErrorMsgPrefix: `validate "network": validate "vpc": the number of "public" and "private" subnets must be the same`,
+		},
+		"error if availability zones are not in the same order": {
+			in: withVPC(environmentVPCConfig{
+				CIDR: ipNetP("10.0.0.0/16"),
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{CIDR: ipNetP("10.0.0.0/24"), AZ: aws.String("us-east-2a")},
+						{CIDR: ipNetP("10.0.1.0/24"), AZ: aws.String("us-east-2b")},
+					},
+					Private: []subnetConfiguration{
+						{CIDR: ipNetP("10.0.2.0/24"), AZ: aws.String("us-east-2b")},
+						{CIDR: ipNetP("10.0.3.0/24"), AZ: aws.String("us-east-2a")},
+					},
+				},
+			}),
+			wantedErrorMsgPrefix: `validate "network": validate "vpc": public subnet 1 is in availability zone us-east-2a but private subnet 1 is in us-east-2b`,
+		},
+		"valid managed VPC": {
+			in: withVPC(environmentVPCConfig{
+				CIDR: ipNetP("10.0.0.0/16"),
+				Subnets: subnetsConfiguration{
+					Public: []subnetConfiguration{
+						{CIDR: ipNetP("10.0.0.0/24"), AZ: aws.String("us-east-2a")},
+						{CIDR: ipNetP("10.0.1.0/24"), AZ: aws.String("us-east-2b")},
+					},
+					Private: []subnetConfiguration{
+						{CIDR: ipNetP("10.0.2.0/24"), AZ: aws.String("us-east-2a")},
+						{CIDR: ipNetP("10.0.3.0/24"), AZ: aws.String("us-east-2b")},
+					},
+				},
+			}),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+
+			if tc.wantedErrorMsgPrefix != "" {
+				require.Error(t, gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestPipelineManifest_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		Pipeline Pipeline
@@ -944,6 +1290,146 @@ func TestPipelineManifest_Validate(t *testing.T) {
 			},
 			wantedError: errors.New("pipeline name '12345678902234567890323456789042345678905234567890623456789072345678908234567890923456789010234567890' must be shorter than 100 characters"),
 		},
+		"error if an action doesn't specify what to run": {
+			Pipeline: Pipeline{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PreDeployments: []PipelineAction{
+							{Name: "migrate"},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "stages[0]": validate "pre_deployments[0]": must specify at least one of "build", "approval" or "job"`),
+		},
+		"error if an action specifies both a build and a job": {
+			Pipeline: Pipeline{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+					},
+					{
+						Name: "prod",
+						PostDeployments: []PipelineAction{
+							{
+								Name: "report",
+								Build: &ActionBuild{
+									Commands: []string{"make report"},
+								},
+								Job: "report-generator",
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "stages[1]": validate "post_deployments[0]": must specify one, not both, of "build" and "job"`),
+		},
+		"error if a build action specifies both a buildspec and commands": {
+			Pipeline: Pipeline{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PreDeployments: []PipelineAction{
+							{
+								Name: "migrate",
+								Build: &ActionBuild{
+									Buildspec: "copilot/pipelines/migrate.yml",
+									Commands:  []string{"make migrate"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "stages[0]": validate "pre_deployments[0]": validate "build": must specify one, not both, of "buildspec" and "commands"`),
+		},
+		"error if the approval topic is not an ARN": {
+			Pipeline: Pipeline{
+				Stages: []PipelineStage{
+					{
+						Name: "prod",
+						PreDeployments: []PipelineAction{
+							{
+								Name: "approve",
+								Approval: &ActionApproval{
+									Topic: "release-managers",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "stages[0]": validate "pre_deployments[0]": validate "approval": "topic" must be the ARN of an SNS topic`),
+		},
+		"error if action names are not unique within a stage": {
+			Pipeline: Pipeline{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PreDeployments: []PipelineAction{
+							{Name: "smoke", Job: "smoke-test"},
+						},
+						PostDeployments: []PipelineAction{
+							{Name: "smoke", Job: "smoke-test"},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "stages[0]": validate "post_deployments[0]": action name "smoke" must be unique within the stage`),
+		},
+		"error if an action name contains invalid characters": {
+			Pipeline: Pipeline{
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PreDeployments: []PipelineAction{
+							{Name: "db_migration", Job: "migrate"},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "stages[0]": validate "pre_deployments[0]": "name" db_migration must contain only alphanumeric characters and dashes`),
+		},
+		"error if a workload is deployed more than once": {
+			Pipeline: Pipeline{
+				Stages: []PipelineStage{
+					{
+						Name:        "test",
+						Deployments: []string{"frontend", "frontend"},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "stages[0]": validate "deployments": workload "frontend" must not be deployed more than once`),
+		},
+		"valid stages with actions": {
+			Pipeline: Pipeline{
+				Stages: []PipelineStage{
+					{
+						Name:        "prod",
+						Deployments: []string{"frontend"},
+						PreDeployments: []PipelineAction{
+							{
+								Name: "approve",
+								Approval: &ActionApproval{
+									Topic:   "arn:aws:sns:us-west-2:123456789012:release-managers",
+									Message: "Ready to release?",
+								},
+							},
+							{
+								Name: "migrate",
+								Build: &ActionBuild{
+									Buildspec: "copilot/pipelines/migrate.yml",
+								},
+							},
+						},
+						PostDeployments: []PipelineAction{
+							{Name: "report", Job: "report-generator"},
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1068,6 +1554,7 @@ func TestRoutingRule_Validate(t *testing.T) {
 	}{
 		"error if both target_container and targetContainer are specified": {
 			RoutingRule: RoutingRuleConfiguration{
+				Path:                     stringP("/"),
 				TargetContainer:          aws.String("mockContainer"),
 				TargetContainerCamelCase: aws.String("mockContainer"),
 			},
@@ -1099,6 +1586,115 @@ func TestRoutingRule_Validate(t *testing.T) {
 				ProtocolVersion: aws.String("gRPC"),
 			},
 		},
+		"error if priority is reserved": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:     stringP("/"),
+				Priority: aws.Int(50000),
+			},
+			wantedError: errors.New(`validate "priority": priority 50000 must be between 1 and 49999`),
+		},
+		"error if an additional rule has no conditions": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path: stringP("/"),
+				AdditionalRules: []AdditionalRoutingRule{
+					{
+						Priority: aws.Int(10),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "additional_rules[0]": must specify at least one of "hosts", "paths", "headers", "query_strings" or "allowed_source_ips"`),
+		},
+		"error if an additional rule has both a fixed response and a redirect": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path: stringP("/"),
+				AdditionalRules: []AdditionalRoutingRule{
+					{
+						Paths:         []string{"/old"},
+						FixedResponse: &FixedResponse{StatusCode: aws.Int(404)},
+						Redirect:      &RedirectAction{Path: aws.String("/new")},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "additional_rules[0]": must specify one, not both, of "fixed_response" and "redirect"`),
+		},
+		"error if a fixed response status code is a redirect": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path: stringP("/"),
+				AdditionalRules: []AdditionalRoutingRule{
+					{
+						Paths:         []string{"/old"},
+						FixedResponse: &FixedResponse{StatusCode: aws.Int(301)},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "additional_rules[0]": validate "fixed_response": "status_code" 301 must be a 2XX, 4XX or 5XX code`),
+		},
+		"error if a redirect status code is not 301 or 302": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path: stringP("/"),
+				AdditionalRules: []AdditionalRoutingRule{
+					{
+						Hosts:    []string{"old.example.com"},
+						Redirect: &RedirectAction{Host: aws.String("new.example.com"), StatusCode: aws.Int(307)},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "additional_rules[0]": validate "redirect": "status_code" 307 must be 301 or 302`),
+		},
+		"error if an additional rule has more than 5 condition values": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path: stringP("/"),
+				AdditionalRules: []AdditionalRoutingRule{
+					{
+						Hosts:   []string{"beta.example.com"},
+						Paths:   []string{"/api", "/api/*"},
+						Headers: map[string][]string{"X-Env": {"beta", "canary", "test"}},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "additional_rules[0]": 6 values in "hosts", "paths", "headers", "query_strings" and "allowed_source_ips" exceed the maximum of 5 for a listener rule`),
+		},
+		"error if two rules share a priority": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:     stringP("/"),
+				Priority: aws.Int(10),
+				AdditionalRules: []AdditionalRoutingRule{
+					{
+						Priority: aws.Int(20),
+						Paths:    []string{"/beta"},
+					},
+					{
+						Priority: aws.Int(10),
+						Headers:  map[string][]string{"X-Env": {"beta"}},
+					},
+				},
+			},
+			wantedError: errors.New(`"priority" and "additional_rules[1].priority" cannot both be 10`),
+		},
+		"should not error with valid additional rules": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path:     stringP("/"),
+				Priority: aws.Int(10),
+				AdditionalRules: []AdditionalRoutingRule{
+					{
+						Priority:         aws.Int(5),
+						Hosts:            []string{"beta.example.com"},
+						Paths:            []string{"/api/*"},
+						Headers:          map[string][]string{"X-Env": {"beta"}},
+						QueryStrings:     map[string]string{"version": "2"},
+						AllowedSourceIps: []IPNet{"10.0.0.0/16"},
+					},
+					{
+						Paths:         []string{"/maintenance"},
+						FixedResponse: &FixedResponse{StatusCode: aws.Int(503), ContentType: aws.String("text/plain"), Body: aws.String("down for maintenance")},
+					},
+					{
+						Hosts:    []string{"old.example.com"},
+						Redirect: &RedirectAction{Protocol: aws.String("HTTPS"), Host: aws.String("new.example.com"), StatusCode: aws.Int(301)},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1191,7 +1787,83 @@ func TestNetworkLoadBalancerConfiguration_Validate(t *testing.T) {
 				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
 				return
 			}
-			require.NoError(t, gotErr)
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestDeploymentConfig_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     DeploymentConfig
+		wanted error
+	}{
+		"should return nil if empty": {},
+		"should return an error if the strategy is invalid": {
+			in: DeploymentConfig{
+				Strategy: aws.String("recreate"),
+			},
+			wanted: errors.New(`invalid "strategy": must be one of rolling, blue-green, canary or linear`),
+		},
+		"should return an error if percent is missing for a canary deployment": {
+			in: DeploymentConfig{
+				Strategy: aws.String("canary"),
+			},
+			wanted: errors.New(`"percent" must be specified for the "canary" strategy`),
+		},
+		"should return an error if percent is out of range": {
+			in: DeploymentConfig{
+				Strategy: aws.String("linear"),
+				Percent:  aws.Int(100),
+			},
+			wanted: errors.New(`"percent" must be between 1 and 99`),
+		},
+		"should return an error if percent is set for a blue-green deployment": {
+			in: DeploymentConfig{
+				Strategy: aws.String("blue-green"),
+				Percent:  aws.Int(10),
+			},
+			wanted: errors.New(`"percent" can only be specified for the "canary" or "linear" strategies`),
+		},
+		"should return an error if interval is set for a rolling deployment": {
+			in: DeploymentConfig{
+				Interval: durationp(5 * time.Minute),
+			},
+			wanted: errors.New(`"interval" can only be specified for the "canary" or "linear" strategies`),
+		},
+		"should return an error if interval is not in minutes": {
+			in: DeploymentConfig{
+				Strategy: aws.String("canary"),
+				Percent:  aws.Int(10),
+				Interval: durationp(90 * time.Second),
+			},
+			wanted: errors.New(`validate "interval": 1m30s must be a whole number of minutes`),
+		},
+		"should return an error if bake time is too long": {
+			in: DeploymentConfig{
+				Strategy: aws.String("blue-green"),
+				BakeTime: durationp(25 * time.Hour),
+			},
+			wanted: errors.New(`validate "bake_time": 25h0m0s must be between 0m and 24h0m0s`),
+		},
+		"should return nil for a valid linear deployment": {
+			in: DeploymentConfig{
+				Strategy:       aws.String("linear"),
+				Percent:        aws.Int(20),
+				Interval:       durationp(2 * time.Minute),
+				BakeTime:       durationp(10 * time.Minute),
+				RollbackAlarms: []string{"frontend-5xx"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
 		})
 	}
 }
@@ -1246,6 +1918,50 @@ func TestTaskConfig_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "count": `,
 		},
+		"error if an imported environment addons output is not a logical ID": {
+			TaskConfig: TaskConfig{
+				EnvAddons: EnvAddonsImports{
+					Secrets: map[string]string{
+						"DB_SECRET": "cluster-secret",
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "env_addons": validate "secrets": output name "cluster-secret" of DB_SECRET must only contain alphanumeric characters`),
+		},
+		"error if fail to validate permissions": {
+			TaskConfig: TaskConfig{
+				Permissions: Permissions{
+					ManagedPolicies: []string{"AmazonS3ReadOnlyAccess"},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "permissions": `,
+		},
+		"error if a secret is pinned to an invalid version": {
+			TaskConfig: TaskConfig{
+				Secrets: map[string]Secret{
+					"GITHUB_TOKEN": {
+						fromVersion: ssmParameterVersion{
+							From:    aws.String("/github/token"),
+							Version: aws.Int(0),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate secret "GITHUB_TOKEN": "version" must be a positive integer`),
+		},
+		"error if a secret ARN is pinned to a version": {
+			TaskConfig: TaskConfig{
+				Secrets: map[string]Secret{
+					"GITHUB_TOKEN": {
+						fromVersion: ssmParameterVersion{
+							From:    aws.String("arn:aws:ssm:us-west-2:111122223333:parameter/github/token"),
+							Version: aws.Int(2),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate secret "GITHUB_TOKEN": "version" cannot be specified with an ARN in "from"`),
+		},
 		"error if fail to validate storage": {
 			TaskConfig: TaskConfig{
 				Storage: Storage{
@@ -1288,6 +2004,166 @@ func TestTaskConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestPermissions_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     Permissions
+		wanted error
+	}{
+		"error if a managed policy is not an IAM policy ARN": {
+			in: Permissions{
+				ManagedPolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess", "arn:aws:iam::123456789012:role/reader"},
+			},
+			wanted: fmt.Errorf(`validate "managed_policies": "arn:aws:iam::123456789012:role/reader" is not the ARN of an IAM managed policy`),
+		},
+		"error if the effect of a statement is invalid": {
+			in: Permissions{
+				Statements: []PolicyStatement{
+					{
+						Effect:    aws.String("Allowed"),
+						Actions:   []string{"s3:GetObject"},
+						Resources: []string{"*"},
+					},
+				},
+			},
+			wanted: fmt.Errorf(`validate "statements[0]": validate "effect": effect "Allowed" must be one of Allow or Deny`),
+		},
+		"error if a statement has no actions": {
+			in: Permissions{
+				Statements: []PolicyStatement{
+					{
+						Resources: []string{"*"},
+					},
+				},
+			},
+			wanted: fmt.Errorf(`validate "statements[0]": "actions" must be specified`),
+		},
+		"error if an action is not prefixed by its service": {
+			in: Permissions{
+				Statements: []PolicyStatement{
+					{
+						Actions:   []string{"dynamodb:Query"},
+						Resources: []string{"*"},
+					},
+					{
+						Effect:    aws.String("Deny"),
+						Actions:   []string{"dynamodb:DeleteItem", "DeleteTable"},
+						Resources: []string{"*"},
+					},
+				},
+			},
+			wanted: fmt.Errorf(`validate "statements[1]": validate "actions[1]": action "DeleteTable" must be in the format "service:Action"`),
+		},
+		"error if a statement has no resources": {
+			in: Permissions{
+				Statements: []PolicyStatement{
+					{
+						Actions: []string{"s3:*"},
+					},
+				},
+			},
+			wanted: fmt.Errorf(`validate "statements[0]": "resources" must be specified`),
+		},
+		"error if a bucket name is invalid": {
+			in: Permissions{
+				Grants: PermissionGrants{
+					S3Read: []string{"Assets"},
+				},
+			},
+			wanted: fmt.Errorf(`validate "grants": validate "s3_read[0]": "Assets" is not a valid S3 bucket name`),
+		},
+		"error if a topic ARN is not an SNS ARN": {
+			in: Permissions{
+				Grants: PermissionGrants{
+					SNSPublish: []string{"orders", "arn:aws:sqs:us-west-2:123456789012:orders"},
+				},
+			},
+			wanted: fmt.Errorf(`validate "grants": validate "sns_publish[1]": "arn:aws:sqs:us-west-2:123456789012:orders" is not the ARN of an SNS topic`),
+		},
+		"error if a topic name is invalid": {
+			in: Permissions{
+				Grants: PermissionGrants{
+					SNSPublish: []string{"orders.fifo.v2"},
+				},
+			},
+			wanted: fmt.Errorf(`validate "grants": validate "sns_publish[0]": topic name "orders.fifo.v2" must only contain letters, numbers, underscores, and hyphens`),
+		},
+		"error if a secrets prefix contains a wildcard": {
+			in: Permissions{
+				Grants: PermissionGrants{
+					SecretsRead: []string{"/myapp/*"},
+				},
+			},
+			wanted: fmt.Errorf(`validate "grants": validate "secrets_read[0]": prefix "/myapp/*" must only contain letters, numbers, and the characters /_.+=@-`),
+		},
+		"valid": {
+			in: Permissions{
+				ManagedPolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+				Statements: []PolicyStatement{
+					{
+						Effect:    aws.String("Allow"),
+						Actions:   []string{"dynamodb:Get*", "dynamodb:Query"},
+						Resources: []string{"arn:aws:dynamodb:us-west-2:123456789012:table/orders"},
+					},
+				},
+				Grants: PermissionGrants{
+					S3Read:      []string{"my-assets.example.com"},
+					SNSPublish:  []string{"orders", "arn:aws:sns:us-west-2:123456789012:payments"},
+					SecretsRead: []string{"/myapp/prod/"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type mockIAMActionCatalog struct {
+	unknown map[string]bool
+}
+
+func (c mockIAMActionCatalog) Check(action string) error {
+	if c.unknown[action] {
+		return fmt.Errorf("unknown action %q", action)
+	}
+	return nil
+}
+
+func TestTaskConfig_CheckIAMActions(t *testing.T) {
+	task := TaskConfig{
+		Permissions: Permissions{
+			Statements: []PolicyStatement{
+				{
+					Actions: []string{"s3:GetObject", "s3:GetObjekt"},
+				},
+				{
+					Actions: []string{"sqs:SendMessageBatch"},
+				},
+			},
+		},
+	}
+	catalog := mockIAMActionCatalog{
+		unknown: map[string]bool{
+			"s3:GetObjekt":         true,
+			"sqs:SendMessageBatch": true,
+		},
+	}
+
+	errs := task.CheckIAMActions(catalog)
+
+	require.Len(t, errs, 2)
+	require.EqualError(t, errs[0], `validate "permissions": validate "statements[0]": validate "actions[1]": unknown action "s3:GetObjekt"`)
+	require.EqualError(t, errs[1], `validate "permissions": validate "statements[1]": validate "actions[0]": unknown action "sqs:SendMessageBatch"`)
+}
+
 func TestPlatformArgsOrString_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     PlatformArgsOrString
@@ -1941,6 +2817,16 @@ func TestNetworkConfig_Validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "vpc": `,
 		},
+		"error if fail to validate connect": {
+			config: NetworkConfig{
+				Connect: ServiceConnectConfigOrBool{
+					ServiceConnectConfiguration: ServiceConnectConfiguration{
+						Alias: aws.String("API"),
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": `,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1955,6 +2841,58 @@ func TestNetworkConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestServiceConnectConfiguration_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		config ServiceConnectConfiguration
+
+		wantedError error
+	}{
+		"error if alias is set for a client only service": {
+			config: ServiceConnectConfiguration{
+				Alias:      aws.String("api"),
+				ClientOnly: aws.Bool(true),
+			},
+			wantedError: errors.New(`must specify one, not both, of "client_only" and "alias"`),
+		},
+		"error if port name is set for a client only service": {
+			config: ServiceConnectConfiguration{
+				PortName:   aws.String("http"),
+				ClientOnly: aws.Bool(true),
+			},
+			wantedError: errors.New(`must specify one, not both, of "client_only" and "port_name"`),
+		},
+		"error if alias is not a DNS name": {
+			config: ServiceConnectConfiguration{
+				Alias: aws.String("api..internal"),
+			},
+			wantedError: errors.New(`"alias" api..internal must be a DNS name made of lowercase letters, numbers, hyphens and periods`),
+		},
+		"error if port name starts with a hyphen": {
+			config: ServiceConnectConfiguration{
+				PortName: aws.String("-http"),
+			},
+			wantedError: errors.New(`"port_name" -http must be up to 64 lowercase letters, numbers, underscores and hyphens, and cannot start with a hyphen`),
+		},
+		"success": {
+			config: ServiceConnectConfiguration{
+				Alias:    aws.String("api.internal"),
+				PortName: aws.String("http_api"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestRequestDrivenWebServiceNetworkConfig_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		config RequestDrivenWebServiceNetworkConfig
@@ -2196,9 +3134,168 @@ func TestJobTriggerConfig_Validate(t *testing.T) {
 		in     *JobTriggerConfig
 		wanted error
 	}{
-		"should return an error if schedule is empty": {
+		"should return an error if no trigger is specified": {
 			in:     &JobTriggerConfig{},
-			wanted: errors.New(`"schedule" must be specified`),
+			wanted: errors.New(`must specify one of "schedule", "event", "s3", "sns" or "manual"`),
+		},
+		"should return an error if more than one trigger is specified": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+				S3: JobS3Trigger{
+					Bucket: aws.String("uploads"),
+				},
+			},
+			wanted: errors.New(`must specify one, not both, of "schedule" and "s3"`),
+		},
+		"should return an error if manual is false": {
+			in: &JobTriggerConfig{
+				Manual: aws.Bool(false),
+			},
+			wanted: errors.New(`"manual" must be true if specified`),
+		},
+		"should return an error if an event trigger has no pattern": {
+			in: &JobTriggerConfig{
+				Event: JobEventTrigger{
+					Bus: aws.String("orders"),
+				},
+			},
+			wanted: errors.New(`validate "event": "pattern" must be specified`),
+		},
+		"should return an error if the bucket name is invalid": {
+			in: &JobTriggerConfig{
+				S3: JobS3Trigger{
+					Bucket: aws.String("Uploads"),
+					Prefix: aws.String("images/"),
+				},
+			},
+			wanted: errors.New(`validate "s3": "bucket" "Uploads" is not a valid S3 bucket name`),
+		},
+		"should return an error if the topic has no service": {
+			in: &JobTriggerConfig{
+				SNS: JobSNSTrigger{
+					Name: aws.String("orders"),
+				},
+			},
+			wanted: errors.New(`validate "sns": "service" must be specified`),
+		},
+		"should return an error if the payload is configured with a schedule": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+				Payload: JobPayloadConfig{
+					Variable: aws.String("EVENT"),
+				},
+			},
+			wanted: errors.New(`"payload" cannot be specified with "schedule"`),
+		},
+		"should return an error if the payload is passed both as a variable and a file": {
+			in: &JobTriggerConfig{
+				Manual: aws.Bool(true),
+				Payload: JobPayloadConfig{
+					Variable: aws.String("EVENT"),
+					Path:     aws.String("/copilot/event.json"),
+				},
+			},
+			wanted: errors.New(`validate "payload": must specify one, not both, of "variable" and "path"`),
+		},
+		"should return an error if the payload variable is not a valid name": {
+			in: &JobTriggerConfig{
+				Manual: aws.Bool(true),
+				Payload: JobPayloadConfig{
+					Variable: aws.String("JOB-EVENT"),
+				},
+			},
+			wanted: errors.New(`validate "payload": "variable" "JOB-EVENT" must start with a letter or an underscore and only contain letters, numbers, and underscores`),
+		},
+		"should return an error if the payload file is in the root directory": {
+			in: &JobTriggerConfig{
+				Manual: aws.Bool(true),
+				Payload: JobPayloadConfig{
+					Path: aws.String("/event.json"),
+				},
+			},
+			wanted: errors.New(`validate "payload": "path" "/event.json" must be an absolute path to a file outside of the root directory`),
+		},
+		"should return an error if the payload file name has invalid characters": {
+			in: &JobTriggerConfig{
+				Manual: aws.Bool(true),
+				Payload: JobPayloadConfig{
+					Path: aws.String("/tmp/my event.json"),
+				},
+			},
+			wanted: errors.New(`validate "payload": "path" "/tmp/my event.json" can only contain the following characters: a-zA-Z0-9.-_/`),
+		},
+		"valid event trigger": {
+			in: &JobTriggerConfig{
+				Event: JobEventTrigger{
+					Pattern: map[string]interface{}{
+						"source": []interface{}{"aws.ecr"},
+					},
+				},
+				Payload: JobPayloadConfig{
+					Path: aws.String("/copilot/event.json"),
+				},
+			},
+		},
+		"valid sns trigger": {
+			in: &JobTriggerConfig{
+				SNS: JobSNSTrigger{
+					Name:    aws.String("orders"),
+					Service: aws.String("api"),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobWorkflow_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     JobWorkflow
+		wanted error
+	}{
+		"should return nil if the steps form a directed acyclic graph": {
+			in: JobWorkflow{
+				"extract":   nil,
+				"orders":    {DependsOn: []string{"extract"}},
+				"customers": {DependsOn: []string{"extract"}},
+				"load": {
+					DependsOn: []string{"orders", "customers"},
+					JobFailureHandlerConfig: JobFailureHandlerConfig{
+						Retries: aws.Int(2),
+						Timeout: aws.String("1h"),
+					},
+				},
+			},
+		},
+		"should return an error if a step depends on a job outside of the workflow": {
+			in: JobWorkflow{
+				"load": {DependsOn: []string{"extract"}},
+			},
+			wanted: errors.New("step load depends on extract which is not a step of the workflow"),
+		},
+		"should return an error if a step depends on itself": {
+			in: JobWorkflow{
+				"load": {DependsOn: []string{"load"}},
+			},
+			wanted: errors.New("step load cannot depend on itself"),
+		},
+		"should return an error if the steps depend on each other": {
+			in: JobWorkflow{
+				"extract":   {DependsOn: []string{"load"}},
+				"transform": {DependsOn: []string{"extract"}},
+				"load":      {DependsOn: []string{"transform"}},
+			},
+			wanted: errors.New("circular step dependency chain includes the following steps: [extract load transform]"),
 		},
 	}
 	for name, tc := range testCases {
@@ -2362,6 +3459,81 @@ func TestOverrideRule_Validate(t *testing.T) {
 	}
 }
 
+func TestPatchOperation_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     PatchOperation
+		wanted error
+	}{
+		"should return an error if op is missing": {
+			in: PatchOperation{
+				Path: "/Resources/Service",
+			},
+			wanted: errors.New(`"op" must be specified`),
+		},
+		"should return an error if op is not supported": {
+			in: PatchOperation{
+				Operation: "delete",
+				Path:      "/Resources/Service",
+			},
+			wanted: errors.New(`operation "delete" must be one of add, remove, replace, move, copy, test`),
+		},
+		"should return an error if value is missing": {
+			in: PatchOperation{
+				Operation: "add",
+				Path:      "/Resources/Service/Properties/DesiredCount",
+			},
+			wanted: errors.New(`"value" must be specified for the "add" operation`),
+		},
+		"should return an error if from is missing": {
+			in: PatchOperation{
+				Operation: "move",
+				Path:      "/Resources/Service",
+			},
+			wanted: errors.New(`"from" must be specified for the "move" operation`),
+		},
+		"should return an error if path is not a JSON pointer": {
+			in: PatchOperation{
+				Operation: "remove",
+				Path:      "Resources.Service",
+			},
+			wanted: errors.New(`invalid "path": pointer "Resources.Service" must start with "/"`),
+		},
+		"should return an error if path has an invalid escape sequence": {
+			in: PatchOperation{
+				Operation: "remove",
+				Path:      "/Resources/Service/Properties/Tags~2",
+			},
+			wanted: errors.New(`invalid "path": pointer "/Resources/Service/Properties/Tags~2" contains an invalid escape sequence, "~" must be followed by "0" or "1"`),
+		},
+		"should return an error if a node is moved into one of its children": {
+			in: PatchOperation{
+				Operation: "move",
+				From:      "/Resources/Service",
+				Path:      "/Resources/Service/Properties/Service",
+			},
+			wanted: errors.New(`cannot move "/Resources/Service" into one of its children`),
+		},
+		"valid patch operation": {
+			in: PatchOperation{
+				Operation: "copy",
+				From:      "/Resources/Service/Properties/Tags",
+				Path:      "/Resources/TaskRole/Properties/Tags",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateLoadBalancerTarget(t *testing.T) {
 	testCases := map[string]struct {
 		in     validateTargetContainerOpts
@@ -2639,3 +3811,52 @@ func TestValidateARM(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldErrors(t *testing.T) {
+	mft := LoadBalancedWebService{
+		Workload: Workload{
+			Name: aws.String("mockName"),
+		},
+		LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+			ImageConfig: ImageWithPortAndHealthcheck{
+				ImageWithPort: ImageWithPort{
+					Image: Image{
+						Build: BuildArgsOrString{BuildString: aws.String("mockBuild")},
+					},
+					Port: uint16P(80),
+				},
+			},
+			RoutingRule: RoutingRuleConfigOrBool{
+				RoutingRuleConfiguration: RoutingRuleConfiguration{
+					Path:                     stringP("/"),
+					ProtocolVersion:          aws.String("quic"),
+					TargetContainer:          aws.String("mockName"),
+					TargetContainerCamelCase: aws.String("mockName"),
+				},
+			},
+			TaskConfig: TaskConfig{
+				Storage: Storage{
+					Ephemeral: aws.Int(10),
+				},
+			},
+			TaskDefOverrides: []OverrideRule{
+				{
+					Path: "Family",
+				},
+			},
+		},
+	}
+
+	errs := FieldErrors(mft.Validate())
+
+	var msgs []string
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	require.Equal(t, []string{
+		`validate "http": must specify one, not both, of "target_container" and "targetContainer"`,
+		`validate "http": "version" field value 'quic' must be one of GRPC, HTTP1 or HTTP2`,
+		`validate "storage": validate "ephemeral": ephemeral storage must be between 20 GiB and 200 GiB`,
+		`validate "taskdef_overrides[0]": "Family" cannot be overridden with a custom value`,
+	}, msgs)
+}
@@ -36,6 +36,11 @@ func (s *WorkerService) Publish() []Topic {
 	return s.WorkerServiceConfig.PublishConfig.Topics
 }
 
+// ContainerSecrets returns the secrets of the main container, the sidecars and the log router.
+func (s *WorkerService) ContainerSecrets() []Secret {
+	return containerSecrets(s.WorkerServiceConfig.TaskConfig, s.WorkerServiceConfig.Logging, s.WorkerServiceConfig.Sidecars)
+}
+
 // WorkerServiceConfig holds the configuration that can be overridden per environments.
 type WorkerServiceConfig struct {
 	ImageConfig      ImageWithHealthcheck `yaml:"image,flow"`
@@ -47,6 +52,7 @@ type WorkerServiceConfig struct {
 	PublishConfig    PublishConfig             `yaml:"publish"`
 	Network          NetworkConfig             `yaml:"network"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	Patches          []PatchOperation          `yaml:"patches"`
 }
 
 // SubscribeConfig represents the configurable options for setting up subscriptions.
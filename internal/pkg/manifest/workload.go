@@ -217,6 +217,14 @@ type ImageOverride struct {
 	Command    CommandOverride    `yaml:"command"`
 }
 
+// PatchOperation holds a JSON Patch (RFC 6902) operation to apply on the workload's CloudFormation template.
+type PatchOperation struct {
+	Operation string    `yaml:"op"`
+	Path      string    `yaml:"path"`
+	From      string    `yaml:"from"`
+	Value     yaml.Node `yaml:"value"`
+}
+
 // EntryPointOverride is a custom type which supports unmarshalling "entrypoint" yaml which
 // can either be of type string or type slice of string.
 type EntryPointOverride stringSliceOrString
@@ -373,12 +381,13 @@ type Topic struct {
 
 // NetworkConfig represents options for network connection to AWS resources within a VPC.
 type NetworkConfig struct {
-	VPC vpcConfig `yaml:"vpc"`
+	VPC     vpcConfig                  `yaml:"vpc"`
+	Connect ServiceConnectConfigOrBool `yaml:"connect"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (c *NetworkConfig) IsEmpty() bool {
-	return c.VPC.isEmpty()
+	return c.VPC.isEmpty() && c.Connect.IsEmpty()
 }
 
 // UnmarshalYAML ensures that a NetworkConfig always defaults to public subnets.
@@ -402,6 +411,65 @@ func (c *NetworkConfig) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// ServiceConnectConfigOrBool holds advanced configuration for ECS Service Connect or a boolean switch.
+type ServiceConnectConfigOrBool struct {
+	ServiceConnectConfiguration
+	Enabled *bool
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (c *ServiceConnectConfigOrBool) IsEmpty() bool {
+	return c.ServiceConnectConfiguration.isEmpty() && c.Enabled == nil
+}
+
+// IsEnabled returns true if Service Connect is turned on, either with a boolean or with advanced configuration.
+func (c *ServiceConnectConfigOrBool) IsEnabled() bool {
+	if c.Enabled != nil {
+		return aws.BoolValue(c.Enabled)
+	}
+	return !c.ServiceConnectConfiguration.isEmpty()
+}
+
+// exposesPort returns true if other services are meant to reach the service, rather than the service only connecting to others.
+func (c *ServiceConnectConfigOrBool) exposesPort() bool {
+	return c.Alias != nil || c.PortName != nil
+}
+
+// UnmarshalYAML implements the yaml(v3) interface. It allows Service Connect to be specified as a
+// bool or a struct alternately.
+func (c *ServiceConnectConfigOrBool) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode(&c.ServiceConnectConfiguration); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if !c.ServiceConnectConfiguration.isEmpty() {
+		// Unmarshalled successfully to c.ServiceConnectConfiguration, unset c.Enabled, and return.
+		c.Enabled = nil
+		return nil
+	}
+
+	if err := value.Decode(&c.Enabled); err != nil {
+		return errors.New(`cannot marshal "connect" field into bool or map`)
+	}
+	return nil
+}
+
+// ServiceConnectConfiguration holds the options other services use to reach the service with Service Connect.
+type ServiceConnectConfiguration struct {
+	Alias      *string `yaml:"alias"`       // DNS name other services use to reach the service. Defaults to the service name.
+	PortName   *string `yaml:"port_name"`   // Name of the port mapping of the main container. Defaults to the service name.
+	ClientOnly *bool   `yaml:"client_only"` // If true, the service only connects to other services and can't be reached.
+}
+
+func (c *ServiceConnectConfiguration) isEmpty() bool {
+	return c.Alias == nil && c.PortName == nil && c.ClientOnly == nil
+}
+
 // Placement represents where to place tasks (public or private subnets).
 type Placement string
 
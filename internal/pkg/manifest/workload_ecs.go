@@ -96,7 +96,9 @@ type TaskConfig struct {
 	Variables      map[string]string    `yaml:"variables"`
 	EnvFile        *string              `yaml:"env_file"`
 	Secrets        map[string]Secret    `yaml:"secrets"`
+	EnvAddons      EnvAddonsImports     `yaml:"env_addons"`
 	Storage        Storage              `yaml:"storage"`
+	Permissions    Permissions          `yaml:"permissions"`
 }
 
 // ContainerPlatform returns the platform for the service.
@@ -120,9 +122,55 @@ func (t TaskConfig) IsARM() bool {
 	return IsArmArch(t.Platform.Arch())
 }
 
+// EnvAddonsImports holds the outputs of the environment addons stack to import into the workload.
+// The keys of Variables and Secrets are the names exposed to the main container, and the values
+// are the logical IDs of the outputs in the templates under copilot/environments/addons/.
+type EnvAddonsImports struct {
+	Variables map[string]string `yaml:"variables"`
+	Secrets   map[string]string `yaml:"secrets"`
+	Policies  []string          `yaml:"policies"`
+}
+
+// IsEmpty returns true if no environment addons outputs are imported.
+func (e EnvAddonsImports) IsEmpty() bool {
+	return len(e.Variables) == 0 && len(e.Secrets) == 0 && len(e.Policies) == 0
+}
+
+// Permissions holds the IAM permissions granted to the task role, in addition to the managed policies of the addons.
+type Permissions struct {
+	ManagedPolicies []string          `yaml:"managed_policies"`
+	Statements      []PolicyStatement `yaml:"statements"`
+	Grants          PermissionGrants  `yaml:"grants"`
+}
+
+// IsEmpty returns true if no permissions are granted.
+func (p Permissions) IsEmpty() bool {
+	return len(p.ManagedPolicies) == 0 && len(p.Statements) == 0 && p.Grants.IsEmpty()
+}
+
+// PolicyStatement is an inline statement of the task role policy.
+type PolicyStatement struct {
+	Effect    *string  `yaml:"effect"` // Defaults to "Allow".
+	Actions   []string `yaml:"actions"`
+	Resources []string `yaml:"resources"`
+}
+
+// PermissionGrants holds common permissions that are expanded into policy statements.
+type PermissionGrants struct {
+	S3Read      []string `yaml:"s3_read"`      // Names of the S3 buckets to read objects from.
+	SNSPublish  []string `yaml:"sns_publish"`  // Names or ARNs of the SNS topics to publish to.
+	SecretsRead []string `yaml:"secrets_read"` // Name prefixes of the SSM parameters and Secrets Manager secrets to read.
+}
+
+// IsEmpty returns true if no permissions are granted.
+func (g PermissionGrants) IsEmpty() bool {
+	return len(g.S3Read) == 0 && len(g.SNSPublish) == 0 && len(g.SecretsRead) == 0
+}
+
 // Secret represents an identifier for sensitive data stored in either SSM or SecretsManager.
 type Secret struct {
 	from               *string              // SSM Parameter name or ARN to a secret.
+	fromVersion        ssmParameterVersion  // SSM Parameter name pinned to a version instead of the latest one.
 	fromSecretsManager secretsManagerSecret // Conveniently fetch from a secretsmanager secret name instead of ARN.
 }
 
@@ -139,8 +187,19 @@ func (s *Secret) UnmarshalYAML(value *yaml.Node) error {
 	if !s.fromSecretsManager.IsEmpty() { // Successfully unmarshaled to a secretsmanager name.
 		return nil
 	}
+	if err := value.Decode(&s.fromVersion); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+	if !s.fromVersion.IsEmpty() { // Successfully unmarshaled to a versioned SSM parameter.
+		return nil
+	}
 	if err := value.Decode(&s.from); err != nil { // Otherwise, try decoding the simple form.
-		return errors.New(`cannot marshal "secret" field to a string or "secretsmanager" object`)
+		return errors.New(`cannot marshal "secret" field to a string, "from" object or "secretsmanager" object`)
 	}
 	return nil
 }
@@ -155,15 +214,62 @@ func (s *Secret) Value() string {
 	if !s.fromSecretsManager.IsEmpty() {
 		return aws.StringValue(s.fromSecretsManager.Name)
 	}
+	if !s.fromVersion.IsEmpty() {
+		return aws.StringValue(s.fromVersion.From)
+	}
 	return aws.StringValue(s.from)
 }
 
+// Version returns the pinned version of the SSM parameter, or nil if the latest version is used.
+func (s *Secret) Version() *int {
+	return s.fromVersion.Version
+}
+
+// JSONKey returns the key of the SecretsManager secret's JSON value to use, or an empty string for the whole value.
+func (s *Secret) JSONKey() string {
+	return aws.StringValue(s.fromSecretsManager.Key)
+}
+
+func containerSecrets(task TaskConfig, logging Logging, sidecars map[string]*SidecarConfig) []Secret {
+	var secrets []Secret
+	for _, secret := range task.Secrets {
+		secrets = append(secrets, secret)
+	}
+	for _, secret := range logging.Secrets {
+		secrets = append(secrets, secret)
+	}
+	for _, secret := range logging.SecretOptions {
+		secrets = append(secrets, secret)
+	}
+	for _, sidecar := range sidecars {
+		if sidecar == nil {
+			continue
+		}
+		for _, secret := range sidecar.Secrets {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
+// ssmParameterVersion represents an SSM parameter pinned to a version.
+type ssmParameterVersion struct {
+	From    *string `yaml:"from"`
+	Version *int    `yaml:"version"`
+}
+
+// IsEmpty returns true if the SSM parameter name is not set.
+func (p ssmParameterVersion) IsEmpty() bool {
+	return p.From == nil
+}
+
 // secretsManagerSecret represents the name of a secret stored in SecretsManager.
 type secretsManagerSecret struct {
 	Name *string `yaml:"secretsmanager"`
+	Key  *string `yaml:"key"` // Optional key of the secret's JSON value.
 }
 
-// IsEmpty returns true if all the fields in secretsManagerSecret have the zero value.
+// IsEmpty returns true if the name of the SecretsManager secret is not set.
 func (s secretsManagerSecret) IsEmpty() bool {
 	return s.Name == nil
 }
@@ -87,7 +87,18 @@ func TestSecret_UnmarshalYAML(t *testing.T) {
 	}{
 		"should return an error if the input cannot be unmarshal to a Secret": {
 			in:        "key: value",
-			wantedErr: errors.New(`cannot marshal "secret" field to a string or "secretsmanager" object`),
+			wantedErr: errors.New(`cannot marshal "secret" field to a string, "from" object or "secretsmanager" object`),
+		},
+		"should be able to unmarshal an SSM parameter name pinned to a version": {
+			in:     "from: /github/token\nversion: 3",
+			wanted: Secret{fromVersion: ssmParameterVersion{From: aws.String("/github/token"), Version: aws.Int(3)}},
+		},
+		"should be able to unmarshal the JSON key of a SecretsManager name": {
+			in: "secretsmanager: mysql\nkey: username",
+			wanted: Secret{fromSecretsManager: secretsManagerSecret{
+				Name: aws.String("mysql"),
+				Key:  aws.String("username"),
+			}},
 		},
 		"should be able to unmarshal an SSM parameter name": {
 			in:     "/github/token",
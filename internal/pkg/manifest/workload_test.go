@@ -648,6 +648,46 @@ network:
 				},
 			},
 		},
+		"unmarshals service connect as a bool": {
+			data: `
+network:
+  connect: true
+`,
+			wantedConfig: &NetworkConfig{
+				VPC: vpcConfig{
+					Placement: &PublicSubnetPlacement,
+				},
+				Connect: ServiceConnectConfigOrBool{
+					Enabled: aws.Bool(true),
+				},
+			},
+		},
+		"unmarshals service connect as a map": {
+			data: `
+network:
+  connect:
+    alias: api
+    port_name: http
+`,
+			wantedConfig: &NetworkConfig{
+				VPC: vpcConfig{
+					Placement: &PublicSubnetPlacement,
+				},
+				Connect: ServiceConnectConfigOrBool{
+					ServiceConnectConfiguration: ServiceConnectConfiguration{
+						Alias:    aws.String("api"),
+						PortName: aws.String("http"),
+					},
+				},
+			},
+		},
+		"error if service connect is neither a bool nor a map": {
+			data: `
+network:
+  connect: api
+`,
+			wantedErr: errors.New(`cannot marshal "connect" field into bool or map`),
+		},
 	}
 
 	for name, tc := range testCases {
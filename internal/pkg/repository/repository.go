@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 )
 
 // ContainerLoginBuildPusher provides support for logging in to repositories, building images and pushing images to repositories.
@@ -77,6 +78,11 @@ func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *docker
 	if err != nil {
 		return "", fmt.Errorf("push to repo %s: %w", r.name, err)
 	}
+	event.Publish(event.ImagePushed{
+		Repository: args.URI,
+		Tags:       args.Tags,
+		Digest:     digest,
+	})
 	return digest, nil
 }
 
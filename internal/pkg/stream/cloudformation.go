@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	cfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 )
 
 // StackEventsDescriber is the CloudFormation interface needed to describe stack events.
@@ -164,9 +165,18 @@ func (s *StackStreamer) Notify() {
 	subs = append(subs, s.subscribers...)
 	s.mu.Unlock()
 
-	for _, event := range s.eventsToFlush {
+	for _, e := range s.eventsToFlush {
+		event.Publish(event.StackEvent{
+			StackName:            s.stackName,
+			LogicalResourceID:    e.LogicalResourceID,
+			PhysicalResourceID:   e.PhysicalResourceID,
+			ResourceType:         e.ResourceType,
+			ResourceStatus:       e.ResourceStatus,
+			ResourceStatusReason: e.ResourceStatusReason,
+			Timestamp:            e.Timestamp,
+		})
 		for _, sub := range subs {
-			sub <- event
+			sub <- e
 		}
 	}
 	s.eventsToFlush = nil // reset after flushing all events.
@@ -5,12 +5,14 @@ package stream
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 	"github.com/stretchr/testify/require"
 )
 
@@ -88,6 +90,30 @@ func TestStackStreamer_Notify(t *testing.T) {
 	require.ElementsMatch(t, wantedEvents, actualEvents)
 }
 
+func TestStackStreamer_NotifyPublishesEvents(t *testing.T) {
+	// GIVEN
+	buf := new(strings.Builder)
+	event.Enable(buf)
+	defer event.Disable()
+	streamer := &StackStreamer{
+		stackName: "phonetool-test",
+		eventsToFlush: []StackEvent{
+			{
+				LogicalResourceID: "Cluster",
+				ResourceType:      "AWS::ECS::Cluster",
+				ResourceStatus:    "CREATE_COMPLETE",
+			},
+		},
+	}
+
+	// WHEN
+	streamer.Notify()
+
+	// THEN
+	require.Contains(t, buf.String(), `"type":"stack_event"`)
+	require.Contains(t, buf.String(), `"stackName":"phonetool-test","logicalResourceId":"Cluster","resourceType":"AWS::ECS::Cluster","resourceStatus":"CREATE_COMPLETE"`)
+}
+
 func testStackStreamer_Fetch_Success(t *testing.T) {
 	// GIVEN
 	startTime := time.Date(2020, time.November, 23, 16, 0, 0, 0, time.UTC)
@@ -13,6 +13,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/term/event"
 )
 
 const (
@@ -30,6 +32,11 @@ type ECSServiceDescriber interface {
 	Service(clusterName, serviceName string) (*ecs.Service, error)
 }
 
+// ListenerRuleDescriber is the interface to describe the target groups that a listener rule forwards traffic to.
+type ListenerRuleDescriber interface {
+	ListenerRuleWeights(ruleARN string) ([]elbv2.TargetGroupWeight, error)
+}
+
 // ECSDeployment represent an ECS rolling update deployment.
 type ECSDeployment struct {
 	Status          string
@@ -62,6 +69,9 @@ func (d ECSDeployment) done() bool {
 type ECSService struct {
 	Deployments         []ECSDeployment
 	LatestFailureEvents []string
+	// Percentage of the traffic shifted to the new revision of the service.
+	// Nil if the deployment doesn't shift traffic between target groups.
+	ShiftedTrafficPercent *int
 }
 
 // ECSDeploymentStreamer is a Streamer for ECSService descriptions until the deployment is completed.
@@ -73,6 +83,11 @@ type ECSDeploymentStreamer struct {
 	service                string
 	deploymentCreationTime time.Time
 
+	// Optional listener rule that shifts traffic between the target groups of the service.
+	rules             ListenerRuleDescriber
+	ruleARN           string
+	newTargetGroupARN string // The target group of the new revision, found on the first fetch.
+
 	subscribers   []chan ECSService
 	once          sync.Once
 	done          chan struct{}
@@ -84,10 +99,21 @@ type ECSDeploymentStreamer struct {
 	retries int
 }
 
+// ECSDeploymentStreamerOpts is an option to configure an ECSDeploymentStreamer.
+type ECSDeploymentStreamerOpts func(s *ECSDeploymentStreamer)
+
+// WithListenerRule streams as well the percentage of traffic that the listener rule forwards to the new revision of the service.
+func WithListenerRule(describer ListenerRuleDescriber, ruleARN string) ECSDeploymentStreamerOpts {
+	return func(s *ECSDeploymentStreamer) {
+		s.rules = describer
+		s.ruleARN = ruleARN
+	}
+}
+
 // NewECSDeploymentStreamer creates a new ECSDeploymentStreamer that streams service descriptions
 // since the deployment creation time and until the primary deployment is completed.
-func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string, deploymentCreationTime time.Time) *ECSDeploymentStreamer {
-	return &ECSDeploymentStreamer{
+func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string, deploymentCreationTime time.Time, opts ...ECSDeploymentStreamerOpts) *ECSDeploymentStreamer {
+	s := &ECSDeploymentStreamer{
 		client:                 ecs,
 		clock:                  realClock{},
 		rand:                   rand.Intn,
@@ -97,6 +123,10 @@ func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string,
 		done:                   make(chan struct{}),
 		pastEventIDs:           make(map[string]bool),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Subscribe returns a read-only channel that will receive service descriptions from the ECSDeploymentStreamer.
@@ -166,13 +196,59 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, err error) {
 		}
 		s.pastEventIDs[id] = true
 	}
+	var shifted *int
+	if s.rules != nil {
+		shifted, err = s.shiftedTrafficPercent()
+		if err != nil {
+			if request.IsErrorThrottle(err) {
+				s.retries += 1
+				return nextFetchDate(s.clock, s.rand, s.retries), nil
+			}
+			return next, fmt.Errorf("fetch listener rule weights: %w", err)
+		}
+	}
 	s.eventsToFlush = append(s.eventsToFlush, ECSService{
-		Deployments:         deployments,
-		LatestFailureEvents: failureMsgs,
+		Deployments:           deployments,
+		LatestFailureEvents:   failureMsgs,
+		ShiftedTrafficPercent: shifted,
 	})
 	return nextFetchDate(s.clock, s.rand, 0), nil
 }
 
+// shiftedTrafficPercent returns the percentage of the traffic that the listener rule forwards to the target group
+// of the new revision, or nil if the rule doesn't split traffic between target groups.
+func (s *ECSDeploymentStreamer) shiftedTrafficPercent() (*int, error) {
+	weights, err := s.rules.ListenerRuleWeights(s.ruleARN)
+	if err != nil {
+		return nil, err
+	}
+	if len(weights) < 2 {
+		return nil, nil
+	}
+	if s.newTargetGroupARN == "" {
+		// The new revision is registered to the target group that receives the least traffic when the deployment starts.
+		newTG := weights[0]
+		for _, w := range weights[1:] {
+			if w.Weight < newTG.Weight {
+				newTG = w
+			}
+		}
+		s.newTargetGroupARN = newTG.TargetGroupARN
+	}
+	var total, shifted int
+	for _, w := range weights {
+		total += w.Weight
+		if w.TargetGroupARN == s.newTargetGroupARN {
+			shifted = w.Weight
+		}
+	}
+	if total == 0 {
+		return nil, nil
+	}
+	percent := shifted * 100 / total
+	return &percent, nil
+}
+
 // Notify flushes all new events to the streamer's subscribers.
 func (s *ECSDeploymentStreamer) Notify() {
 	// Copy current list of subscribers over, so that we can we add more subscribers while
@@ -182,14 +258,37 @@ func (s *ECSDeploymentStreamer) Notify() {
 	subs = append(subs, s.subscribers...)
 	s.mu.Unlock()
 
-	for _, event := range s.eventsToFlush {
+	for _, e := range s.eventsToFlush {
+		s.publish(e)
 		for _, sub := range subs {
-			sub <- event
+			sub <- e
 		}
 	}
 	s.eventsToFlush = nil // reset after flushing all events.
 }
 
+func (s *ECSDeploymentStreamer) publish(svc ECSService) {
+	deployments := make([]event.ECSDeploymentStatus, len(svc.Deployments))
+	for i, d := range svc.Deployments {
+		deployments[i] = event.ECSDeploymentStatus{
+			Status:          d.Status,
+			TaskDefRevision: d.TaskDefRevision,
+			DesiredCount:    d.DesiredCount,
+			RunningCount:    d.RunningCount,
+			FailedCount:     d.FailedCount,
+			PendingCount:    d.PendingCount,
+			RolloutState:    d.RolloutState,
+		}
+	}
+	event.Publish(event.ECSDeployment{
+		Cluster:               s.cluster,
+		Service:               s.service,
+		Deployments:           deployments,
+		LatestFailureEvents:   svc.LatestFailureEvents,
+		ShiftedTrafficPercent: svc.ShiftedTrafficPercent,
+	})
+}
+
 // Close closes all subscribed channels notifying them that no more events will be sent.
 func (s *ECSDeploymentStreamer) Close() {
 	s.mu.Lock()
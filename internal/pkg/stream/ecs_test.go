@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,6 +24,15 @@ func (m mockECS) Service(clusterName, serviceName string) (*ecs.Service, error)
 	return m.out, m.err
 }
 
+type mockListenerRule struct {
+	out []elbv2.TargetGroupWeight
+	err error
+}
+
+func (m *mockListenerRule) ListenerRuleWeights(ruleARN string) ([]elbv2.TargetGroupWeight, error) {
+	return m.out, m.err
+}
+
 func TestECSDeploymentStreamer_Subscribe(t *testing.T) {
 	t.Run("allow new subscriptions if stack streamer is still active", func(t *testing.T) {
 		// GIVEN
@@ -345,6 +355,63 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 		require.Equal(t, 1, len(streamer.eventsToFlush), "should have only event to flush")
 		require.Nil(t, streamer.eventsToFlush[0].LatestFailureEvents, "there should be no failed events emitted")
 	})
+	t.Run("returns a wrapped error on describe listener rule failure", func(t *testing.T) {
+		// GIVEN
+		rules := &mockListenerRule{
+			err: errors.New("some error"),
+		}
+		streamer := NewECSDeploymentStreamer(mockECS{out: &ecs.Service{}}, "my-cluster", "my-svc", time.Now(), WithListenerRule(rules, "my-rule"))
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch listener rule weights: some error")
+	})
+	t.Run("stores the traffic shifted to the target group that had the least traffic initially", func(t *testing.T) {
+		// GIVEN
+		startDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+		rules := &mockListenerRule{
+			out: []elbv2.TargetGroupWeight{
+				{TargetGroupARN: "blue", Weight: 100},
+				{TargetGroupARN: "green", Weight: 0},
+			},
+		}
+		streamer := NewECSDeploymentStreamer(mockECS{out: &ecs.Service{}}, "my-cluster", "my-svc", startDate, WithListenerRule(rules, "my-rule"))
+		streamer.clock = fakeClock{startDate}
+		streamer.rand = func(n int) int { return n }
+
+		// WHEN
+		_, err := streamer.Fetch()
+		require.NoError(t, err)
+		rules.out = []elbv2.TargetGroupWeight{
+			{TargetGroupARN: "blue", Weight: 90},
+			{TargetGroupARN: "green", Weight: 10},
+		}
+		_, err = streamer.Fetch()
+		require.NoError(t, err)
+
+		// THEN
+		require.Equal(t, 2, len(streamer.eventsToFlush), "should have two events to flush")
+		require.Equal(t, aws.Int(0), streamer.eventsToFlush[0].ShiftedTrafficPercent)
+		require.Equal(t, aws.Int(10), streamer.eventsToFlush[1].ShiftedTrafficPercent)
+	})
+	t.Run("does not store shifted traffic if the rule forwards to a single target group", func(t *testing.T) {
+		// GIVEN
+		rules := &mockListenerRule{
+			out: []elbv2.TargetGroupWeight{
+				{TargetGroupARN: "blue", Weight: 1},
+			},
+		}
+		streamer := NewECSDeploymentStreamer(mockECS{out: &ecs.Service{}}, "my-cluster", "my-svc", time.Now(), WithListenerRule(rules, "my-rule"))
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Nil(t, streamer.eventsToFlush[0].ShiftedTrafficPercent)
+	})
 }
 
 func TestECSDeploymentStreamer_Notify(t *testing.T) {
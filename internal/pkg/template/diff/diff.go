@@ -0,0 +1,269 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package diff provides functionality to compare two YAML documents structurally.
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const indentSize = 2
+
+type action int
+
+const (
+	actionNone action = iota // The node is unchanged itself, but some of its descendants changed.
+	actionAdd
+	actionRemove
+	actionModify
+)
+
+// Tree represents the differences between two YAML documents.
+type Tree struct {
+	root *node
+}
+
+type node struct {
+	key      string
+	action   action
+	old, new *yaml.Node
+	children []*node
+}
+
+// ParseOption allows to customize how the documents are compared.
+type ParseOption func(*parseOpts)
+
+type parseOpts struct {
+	topLevelKeys []string
+}
+
+// WithTopLevelKeys restricts the comparison to the given keys of the root mapping.
+func WithTopLevelKeys(keys ...string) ParseOption {
+	return func(o *parseOpts) {
+		o.topLevelKeys = keys
+	}
+}
+
+// Parse compares the old and new YAML documents and returns the tree of differences.
+func Parse(old, new []byte, opts ...ParseOption) (Tree, error) {
+	conf := &parseOpts{}
+	for _, opt := range opts {
+		opt(conf)
+	}
+	oldRoot, err := unmarshal(old)
+	if err != nil {
+		return Tree{}, fmt.Errorf("unmarshal old document: %w", err)
+	}
+	newRoot, err := unmarshal(new)
+	if err != nil {
+		return Tree{}, fmt.Errorf("unmarshal new document: %w", err)
+	}
+	if len(conf.topLevelKeys) != 0 {
+		oldRoot = filterKeys(oldRoot, conf.topLevelKeys)
+		newRoot = filterKeys(newRoot, conf.topLevelKeys)
+	}
+	// Compare an empty document against a mapping key by key instead of adding or removing the whole document.
+	if oldRoot == nil && newRoot != nil && newRoot.Kind == yaml.MappingNode {
+		oldRoot = emptyMapping()
+	}
+	if newRoot == nil && oldRoot != nil && oldRoot.Kind == yaml.MappingNode {
+		newRoot = emptyMapping()
+	}
+	return Tree{
+		root: compare("", oldRoot, newRoot),
+	}, nil
+}
+
+// Empty returns true if the two documents are structurally equal.
+func (t Tree) Empty() bool {
+	return t.root == nil
+}
+
+// Write writes the differences in a human-readable format to w.
+// Each line is prefixed with "+" if it was added, "-" if it was removed, and "~" if the value was modified.
+func (t Tree) Write(w io.Writer) error {
+	if t.root == nil {
+		return nil
+	}
+	buf := new(bytes.Buffer)
+	if t.root.action == actionNone {
+		for _, child := range t.root.children {
+			writeNode(buf, child, 0)
+		}
+	} else {
+		writeNode(buf, t.root, 0)
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+func unmarshal(doc []byte) (*yaml.Node, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(doc, &root); err != nil {
+		return nil, err
+	}
+	if root.Kind == 0 { // The document is empty.
+		return nil, nil
+	}
+	if root.Kind == yaml.DocumentNode {
+		if len(root.Content) == 0 {
+			return nil, nil
+		}
+		return root.Content[0], nil
+	}
+	return &root, nil
+}
+
+func emptyMapping() *yaml.Node {
+	return &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  "!!map",
+	}
+}
+
+// filterKeys returns a copy of the mapping node m that only contains the given keys.
+func filterKeys(m *yaml.Node, keys []string) *yaml.Node {
+	if m == nil || m.Kind != yaml.MappingNode {
+		return m
+	}
+	filtered := emptyMapping()
+	for _, key := range keys {
+		if v := valueOf(m, key); v != nil {
+			filtered.Content = append(filtered.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, v)
+		}
+	}
+	return filtered
+}
+
+// compare returns the differences between old and new, or nil if the nodes are equal.
+func compare(key string, old, new *yaml.Node) *node {
+	old, new = resolveAlias(old), resolveAlias(new)
+	switch {
+	case old == nil && new == nil:
+		return nil
+	case old == nil:
+		return &node{key: key, action: actionAdd, new: new}
+	case new == nil:
+		return &node{key: key, action: actionRemove, old: old}
+	case old.Kind != new.Kind || old.Tag != new.Tag:
+		return &node{key: key, action: actionModify, old: old, new: new}
+	}
+	var children []*node
+	switch new.Kind {
+	case yaml.ScalarNode:
+		if old.Value == new.Value {
+			return nil
+		}
+		return &node{key: key, action: actionModify, old: old, new: new}
+	case yaml.MappingNode:
+		for i := 0; i < len(new.Content); i += 2 {
+			k := new.Content[i].Value
+			if diff := compare(k, valueOf(old, k), new.Content[i+1]); diff != nil {
+				children = append(children, diff)
+			}
+		}
+		for i := 0; i < len(old.Content); i += 2 {
+			k := old.Content[i].Value
+			if valueOf(new, k) != nil {
+				continue
+			}
+			children = append(children, compare(k, old.Content[i+1], nil))
+		}
+	case yaml.SequenceNode:
+		for i := 0; i < len(old.Content) || i < len(new.Content); i++ {
+			var o, n *yaml.Node
+			if i < len(old.Content) {
+				o = old.Content[i]
+			}
+			if i < len(new.Content) {
+				n = new.Content[i]
+			}
+			if diff := compare(fmt.Sprintf("[%d]", i), o, n); diff != nil {
+				children = append(children, diff)
+			}
+		}
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	return &node{key: key, action: actionNone, old: old, new: new, children: children}
+}
+
+func valueOf(m *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func resolveAlias(n *yaml.Node) *yaml.Node {
+	for n != nil && n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	return n
+}
+
+func writeNode(buf *bytes.Buffer, n *node, depth int) {
+	switch n.action {
+	case actionNone:
+		writeLine(buf, ' ', depth, n.key+":")
+		for _, child := range n.children {
+			writeNode(buf, child, depth+1)
+		}
+	case actionAdd:
+		writeValue(buf, '+', depth, n.key, n.new)
+	case actionRemove:
+		writeValue(buf, '-', depth, n.key, n.old)
+	case actionModify:
+		oldLines, newLines := render(n.old), render(n.new)
+		if n.old.Kind == yaml.ScalarNode && n.new.Kind == yaml.ScalarNode && len(oldLines) == 1 && len(newLines) == 1 {
+			writeLine(buf, '~', depth, fmt.Sprintf("%s: %s -> %s", n.key, oldLines[0], newLines[0]))
+			return
+		}
+		writeValue(buf, '-', depth, n.key, n.old)
+		writeValue(buf, '+', depth, n.key, n.new)
+	}
+}
+
+// writeValue writes the key and its whole value with every line prefixed by the marker.
+func writeValue(buf *bytes.Buffer, marker byte, depth int, key string, value *yaml.Node) {
+	lines := render(value)
+	if value.Kind == yaml.ScalarNode || (len(lines) == 1 && value.Style&yaml.FlowStyle != 0) {
+		writeLine(buf, marker, depth, fmt.Sprintf("%s: %s", key, lines[0]))
+		lines = lines[1:]
+	} else {
+		writeLine(buf, marker, depth, key+":")
+	}
+	for _, line := range lines {
+		writeLine(buf, marker, depth+1, line)
+	}
+}
+
+func writeLine(buf *bytes.Buffer, marker byte, depth int, content string) {
+	buf.WriteByte(marker)
+	buf.WriteByte(' ')
+	buf.WriteString(strings.Repeat(" ", depth*indentSize))
+	buf.WriteString(content)
+	buf.WriteByte('\n')
+}
+
+// render marshals the node to YAML and returns its lines.
+func render(n *yaml.Node) []string {
+	buf := new(bytes.Buffer)
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(indentSize)
+	if err := enc.Encode(n); err != nil {
+		// The node was produced by the YAML decoder so it can always be encoded back.
+		return []string{n.Value}
+	}
+	_ = enc.Close()
+	return strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+}
@@ -0,0 +1,146 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		inOld  string
+		inNew  string
+		inOpts []ParseOption
+
+		wanted      string
+		wantedEmpty bool
+		wantedErr   string
+	}{
+		"error if a document is not valid YAML": {
+			inOld:     "a: b",
+			inNew:     "a: [b",
+			wantedErr: "unmarshal new document: yaml: line 1: did not find expected ',' or ']'",
+		},
+		"empty if the documents are structurally equal": {
+			inOld: `Resources:
+  Service:
+    Type: AWS::ECS::Service # Comments and styles are ignored.
+    Properties:
+      Cluster: !Ref Cluster`,
+			inNew: `Resources:
+  Service:
+    Type: "AWS::ECS::Service"
+    Properties: {Cluster: !Ref Cluster}`,
+			wantedEmpty: true,
+		},
+		"additions, removals and modifications": {
+			inOld: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+      Cluster: !Ref Cluster
+  Rule:
+    Type: AWS::ElasticLoadBalancingV2::ListenerRule
+    Properties:
+      Priority: 1
+Outputs:
+  DiscoveryServiceARN:
+    Value: !GetAtt DiscoveryService.Arn`,
+			inNew: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 2
+      Cluster: !ImportValue cluster
+      Tags:
+        - Key: copilot-application
+          Value: phonetool
+Outputs:
+  DiscoveryServiceARN:
+    Value: !GetAtt DiscoveryService.Arn`,
+			wanted: `  Resources:
+    Service:
+      Properties:
+~       DesiredCount: 1 -> 2
+~       Cluster: !Ref Cluster -> !ImportValue cluster
++       Tags:
++         - Key: copilot-application
++           Value: phonetool
+-   Rule:
+-     Type: AWS::ElasticLoadBalancingV2::ListenerRule
+-     Properties:
+-       Priority: 1
+`,
+		},
+		"type changes are rendered as a removal and an addition": {
+			inOld: `Parameters:
+  Subnets: subnet-1`,
+			inNew: `Parameters:
+  Subnets:
+    - subnet-1
+    - subnet-2`,
+			wanted: `  Parameters:
+-   Subnets: subnet-1
++   Subnets:
++     - subnet-1
++     - subnet-2
+`,
+		},
+		"sequences are compared by index": {
+			inOld: `Ports: [80, 443]`,
+			inNew: `Ports: [80, 8080, 9090]`,
+			wanted: `  Ports:
+~   [1]: 443 -> 8080
++   [2]: 9090
+`,
+		},
+		"everything is added if the old document is empty": {
+			inNew: `Outputs:
+  Endpoint:
+    Value: example.com`,
+			wanted: `+ Outputs:
++   Endpoint:
++     Value: example.com
+`,
+		},
+		"only the top level keys are compared": {
+			inOld: `Description: old
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			inNew: `Description: new
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+Outputs:
+  Name:
+    Value: !Ref Service`,
+			inOpts: []ParseOption{WithTopLevelKeys("Resources", "Outputs")},
+			wanted: `+ Outputs:
++   Name:
++     Value: !Ref Service
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tree, err := Parse([]byte(tc.inOld), []byte(tc.inNew), tc.inOpts...)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEmpty, tree.Empty())
+			buf := new(bytes.Buffer)
+			require.NoError(t, tree.Write(buf))
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
+}
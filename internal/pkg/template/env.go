@@ -44,6 +44,7 @@ type EnvOpts struct {
 	ImportVPC *config.ImportVPC
 	VPCConfig *config.AdjustVPC
 	Telemetry *config.Telemetry
+	Addons    *config.EnvAddons
 
 	LatestVersion string
 }
@@ -0,0 +1,318 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package override
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Operations of a JSON Patch as defined in RFC 6902: https://datatracker.ietf.org/doc/html/rfc6902#section-4.
+const (
+	PatchOpAdd     = "add"
+	PatchOpRemove  = "remove"
+	PatchOpReplace = "replace"
+	PatchOpMove    = "move"
+	PatchOpCopy    = "copy"
+	PatchOpTest    = "test"
+)
+
+// PatchOperations are the supported operations of a Patch.
+var PatchOperations = []string{PatchOpAdd, PatchOpRemove, PatchOpReplace, PatchOpMove, PatchOpCopy, PatchOpTest}
+
+// Patch is a JSON Patch operation that is applied to a template.
+type Patch struct {
+	Operation string    // One of PatchOperations.
+	Path      string    // JSON Pointer to the target location, example: "/Resources/Service/Properties/DesiredCount".
+	From      string    // JSON Pointer to the source location of "move" and "copy" operations.
+	Value     yaml.Node // Value of "add", "replace" and "test" operations.
+}
+
+// PatchTemplate applies the patches in order to the whole template.
+// If an operation fails, the returned error points to the failing operation and no further patches are applied.
+func PatchTemplate(patches []Patch, origTemp []byte) ([]byte, error) {
+	if len(patches) == 0 {
+		return origTemp, nil
+	}
+	content, err := unmarshalYAML(origTemp)
+	if err != nil {
+		return nil, err
+	}
+	if len(content.Content) == 0 {
+		return nil, errors.New("cannot apply patches on empty YAML template")
+	}
+	for i, p := range patches {
+		if err := p.apply(content); err != nil {
+			return nil, fmt.Errorf(`patch %d: %s operation at "%s": %w`, i, p.Operation, p.Path, err)
+		}
+	}
+	return marshalYAML(content)
+}
+
+// ValidatePatch returns an error if the patch can't be applied to any template.
+func ValidatePatch(p Patch) error {
+	switch p.Operation {
+	case PatchOpAdd, PatchOpReplace, PatchOpTest:
+		if p.Value.IsZero() {
+			return fmt.Errorf(`"value" must be specified for the "%s" operation`, p.Operation)
+		}
+	case PatchOpMove, PatchOpCopy:
+		if _, err := parsePointer(p.From); err != nil {
+			return fmt.Errorf(`invalid "from": %w`, err)
+		}
+	case PatchOpRemove:
+	default:
+		return fmt.Errorf(`operation "%s" must be one of %s`, p.Operation, strings.Join(PatchOperations, ", "))
+	}
+	if _, err := parsePointer(p.Path); err != nil {
+		return fmt.Errorf(`invalid "path": %w`, err)
+	}
+	if p.Operation == PatchOpMove && strings.HasPrefix(p.Path, p.From+"/") {
+		return fmt.Errorf(`cannot move "%s" into one of its children`, p.From)
+	}
+	return nil
+}
+
+// apply applies the patch to the YAML document node.
+func (p Patch) apply(doc *yaml.Node) error {
+	if err := ValidatePatch(p); err != nil {
+		return err
+	}
+	path, _ := parsePointer(p.Path)
+	switch p.Operation {
+	case PatchOpAdd:
+		return add(doc, path, copyNode(&p.Value))
+	case PatchOpRemove:
+		_, err := remove(doc, path)
+		return err
+	case PatchOpReplace:
+		return replace(doc, path, copyNode(&p.Value))
+	case PatchOpMove:
+		from, _ := parsePointer(p.From)
+		val, err := remove(doc, from)
+		if err != nil {
+			return err
+		}
+		return add(doc, path, val)
+	case PatchOpCopy:
+		from, _ := parsePointer(p.From)
+		val, err := find(doc, from)
+		if err != nil {
+			return err
+		}
+		return add(doc, path, copyNode(val))
+	case PatchOpTest:
+		val, err := find(doc, path)
+		if err != nil {
+			return err
+		}
+		if !equalNodes(val, &p.Value) {
+			return errors.New("value does not match")
+		}
+	}
+	return nil
+}
+
+// pointer is a parsed JSON Pointer, see https://datatracker.ietf.org/doc/html/rfc6901.
+type pointer []string
+
+func parsePointer(s string) (pointer, error) {
+	if s == "" {
+		return nil, errors.New("pointer must not be empty")
+	}
+	if !strings.HasPrefix(s, "/") {
+		return nil, fmt.Errorf(`pointer "%s" must start with "/"`, s)
+	}
+	var tokens pointer
+	for _, token := range strings.Split(s[1:], "/") {
+		for i := 0; i < len(token); i++ {
+			if token[i] == '~' && (i == len(token)-1 || (token[i+1] != '0' && token[i+1] != '1')) {
+				return nil, fmt.Errorf(`pointer "%s" contains an invalid escape sequence, "~" must be followed by "0" or "1"`, s)
+			}
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+func (p pointer) String() string {
+	var b strings.Builder
+	for _, token := range p {
+		b.WriteString("/")
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+	return b.String()
+}
+
+// find returns the node at path.
+func find(doc *yaml.Node, path pointer) (*yaml.Node, error) {
+	node := doc.Content[0]
+	for i, token := range path {
+		switch node.Kind {
+		case yaml.MappingNode:
+			idx := mappingIndex(node, token)
+			if idx == -1 {
+				return nil, fmt.Errorf(`key "%s" not found at "%s"`, token, path[:i])
+			}
+			node = node.Content[idx+1]
+		case yaml.SequenceNode:
+			idx, err := sequenceIndex(node, token, false)
+			if err != nil {
+				return nil, fmt.Errorf(`at "%s": %w`, path[:i], err)
+			}
+			node = node.Content[idx]
+		default:
+			return nil, fmt.Errorf(`"%s" is not a mapping or a sequence`, path[:i])
+		}
+	}
+	return node, nil
+}
+
+// add inserts the value at path. The parent of the path must exist.
+// If the parent is a mapping, the value at the key is replaced. If the parent is a sequence, the value is inserted at the index.
+func add(doc *yaml.Node, path pointer, value *yaml.Node) error {
+	parent, err := find(doc, path[:len(path)-1])
+	if err != nil {
+		return err
+	}
+	key := path[len(path)-1]
+	switch parent.Kind {
+	case yaml.MappingNode:
+		if idx := mappingIndex(parent, key); idx != -1 {
+			parent.Content[idx+1] = value
+			return nil
+		}
+		parent.Content = append(parent.Content, &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   nodeTagStr,
+			Value: key,
+		}, value)
+	case yaml.SequenceNode:
+		idx, err := sequenceIndex(parent, key, true)
+		if err != nil {
+			return fmt.Errorf(`at "%s": %w`, path[:len(path)-1], err)
+		}
+		parent.Content = append(parent.Content[:idx], append([]*yaml.Node{value}, parent.Content[idx:]...)...)
+	default:
+		return fmt.Errorf(`"%s" is not a mapping or a sequence`, path[:len(path)-1])
+	}
+	return nil
+}
+
+// replace substitutes the existing node at path with value.
+func replace(doc *yaml.Node, path pointer, value *yaml.Node) error {
+	target, err := find(doc, path)
+	if err != nil {
+		return err
+	}
+	*target = *value
+	return nil
+}
+
+// remove deletes the node at path and returns it.
+func remove(doc *yaml.Node, path pointer) (*yaml.Node, error) {
+	parent, err := find(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	key := path[len(path)-1]
+	switch parent.Kind {
+	case yaml.MappingNode:
+		idx := mappingIndex(parent, key)
+		if idx == -1 {
+			return nil, fmt.Errorf(`key "%s" not found at "%s"`, key, path[:len(path)-1])
+		}
+		removed := parent.Content[idx+1]
+		parent.Content = append(parent.Content[:idx], parent.Content[idx+2:]...)
+		return removed, nil
+	case yaml.SequenceNode:
+		idx, err := sequenceIndex(parent, key, false)
+		if err != nil {
+			return nil, fmt.Errorf(`at "%s": %w`, path[:len(path)-1], err)
+		}
+		removed := parent.Content[idx]
+		parent.Content = append(parent.Content[:idx], parent.Content[idx+1:]...)
+		return removed, nil
+	default:
+		return nil, fmt.Errorf(`"%s" is not a mapping or a sequence`, path[:len(path)-1])
+	}
+}
+
+// mappingIndex returns the index of the key node in the mapping's content, or -1 if the key doesn't exist.
+func mappingIndex(m *yaml.Node, key string) int {
+	for i := 0; i < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// sequenceIndex parses the index token of a sequence.
+// If insert is true, the index can be equal to the length of the sequence or "-" to append to the sequence.
+func sequenceIndex(seq *yaml.Node, token string, insert bool) (int, error) {
+	if token == seqAppendToLastSymbol && insert {
+		return len(seq.Content), nil
+	}
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf(`"%s" is not a valid sequence index`, token)
+	}
+	max := len(seq.Content) - 1
+	if insert {
+		max = len(seq.Content)
+	}
+	if idx > max {
+		return 0, fmt.Errorf("index %d is out of bounds for a sequence of length %d", idx, len(seq.Content))
+	}
+	return idx, nil
+}
+
+func copyNode(n *yaml.Node) *yaml.Node {
+	cp := *n
+	cp.Content = make([]*yaml.Node, len(n.Content))
+	for i, child := range n.Content {
+		cp.Content[i] = copyNode(child)
+	}
+	return &cp
+}
+
+// equalNodes returns true if the two nodes are structurally equal, the order of the keys in mappings doesn't matter.
+func equalNodes(a, b *yaml.Node) bool {
+	if a.Kind == yaml.DocumentNode && len(a.Content) == 1 {
+		return equalNodes(a.Content[0], b)
+	}
+	if b.Kind == yaml.DocumentNode && len(b.Content) == 1 {
+		return equalNodes(a, b.Content[0])
+	}
+	if a.Kind != b.Kind || a.ShortTag() != b.ShortTag() || len(a.Content) != len(b.Content) {
+		return false
+	}
+	switch a.Kind {
+	case yaml.ScalarNode:
+		return a.Value == b.Value
+	case yaml.MappingNode:
+		for i := 0; i < len(a.Content); i += 2 {
+			idx := mappingIndex(b, a.Content[i].Value)
+			if idx == -1 || !equalNodes(a.Content[i+1], b.Content[idx+1]) {
+				return false
+			}
+		}
+		return true
+	case yaml.AliasNode:
+		return equalNodes(a.Alias, b.Alias)
+	default:
+		for i := range a.Content {
+			if !equalNodes(a.Content[i], b.Content[i]) {
+				return false
+			}
+		}
+		return true
+	}
+}
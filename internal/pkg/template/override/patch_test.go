@@ -0,0 +1,289 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package override
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func yamlValue(t *testing.T, s string) yaml.Node {
+	var doc yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(s), &doc))
+	return *doc.Content[0]
+}
+
+func Test_PatchTemplate(t *testing.T) {
+	const template = `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+      Tags:
+        - Key: copilot-application
+          Value: phonetool
+  TaskRole:
+    Type: AWS::IAM::Role
+`
+	testCases := map[string]struct {
+		inPatches func(t *testing.T) []Patch
+		wanted    string
+		wantedErr string
+	}{
+		"return the template as is if there are no patches": {
+			inPatches: func(t *testing.T) []Patch { return nil },
+			wanted:    template,
+		},
+		"add and replace values in mappings and sequences": {
+			inPatches: func(t *testing.T) []Patch {
+				return []Patch{
+					{
+						Operation: PatchOpAdd,
+						Path:      "/Resources/Service/Properties/EnableExecuteCommand",
+						Value:     yamlValue(t, "true"),
+					},
+					{
+						Operation: PatchOpAdd,
+						Path:      "/Resources/Service/Properties/Tags/0",
+						Value:     yamlValue(t, "{Key: team, Value: frontend}"),
+					},
+					{
+						Operation: PatchOpAdd,
+						Path:      "/Resources/Service/Properties/Tags/-",
+						Value:     yamlValue(t, "{Key: cost-center, Value: \"1234\"}"),
+					},
+					{
+						Operation: PatchOpReplace,
+						Path:      "/Resources/Service/Properties/DesiredCount",
+						Value:     yamlValue(t, "3"),
+					},
+				}
+			},
+			wanted: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 3
+      Tags:
+        - {Key: team, Value: frontend}
+        - Key: copilot-application
+          Value: phonetool
+        - {Key: cost-center, Value: "1234"}
+      EnableExecuteCommand: true
+  TaskRole:
+    Type: AWS::IAM::Role
+`,
+		},
+		"remove, move and copy values": {
+			inPatches: func(t *testing.T) []Patch {
+				return []Patch{
+					{
+						Operation: PatchOpCopy,
+						From:      "/Resources/Service/Properties/Tags",
+						Path:      "/Resources/TaskRole/Tags",
+					},
+					{
+						Operation: PatchOpMove,
+						From:      "/Resources/TaskRole/Tags/0/Value",
+						Path:      "/Resources/TaskRole/Tags/0/Key",
+					},
+					{
+						Operation: PatchOpRemove,
+						Path:      "/Resources/Service/Properties/Tags",
+					},
+				}
+			},
+			wanted: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+  TaskRole:
+    Type: AWS::IAM::Role
+    Tags:
+      - Key: phonetool
+`,
+		},
+		"escaped characters in pointers": {
+			inPatches: func(t *testing.T) []Patch {
+				return []Patch{
+					{
+						Operation: PatchOpAdd,
+						Path:      "/Resources/TaskRole/Metadata",
+						Value:     yamlValue(t, "{}"),
+					},
+					{
+						Operation: PatchOpAdd,
+						Path:      "/Resources/TaskRole/Metadata/a~1b~0c",
+						Value:     yamlValue(t, "value"),
+					},
+				}
+			},
+			wanted: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+      Tags:
+        - Key: copilot-application
+          Value: phonetool
+  TaskRole:
+    Type: AWS::IAM::Role
+    Metadata: {a/b~c: value}
+`,
+		},
+		"test succeeds if the values are structurally equal": {
+			inPatches: func(t *testing.T) []Patch {
+				return []Patch{
+					{
+						Operation: PatchOpTest,
+						Path:      "/Resources/Service/Properties/Tags/0",
+						Value:     yamlValue(t, "{Value: phonetool, Key: copilot-application}"),
+					},
+				}
+			},
+			wanted: template,
+		},
+		"error if a test fails": {
+			inPatches: func(t *testing.T) []Patch {
+				return []Patch{
+					{
+						Operation: PatchOpTest,
+						Path:      "/Resources/Service/Properties/DesiredCount",
+						Value:     yamlValue(t, `"1"`),
+					},
+				}
+			},
+			wantedErr: `patch 0: test operation at "/Resources/Service/Properties/DesiredCount": value does not match`,
+		},
+		"error if a key does not exist": {
+			inPatches: func(t *testing.T) []Patch {
+				return []Patch{
+					{
+						Operation: PatchOpAdd,
+						Path:      "/Resources/Service/Properties/DesiredCount",
+						Value:     yamlValue(t, "2"),
+					},
+					{
+						Operation: PatchOpRemove,
+						Path:      "/Resources/Service/Properties/Cluster",
+					},
+				}
+			},
+			wantedErr: `patch 1: remove operation at "/Resources/Service/Properties/Cluster": key "Cluster" not found at "/Resources/Service/Properties"`,
+		},
+		"error if the parent of an added value does not exist": {
+			inPatches: func(t *testing.T) []Patch {
+				return []Patch{
+					{
+						Operation: PatchOpAdd,
+						Path:      "/Resources/LogGroup/Type",
+						Value:     yamlValue(t, "AWS::Logs::LogGroup"),
+					},
+				}
+			},
+			wantedErr: `patch 0: add operation at "/Resources/LogGroup/Type": key "LogGroup" not found at "/Resources"`,
+		},
+		"error if a sequence index is out of bounds": {
+			inPatches: func(t *testing.T) []Patch {
+				return []Patch{
+					{
+						Operation: PatchOpReplace,
+						Path:      "/Resources/Service/Properties/Tags/1",
+						Value:     yamlValue(t, "{Key: team, Value: frontend}"),
+					},
+				}
+			},
+			wantedErr: `patch 0: replace operation at "/Resources/Service/Properties/Tags/1": at "/Resources/Service/Properties/Tags": index 1 is out of bounds for a sequence of length 1`,
+		},
+		"error if a scalar is traversed": {
+			inPatches: func(t *testing.T) []Patch {
+				return []Patch{
+					{
+						Operation: PatchOpRemove,
+						Path:      "/Resources/Service/Type/Name",
+					},
+				}
+			},
+			wantedErr: `patch 0: remove operation at "/Resources/Service/Type/Name": "/Resources/Service/Type" is not a mapping or a sequence`,
+		},
+		"error if a patch is invalid": {
+			inPatches: func(t *testing.T) []Patch {
+				return []Patch{
+					{
+						Operation: "delete",
+						Path:      "/Resources/Service",
+					},
+				}
+			},
+			wantedErr: `patch 0: delete operation at "/Resources/Service": operation "delete" must be one of add, remove, replace, move, copy, test`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := PatchTemplate(tc.inPatches(t), []byte(template))
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
+
+func Test_ValidatePatch(t *testing.T) {
+	testCases := map[string]struct {
+		in        Patch
+		wantedErr string
+	}{
+		"error if the operation is not supported": {
+			in:        Patch{Operation: "merge", Path: "/Resources"},
+			wantedErr: `operation "merge" must be one of add, remove, replace, move, copy, test`,
+		},
+		"error if the path is empty": {
+			in:        Patch{Operation: PatchOpRemove},
+			wantedErr: `invalid "path": pointer must not be empty`,
+		},
+		"error if the path does not start with a slash": {
+			in:        Patch{Operation: PatchOpRemove, Path: "Resources/Service"},
+			wantedErr: `invalid "path": pointer "Resources/Service" must start with "/"`,
+		},
+		"error if the path has an invalid escape sequence": {
+			in:        Patch{Operation: PatchOpRemove, Path: "/Resources/a~2b"},
+			wantedErr: `invalid "path": pointer "/Resources/a~2b" contains an invalid escape sequence, "~" must be followed by "0" or "1"`,
+		},
+		"error if the value is missing": {
+			in:        Patch{Operation: PatchOpReplace, Path: "/Resources/Service"},
+			wantedErr: `"value" must be specified for the "replace" operation`,
+		},
+		"error if from is missing": {
+			in:        Patch{Operation: PatchOpCopy, Path: "/Resources/Service"},
+			wantedErr: `invalid "from": pointer must not be empty`,
+		},
+		"error if a value is moved into its children": {
+			in:        Patch{Operation: PatchOpMove, From: "/Resources/Service", Path: "/Resources/Service/Properties"},
+			wantedErr: `cannot move "/Resources/Service" into one of its children`,
+		},
+		"valid patch": {
+			in: Patch{Operation: PatchOpMove, From: "/Resources/Service", Path: "/Resources/Svc"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidatePatch(tc.in)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
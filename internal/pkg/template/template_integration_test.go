@@ -137,6 +137,47 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				ALBEnabled: true,
 			},
 		},
+		"renders a valid template with additional listener rules": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck:          defaultHttpHealthCheck,
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				ALBEnabled:       true,
+				HTTPRulePriority: aws.Int(10),
+				AdditionalRules: []template.ListenerRuleOpts{
+					{
+						Hosts: []string{"beta.example.com"},
+						Paths: []string{"/api/*"},
+						Headers: []template.HTTPHeaderConditionOpts{
+							{Name: "X-Env", Values: []string{"beta"}},
+						},
+						QueryStrings: []template.QueryStringConditionOpts{
+							{Key: "version", Value: "2"},
+						},
+						AllowedSourceIps: []string{"10.0.0.0/16"},
+					},
+					{
+						Priority: aws.Int(20),
+						Paths:    []string{"/maintenance"},
+						FixedResponse: &template.FixedResponseOpts{
+							StatusCode:  "503",
+							ContentType: "text/plain",
+							Body:        "down for maintenance",
+						},
+					},
+					{
+						Hosts: []string{"old.example.com"},
+						Redirect: &template.RedirectOpts{
+							Host:       "new.example.com",
+							StatusCode: "HTTP_301",
+						},
+					},
+				},
+			},
+		},
 		"renders a valid template with addons with no outputs": {
 			opts: template.WorkloadOpts{
 				HTTPHealthCheck: defaultHttpHealthCheck,
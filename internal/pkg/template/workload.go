@@ -50,6 +50,16 @@ const (
 
 	ArchX86   = "X86_64"
 	ArchARM64 = "ARM64"
+
+	// ECS service deployment strategies.
+	DeploymentStrategyRolling   = "ROLLING"
+	DeploymentStrategyBlueGreen = "BLUE_GREEN"
+	DeploymentStrategyCanary    = "CANARY"
+	DeploymentStrategyLinear    = "LINEAR"
+
+	// Container and volume that write the payload of a job to a file before its main container starts.
+	JobPayloadContainerName = "copilot_job_payload"
+	JobPayloadVolumeName    = "copilot-job-payload"
 )
 
 // Constants for ARN options.
@@ -75,8 +85,12 @@ var (
 		"logconfig",
 		"autoscaling",
 		"eventrule",
+		"job-topic-trigger",
+		"job-payload-container",
 		"state-machine",
 		"state-machine-definition.json",
+		"workflow-state-machine",
+		"workflow-state-machine-definition.json",
 		"efs-access-point",
 		"env-controller",
 		"mount-points",
@@ -88,6 +102,7 @@ var (
 		"subscribe",
 		"nlb",
 		"vpc-connector",
+		"target-group-properties",
 	}
 
 	// Operating systems to determine Fargate platform versions.
@@ -106,6 +121,13 @@ type WorkloadNestedStackOpts struct {
 	SecurityGroupOutputs []string
 }
 
+// ImportedEnvAddonsOpts holds the outputs of the environment addons stack that are imported by the workload.
+type ImportedEnvAddonsOpts struct {
+	Variables map[string]string // Environment variable names to output names.
+	Secrets   map[string]string // Secret names to output names of SecretsManager secret ARNs.
+	Policies  []string          // Output names of IAM ManagedPolicy ARNs to attach to the task role.
+}
+
 // SidecarOpts holds configuration that's needed if the service has sidecar containers.
 type SidecarOpts struct {
 	Name         *string
@@ -207,6 +229,48 @@ type HTTPHealthCheckOpts struct {
 	GracePeriod         *int64
 }
 
+// ListenerRuleOpts holds configuration for an additional listener rule of a load balanced service.
+type ListenerRuleOpts struct {
+	Priority         *int // Nil if the priority is assigned by the rule priority function.
+	Hosts            []string
+	Paths            []string
+	Headers          []HTTPHeaderConditionOpts
+	QueryStrings     []QueryStringConditionOpts
+	AllowedSourceIps []string
+	FixedResponse    *FixedResponseOpts // Nil if the rule does not return a fixed response.
+	Redirect         *RedirectOpts      // Nil if the rule does not redirect requests.
+}
+
+// HTTPHeaderConditionOpts holds the values of an HTTP header that a listener rule matches.
+type HTTPHeaderConditionOpts struct {
+	Name   string
+	Values []string
+}
+
+// QueryStringConditionOpts holds a query string key-value pair that a listener rule matches.
+type QueryStringConditionOpts struct {
+	Key   string
+	Value string
+}
+
+// FixedResponseOpts holds configuration for a listener rule that returns a fixed response.
+type FixedResponseOpts struct {
+	StatusCode  string
+	ContentType string
+	Body        string
+}
+
+// RedirectOpts holds configuration for a listener rule that redirects requests.
+type RedirectOpts struct {
+	// Components that are empty keep their original value.
+	Protocol   string
+	Host       string
+	Port       string
+	Path       string
+	Query      string
+	StatusCode string
+}
+
 // A Secret represents an SSM or SecretsManager secret that can be rendered in CloudFormation.
 type Secret interface {
 	RequiresSub() bool
@@ -237,7 +301,8 @@ func SecretFromSSMOrARN(value string) ssmOrSecretARN {
 
 // secretsManagerName is a Secret that can be referred by a SecretsManager secret name.
 type secretsManagerName struct {
-	value string
+	value   string
+	jsonKey string
 }
 
 // RequiresSub returns true if the secret should be populated in CloudFormation with !Sub.
@@ -247,6 +312,10 @@ func (s secretsManagerName) RequiresSub() bool {
 
 // ValueFrom returns the resource ID of the SecretsManager secret for populating the ARN.
 func (s secretsManagerName) ValueFrom() string {
+	if s.jsonKey != "" {
+		// The version stage and version ID are left empty to use the current version of the secret.
+		return fmt.Sprintf("secret:%s:%s::", s.value, s.jsonKey)
+	}
 	return fmt.Sprintf("secret:%s", s.value)
 }
 
@@ -262,6 +331,14 @@ func SecretFromSecretsManager(value string) secretsManagerName {
 	}
 }
 
+// SecretFromSecretsManagerJSONKey returns a Secret that refers to a key of the JSON value of a SecretsManager secret name.
+func SecretFromSecretsManagerJSONKey(value, jsonKey string) secretsManagerName {
+	return secretsManagerName{
+		value:   value,
+		jsonKey: jsonKey,
+	}
+}
+
 // NetworkLoadBalancerListener holds configuration that's need for a Network Load Balancer listener.
 type NetworkLoadBalancerListener struct {
 	// The port and protocol that the Network Load Balancer listens to.
@@ -303,6 +380,25 @@ type AdvancedCount struct {
 	Cps         []*CapacityProviderStrategy
 }
 
+// DeploymentConfigurationOpts holds configuration for the deployment strategy of an ECS service.
+type DeploymentConfigurationOpts struct {
+	Strategy              string // Empty for the default rolling update.
+	StepPercent           *int   // Percentage of traffic shifted at each step of a canary or linear deployment.
+	StepBakeTimeInMinutes *int   // Minutes to wait between the steps of a canary or linear deployment.
+	BakeTimeInMinutes     *int   // Minutes to keep the previous tasks after all traffic is shifted.
+	RollbackAlarms        []string
+}
+
+// ShiftsTraffic returns true if the deployment shifts traffic between two target groups.
+func (d DeploymentConfigurationOpts) ShiftsTraffic() bool {
+	switch d.Strategy {
+	case DeploymentStrategyBlueGreen, DeploymentStrategyCanary, DeploymentStrategyLinear:
+		return true
+	default:
+		return false
+	}
+}
+
 // ContainerHealthCheck holds configuration for container health check.
 type ContainerHealthCheck struct {
 	Command     []string
@@ -344,12 +440,73 @@ type ObservabilityOpts struct {
 // ExecuteCommandOpts holds configuration that's needed for ECS Execute Command.
 type ExecuteCommandOpts struct{}
 
+// ServiceConnectOpts holds configuration that's needed for ECS Service Connect.
+type ServiceConnectOpts struct {
+	Server *ServiceConnectServerOpts // Nil if the service only connects to other services.
+}
+
+// ServiceConnectServerOpts holds the port of the main container that other services reach with Service Connect.
+type ServiceConnectServerOpts struct {
+	Alias    string
+	PortName string
+}
+
+// PermissionsOpts holds the IAM permissions from the manifest that are granted to the task role.
+type PermissionsOpts struct {
+	ManagedPolicies []string // ARNs of IAM managed policies.
+	Statements      []PolicyStatementOpts
+}
+
+// PolicyStatementOpts holds a statement of the task role policy.
+// The resources are rendered with Fn::Sub, so they can reference pseudo parameters such as ${AWS::Partition}.
+type PolicyStatementOpts struct {
+	Effect    string
+	Actions   []string
+	Resources []string
+}
+
+// JobTriggerOpts holds configuration for the events that trigger a job instead of a schedule.
+// A job without an event pattern or a topic only runs when its state machine is started manually.
+type JobTriggerOpts struct {
+	EventPattern string             // JSON pattern of the EventBridge events that trigger the job.
+	EventBusName string             // Name or ARN of the event bus of the pattern, empty for the default event bus.
+	Topic        *TopicSubscription // SNS topic of another workload whose messages trigger the job.
+	Payload      JobPayloadOpts
+}
+
+// JobPayloadOpts holds how the input of the job's state machine is passed to the main container.
+type JobPayloadOpts struct {
+	Variable string // Name of the environment variable with the payload.
+	FileName string // Name of the file written by the payload container, empty if the payload is a variable.
+}
+
 // StateMachineOpts holds configuration needed for State Machine retries and timeout.
 type StateMachineOpts struct {
 	Timeout *int
 	Retries *int
 }
 
+// WorkflowOpts holds configuration for the state machine that runs jobs as the steps of a workflow.
+type WorkflowOpts struct {
+	Steps []WorkflowStepOpts // Steps start at the same time, and each step waits for the steps it depends on to succeed.
+}
+
+// WorkflowStepOpts holds configuration for a job that runs as a step of a workflow.
+type WorkflowStepOpts struct {
+	Name      string   // Name of the job.
+	DependsOn []string // Names of the steps that must succeed before the job runs.
+	StateMachineOpts
+}
+
+// NextAfterDependency returns the dependency to wait for once the i-th dependency of the step succeeds,
+// or the name of the step if it was the last one.
+func (s WorkflowStepOpts) NextAfterDependency(i int) string {
+	if i+1 < len(s.DependsOn) {
+		return s.DependsOn[i+1]
+	}
+	return s.Name
+}
+
 // PublishOpts holds configuration needed if the service has publishers.
 type PublishOpts struct {
 	Topics []*Topic
@@ -451,6 +608,7 @@ type WorkloadOpts struct {
 	Tags                     map[string]string        // Used by App Runner workloads to tag App Runner service resources
 	NestedStack              *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
 	AddonsExtraParams        string                   // Additional user defined Parameters for the addons stack.
+	ImportedEnvAddons        *ImportedEnvAddonsOpts   // Outputs imported from the environment addons stack.
 	Sidecars                 []*SidecarOpts
 	LogConfig                *LogConfigOpts
 	Autoscaling              *AutoscalingOpts
@@ -459,6 +617,8 @@ type WorkloadOpts struct {
 	Storage                  *StorageOpts
 	Network                  NetworkOpts
 	ExecuteCommand           *ExecuteCommandOpts
+	ServiceConnect           *ServiceConnectOpts
+	Permissions              *PermissionsOpts
 	Platform                 RuntimePlatformOpts
 	EntryPoint               []string
 	Command                  []string
@@ -476,7 +636,10 @@ type WorkloadOpts struct {
 	HTTPHealthCheck     HTTPHealthCheckOpts
 	DeregistrationDelay *int64
 	AllowedSourceIps    []string
+	HTTPRulePriority    *int // Nil if the priority is assigned by the rule priority function.
+	AdditionalRules     []ListenerRuleOpts
 	NLB                 *NetworkLoadBalancer
+	Deployment          DeploymentConfigurationOpts
 
 	// Lambda functions.
 	RulePriorityLambda             string
@@ -489,7 +652,9 @@ type WorkloadOpts struct {
 
 	// Additional options for job templates.
 	ScheduleExpression string
+	JobTrigger         *JobTriggerOpts // Nil if the job runs on a schedule.
 	StateMachine       *StateMachineOpts
+	Workflow           *WorkflowOpts // Nil if the job's triggers start the job itself.
 
 	// Additional options for request driven web service templates.
 	StartCommand      *string
@@ -574,6 +739,7 @@ func withSvcParsingFuncs() ParseOption {
 		return t.Funcs(map[string]interface{}{
 			"toSnakeCase":         ToSnakeCaseFunc,
 			"hasSecrets":          hasSecrets,
+			"hasManagedPolicies":  hasManagedPolicies,
 			"fmtSlice":            FmtSliceFunc,
 			"quoteSlice":          QuoteSliceFunc,
 			"randomUUID":          randomUUIDFunc,
@@ -596,6 +762,22 @@ func hasSecrets(opts WorkloadOpts) bool {
 	if opts.NestedStack != nil && (len(opts.NestedStack.SecretOutputs) > 0) {
 		return true
 	}
+	if opts.ImportedEnvAddons != nil && (len(opts.ImportedEnvAddons.Secrets) > 0) {
+		return true
+	}
+	return false
+}
+
+func hasManagedPolicies(opts WorkloadOpts) bool {
+	if opts.NestedStack != nil && (len(opts.NestedStack.PolicyOutputs) > 0) {
+		return true
+	}
+	if opts.ImportedEnvAddons != nil && (len(opts.ImportedEnvAddons.Policies) > 0) {
+		return true
+	}
+	if opts.Permissions != nil && (len(opts.Permissions.ManagedPolicies) > 0) {
+		return true
+	}
 	return false
 }
 
@@ -4,9 +4,12 @@
 package template
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 )
@@ -28,35 +31,40 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				}
 
 				return map[string][]byte{
-					"templates/workloads/services/backend/cf.yml":                         []byte(baseContent),
-					"templates/workloads/partials/cf/loggroup.yml":                        []byte("loggroup"),
-					"templates/workloads/partials/cf/envvars-container.yml":               []byte("envvars-container"),
-					"templates/workloads/partials/cf/envvars-common.yml":                  []byte("envvars-common"),
-					"templates/workloads/partials/cf/secrets.yml":                         []byte("secrets"),
-					"templates/workloads/partials/cf/executionrole.yml":                   []byte("executionrole"),
-					"templates/workloads/partials/cf/taskrole.yml":                        []byte("taskrole"),
-					"templates/workloads/partials/cf/workload-container.yml":              []byte("workload-container"),
-					"templates/workloads/partials/cf/fargate-taskdef-base-properties.yml": []byte("fargate-taskdef-base-properties"),
-					"templates/workloads/partials/cf/service-base-properties.yml":         []byte("service-base-properties"),
-					"templates/workloads/partials/cf/servicediscovery.yml":                []byte("servicediscovery"),
-					"templates/workloads/partials/cf/addons.yml":                          []byte("addons"),
-					"templates/workloads/partials/cf/sidecars.yml":                        []byte("sidecars"),
-					"templates/workloads/partials/cf/logconfig.yml":                       []byte("logconfig"),
-					"templates/workloads/partials/cf/autoscaling.yml":                     []byte("autoscaling"),
-					"templates/workloads/partials/cf/state-machine-definition.json.yml":   []byte("state-machine-definition"),
-					"templates/workloads/partials/cf/eventrule.yml":                       []byte("eventrule"),
-					"templates/workloads/partials/cf/state-machine.yml":                   []byte("state-machine"),
-					"templates/workloads/partials/cf/efs-access-point.yml":                []byte("efs-access-point"),
-					"templates/workloads/partials/cf/env-controller.yml":                  []byte("env-controller"),
-					"templates/workloads/partials/cf/mount-points.yml":                    []byte("mount-points"),
-					"templates/workloads/partials/cf/volumes.yml":                         []byte("volumes"),
-					"templates/workloads/partials/cf/image-overrides.yml":                 []byte("image-overrides"),
-					"templates/workloads/partials/cf/instancerole.yml":                    []byte("instancerole"),
-					"templates/workloads/partials/cf/accessrole.yml":                      []byte("accessrole"),
-					"templates/workloads/partials/cf/publish.yml":                         []byte("publish"),
-					"templates/workloads/partials/cf/subscribe.yml":                       []byte("subscribe"),
-					"templates/workloads/partials/cf/nlb.yml":                             []byte("nlb"),
-					"templates/workloads/partials/cf/vpc-connector.yml":                   []byte("vpc-connector"),
+					"templates/workloads/services/backend/cf.yml":                                []byte(baseContent),
+					"templates/workloads/partials/cf/loggroup.yml":                               []byte("loggroup"),
+					"templates/workloads/partials/cf/envvars-container.yml":                      []byte("envvars-container"),
+					"templates/workloads/partials/cf/envvars-common.yml":                         []byte("envvars-common"),
+					"templates/workloads/partials/cf/secrets.yml":                                []byte("secrets"),
+					"templates/workloads/partials/cf/executionrole.yml":                          []byte("executionrole"),
+					"templates/workloads/partials/cf/taskrole.yml":                               []byte("taskrole"),
+					"templates/workloads/partials/cf/workload-container.yml":                     []byte("workload-container"),
+					"templates/workloads/partials/cf/fargate-taskdef-base-properties.yml":        []byte("fargate-taskdef-base-properties"),
+					"templates/workloads/partials/cf/service-base-properties.yml":                []byte("service-base-properties"),
+					"templates/workloads/partials/cf/servicediscovery.yml":                       []byte("servicediscovery"),
+					"templates/workloads/partials/cf/addons.yml":                                 []byte("addons"),
+					"templates/workloads/partials/cf/sidecars.yml":                               []byte("sidecars"),
+					"templates/workloads/partials/cf/logconfig.yml":                              []byte("logconfig"),
+					"templates/workloads/partials/cf/autoscaling.yml":                            []byte("autoscaling"),
+					"templates/workloads/partials/cf/state-machine-definition.json.yml":          []byte("state-machine-definition"),
+					"templates/workloads/partials/cf/eventrule.yml":                              []byte("eventrule"),
+					"templates/workloads/partials/cf/job-topic-trigger.yml":                      []byte("job-topic-trigger"),
+					"templates/workloads/partials/cf/job-payload-container.yml":                  []byte("job-payload-container"),
+					"templates/workloads/partials/cf/state-machine.yml":                          []byte("state-machine"),
+					"templates/workloads/partials/cf/workflow-state-machine-definition.json.yml": []byte("workflow-state-machine-definition"),
+					"templates/workloads/partials/cf/workflow-state-machine.yml":                 []byte("workflow-state-machine"),
+					"templates/workloads/partials/cf/efs-access-point.yml":                       []byte("efs-access-point"),
+					"templates/workloads/partials/cf/env-controller.yml":                         []byte("env-controller"),
+					"templates/workloads/partials/cf/mount-points.yml":                           []byte("mount-points"),
+					"templates/workloads/partials/cf/volumes.yml":                                []byte("volumes"),
+					"templates/workloads/partials/cf/image-overrides.yml":                        []byte("image-overrides"),
+					"templates/workloads/partials/cf/instancerole.yml":                           []byte("instancerole"),
+					"templates/workloads/partials/cf/accessrole.yml":                             []byte("accessrole"),
+					"templates/workloads/partials/cf/publish.yml":                                []byte("publish"),
+					"templates/workloads/partials/cf/subscribe.yml":                              []byte("subscribe"),
+					"templates/workloads/partials/cf/nlb.yml":                                    []byte("nlb"),
+					"templates/workloads/partials/cf/vpc-connector.yml":                          []byte("vpc-connector"),
+					"templates/workloads/partials/cf/target-group-properties.yml":                []byte("target-group-properties"),
 				}
 			},
 			wantedContent: `  loggroup
@@ -74,8 +82,12 @@ func TestTemplate_ParseSvc(t *testing.T) {
   logconfig
   autoscaling
   eventrule
+  job-topic-trigger
+  job-payload-container
   state-machine
   state-machine-definition
+  workflow-state-machine
+  workflow-state-machine-definition
   efs-access-point
   env-controller
   mount-points
@@ -87,6 +99,7 @@ func TestTemplate_ParseSvc(t *testing.T) {
   subscribe
   nlb
   vpc-connector
+  target-group-properties
 `,
 		},
 	}
@@ -142,6 +155,14 @@ func TestHasSecrets(t *testing.T) {
 			},
 			wanted: true,
 		},
+		"imports secrets from the environment addons": {
+			in: WorkloadOpts{
+				ImportedEnvAddons: &ImportedEnvAddonsOpts{
+					Secrets: map[string]string{"DB_SECRET": "ClusterSecret"},
+				},
+			},
+			wanted: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -151,6 +172,251 @@ func TestHasSecrets(t *testing.T) {
 	}
 }
 
+func TestTemplate_ParseImportedEnvAddons(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			TaskRole struct {
+				Properties struct {
+					ManagedPolicyArns []interface{} `yaml:"ManagedPolicyArns"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskRole"`
+			TaskDefinition struct {
+				Properties struct {
+					ContainerDefinitions []struct {
+						Environment []map[string]interface{} `yaml:"Environment"`
+						Secrets     []map[string]interface{} `yaml:"Secrets"`
+					} `yaml:"ContainerDefinitions"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+
+	// WHEN
+	content, err := tpl.ParseBackendService(WorkloadOpts{
+		WorkloadType: "Backend Service",
+		ImportedEnvAddons: &ImportedEnvAddonsOpts{
+			Variables: map[string]string{"DB_ENDPOINT": "ClusterEndpoint"},
+			Secrets:   map[string]string{"DB_SECRET": "ClusterSecret"},
+			Policies:  []string{"BucketAccessPolicy"},
+		},
+	})
+
+	// THEN
+	require.NoError(t, err, "parse backend service")
+	var actual cfn
+	require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual), "unmarshal actual template")
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"Fn::ImportValue": "${AppName}-${EnvName}-Addons-BucketAccessPolicy",
+		},
+	}, actual.Resources.TaskRole.Properties.ManagedPolicyArns)
+	container := actual.Resources.TaskDefinition.Properties.ContainerDefinitions[0]
+	require.Contains(t, container.Environment, map[string]interface{}{
+		"Name": "DB_ENDPOINT",
+		"Value": map[string]interface{}{
+			"Fn::ImportValue": "${AppName}-${EnvName}-Addons-ClusterEndpoint",
+		},
+	})
+	require.Equal(t, []map[string]interface{}{
+		{
+			"Name": "DB_SECRET",
+			"ValueFrom": map[string]interface{}{
+				"Fn::ImportValue": "${AppName}-${EnvName}-Addons-ClusterSecret",
+			},
+		},
+	}, container.Secrets)
+}
+
+func TestTemplate_ParsePermissions(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			TaskRole struct {
+				Properties struct {
+					ManagedPolicyArns []interface{} `yaml:"ManagedPolicyArns"`
+					Policies          []struct {
+						PolicyName     string `yaml:"PolicyName"`
+						PolicyDocument struct {
+							Statement []map[string]interface{} `yaml:"Statement"`
+						} `yaml:"PolicyDocument"`
+					} `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskRole"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+
+	// WHEN
+	content, err := tpl.ParseWorkerService(WorkloadOpts{
+		WorkloadType: "Worker Service",
+		Permissions: &PermissionsOpts{
+			ManagedPolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+			Statements: []PolicyStatementOpts{
+				{
+					Effect:    "Allow",
+					Actions:   []string{"dynamodb:GetItem", "dynamodb:Query"},
+					Resources: []string{"arn:${AWS::Partition}:dynamodb:${AWS::Region}:${AWS::AccountId}:table/orders"},
+				},
+				{
+					Effect:    "Deny",
+					Actions:   []string{"dynamodb:DeleteTable"},
+					Resources: []string{"*"},
+				},
+			},
+		},
+	})
+
+	// THEN
+	require.NoError(t, err, "parse worker service")
+	var actual cfn
+	require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual), "unmarshal actual template")
+	role := actual.Resources.TaskRole.Properties
+	require.Equal(t, []interface{}{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}, role.ManagedPolicyArns)
+	policy := role.Policies[len(role.Policies)-1]
+	require.Equal(t, "ManifestPermissions", policy.PolicyName)
+	require.Equal(t, []map[string]interface{}{
+		{
+			"Effect":   "Allow",
+			"Action":   []interface{}{"dynamodb:GetItem", "dynamodb:Query"},
+			"Resource": []interface{}{"arn:${AWS::Partition}:dynamodb:${AWS::Region}:${AWS::AccountId}:table/orders"},
+		},
+		{
+			"Effect":   "Deny",
+			"Action":   []interface{}{"dynamodb:DeleteTable"},
+			"Resource": []interface{}{"*"},
+		},
+	}, policy.PolicyDocument.Statement)
+}
+
+func TestTemplate_ParseJobTrigger(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Rule *struct {
+				Properties map[string]interface{} `yaml:"Properties"`
+			} `yaml:"Rule"`
+			TriggerPipe  *struct{} `yaml:"TriggerPipe"`
+			StateMachine struct {
+				Properties struct {
+					DefinitionString string `yaml:"DefinitionString"`
+				} `yaml:"Properties"`
+			} `yaml:"StateMachine"`
+			TaskDefinition struct {
+				Properties struct {
+					ContainerDefinitions []struct {
+						Name interface{} `yaml:"Name"`
+					} `yaml:"ContainerDefinitions"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+		} `yaml:"Resources"`
+	}
+	testCases := map[string]struct {
+		inTrigger *JobTriggerOpts
+
+		wantedRule             map[string]interface{}
+		wantedPipe             bool
+		wantedOverride         map[string]interface{}
+		wantedPayloadContainer bool
+	}{
+		"schedule": {
+			wantedRule: map[string]interface{}{
+				"ScheduleExpression": "Schedule",
+			},
+		},
+		"event pattern on a custom bus": {
+			inTrigger: &JobTriggerOpts{
+				EventPattern: `{"source":["aws.ecr"]}`,
+				EventBusName: "orders",
+				Payload: JobPayloadOpts{
+					Variable: "EVENT",
+				},
+			},
+			wantedRule: map[string]interface{}{
+				"EventPattern": map[string]interface{}{"source": []interface{}{"aws.ecr"}},
+				"EventBusName": "orders",
+			},
+			wantedOverride: map[string]interface{}{
+				"Name":        "${ContainerName}",
+				"Environment": []interface{}{map[string]interface{}{"Name": "EVENT", "Value.$": "States.JsonToString($)"}},
+			},
+		},
+		"sns topic with a payload file": {
+			inTrigger: &JobTriggerOpts{
+				Topic: &TopicSubscription{
+					Name:    aws.String("orders"),
+					Service: aws.String("api"),
+				},
+				Payload: JobPayloadOpts{
+					Variable: "COPILOT_JOB_PAYLOAD",
+					FileName: "event.json",
+				},
+			},
+			wantedPipe: true,
+			wantedOverride: map[string]interface{}{
+				"Name":        "copilot_job_payload",
+				"Environment": []interface{}{map[string]interface{}{"Name": "COPILOT_JOB_PAYLOAD", "Value.$": "States.JsonToString($)"}},
+			},
+			wantedPayloadContainer: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseScheduledJob(WorkloadOpts{
+				WorkloadType: "Scheduled Job",
+				JobTrigger:   tc.inTrigger,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse scheduled job")
+			var actual cfn
+			require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual), "unmarshal actual template")
+			if tc.wantedRule == nil {
+				require.Nil(t, actual.Resources.Rule)
+			} else {
+				require.NotNil(t, actual.Resources.Rule)
+				for key, val := range tc.wantedRule {
+					require.Equal(t, val, actual.Resources.Rule.Properties[key])
+				}
+			}
+			require.Equal(t, tc.wantedPipe, actual.Resources.TriggerPipe != nil)
+
+			var definition struct {
+				States map[string]struct {
+					Parameters struct {
+						CopilotOverrides struct {
+							ContainerOverrides []map[string]interface{}
+						}
+						Overrides string `json:"Overrides.$"`
+					}
+				}
+			}
+			require.NoError(t, json.Unmarshal([]byte(actual.Resources.StateMachine.Properties.DefinitionString), &definition), "unmarshal state machine definition")
+			require.Equal(t, "$.CopilotOverrides", definition.States["Run Fargate Task"].Parameters.Overrides)
+			defaultOverrides := definition.States["Default Overrides"].Parameters.CopilotOverrides.ContainerOverrides
+			if tc.wantedOverride == nil {
+				require.Empty(t, defaultOverrides)
+			} else {
+				require.Equal(t, []map[string]interface{}{tc.wantedOverride}, defaultOverrides)
+			}
+
+			var hasPayloadContainer bool
+			for _, container := range actual.Resources.TaskDefinition.Properties.ContainerDefinitions {
+				if container.Name == JobPayloadContainerName {
+					hasPayloadContainer = true
+				}
+			}
+			require.Equal(t, tc.wantedPayloadContainer, hasPayloadContainer)
+		})
+	}
+}
+
 func TestTemplate_ParseNetwork(t *testing.T) {
 	type cfn struct {
 		Resources struct {
@@ -230,6 +496,291 @@ func TestTemplate_ParseNetwork(t *testing.T) {
 	}
 }
 
+func TestTemplate_ParseServiceConnect(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					ServiceConnectConfiguration map[interface{}]interface{} `yaml:"ServiceConnectConfiguration"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+		} `yaml:"Resources"`
+		Outputs map[string]interface{} `yaml:"Outputs"`
+	}
+	const namespace = `
+Namespace:
+  Fn::Sub:
+    - arn:${AWS::Partition}:servicediscovery:${AWS::Region}:${AWS::AccountId}:namespace/${NamespaceID}
+    - NamespaceID:
+        Fn::ImportValue: !Sub '${AppName}-${EnvName}-ServiceDiscoveryNamespaceID'`
+
+	testCases := map[string]struct {
+		input *ServiceConnectOpts
+
+		wantedConfig  string
+		wantedOutputs []string
+	}{
+		"should render a client only configuration": {
+			input: &ServiceConnectOpts{},
+			wantedConfig: `
+Enabled: true` + namespace,
+			wantedOutputs: []string{"DiscoveryServiceARN", "ServiceConnectEnabled"},
+		},
+		"should render the alias and port name of the service": {
+			input: &ServiceConnectOpts{
+				Server: &ServiceConnectServerOpts{
+					Alias:    "users",
+					PortName: "http",
+				},
+			},
+			wantedConfig: `
+Enabled: true` + namespace + `
+Services:
+  - PortName: http
+    DiscoveryName: !Sub '${WorkloadName}-connect'
+    ClientAliases:
+      - Port: !Ref ContainerPort
+        DnsName: users
+`,
+			wantedOutputs: []string{"DiscoveryServiceARN", "ServiceConnectEnabled", "ServiceConnectEndpoint"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+			wanted := make(map[interface{}]interface{})
+			err := yaml.Unmarshal([]byte(tc.wantedConfig), &wanted)
+			require.NoError(t, err, "unmarshal wanted config")
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				WorkloadType:   "Backend Service",
+				ServiceConnect: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual config")
+			require.Equal(t, wanted, actual.Resources.Service.Properties.ServiceConnectConfiguration)
+			var outputs []string
+			for k := range actual.Outputs {
+				outputs = append(outputs, k)
+			}
+			require.ElementsMatch(t, tc.wantedOutputs, outputs)
+		})
+	}
+}
+
+func TestTemplate_ParseDeployment(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					DeploymentConfiguration map[interface{}]interface{}   `yaml:"DeploymentConfiguration"`
+					LoadBalancers           []map[interface{}]interface{} `yaml:"LoadBalancers"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+			AlternateTargetGroup map[interface{}]interface{} `yaml:"AlternateTargetGroup"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input DeploymentConfigurationOpts
+
+		wantedDeploymentConfig     string
+		wantedAlternateTargetGroup bool
+	}{
+		"should render a rolling update by default": {
+			wantedDeploymentConfig: `
+DeploymentCircuitBreaker:
+  Enable: true
+  Rollback: true
+MinimumHealthyPercent: 100
+MaximumPercent: 200
+`,
+		},
+		"should render rollback alarms for a rolling update": {
+			input: DeploymentConfigurationOpts{
+				RollbackAlarms: []string{"frontend-5xx"},
+			},
+			wantedDeploymentConfig: `
+DeploymentCircuitBreaker:
+  Enable: true
+  Rollback: true
+MinimumHealthyPercent: 100
+MaximumPercent: 200
+Alarms:
+  AlarmNames: ["frontend-5xx"]
+  Enable: true
+  Rollback: true
+`,
+		},
+		"should render a canary deployment with an alternate target group": {
+			input: DeploymentConfigurationOpts{
+				Strategy:              DeploymentStrategyCanary,
+				StepPercent:           aws.Int(10),
+				StepBakeTimeInMinutes: aws.Int(5),
+				BakeTimeInMinutes:     aws.Int(15),
+			},
+			wantedDeploymentConfig: `
+DeploymentCircuitBreaker:
+  Enable: true
+  Rollback: true
+MinimumHealthyPercent: 100
+MaximumPercent: 200
+Strategy: CANARY
+CanaryConfiguration:
+  CanaryPercent: 10
+  CanaryBakeTimeInMinutes: 5
+BakeTimeInMinutes: 15
+`,
+			wantedAlternateTargetGroup: true,
+		},
+		"should render a linear deployment with an alternate target group": {
+			input: DeploymentConfigurationOpts{
+				Strategy:    DeploymentStrategyLinear,
+				StepPercent: aws.Int(20),
+			},
+			wantedDeploymentConfig: `
+DeploymentCircuitBreaker:
+  Enable: true
+  Rollback: true
+MinimumHealthyPercent: 100
+MaximumPercent: 200
+Strategy: LINEAR
+LinearConfiguration:
+  StepPercent: 20
+`,
+			wantedAlternateTargetGroup: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+			wanted := make(map[interface{}]interface{})
+			err := yaml.Unmarshal([]byte(tc.wantedDeploymentConfig), &wanted)
+			require.NoError(t, err, "unmarshal wanted config")
+
+			// WHEN
+			content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+				ALBEnabled: true,
+				Deployment: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse load balanced web service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual config")
+			require.Equal(t, wanted, actual.Resources.Service.Properties.DeploymentConfiguration)
+			require.Equal(t, tc.wantedAlternateTargetGroup, actual.Resources.AlternateTargetGroup != nil)
+			_, ok := actual.Resources.Service.Properties.LoadBalancers[0]["AdvancedConfiguration"]
+			require.Equal(t, tc.wantedAlternateTargetGroup, ok)
+		})
+	}
+}
+
+func TestTemplate_ParseAdditionalListenerRules(t *testing.T) {
+	type cfn struct {
+		Resources map[string]struct {
+			Type       string `yaml:"Type"`
+			Properties struct {
+				Actions []map[string]interface{} `yaml:"Actions"`
+			} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		deployment DeploymentConfigurationOpts
+		rules      []ListenerRuleOpts
+
+		wantedActions map[string]string
+	}{
+		"should forward to the target group with a rolling update": {
+			rules: []ListenerRuleOpts{
+				{
+					Priority: aws.Int(10),
+					Hosts:    []string{"beta.example.com"},
+				},
+			},
+			wantedActions: map[string]string{
+				"AdditionalListenerRule0": `
+- TargetGroupArn: TargetGroup
+  Type: forward
+`,
+			},
+		},
+		"should not reference the alternate target group when the deployment shifts traffic": {
+			deployment: DeploymentConfigurationOpts{
+				Strategy:    DeploymentStrategyCanary,
+				StepPercent: aws.Int(10),
+			},
+			rules: []ListenerRuleOpts{
+				{
+					Priority: aws.Int(10),
+					Paths:    []string{"/maintenance"},
+					FixedResponse: &FixedResponseOpts{
+						StatusCode: "503",
+					},
+				},
+				{
+					Priority: aws.Int(20),
+					Hosts:    []string{"old.example.com"},
+					Redirect: &RedirectOpts{
+						Host:       "new.example.com",
+						StatusCode: "HTTP_301",
+					},
+				},
+			},
+			wantedActions: map[string]string{
+				"AdditionalListenerRule0": `
+- Type: fixed-response
+  FixedResponseConfig:
+    StatusCode: "503"
+`,
+				"AdditionalListenerRule1": `
+- Type: redirect
+  RedirectConfig:
+    Host: "new.example.com"
+    StatusCode: HTTP_301
+`,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+				ALBEnabled:      true,
+				Deployment:      tc.deployment,
+				AdditionalRules: tc.rules,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse load balanced web service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual template")
+			for resource, actions := range tc.wantedActions {
+				var wanted []map[string]interface{}
+				err := yaml.Unmarshal([]byte(actions), &wanted)
+				require.NoError(t, err, "unmarshal wanted actions")
+				require.Equal(t, wanted, actual.Resources[resource].Properties.Actions, resource)
+			}
+		})
+	}
+}
+
 func TestRuntimePlatformOpts_Version(t *testing.T) {
 	testCases := map[string]struct {
 		in       RuntimePlatformOpts
@@ -309,4 +860,5 @@ func TestSecretsManagerName_Service(t *testing.T) {
 
 func TestSecretsManagerName_ValueFrom(t *testing.T) {
 	require.Equal(t, "secret:aes128-1a2b3c", SecretFromSecretsManager("aes128-1a2b3c").ValueFrom())
+	require.Equal(t, "secret:mysql:username::", SecretFromSecretsManagerJSONKey("mysql", "username").ValueFrom())
 }
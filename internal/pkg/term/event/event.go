@@ -0,0 +1,185 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package event writes machine-readable events about the progress of a command as newline-delimited JSON.
+// Events are discarded unless the stream is enabled.
+package event
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// Types of events.
+const (
+	TypeStackEvent    = "stack_event"
+	TypeECSDeployment = "ecs_deployment"
+	TypeImagePushed   = "image_pushed"
+	TypeOutput        = "output"
+	TypeError         = "error"
+)
+
+// Payload is the content of an event.
+type Payload interface {
+	Type() string
+}
+
+// StackEvent is a CloudFormation stack event.
+type StackEvent struct {
+	StackName            string    `json:"stackName"`
+	LogicalResourceID    string    `json:"logicalResourceId"`
+	PhysicalResourceID   string    `json:"physicalResourceId,omitempty"`
+	ResourceType         string    `json:"resourceType"`
+	ResourceStatus       string    `json:"resourceStatus"`
+	ResourceStatusReason string    `json:"resourceStatusReason,omitempty"`
+	Timestamp            time.Time `json:"timestamp"`
+}
+
+// Type returns TypeStackEvent.
+func (StackEvent) Type() string { return TypeStackEvent }
+
+// ECSDeployment is the state of the deployments of an ECS service.
+type ECSDeployment struct {
+	Cluster               string                `json:"cluster"`
+	Service               string                `json:"service"`
+	Deployments           []ECSDeploymentStatus `json:"deployments"`
+	LatestFailureEvents   []string              `json:"latestFailureEvents,omitempty"`
+	ShiftedTrafficPercent *int                  `json:"shiftedTrafficPercent,omitempty"`
+}
+
+// ECSDeploymentStatus is the state of a single deployment of an ECS service.
+type ECSDeploymentStatus struct {
+	Status          string `json:"status"`
+	TaskDefRevision string `json:"taskDefRevision"`
+	DesiredCount    int    `json:"desiredCount"`
+	RunningCount    int    `json:"runningCount"`
+	FailedCount     int    `json:"failedCount"`
+	PendingCount    int    `json:"pendingCount"`
+	RolloutState    string `json:"rolloutState,omitempty"`
+}
+
+// Type returns TypeECSDeployment.
+func (ECSDeployment) Type() string { return TypeECSDeployment }
+
+// ImagePushed is a container image pushed to a repository.
+type ImagePushed struct {
+	Repository string   `json:"repository"`
+	Tags       []string `json:"tags,omitempty"`
+	Digest     string   `json:"digest"`
+}
+
+// Type returns TypeImagePushed.
+func (ImagePushed) Type() string { return TypeImagePushed }
+
+// Output is a result of a command that completed successfully.
+type Output struct {
+	Application string            `json:"application,omitempty"`
+	Environment string            `json:"environment,omitempty"`
+	Workload    string            `json:"workload,omitempty"`
+	URI         string            `json:"uri,omitempty"`
+	TaskARNs    []string          `json:"taskARNs,omitempty"`
+	Values      map[string]string `json:"values,omitempty"`
+}
+
+// Type returns TypeOutput.
+func (Output) Type() string { return TypeOutput }
+
+// Error is the error that made a command fail.
+type Error struct {
+	Message string `json:"message"`
+	Kind    string `json:"kind,omitempty"` // Type of the most specific error in the chain, for example "*cloudformation.ErrStackAlreadyExists".
+	Code    string `json:"code,omitempty"` // Error code returned by AWS, if any.
+}
+
+// Type returns TypeError.
+func (Error) Type() string { return TypeError }
+
+// NewError returns the event for an error.
+func NewError(err error) Error {
+	e := Error{
+		Message: err.Error(),
+	}
+	for cur := err; cur != nil; cur = errors.Unwrap(cur) {
+		if kind := fmt.Sprintf("%T", cur); !isUntypedError(kind) {
+			e.Kind = kind
+		}
+	}
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		e.Code = aerr.Code()
+	}
+	return e
+}
+
+func isUntypedError(kind string) bool {
+	switch kind {
+	case "*errors.errorString", "*fmt.wrapError", "*fmt.wrapErrors":
+		return true
+	}
+	return false
+}
+
+type envelope struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Data      Payload   `json:"data"`
+}
+
+type stream struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+	now func() time.Time
+}
+
+var (
+	mu      sync.RWMutex
+	current *stream
+)
+
+// Enable writes the events published from now on to w.
+func Enable(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = &stream{
+		enc: json.NewEncoder(w),
+		now: time.Now,
+	}
+}
+
+// Disable discards the events published from now on.
+func Disable() {
+	mu.Lock()
+	defer mu.Unlock()
+	current = nil
+}
+
+// Enabled returns true if published events are written.
+func Enabled() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current != nil
+}
+
+// Publish writes the event as a single line of JSON if the stream is enabled.
+func Publish(p Payload) {
+	mu.RLock()
+	s := current
+	mu.RUnlock()
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Best effort: a failure to write an event should not fail the command.
+	_ = s.enc.Encode(envelope{
+		Type:      p.Type(),
+		Timestamp: s.now().UTC(),
+		Data:      p,
+	})
+}
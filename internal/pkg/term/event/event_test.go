@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package event
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/require"
+)
+
+type testTypedError struct{}
+
+func (testTypedError) Error() string { return "typed error" }
+
+func TestPublish(t *testing.T) {
+	t.Run("should discard events if the stream is not enabled", func(t *testing.T) {
+		Disable()
+
+		Publish(Output{Application: "phonetool"})
+
+		require.False(t, Enabled())
+	})
+	t.Run("should write each event as a line of JSON", func(t *testing.T) {
+		buf := new(strings.Builder)
+		Enable(buf)
+		defer Disable()
+		current.now = func() time.Time {
+			return time.Date(2020, 3, 13, 19, 50, 30, 0, time.UTC)
+		}
+
+		Publish(ImagePushed{
+			Repository: "1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+			Tags:       []string{"latest"},
+			Digest:     "sha256:1234",
+		})
+		Publish(Output{
+			Application: "phonetool",
+			Environment: "test",
+			Workload:    "frontend",
+			URI:         "https://example.com",
+		})
+
+		require.True(t, Enabled())
+		require.Equal(t, `{"type":"image_pushed","timestamp":"2020-03-13T19:50:30Z","data":{"repository":"1111.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend","tags":["latest"],"digest":"sha256:1234"}}
+{"type":"output","timestamp":"2020-03-13T19:50:30Z","data":{"application":"phonetool","environment":"test","workload":"frontend","uri":"https://example.com"}}
+`, buf.String())
+	})
+}
+
+func TestNewError(t *testing.T) {
+	testCases := map[string]struct {
+		in     error
+		wanted Error
+	}{
+		"untyped errors": {
+			in: fmt.Errorf("deploy service: %w", errors.New("some error")),
+			wanted: Error{
+				Message: "deploy service: some error",
+			},
+		},
+		"typed errors": {
+			in: fmt.Errorf("deploy service: %w", testTypedError{}),
+			wanted: Error{
+				Message: "deploy service: typed error",
+				Kind:    "event.testTypedError",
+			},
+		},
+		"AWS errors": {
+			in: fmt.Errorf("describe stack: %w", awserr.New("ValidationError", "stack does not exist", nil)),
+			wanted: Error{
+				Message: "describe stack: ValidationError: stack does not exist",
+				Kind:    "*awserr.baseError",
+				Code:    "ValidationError",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, NewError(tc.in))
+		})
+	}
+}
@@ -33,6 +33,10 @@ type ECSServiceRendererOpts struct {
 	Group      *errgroup.Group
 	Ctx        context.Context
 	RenderOpts RenderOptions
+
+	// Listener rule that shifts traffic to the new revision of the service, if any.
+	RuleDescriber   stream.ListenerRuleDescriber
+	ListenerRuleARN string
 }
 
 // ListeningChangeSetRenderer returns a component that listens for CloudFormation
@@ -74,9 +78,11 @@ func ListeningECSServiceResourceRenderer(streamer StackSubscriber, ecsDescriber
 		ecsDescriber: ecsDescriber,
 		logicalID:    logicalID,
 
-		group:      g,
-		ctx:        ctx,
-		renderOpts: opts.RenderOpts,
+		group:           g,
+		ctx:             ctx,
+		renderOpts:      opts.RenderOpts,
+		ruleDescriber:   opts.RuleDescriber,
+		listenerRuleARN: opts.ListenerRuleARN,
 		resourceRenderer: ListeningResourceRenderer(streamer, logicalID, description, ResourceRendererOpts{
 			RenderOpts: opts.RenderOpts,
 		}),
@@ -241,9 +247,11 @@ type ecsServiceResourceComponent struct {
 	logicalID    string                     // LogicalID for the service.
 
 	// Optional inputs.
-	group      *errgroup.Group // Existing group to catch ECSDeploymentStreamer errors.
-	ctx        context.Context // Context for the ECSDeploymentStreamer.
-	renderOpts RenderOptions
+	group           *errgroup.Group // Existing group to catch ECSDeploymentStreamer errors.
+	ctx             context.Context // Context for the ECSDeploymentStreamer.
+	renderOpts      RenderOptions
+	ruleDescriber   stream.ListenerRuleDescriber // Client to describe the listener rule that shifts traffic.
+	listenerRuleARN string                       // ARN of the listener rule that shifts traffic.
 
 	// Sub-components.
 	resourceRenderer   DynamicRenderer
@@ -326,7 +334,11 @@ func (c *ecsServiceResourceComponent) Done() <-chan struct{} {
 
 func (c *ecsServiceResourceComponent) newListeningRollingUpdateRenderer(serviceARN string, startTime time.Time) DynamicRenderer {
 	cluster, service := parseServiceARN(serviceARN)
-	streamer := stream.NewECSDeploymentStreamer(c.ecsDescriber, cluster, service, startTime)
+	var opts []stream.ECSDeploymentStreamerOpts
+	if c.ruleDescriber != nil && c.listenerRuleARN != "" {
+		opts = append(opts, stream.WithListenerRule(c.ruleDescriber, c.listenerRuleARN))
+	}
+	streamer := stream.NewECSDeploymentStreamer(c.ecsDescriber, cluster, service, startTime, opts...)
 	renderer := ListeningRollingUpdateRenderer(streamer, NestedRenderOptions(c.renderOpts))
 	c.group.Go(func() error {
 		return stream.Stream(c.ctx, streamer)
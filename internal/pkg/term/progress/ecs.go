@@ -38,8 +38,9 @@ func ListeningRollingUpdateRenderer(streamer ECSServiceSubscriber, opts RenderOp
 
 type rollingUpdateComponent struct {
 	// Data to render.
-	deployments []stream.ECSDeployment
-	failureMsgs []string
+	deployments    []stream.ECSDeployment
+	failureMsgs    []string
+	shiftedTraffic *int // Percentage of the traffic shifted to the new revision, nil if traffic isn't shifted.
 
 	// Style configuration for the component.
 	padding           int
@@ -55,6 +56,7 @@ func (c *rollingUpdateComponent) Listen() {
 	for ev := range c.stream {
 		c.mu.Lock()
 		c.deployments = ev.Deployments
+		c.shiftedTraffic = ev.ShiftedTrafficPercent
 		c.failureMsgs = append(c.failureMsgs, ev.LatestFailureEvents...)
 		if len(c.failureMsgs) > c.maxLenFailureMsgs {
 			c.failureMsgs = c.failureMsgs[len(c.failureMsgs)-c.maxLenFailureMsgs:]
@@ -76,6 +78,12 @@ func (c *rollingUpdateComponent) Render(out io.Writer) (numLines int, err error)
 	}
 	numLines += nl
 
+	nl, err = c.renderShiftedTraffic(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
 	nl, err = c.renderFailureMsgs(buf)
 	if err != nil {
 		return 0, err
@@ -116,6 +124,19 @@ func (c *rollingUpdateComponent) renderDeployments(out io.Writer) (numLines int,
 	return nl, err
 }
 
+func (c *rollingUpdateComponent) renderShiftedTraffic(out io.Writer) (numLines int, err error) {
+	if c.shiftedTraffic == nil || len(c.deployments) < 2 {
+		// Traffic is only shifted while the new revision is deployed alongside the previous one.
+		return 0, nil
+	}
+	return renderComponents(out, []Renderer{
+		&singleLineComponent{
+			Text:    fmt.Sprintf("%s %d%%", color.Faint.Sprintf("Traffic shifted to the new revision:"), *c.shiftedTraffic),
+			Padding: c.padding,
+		},
+	})
+}
+
 func (c *rollingUpdateComponent) renderFailureMsgs(out io.Writer) (numLines int, err error) {
 	if len(c.failureMsgs) == 0 {
 		return 0, nil
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/stretchr/testify/require"
 )
@@ -77,8 +78,9 @@ func TestRollingUpdateComponent_Listen(t *testing.T) {
 
 func TestRollingUpdateComponent_Render(t *testing.T) {
 	testCases := map[string]struct {
-		inDeployments []stream.ECSDeployment
-		inFailureMsgs []string
+		inDeployments    []stream.ECSDeployment
+		inFailureMsgs    []string
+		inShiftedTraffic *int
 
 		wantedNumLines int
 		wantedOut      string
@@ -98,6 +100,51 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 			wantedOut: `Deployments
            Revision  Rollout      Desired  Running  Failed  Pending
   PRIMARY  2         [completed]  10       10       0       0
+`,
+		},
+		"should render the traffic shifted to the new revision while deployments are in progress": {
+			inDeployments: []stream.ECSDeployment{
+				{
+					Status:          "PRIMARY",
+					TaskDefRevision: "3",
+					DesiredCount:    10,
+					RunningCount:    10,
+					RolloutState:    "IN_PROGRESS",
+				},
+				{
+					Status:          "ACTIVE",
+					TaskDefRevision: "2",
+					DesiredCount:    10,
+					RunningCount:    10,
+					RolloutState:    "COMPLETED",
+				},
+			},
+			inShiftedTraffic: aws.Int(10),
+
+			wantedNumLines: 5,
+			wantedOut: `Deployments
+           Revision  Rollout        Desired  Running  Failed  Pending
+  PRIMARY  3         [in progress]  10       10       0       0
+  ACTIVE   2         [completed]    10       10       0       0
+Traffic shifted to the new revision: 10%
+`,
+		},
+		"should not render the traffic shifted once the previous deployment is gone": {
+			inDeployments: []stream.ECSDeployment{
+				{
+					Status:          "PRIMARY",
+					TaskDefRevision: "3",
+					DesiredCount:    10,
+					RunningCount:    10,
+					RolloutState:    "COMPLETED",
+				},
+			},
+			inShiftedTraffic: aws.Int(100),
+
+			wantedNumLines: 3,
+			wantedOut: `Deployments
+           Revision  Rollout      Desired  Running  Failed  Pending
+  PRIMARY  3         [completed]  10       10       0       0
 `,
 		},
 		"should render a single failure event": {
@@ -140,8 +187,9 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 			// GIVEN
 			buf := new(strings.Builder)
 			c := &rollingUpdateComponent{
-				deployments: tc.inDeployments,
-				failureMsgs: tc.inFailureMsgs,
+				deployments:    tc.inDeployments,
+				failureMsgs:    tc.inFailureMsgs,
+				shiftedTraffic: tc.inShiftedTraffic,
 			}
 
 			// WHEN
@@ -68,6 +68,7 @@ const (
 	svcNameFinalMsg     = "Service name:"
 	jobNameFinalMsg     = "Job name:"
 	deployedSvcFinalMsg = "Service:"
+	deployedJobFinalMsg = "Job:"
 	taskFinalMsg        = "Task:"
 	workloadFinalMsg    = "Name:"
 	dockerfileFinalMsg  = "Dockerfile:"
@@ -426,6 +427,16 @@ func (s *DeployedService) String() string {
 	return fmt.Sprintf("%s (%s)", s.Svc, s.Env)
 }
 
+// DeployedJob contains the job name and environment name of the deployed job.
+type DeployedJob struct {
+	Name string
+	Env  string
+}
+
+func (j *DeployedJob) String() string {
+	return fmt.Sprintf("%s (%s)", j.Name, j.Env)
+}
+
 // Task has the user select a task. Callers can provide an environment, an app, or a "use default cluster" option
 // to filter the returned tasks.
 func (s *CFTaskSelect) Task(msg, help string, opts ...GetDeployedTaskOpts) (string, error) {
@@ -577,6 +588,79 @@ func (s *DeploySelect) DeployedService(msg, help string, app string, opts ...Get
 	return deployedSvc, nil
 }
 
+// DeployedJob has the user select a deployed job. Callers can provide either a particular environment,
+// a particular job with WithSvc, or both.
+func (s *DeploySelect) DeployedJob(msg, help string, app string, opts ...GetDeployedServiceOpts) (*DeployedJob, error) {
+	for _, opt := range opts {
+		opt(s)
+	}
+	var err error
+	var envNames []string
+	if s.env != "" {
+		envNames = append(envNames, s.env)
+	} else {
+		envNames, err = s.retrieveEnvironments(app)
+		if err != nil {
+			return nil, fmt.Errorf("list environments: %w", err)
+		}
+	}
+	var jobEnvs []*DeployedJob
+	for _, envName := range envNames {
+		var jobNames []string
+		if s.svc != "" {
+			deployed, err := s.deployStoreSvc.IsJobDeployed(app, envName, s.svc)
+			if err != nil {
+				return nil, fmt.Errorf("check if job %s is deployed in environment %s: %w", s.svc, envName, err)
+			}
+			if !deployed {
+				continue
+			}
+			jobNames = append(jobNames, s.svc)
+		} else {
+			jobNames, err = s.deployStoreSvc.ListDeployedJobs(app, envName)
+			if err != nil {
+				return nil, fmt.Errorf("list deployed jobs for environment %s: %w", envName, err)
+			}
+		}
+		for _, jobName := range jobNames {
+			jobEnvs = append(jobEnvs, &DeployedJob{
+				Name: jobName,
+				Env:  envName,
+			})
+		}
+	}
+	if len(jobEnvs) == 0 {
+		return nil, fmt.Errorf("no deployed jobs found in application %s", color.HighlightUserInput(app))
+	}
+	if len(jobEnvs) == 1 {
+		deployedJob := jobEnvs[0]
+		if s.svc == "" && s.env == "" {
+			log.Infof("Found only one deployed job %s in environment %s\n", color.HighlightUserInput(deployedJob.Name), color.HighlightUserInput(deployedJob.Env))
+		}
+		if (s.svc != "") != (s.env != "") {
+			log.Infof("Job %s found in environment %s\n", color.HighlightUserInput(deployedJob.Name), color.HighlightUserInput(deployedJob.Env))
+		}
+		return deployedJob, nil
+	}
+
+	jobEnvNames := make([]string, len(jobEnvs))
+	jobEnvNameMap := map[string]*DeployedJob{}
+	for i, job := range jobEnvs {
+		jobEnvNames[i] = job.String()
+		jobEnvNameMap[jobEnvNames[i]] = job
+	}
+	jobEnvName, err := s.prompt.SelectOne(
+		msg,
+		help,
+		jobEnvNames,
+		prompt.WithFinalMessage(deployedJobFinalMsg),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select deployed jobs for application %s: %w", app, err)
+	}
+	return jobEnvNameMap[jobEnvName], nil
+}
+
 func (s *DeploySelect) filterServices(inServices []*DeployedService) ([]*DeployedService, error) {
 	outServices := inServices
 	for _, filter := range s.filters {
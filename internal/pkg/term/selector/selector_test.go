@@ -483,6 +483,89 @@ func TestDeploySelect_Service(t *testing.T) {
 	}
 }
 
+func TestDeploySelect_Job(t *testing.T) {
+	const testApp = "mockApp"
+	testCases := map[string]struct {
+		setupMocks func(mocks deploySelectMocks)
+		job        string
+		env        string
+
+		wantErr error
+		wantEnv string
+		wantJob string
+	}{
+		"return error if fail to list deployed jobs": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{{Name: "test"}}, nil)
+				m.deploySvc.EXPECT().ListDeployedJobs(testApp, "test").Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list deployed jobs for environment test: some error"),
+		},
+		"return error if no deployed jobs found": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{{Name: "test"}}, nil)
+				m.deploySvc.EXPECT().ListDeployedJobs(testApp, "test").Return([]string{}, nil)
+			},
+			wantErr: fmt.Errorf("no deployed jobs found in application %s", testApp),
+		},
+		"success": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.deploySvc.EXPECT().ListDeployedJobs(testApp, "test").Return([]string{"mockJob"}, nil)
+				m.deploySvc.EXPECT().ListDeployedJobs(testApp, "prod").Return([]string{"mockJob"}, nil)
+				m.prompt.
+					EXPECT().
+					SelectOne("Select a deployed job", "Help text", []string{"mockJob (test)", "mockJob (prod)"}, gomock.Any()).
+					Return("mockJob (prod)", nil)
+			},
+			wantEnv: "prod",
+			wantJob: "mockJob",
+		},
+		"success with flags": {
+			env: "test",
+			job: "mockJob",
+			setupMocks: func(m deploySelectMocks) {
+				m.deploySvc.EXPECT().IsJobDeployed(testApp, "test", "mockJob").Return(true, nil)
+			},
+			wantEnv: "test",
+			wantJob: "mockJob",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockdeploySvc := mocks.NewMockDeployStoreClient(ctrl)
+			mockconfigSvc := mocks.NewMockConfigLister(ctrl)
+			mockprompt := mocks.NewMockPrompter(ctrl)
+			tc.setupMocks(deploySelectMocks{
+				deploySvc: mockdeploySvc,
+				configSvc: mockconfigSvc,
+				prompt:    mockprompt,
+			})
+
+			sel := DeploySelect{
+				Select: &Select{
+					config: mockconfigSvc,
+					prompt: mockprompt,
+				},
+				deployStoreSvc: mockdeploySvc,
+			}
+
+			gotDeployed, err := sel.DeployedJob("Select a deployed job", "Help text", testApp, WithEnv(tc.env), WithSvc(tc.job))
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantJob, gotDeployed.Name)
+				require.Equal(t, tc.wantEnv, gotDeployed.Env)
+			}
+		})
+	}
+}
+
 type workspaceSelectMocks struct {
 	workloadLister *mocks.MockWorkspaceRetriever
 	prompt         *mocks.MockPrompter
@@ -7,6 +7,9 @@
 //  .
 //  ├── copilot                        (application directory)
 //  │   ├── .workspace                 (workspace summary)
+//  │   ├── environments
+//  │   │   └── test
+//  │   │       └── manifest.yml       (environment manifest)
 //  │   └── my-service
 //  │   │   └── manifest.yml           (service manifest)
 //  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
@@ -36,6 +39,7 @@ const (
 	SummaryFileName = ".workspace"
 
 	addonsDirName             = "addons"
+	environmentsDirName       = "environments"
 	pipelinesDirName          = "pipelines"
 	maximumParentDirsToSearch = 5
 	pipelineFileName          = "pipeline.yml"
@@ -165,6 +169,37 @@ func (ws *Workspace) ListWorkloads() ([]string, error) {
 	})
 }
 
+// ListEnvironments returns the names of the environments that have a manifest in the workspace.
+func (ws *Workspace) ListEnvironments() ([]string, error) {
+	copilotPath, err := ws.copilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	envsPath := filepath.Join(copilotPath, environmentsDirName)
+	exists, err := ws.fsUtils.DirExists(envsPath)
+	if err != nil {
+		return nil, fmt.Errorf("check if directory %s exists: %w", envsPath, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	files, err := ws.fsUtils.ReadDir(envsPath)
+	if err != nil {
+		return nil, fmt.Errorf("read directory %s: %w", envsPath, err)
+	}
+	var names []string
+	for _, f := range files {
+		if !f.IsDir() {
+			continue
+		}
+		if exists, _ := ws.fsUtils.Exists(filepath.Join(envsPath, f.Name(), manifestFileName)); !exists {
+			continue
+		}
+		names = append(names, f.Name())
+	}
+	return names, nil
+}
+
 // PipelineManifest holds identifying information about a pipeline manifest file.
 type PipelineManifest struct {
 	Name string // Name of the pipeline inside the manifest file.
@@ -278,7 +313,24 @@ func (ws *Workspace) ReadWorkloadManifest(mftDirName string) (WorkloadManifest,
 		return nil, err
 	}
 	mft := WorkloadManifest(raw)
-	mftName, err := mft.workloadName()
+	mftName, err := manifestName(mft)
+	if err != nil {
+		return nil, err
+	}
+	if mftName != mftDirName {
+		return nil, fmt.Errorf(`name of the manifest "%s" and directory "%s" do not match`, mftName, mftDirName)
+	}
+	return mft, nil
+}
+
+// ReadEnvironmentManifest returns the contents of the environment's manifest under copilot/environments/{name}/manifest.yml.
+func (ws *Workspace) ReadEnvironmentManifest(mftDirName string) (EnvironmentManifest, error) {
+	raw, err := ws.read(environmentsDirName, mftDirName, manifestFileName)
+	if err != nil {
+		return nil, err
+	}
+	mft := EnvironmentManifest(raw)
+	mftName, err := manifestName(mft)
 	if err != nil {
 		return nil, err
 	}
@@ -375,6 +427,17 @@ func (ws *Workspace) ReadAddon(svc, fname string) ([]byte, error) {
 	return ws.read(svc, addonsDirName, fname)
 }
 
+// ReadEnvAddonsDir returns a list of file names under the "environments/addons/" directory.
+// These addons are shared by all the workloads deployed to an environment.
+func (ws *Workspace) ReadEnvAddonsDir() ([]string, error) {
+	return ws.ReadAddonsDir(environmentsDirName)
+}
+
+// ReadEnvAddon returns the contents of a file under the "environments/addons/" directory.
+func (ws *Workspace) ReadEnvAddon(fname string) ([]byte, error) {
+	return ws.read(environmentsDirName, addonsDirName, fname)
+}
+
 // WriteAddon writes the content of an addon file under "{svc}/addons/{name}.yml".
 // If successful returns the full path of the file, otherwise an empty string and an error.
 func (ws *Workspace) WriteAddon(content encoding.BinaryMarshaler, svc, name string) (string, error) {
@@ -578,14 +641,17 @@ func (ws *Workspace) ListDockerfiles() ([]string, error) {
 // WorkloadManifest represents raw local workload manifest.
 type WorkloadManifest []byte
 
-func (w WorkloadManifest) workloadName() (string, error) {
-	wl := struct {
+// EnvironmentManifest represents raw local environment manifest.
+type EnvironmentManifest []byte
+
+func manifestName(raw []byte) (string, error) {
+	mft := struct {
 		Name string `yaml:"name"`
 	}{}
-	if err := yaml.Unmarshal(w, &wl); err != nil {
+	if err := yaml.Unmarshal(raw, &mft); err != nil {
 		return "", fmt.Errorf(`unmarshal manifest file to retrieve "name": %w`, err)
 	}
-	return wl.Name, nil
+	return mft.Name, nil
 }
 
 // WorkloadType returns the workload type of the manifest.
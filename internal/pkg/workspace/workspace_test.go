@@ -512,6 +512,118 @@ type: Scheduled Job`))
 	}
 }
 
+func TestWorkspace_ListEnvironments(t *testing.T) {
+	testCases := map[string]struct {
+		copilotDir string
+		fs         func() afero.Fs
+
+		wantedNames []string
+		wantedErr   error
+	}{
+		"return nil if there is no environments directory": {
+			copilotDir: "/copilot",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.Mkdir("/copilot", 0755)
+				return fs
+			},
+		},
+		"retrieve only directories with manifest files": {
+			copilotDir: "/copilot",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/test", 0755)
+				fs.MkdirAll("/copilot/environments/prod", 0755)
+				fs.MkdirAll("/copilot/environments/addons", 0755)
+				fs.Create("/copilot/environments/test/manifest.yml")
+				fs.Create("/copilot/environments/prod/manifest.yml")
+				fs.Create("/copilot/environments/addons/db.yml")
+				return fs
+			},
+			wantedNames: []string{"prod", "test"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ws := &Workspace{
+				copilotDir: tc.copilotDir,
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			names, err := ws.ListEnvironments()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.ElementsMatch(t, tc.wantedNames, names)
+			}
+		})
+	}
+}
+
+func TestWorkspace_ReadEnvironmentManifest(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedData EnvironmentManifest
+		wantedErr  error
+	}{
+		"return error if the manifest does not exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments", 0755)
+				return fs
+			},
+			wantedErr: errors.New("file /copilot/environments/test/manifest.yml does not exists"),
+		},
+		"return error if directory name and manifest name do not match": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/test", 0755)
+				afero.WriteFile(fs, "/copilot/environments/test/manifest.yml", []byte(`name: prod
+type: Environment`), 0644)
+				return fs
+			},
+			wantedErr: errors.New(`name of the manifest "prod" and directory "test" do not match`),
+		},
+		"read the manifest": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/test", 0755)
+				afero.WriteFile(fs, "/copilot/environments/test/manifest.yml", []byte(`name: test
+type: Environment`), 0644)
+				return fs
+			},
+			wantedData: EnvironmentManifest(`name: test
+type: Environment`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			data, err := ws.ReadEnvironmentManifest("test")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedData, data)
+			}
+		})
+	}
+}
+
 func TestWorkspace_ListPipelines(t *testing.T) {
 	testCases := map[string]struct {
 		copilotDir string
@@ -690,6 +802,57 @@ func TestWorkspace_ReadAddonsDir(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadEnvAddonsDir(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedFileNames []string
+		wantedErr       error
+	}{
+		"dir not exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/test", 0755)
+				return fs
+			},
+			wantedErr: &os.PathError{
+				Op:   "open",
+				Path: "/copilot/environments/addons",
+				Err:  os.ErrNotExist,
+			},
+		},
+		"retrieves file names": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/addons", 0755)
+				afero.WriteFile(fs, "/copilot/environments/addons/addons.parameters.yml", []byte("Parameters:"), 0644)
+				afero.WriteFile(fs, "/copilot/environments/addons/db.yml", []byte("Resources:"), 0644)
+				return fs
+			},
+			wantedFileNames: []string{"addons.parameters.yml", "db.yml"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			// WHEN
+			actualFileNames, actualErr := ws.ReadEnvAddonsDir()
+
+			// THEN
+			require.Equal(t, tc.wantedErr, actualErr)
+			require.Equal(t, tc.wantedFileNames, actualFileNames)
+		})
+	}
+}
+
 func TestWorkspace_WriteAddon(t *testing.T) {
 	testCases := map[string]struct {
 		marshaler   mockBinaryMarshaler